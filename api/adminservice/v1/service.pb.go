@@ -54,60 +54,73 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0xc7, 0x33, 0x97, 0xd5, 0x6a, 0xd4, 0x7d, 0xf3, 0xae, 0x56, 0xbb, 0x3d, 0xb8, 0xab, 0xe5,
-	0x9e, 0xa8, 0x05, 0x0a, 0x7d, 0x6f, 0x9a, 0x86, 0x54, 0x22, 0x01, 0x9a, 0xf0, 0x22, 0x71, 0x41,
-	0x93, 0xf8, 0x69, 0x6b, 0xd5, 0x89, 0xcd, 0xcc, 0x38, 0xa5, 0x27, 0xb8, 0x20, 0x21, 0x21, 0x21,
-	0x90, 0x90, 0x90, 0x90, 0x38, 0x71, 0x01, 0x89, 0xcf, 0x80, 0xc4, 0x8d, 0x0b, 0x52, 0x8f, 0x3d,
-	0xd2, 0xf4, 0xc2, 0xb1, 0x1f, 0x01, 0xb9, 0xce, 0x4c, 0xed, 0x64, 0xa8, 0x66, 0x9c, 0xde, 0x9a,
-	0xda, 0xbf, 0xff, 0xf3, 0xcb, 0x13, 0xcf, 0x3c, 0x93, 0xe0, 0x49, 0x0e, 0xed, 0xc0, 0xa7, 0xc4,
-	0x2b, 0x30, 0xa0, 0x5d, 0xa0, 0x05, 0x12, 0xb8, 0x05, 0xe2, 0xb4, 0xdd, 0x4e, 0xf4, 0xda, 0x6d,
-	0x41, 0xa1, 0x3b, 0x59, 0xe8, 0xff, 0x99, 0x0f, 0xa8, 0xcf, 0x7d, 0xeb, 0x9c, 0x40, 0xf2, 0x31,
-	0x92, 0x27, 0x81, 0x9b, 0x4f, 0x22, 0xf9, 0xee, 0xe4, 0xf8, 0xac, 0x4e, 0x2e, 0x85, 0xfb, 0x21,
-	0x30, 0x7e, 0x8f, 0x02, 0x0b, 0xfc, 0x0e, 0xeb, 0x17, 0x98, 0xfa, 0x32, 0x81, 0xc7, 0x8a, 0xd1,
-	0xad, 0x8d, 0xf8, 0x56, 0xeb, 0x35, 0xc2, 0x7f, 0xd6, 0xa1, 0x19, 0xba, 0x9e, 0x53, 0x0b, 0x39,
-	0x69, 0x7a, 0xd0, 0xe0, 0x84, 0x83, 0xb5, 0x94, 0xd7, 0x50, 0xc9, 0x2b, 0xc8, 0x7a, 0x5c, 0x78,
-	0x7c, 0x39, 0x7b, 0x40, 0x6c, 0xfc, 0x7f, 0xce, 0x7a, 0x83, 0xf0, 0x5f, 0xab, 0xc0, 0x5a, 0xd4,
-	0x6d, 0x42, 0xca, 0x4e, 0x2f, 0x5c, 0x85, 0x0a, 0xbd, 0xe2, 0x08, 0x09, 0xd2, 0x2f, 0x6a, 0x9e,
-	0xb8, 0x65, 0xcd, 0x65, 0xdc, 0xa7, 0xbb, 0x6b, 0x3e, 0xe3, 0x9a, 0xcd, 0x53, 0x90, 0x66, 0xcd,
-	0x53, 0x06, 0x48, 0xb9, 0x5d, 0xfc, 0x73, 0x05, 0x78, 0x63, 0x8b, 0x50, 0xc7, 0xba, 0xa0, 0x95,
-	0x27, 0x6e, 0x17, 0x16, 0x17, 0x0d, 0x29, 0x59, 0xfa, 0x21, 0xc6, 0x25, 0xcf, 0x67, 0x10, 0x17,
-	0x9f, 0xd6, 0x8a, 0x39, 0x01, 0x44, 0xf9, 0x4b, 0xc6, 0x9c, 0x14, 0x78, 0x81, 0xf0, 0xef, 0x55,
-	0x97, 0xf1, 0x7e, 0x67, 0x6e, 0x12, 0xb6, 0xcd, 0xac, 0x79, 0xad, 0xbc, 0x41, 0x4c, 0xd8, 0x2c,
-	0x64, 0xa4, 0x93, 0x4d, 0xa9, 0x43, 0xdb, 0xef, 0x42, 0x74, 0x41, 0xb3, 0x29, 0x27, 0x80, 0x59,
-	0x53, 0x92, 0x9c, 0x14, 0xf8, 0x84, 0xf0, 0x7f, 0x15, 0xe0, 0x77, 0x7c, 0xba, 0xbd, 0xe1, 0xf9,
-	0x3b, 0xe5, 0x07, 0xd0, 0x0a, 0xb9, 0xeb, 0x77, 0xea, 0x64, 0xa7, 0xaf, 0x7c, 0x7b, 0xca, 0xaa,
-	0xea, 0x7e, 0xe6, 0xa7, 0xc6, 0x08, 0xdb, 0xda, 0x19, 0xa5, 0xc9, 0xf7, 0xf0, 0x16, 0xe1, 0xbf,
-	0x2b, 0xc0, 0xeb, 0x10, 0x78, 0x6e, 0x8b, 0x44, 0x37, 0xd6, 0x80, 0x31, 0xb2, 0x09, 0xcc, 0x5a,
-	0xd1, 0xad, 0xa5, 0x80, 0x85, 0x6f, 0x69, 0xa4, 0x0c, 0x69, 0xf9, 0x11, 0xe1, 0x89, 0x0a, 0xf0,
-	0x6b, 0xa4, 0x0d, 0x2c, 0x20, 0x2d, 0x50, 0xe9, 0x5e, 0xd5, 0x2d, 0x75, 0x5a, 0x8a, 0xf0, 0xae,
-	0x9e, 0x4d, 0x98, 0x7c, 0x03, 0x1f, 0x10, 0xfe, 0xb7, 0x02, 0x7c, 0xb5, 0xba, 0xae, 0x52, 0x2f,
-	0xeb, 0x56, 0x53, 0xf3, 0x42, 0xfa, 0xca, 0xa8, 0x31, 0x52, 0xf7, 0x09, 0xc2, 0xbf, 0xd4, 0x81,
-	0x04, 0x81, 0xb7, 0x5b, 0xee, 0x42, 0x87, 0x33, 0x6b, 0x46, 0x73, 0x99, 0x24, 0x18, 0xa1, 0x35,
-	0x9b, 0x05, 0x4d, 0x8d, 0x84, 0xa2, 0xe3, 0x34, 0x80, 0xd0, 0xd6, 0x56, 0x91, 0x73, 0xea, 0x36,
-	0x43, 0x0e, 0x4c, 0x73, 0x24, 0x28, 0x48, 0xb3, 0x91, 0xa0, 0x0c, 0x48, 0xad, 0x9e, 0x78, 0x6b,
-	0x18, 0xf2, 0x5b, 0x31, 0xd8, 0x57, 0x7e, 0xa4, 0x58, 0x1a, 0x29, 0x23, 0xd5, 0xc2, 0x68, 0xa8,
-	0x64, 0x6b, 0xa1, 0x82, 0x34, 0x6b, 0xa1, 0x32, 0x40, 0xca, 0x3d, 0x43, 0xf8, 0x37, 0x31, 0x77,
-	0x4b, 0x5e, 0xc8, 0x38, 0x50, 0x6b, 0xce, 0x68, 0x5a, 0xf7, 0x29, 0x21, 0x35, 0x9f, 0x0d, 0x96,
-	0x42, 0x8f, 0x11, 0x1e, 0x8b, 0xa6, 0x4e, 0xff, 0x0a, 0xb3, 0x2e, 0x6b, 0x0f, 0x2a, 0x81, 0x08,
-	0x95, 0x99, 0x0c, 0xa4, 0xf4, 0x78, 0x85, 0xb0, 0x95, 0xb8, 0x54, 0x83, 0x76, 0x33, 0xb2, 0x59,
-	0x34, 0xcd, 0xec, 0x83, 0xc2, 0x69, 0x29, 0x33, 0x2f, 0xcd, 0xde, 0x23, 0xfc, 0x4f, 0xd1, 0x71,
-	0xae, 0xd3, 0x5b, 0x81, 0x73, 0x7c, 0x7e, 0x6b, 0xfb, 0x5c, 0x7e, 0x76, 0xab, 0xba, 0xcb, 0x4a,
-	0x89, 0x0b, 0xcb, 0xf2, 0x88, 0x29, 0xa9, 0x67, 0x3f, 0x5e, 0x20, 0x69, 0xcd, 0x25, 0x83, 0xa5,
-	0xa5, 0x34, 0x5c, 0xce, 0x1e, 0x20, 0xe5, 0x9e, 0x22, 0xfc, 0x6b, 0xbc, 0x1d, 0xcb, 0x51, 0x30,
-	0x6b, 0xb0, 0x87, 0x0f, 0xee, 0xff, 0x73, 0x99, 0xd8, 0xd4, 0x19, 0xef, 0x46, 0x48, 0x37, 0x21,
-	0xe9, 0xa3, 0xb7, 0x9a, 0x06, 0x31, 0xb3, 0x33, 0xde, 0x30, 0x9d, 0x72, 0xaa, 0x41, 0x26, 0xa7,
-	0x41, 0xcc, 0xcc, 0x69, 0x98, 0x4e, 0x7d, 0x89, 0xaa, 0xc3, 0x06, 0x05, 0xb6, 0x25, 0x4e, 0x59,
-	0xf1, 0x79, 0x58, 0xf7, 0x91, 0x18, 0x46, 0xcd, 0xbe, 0x44, 0xa9, 0x13, 0x06, 0x86, 0x12, 0x83,
-	0x8e, 0x93, 0x18, 0xf2, 0xb1, 0xa1, 0xee, 0x50, 0x52, 0xc1, 0xa6, 0x43, 0x49, 0x9d, 0x21, 0x2d,
-	0x5f, 0x22, 0xfc, 0x47, 0x05, 0x78, 0xf4, 0xef, 0xf5, 0x10, 0x42, 0x88, 0x05, 0x17, 0x74, 0x1f,
-	0xe1, 0x34, 0x27, 0xdc, 0x16, 0xb3, 0xe2, 0x42, 0x6b, 0xc5, 0xdb, 0x3b, 0xb0, 0x73, 0xfb, 0x07,
-	0x76, 0xee, 0xe8, 0xc0, 0x46, 0x8f, 0x7a, 0x36, 0x7a, 0xd7, 0xb3, 0xd1, 0xe7, 0x9e, 0x8d, 0xf6,
-	0x7a, 0x36, 0xfa, 0xda, 0xb3, 0xd1, 0xb7, 0x9e, 0x9d, 0x3b, 0xea, 0xd9, 0xe8, 0xf9, 0xa1, 0x9d,
-	0xdb, 0x3b, 0xb4, 0x73, 0xfb, 0x87, 0x76, 0xee, 0xee, 0xf4, 0xa6, 0x7f, 0x52, 0xd9, 0xf5, 0x4f,
-	0xf9, 0x1d, 0x61, 0x2e, 0xf9, 0xba, 0xf9, 0xd3, 0xf1, 0x8f, 0x08, 0xe7, 0xbf, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x69, 0x17, 0x5e, 0xcb, 0xda, 0x10, 0x00, 0x00,
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x8b, 0x23, 0x45,
+	0x18, 0xc7, 0x53, 0x17, 0x91, 0x62, 0x7d, 0x6b, 0xc5, 0x97, 0x15, 0x5a, 0xd1, 0xab, 0x24, 0xcc,
+	0xaa, 0xab, 0x3b, 0xb3, 0xb3, 0xb3, 0x79, 0x33, 0x23, 0x4e, 0xdc, 0xd9, 0xc4, 0x17, 0xf0, 0x22,
+	0x95, 0xee, 0x67, 0x27, 0xcd, 0x74, 0x52, 0x6d, 0x55, 0x75, 0xc6, 0x39, 0xe9, 0x45, 0x10, 0x04,
+	0x51, 0x10, 0x04, 0xc1, 0x93, 0x20, 0x0a, 0x9e, 0xfc, 0x00, 0x82, 0x37, 0x8f, 0x73, 0xdc, 0xa3,
+	0x93, 0xb9, 0x78, 0xdc, 0x83, 0x1f, 0x60, 0xe9, 0xed, 0x54, 0x4d, 0xaa, 0x53, 0x19, 0xaa, 0xba,
+	0xf7, 0x36, 0x99, 0xae, 0xdf, 0xbf, 0x7e, 0x79, 0xd2, 0x55, 0x4f, 0x75, 0xe3, 0x0d, 0x01, 0x93,
+	0x84, 0x32, 0x12, 0x37, 0x38, 0xb0, 0x19, 0xb0, 0x06, 0x49, 0xa2, 0x06, 0x09, 0x27, 0xd1, 0x34,
+	0xfb, 0x1c, 0x05, 0xd0, 0x98, 0x6d, 0x34, 0x16, 0x7f, 0xd6, 0x13, 0x46, 0x05, 0xf5, 0x5e, 0x95,
+	0x48, 0x3d, 0x47, 0xea, 0x24, 0x89, 0xea, 0xcb, 0x48, 0x7d, 0xb6, 0x71, 0x79, 0xd3, 0x26, 0x97,
+	0xc1, 0x67, 0x29, 0x70, 0xf1, 0x29, 0x03, 0x9e, 0xd0, 0x29, 0x5f, 0x4c, 0x70, 0xe5, 0xff, 0xd7,
+	0xf0, 0xa5, 0x66, 0x36, 0x74, 0x98, 0x0f, 0xf5, 0x7e, 0x42, 0xf8, 0xe9, 0x01, 0x8c, 0xd2, 0x28,
+	0x0e, 0xfb, 0xa9, 0x20, 0xa3, 0x18, 0x86, 0x82, 0x08, 0xf0, 0x76, 0xea, 0x16, 0x2a, 0x75, 0x03,
+	0x39, 0xc8, 0x27, 0xbe, 0x7c, 0xb3, 0x7c, 0x40, 0x6e, 0xfc, 0x4a, 0xcd, 0xfb, 0x19, 0xe1, 0x67,
+	0x3a, 0xc0, 0x03, 0x16, 0x8d, 0x40, 0xb3, 0xb3, 0x0b, 0x37, 0xa1, 0x52, 0xaf, 0x59, 0x21, 0x41,
+	0xf9, 0x65, 0xc5, 0x93, 0x43, 0x76, 0x23, 0x2e, 0x28, 0x3b, 0xde, 0xa5, 0x5c, 0x58, 0x16, 0xcf,
+	0x40, 0xba, 0x15, 0xcf, 0x18, 0xa0, 0xe4, 0x8e, 0xf1, 0xa3, 0x3d, 0x10, 0xc3, 0x31, 0x61, 0xa1,
+	0xf7, 0x86, 0x55, 0x9e, 0x1c, 0x2e, 0x2d, 0xde, 0x74, 0xa4, 0xd4, 0xd4, 0x5f, 0x60, 0xdc, 0x8e,
+	0x29, 0x87, 0x7c, 0xf2, 0xab, 0x56, 0x31, 0xe7, 0x80, 0x9c, 0xfe, 0x2d, 0x67, 0x4e, 0x09, 0x7c,
+	0x8f, 0xf0, 0x93, 0x7b, 0x11, 0x17, 0x8b, 0xca, 0x7c, 0x40, 0xf8, 0x21, 0xf7, 0xae, 0x5b, 0xe5,
+	0x15, 0x31, 0x69, 0xb3, 0x5d, 0x92, 0x5e, 0x2e, 0xca, 0x00, 0x26, 0x74, 0x06, 0xd9, 0x05, 0xcb,
+	0xa2, 0x9c, 0x03, 0x6e, 0x45, 0x59, 0xe6, 0x94, 0xc0, 0xdf, 0x08, 0xbf, 0xdc, 0x03, 0xf1, 0x31,
+	0x65, 0x87, 0x77, 0x62, 0x7a, 0xd4, 0xfd, 0x1c, 0x82, 0x54, 0x44, 0x74, 0x3a, 0x20, 0x47, 0x0b,
+	0xe5, 0x8f, 0xae, 0x78, 0x7b, 0xb6, 0xbf, 0xf9, 0x85, 0x31, 0xd2, 0xb6, 0xff, 0x90, 0xd2, 0xd4,
+	0x77, 0xf8, 0x05, 0xe1, 0x67, 0x7b, 0x20, 0x06, 0x90, 0xc4, 0x51, 0x40, 0xb2, 0x81, 0x7d, 0xe0,
+	0x9c, 0x1c, 0x00, 0xf7, 0x5a, 0xb6, 0x73, 0x19, 0x60, 0xe9, 0xdb, 0xae, 0x94, 0xa1, 0x2c, 0xff,
+	0x42, 0xf8, 0xa5, 0x1e, 0x88, 0xf7, 0xc9, 0x04, 0x78, 0x42, 0x02, 0x30, 0xe9, 0xbe, 0x67, 0x3b,
+	0xd5, 0x45, 0x29, 0xd2, 0x7b, 0xef, 0xe1, 0x84, 0xa9, 0x2f, 0xf0, 0x07, 0xc2, 0x2f, 0xf4, 0x40,
+	0x74, 0xf6, 0x6e, 0x9b, 0xd4, 0xbb, 0xb6, 0xb3, 0x99, 0x79, 0x29, 0xfd, 0x4e, 0xd5, 0x18, 0xa5,
+	0xfb, 0x35, 0xc2, 0x8f, 0x0d, 0x80, 0x24, 0x49, 0x7c, 0xdc, 0x9d, 0xc1, 0x54, 0x70, 0xef, 0x9a,
+	0xe5, 0x32, 0x59, 0x62, 0xa4, 0xd6, 0x66, 0x19, 0x54, 0x6b, 0x09, 0xcd, 0x30, 0x1c, 0x02, 0x61,
+	0xc1, 0xb8, 0x29, 0x04, 0x8b, 0x46, 0xa9, 0x00, 0x6e, 0xd9, 0x12, 0x0c, 0xa4, 0x5b, 0x4b, 0x30,
+	0x06, 0x68, 0xab, 0x27, 0xdf, 0x1a, 0x56, 0xfc, 0x5a, 0x0e, 0xfb, 0xca, 0x3a, 0xc5, 0x76, 0xa5,
+	0x0c, 0xad, 0x84, 0x59, 0x53, 0x29, 0x57, 0x42, 0x03, 0xe9, 0x56, 0x42, 0x63, 0x80, 0x92, 0xfb,
+	0x16, 0xe1, 0x27, 0x64, 0xdf, 0x6d, 0xc7, 0x29, 0x17, 0xc0, 0xbc, 0x2d, 0xa7, 0x6e, 0xbd, 0xa0,
+	0xa4, 0xd4, 0xf5, 0x72, 0xb0, 0x12, 0xfa, 0x0a, 0xe1, 0x4b, 0x59, 0xd7, 0x59, 0x5c, 0xe1, 0xde,
+	0xdb, 0xd6, 0x8d, 0x4a, 0x22, 0x52, 0xe5, 0x5a, 0x09, 0x52, 0x79, 0xfc, 0x88, 0xb0, 0xb7, 0x74,
+	0xa9, 0x0f, 0x93, 0x51, 0x66, 0x73, 0xc3, 0x35, 0x73, 0x01, 0x4a, 0xa7, 0x9d, 0xd2, 0xbc, 0x32,
+	0xfb, 0x1d, 0xe1, 0xe7, 0x9b, 0x61, 0x78, 0x8b, 0x7d, 0x98, 0x84, 0x0f, 0xce, 0x6f, 0x13, 0x2a,
+	0xd4, 0x6f, 0xd7, 0xb1, 0x5d, 0x56, 0x46, 0x5c, 0x5a, 0x76, 0x2b, 0xa6, 0x68, 0xf7, 0x7e, 0xbe,
+	0x40, 0x74, 0xcd, 0x1d, 0x87, 0xa5, 0x65, 0x34, 0xbc, 0x59, 0x3e, 0x40, 0xc9, 0x7d, 0x83, 0xf0,
+	0xe3, 0xf9, 0x76, 0xac, 0x5a, 0xc1, 0xa6, 0xc3, 0x1e, 0x5e, 0xdc, 0xff, 0xb7, 0x4a, 0xb1, 0xda,
+	0x19, 0x6f, 0x3f, 0x65, 0x07, 0xb0, 0xec, 0x63, 0xb7, 0x9a, 0x8a, 0x98, 0xdb, 0x19, 0x6f, 0x95,
+	0xd6, 0x9c, 0xfa, 0x50, 0xca, 0xa9, 0x0f, 0x55, 0x9c, 0xfa, 0xb0, 0xd6, 0x29, 0x7b, 0x88, 0x1a,
+	0xc0, 0x1d, 0x06, 0x7c, 0x2c, 0x4f, 0x59, 0xf9, 0x79, 0xd8, 0xf6, 0x96, 0x58, 0x45, 0xdd, 0x1e,
+	0xa2, 0xcc, 0x09, 0x85, 0xa6, 0xc4, 0x61, 0x1a, 0x2e, 0x35, 0xf9, 0xdc, 0xd0, 0xb6, 0x29, 0x99,
+	0x60, 0xd7, 0xa6, 0x64, 0xce, 0x50, 0x96, 0x3f, 0x20, 0xfc, 0x54, 0x0f, 0x44, 0xf6, 0xef, 0xdb,
+	0x29, 0xa4, 0x90, 0x0b, 0x6e, 0xdb, 0xde, 0xc2, 0x3a, 0x27, 0xdd, 0x6e, 0x94, 0xc5, 0xb5, 0x25,
+	0xd9, 0x66, 0x40, 0x04, 0x0c, 0x83, 0x31, 0x84, 0x69, 0x0c, 0x96, 0x4b, 0x52, 0x87, 0xdc, 0x96,
+	0x64, 0x91, 0xd5, 0x6e, 0x7f, 0xd9, 0xa9, 0x94, 0x8f, 0x5b, 0x83, 0x2b, 0x1a, 0x6d, 0x97, 0xa4,
+	0xb5, 0x0a, 0xe5, 0x7b, 0xae, 0x63, 0x85, 0x74, 0xc8, 0xad, 0x42, 0x45, 0x56, 0x3b, 0xa9, 0xee,
+	0x13, 0x11, 0x8c, 0x95, 0x8c, 0x5d, 0xd3, 0xd5, 0x18, 0xb7, 0x93, 0x6a, 0x01, 0xd5, 0x0a, 0xd3,
+	0x81, 0x18, 0x9c, 0x0b, 0xa3, 0x43, 0x6e, 0x85, 0x29, 0xb2, 0x5a, 0x61, 0xb2, 0x2e, 0x2e, 0x2f,
+	0xd9, 0x1e, 0xe1, 0x35, 0xc6, 0xad, 0x30, 0x05, 0x54, 0xeb, 0xc1, 0xfb, 0x0c, 0x66, 0x11, 0x1c,
+	0xc9, 0xcb, 0xc3, 0x04, 0x02, 0xcb, 0x1e, 0x6c, 0x20, 0xdd, 0x7a, 0xb0, 0x31, 0x40, 0xc9, 0xfd,
+	0x8a, 0xf0, 0x73, 0x6d, 0x9a, 0x4e, 0x57, 0x9f, 0x98, 0xb9, 0x67, 0xb7, 0xd5, 0xad, 0xa1, 0xa5,
+	0x64, 0xa7, 0x5a, 0x88, 0x12, 0xfd, 0x13, 0xe1, 0x17, 0xf3, 0x65, 0x90, 0x0d, 0x03, 0xd6, 0xca,
+	0xde, 0xf3, 0xbd, 0x1b, 0xde, 0x62, 0x21, 0xb0, 0x68, 0x7a, 0xe0, 0xf5, 0x1c, 0x16, 0x92, 0x31,
+	0x41, 0x0a, 0xef, 0x56, 0x0f, 0xd2, 0x8e, 0x8a, 0x8b, 0xb7, 0x11, 0xab, 0xc6, 0x1d, 0x97, 0x97,
+	0x19, 0x6b, 0x75, 0xbb, 0x15, 0x53, 0x34, 0x57, 0xb9, 0xef, 0xa9, 0xfe, 0xd0, 0x22, 0xc1, 0x61,
+	0x4c, 0x6d, 0x5d, 0xd7, 0xe1, 0x6e, 0xae, 0xeb, 0x53, 0xa4, 0x6b, 0x2b, 0x3e, 0x39, 0xf5, 0x6b,
+	0x77, 0x4f, 0xfd, 0xda, 0xbd, 0x53, 0x1f, 0x7d, 0x39, 0xf7, 0xd1, 0x6f, 0x73, 0x1f, 0xfd, 0x33,
+	0xf7, 0xd1, 0xc9, 0xdc, 0x47, 0xff, 0xce, 0x7d, 0xf4, 0xdf, 0xdc, 0xaf, 0xdd, 0x9b, 0xfb, 0xe8,
+	0xbb, 0x33, 0xbf, 0x76, 0x72, 0xe6, 0xd7, 0xee, 0x9e, 0xf9, 0xb5, 0x4f, 0xae, 0x1e, 0xd0, 0x73,
+	0x81, 0x88, 0x5e, 0xf0, 0xba, 0x7b, 0x6b, 0xf9, 0xf3, 0xe8, 0x91, 0x07, 0xef, 0xba, 0x5f, 0xbf,
+	0x3f, 0x00, 0xe6, 0x91, 0xf4, 0x44, 0x81, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +189,31 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// CreateSchedule creates a new schedule.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the schedule specification and current state of a schedule.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the specification, action, policies and state of a schedule.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	// PatchSchedule makes a one-off change to a schedule: triggers an action immediately, backfills a time range,
+	// or pauses or unpauses it.
+	PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules in a namespace.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// PreviewScheduleSpec validates a schedule spec and returns its upcoming action times, without creating a
+	// schedule.
+	PreviewScheduleSpec(ctx context.Context, in *PreviewScheduleSpecRequest, opts ...grpc.CallOption) (*PreviewScheduleSpecResponse, error)
+	// CountWorkflowExecutions counts workflow executions which match the query. Unlike the workflow service API,
+	// it also returns the number of executions for each value of the GROUP BY search attribute.
+	CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a workflow task queue.
+	UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the worker build id version sets of a workflow task queue.
+	GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error)
+	// DescribeTaskQueueBacklog returns the backlog stats of a task queue aggregated across its partitions.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
 }
 
 type adminServiceClient struct {
@@ -420,6 +458,105 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error) {
+	out := new(PatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewScheduleSpec(ctx context.Context, in *PreviewScheduleSpecRequest, opts ...grpc.CallOption) (*PreviewScheduleSpecResponse, error) {
+	out := new(PreviewScheduleSpecResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PreviewScheduleSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CountWorkflowExecutions(ctx context.Context, in *CountWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	out := new(CountWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	out := new(UpdateWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error) {
+	out := new(GetWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -476,6 +613,31 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// CreateSchedule creates a new schedule.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the schedule specification and current state of a schedule.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the specification, action, policies and state of a schedule.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	// PatchSchedule makes a one-off change to a schedule: triggers an action immediately, backfills a time range,
+	// or pauses or unpauses it.
+	PatchSchedule(context.Context, *PatchScheduleRequest) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules in a namespace.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// PreviewScheduleSpec validates a schedule spec and returns its upcoming action times, without creating a
+	// schedule.
+	PreviewScheduleSpec(context.Context, *PreviewScheduleSpecRequest) (*PreviewScheduleSpecResponse, error)
+	// CountWorkflowExecutions counts workflow executions which match the query. Unlike the workflow service API,
+	// it also returns the number of executions for each value of the GROUP BY search attribute.
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a workflow task queue.
+	UpdateWorkerBuildIdOrdering(context.Context, *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the worker build id version sets of a workflow task queue.
+	GetWorkerBuildIdOrdering(context.Context, *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error)
+	// DescribeTaskQueueBacklog returns the backlog stats of a task queue aggregated across its partitions.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -560,6 +722,39 @@ func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Conte
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedule(ctx context.Context, req *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedule(ctx context.Context, req *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) PatchSchedule(ctx context.Context, req *PatchScheduleRequest) (*PatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (*UnimplementedAdminServiceServer) PreviewScheduleSpec(ctx context.Context, req *PreviewScheduleSpecRequest) (*PreviewScheduleSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewScheduleSpec not implemented")
+}
+func (*UnimplementedAdminServiceServer) CountWorkflowExecutions(ctx context.Context, req *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkflowExecutions not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkerBuildIdOrdering(ctx context.Context, req *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkerBuildIdOrdering(ctx context.Context, req *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTaskQueueBacklog(ctx context.Context, req *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -1033,6 +1228,204 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PatchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PatchSchedule(ctx, req.(*PatchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewScheduleSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewScheduleSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewScheduleSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PreviewScheduleSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewScheduleSpec(ctx, req.(*PreviewScheduleSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CountWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CountWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CountWorkflowExecutions(ctx, req.(*CountWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdOrdering(ctx, req.(*UpdateWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkerBuildIdOrdering(ctx, req.(*GetWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1141,6 +1534,50 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _AdminService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _AdminService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PatchSchedule",
+			Handler:    _AdminService_PatchSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _AdminService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _AdminService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "PreviewScheduleSpec",
+			Handler:    _AdminService_PreviewScheduleSpec_Handler,
+		},
+		{
+			MethodName: "CountWorkflowExecutions",
+			Handler:    _AdminService_CountWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerBuildIdOrdering",
+			Handler:    _AdminService_UpdateWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdOrdering",
+			Handler:    _AdminService_GetWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _AdminService_DescribeTaskQueueBacklog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
@@ -52,18 +52,21 @@ const (
 	DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED DeadLetterQueueType = 0
 	DEAD_LETTER_QUEUE_TYPE_REPLICATION DeadLetterQueueType = 1
 	DEAD_LETTER_QUEUE_TYPE_NAMESPACE   DeadLetterQueueType = 2
+	DEAD_LETTER_QUEUE_TYPE_VISIBILITY  DeadLetterQueueType = 3
 )
 
 var DeadLetterQueueType_name = map[int32]string{
 	0: "Unspecified",
 	1: "Replication",
 	2: "Namespace",
+	3: "Visibility",
 }
 
 var DeadLetterQueueType_value = map[string]int32{
 	"Unspecified": 0,
 	"Replication": 1,
 	"Namespace":   2,
+	"Visibility":  3,
 }
 
 func (DeadLetterQueueType) EnumDescriptor() ([]byte, []int) {
@@ -101,28 +104,29 @@ func init() {
 }
 
 var fileDescriptor_4a3bfa9c01eff6e4 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0x31, 0x4b, 0xf3, 0x40,
-	0x1c, 0xc7, 0xf1, 0xdc, 0x33, 0x3c, 0xc3, 0x0d, 0x12, 0xe2, 0xa8, 0x9c, 0x22, 0x22, 0x5a, 0x30,
-	0xa1, 0x76, 0x74, 0x4a, 0x2f, 0xff, 0x62, 0x30, 0x4d, 0xd2, 0x6b, 0x52, 0xa8, 0x83, 0x47, 0x6c,
-	0x0f, 0x2d, 0x36, 0xbd, 0x90, 0x26, 0x01, 0x37, 0x77, 0x17, 0x5f, 0x86, 0x2f, 0xc5, 0xb1, 0x63,
-	0x47, 0x7b, 0x5d, 0x1c, 0xfb, 0x12, 0x84, 0x8a, 0x0e, 0x45, 0xdd, 0x7e, 0xc3, 0x67, 0xf8, 0xf1,
-	0xc5, 0x27, 0x85, 0x48, 0x33, 0x99, 0x27, 0x63, 0x6b, 0x2a, 0xf2, 0x4a, 0xe4, 0x56, 0x92, 0x8d,
-	0x2c, 0x31, 0x29, 0xd3, 0xa9, 0x55, 0xd5, 0xad, 0x81, 0x4c, 0x53, 0x39, 0x31, 0xb3, 0x5c, 0x16,
-	0xd2, 0xd8, 0xfd, 0xa2, 0xe6, 0x27, 0x35, 0x93, 0x6c, 0x64, 0xae, 0xa9, 0x59, 0xd5, 0x6b, 0x4f,
-	0x08, 0x6f, 0x3b, 0x22, 0x19, 0x7a, 0xa2, 0x28, 0x44, 0xde, 0x29, 0x45, 0x29, 0xa2, 0x87, 0x4c,
-	0x18, 0x47, 0xf8, 0xc0, 0x01, 0xdb, 0xe1, 0x1e, 0x44, 0x11, 0x30, 0xde, 0x89, 0x21, 0x06, 0x1e,
-	0xf5, 0x43, 0xe0, 0xb1, 0xdf, 0x0d, 0x81, 0xba, 0x2d, 0x17, 0x1c, 0x5d, 0xfb, 0xc3, 0x31, 0x08,
-	0x3d, 0x97, 0xda, 0x91, 0x1b, 0xf8, 0x3a, 0x32, 0x0e, 0xf1, 0xfe, 0x2f, 0xce, 0xb7, 0xdb, 0xd0,
-	0x0d, 0x6d, 0x0a, 0xfa, 0xbf, 0xda, 0x10, 0x6f, 0xd1, 0x3b, 0x31, 0xb8, 0x9f, 0x96, 0x69, 0x6b,
-	0x9c, 0x54, 0x32, 0x37, 0xf6, 0xf0, 0x0e, 0xbd, 0x00, 0x7a, 0xd9, 0x8d, 0xdb, 0xbc, 0xe5, 0xd9,
-	0xbd, 0x80, 0x6d, 0x1c, 0xa8, 0xe3, 0xd3, 0x4d, 0xe0, 0x02, 0x00, 0xa7, 0x8c, 0x36, 0xce, 0x78,
-	0xd0, 0x03, 0xc6, 0x43, 0x16, 0x44, 0x41, 0x83, 0x37, 0x5d, 0xdf, 0x66, 0x7d, 0x1d, 0x35, 0xaf,
-	0x67, 0x0b, 0xa2, 0xcd, 0x17, 0x44, 0x5b, 0x2d, 0x08, 0x7a, 0x54, 0x04, 0xbd, 0x28, 0x82, 0x5e,
-	0x15, 0x41, 0x33, 0x45, 0xd0, 0x9b, 0x22, 0xe8, 0x5d, 0x11, 0x6d, 0xa5, 0x08, 0x7a, 0x5e, 0x12,
-	0x6d, 0xb6, 0x24, 0xda, 0x7c, 0x49, 0xb4, 0xab, 0xe3, 0x5b, 0x69, 0x7e, 0xa7, 0x1c, 0xc9, 0x9f,
-	0xc2, 0x9f, 0xaf, 0xc7, 0xcd, 0xff, 0x75, 0xf8, 0xc6, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x8a, 0xe1, 0xa4, 0xa5, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd1, 0xbb, 0x4e, 0xe3, 0x40,
+	0x14, 0xc6, 0x71, 0xcf, 0xae, 0xb4, 0xc5, 0x14, 0x2b, 0xcb, 0x5b, 0x2e, 0x1a, 0x2e, 0x02, 0x04,
+	0x91, 0xb0, 0x15, 0x52, 0x52, 0x39, 0xe3, 0x13, 0x31, 0xc2, 0xb1, 0x1d, 0x5f, 0x22, 0x85, 0x82,
+	0x91, 0x49, 0x46, 0x10, 0x11, 0x67, 0x2c, 0xc7, 0xb6, 0x44, 0xc7, 0x23, 0xf0, 0x18, 0xd4, 0x3c,
+	0x05, 0x65, 0xca, 0x94, 0xc4, 0x69, 0x28, 0xf3, 0x08, 0x48, 0x41, 0x50, 0x44, 0x84, 0xee, 0x14,
+	0xbf, 0xe2, 0xe8, 0xfb, 0xe3, 0xe3, 0x5c, 0x24, 0xa9, 0xcc, 0xe2, 0x91, 0x31, 0x11, 0x59, 0x29,
+	0x32, 0x23, 0x4e, 0x87, 0x86, 0x18, 0x17, 0xc9, 0xc4, 0x28, 0xeb, 0x46, 0x5f, 0x26, 0x89, 0x1c,
+	0xeb, 0x69, 0x26, 0x73, 0xa9, 0x6d, 0x7d, 0x52, 0xfd, 0x83, 0xea, 0x71, 0x3a, 0xd4, 0x57, 0x54,
+	0x2f, 0xeb, 0xb5, 0x67, 0x84, 0xff, 0x59, 0x22, 0x1e, 0xd8, 0x22, 0xcf, 0x45, 0xd6, 0x29, 0x44,
+	0x21, 0xc2, 0xfb, 0x54, 0x68, 0x87, 0x78, 0xcf, 0x02, 0xd3, 0xe2, 0x36, 0x84, 0x21, 0xf8, 0xbc,
+	0x13, 0x41, 0x04, 0x3c, 0xec, 0x79, 0xc0, 0x23, 0x27, 0xf0, 0x80, 0xb2, 0x16, 0x03, 0x4b, 0x55,
+	0x7e, 0x70, 0x3e, 0x78, 0x36, 0xa3, 0x66, 0xc8, 0x5c, 0x47, 0x45, 0xda, 0x3e, 0xde, 0xd9, 0xe0,
+	0x1c, 0xb3, 0x0d, 0x81, 0x67, 0x52, 0x50, 0x7f, 0x69, 0x07, 0x78, 0x77, 0x83, 0xea, 0xb2, 0x80,
+	0x35, 0x99, 0xcd, 0xc2, 0x9e, 0xfa, 0xbb, 0x36, 0xc0, 0x7f, 0xe9, 0xad, 0xe8, 0xdf, 0x4d, 0x8a,
+	0xa4, 0x35, 0x8a, 0x4b, 0x99, 0x69, 0xdb, 0xf8, 0x3f, 0x3d, 0x07, 0x7a, 0x11, 0x44, 0x6d, 0xde,
+	0xb2, 0xcd, 0xae, 0xeb, 0xaf, 0xfd, 0x59, 0xc7, 0x27, 0xeb, 0x80, 0x01, 0x00, 0xa7, 0x3e, 0x6d,
+	0x9c, 0x72, 0xb7, 0x0b, 0x3e, 0xf7, 0x7c, 0x37, 0x74, 0x1b, 0xbc, 0xc9, 0x1c, 0xd3, 0xef, 0xa9,
+	0xa8, 0x79, 0x35, 0x9d, 0x13, 0x65, 0x36, 0x27, 0xca, 0x72, 0x4e, 0xd0, 0x43, 0x45, 0xd0, 0x53,
+	0x45, 0xd0, 0x4b, 0x45, 0xd0, 0xb4, 0x22, 0xe8, 0xb5, 0x22, 0xe8, 0xad, 0x22, 0xca, 0xb2, 0x22,
+	0xe8, 0x71, 0x41, 0x94, 0xe9, 0x82, 0x28, 0xb3, 0x05, 0x51, 0x2e, 0x8f, 0x6e, 0xa4, 0xfe, 0xb5,
+	0xf8, 0x50, 0x7e, 0xd7, 0xe7, 0x6c, 0x75, 0x5c, 0xff, 0x59, 0xf5, 0x69, 0xbc, 0x0f, 0x00, 0xe4,
+	0x28, 0x79, 0x69, 0xcc, 0x01, 0x00, 0x00,
 }
 
 func (x DeadLetterQueueType) String() string {
@@ -82,6 +82,10 @@ type StartWorkflowExecutionRequest struct {
 	ContinuedFailure                *v13.Failure                      `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
 	LastCompletionResult            *v14.Payloads                     `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstWorkflowTaskBackoff        *time.Duration                    `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	// Matching priority of the tasks of the workflow, from 1 (highest) to 5 (lowest), 0 for the default.
+	PriorityKey int32 `protobuf:"varint,10,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Matching fairness key, e.g. the tenant, of the tasks of the workflow.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()      { *m = StartWorkflowExecutionRequest{} }
@@ -179,6 +183,20 @@ func (m *StartWorkflowExecutionRequest) GetFirstWorkflowTaskBackoff() *time.Dura
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *StartWorkflowExecutionRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId string          `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	Clock *v15.ShardClock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
@@ -1833,6 +1851,10 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "with" is needed here. --)
 	SignalWithStartRequest *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_with_start_request,json=signalWithStartRequest,proto3" json:"signal_with_start_request,omitempty"`
+	// Matching priority of the tasks of the workflow when the request starts it.
+	PriorityKey int32 `protobuf:"varint,3,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Matching fairness key of the tasks of the workflow when the request starts it.
+	FairnessKey string `protobuf:"bytes,4,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
@@ -1883,6 +1905,20 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *v
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -5148,6 +5184,113 @@ func (m *RebuildMutableStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RebuildMutableStateResponse proto.InternalMessageInfo
 
+type UpsertWorkflowExecutionMetadataRequest struct {
+	NamespaceId      string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution        *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Search attributes with empty value are removed from the workflow.
+	SearchAttributes *v14.SearchAttributes  `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Memo             *v14.Memo              `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) Reset() {
+	*m = UpsertWorkflowExecutionMetadataRequest{}
+}
+func (*UpsertWorkflowExecutionMetadataRequest) ProtoMessage() {}
+func (*UpsertWorkflowExecutionMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowExecutionMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowExecutionMetadataRequest.Merge(m, src)
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowExecutionMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowExecutionMetadataRequest proto.InternalMessageInfo
+
+func (m *UpsertWorkflowExecutionMetadataRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) GetExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) GetSearchAttributes() *v14.SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) GetMemo() *v14.Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+type UpsertWorkflowExecutionMetadataResponse struct {
+}
+
+func (m *UpsertWorkflowExecutionMetadataResponse) Reset() {
+	*m = UpsertWorkflowExecutionMetadataResponse{}
+}
+func (*UpsertWorkflowExecutionMetadataResponse) ProtoMessage() {}
+func (*UpsertWorkflowExecutionMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowExecutionMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowExecutionMetadataResponse.Merge(m, src)
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowExecutionMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowExecutionMetadataResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
@@ -5242,6 +5385,8 @@ func init() {
 	proto.RegisterType((*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster")
 	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateRequest")
 	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateResponse")
+	proto.RegisterType((*UpsertWorkflowExecutionMetadataRequest)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowExecutionMetadataRequest")
+	proto.RegisterType((*UpsertWorkflowExecutionMetadataResponse)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowExecutionMetadataResponse")
 }
 
 func init() {
@@ -5249,278 +5394,284 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x49, 0x6c, 0x1c, 0x57,
-	0x7a, 0x56, 0xb1, 0xbb, 0xc9, 0xe6, 0x4f, 0xb2, 0x97, 0xe2, 0xd6, 0x24, 0xa5, 0x16, 0x55, 0xb6,
-	0x2c, 0x7a, 0x51, 0x53, 0xcb, 0x8c, 0xad, 0x51, 0xc6, 0x76, 0x24, 0x6a, 0x6b, 0x41, 0xd2, 0xd0,
-	0x45, 0x8e, 0x6c, 0x78, 0xc6, 0x53, 0x2e, 0x56, 0x3d, 0xb2, 0x2b, 0xec, 0xae, 0x6a, 0xd7, 0xab,
-	0x26, 0xd9, 0xce, 0x21, 0xcb, 0x20, 0x41, 0x66, 0x02, 0x64, 0x0c, 0x04, 0x01, 0x06, 0x83, 0xc9,
-	0x25, 0x40, 0x96, 0x4b, 0x90, 0x43, 0x4e, 0x73, 0xc8, 0x25, 0x87, 0x20, 0xa7, 0xc0, 0xc8, 0x25,
-	0x83, 0xe4, 0x30, 0xb1, 0x0c, 0x04, 0x09, 0x92, 0xc3, 0x1c, 0x03, 0xe4, 0x12, 0xbc, 0xad, 0xf6,
-	0xde, 0x48, 0x29, 0xf2, 0x4c, 0x7c, 0x63, 0xbf, 0xf7, 0xff, 0xff, 0x7b, 0xff, 0xf6, 0xbd, 0xed,
-	0x2f, 0xc2, 0xd7, 0x3d, 0xd4, 0x6a, 0x3b, 0xae, 0xde, 0x5c, 0xc7, 0xc8, 0x3d, 0x40, 0xee, 0xba,
-	0xde, 0xb6, 0xd6, 0x1b, 0x16, 0xf6, 0x1c, 0xb7, 0x4b, 0x5a, 0x2c, 0x03, 0xad, 0x1f, 0x5c, 0x5e,
-	0x77, 0xd1, 0x47, 0x1d, 0x84, 0x3d, 0xcd, 0x45, 0xb8, 0xed, 0xd8, 0x18, 0xd5, 0xda, 0xae, 0xe3,
-	0x39, 0xf2, 0x79, 0xc1, 0x5d, 0x63, 0xdc, 0x35, 0xbd, 0x6d, 0xd5, 0xa2, 0xdc, 0xb5, 0x83, 0xcb,
-	0xcb, 0xd5, 0x3d, 0xc7, 0xd9, 0x6b, 0xa2, 0x75, 0xca, 0xb4, 0xd3, 0xd9, 0x5d, 0x37, 0x3b, 0xae,
-	0xee, 0x59, 0x8e, 0xcd, 0xc4, 0x2c, 0x9f, 0x8d, 0xf7, 0x7b, 0x56, 0x0b, 0x61, 0x4f, 0x6f, 0xb5,
-	0x39, 0xc1, 0x39, 0x13, 0xb5, 0x91, 0x6d, 0x22, 0xdb, 0xb0, 0x10, 0x5e, 0xdf, 0x73, 0xf6, 0x1c,
-	0xda, 0x4e, 0xff, 0xe2, 0x24, 0x2f, 0xfa, 0x8a, 0x10, 0x0d, 0x0c, 0xa7, 0xd5, 0x72, 0x6c, 0x32,
-	0xf3, 0x16, 0xc2, 0x58, 0xdf, 0xe3, 0x13, 0x5e, 0x3e, 0x1f, 0xa1, 0xe2, 0x33, 0x4d, 0x92, 0x5d,
-	0x88, 0x90, 0x79, 0x3a, 0xde, 0xff, 0xa8, 0x83, 0x3a, 0x28, 0x49, 0x18, 0x1d, 0x15, 0xd9, 0x9d,
-	0x16, 0x26, 0x44, 0x87, 0x8e, 0xbb, 0xbf, 0xdb, 0x74, 0x0e, 0x39, 0xd5, 0x4b, 0x11, 0x2a, 0xd1,
-	0x99, 0x94, 0xf6, 0x42, 0x84, 0xee, 0xa3, 0x0e, 0x4a, 0x9b, 0x5b, 0x54, 0x85, 0x5d, 0xdd, 0x6a,
-	0x76, 0xdc, 0x94, 0x99, 0xbd, 0x92, 0xe6, 0x58, 0xa3, 0xe9, 0x18, 0xfb, 0x49, 0xda, 0xd7, 0xfa,
-	0x04, 0x41, 0x92, 0xfa, 0xe5, 0x34, 0x6a, 0x5f, 0x75, 0x66, 0x79, 0x4e, 0xfa, 0x6a, 0x5f, 0xd2,
-	0x98, 0x95, 0x2e, 0xf4, 0x25, 0x26, 0x4e, 0xe0, 0x84, 0x17, 0xd3, 0x08, 0x7b, 0x5b, 0xb5, 0x96,
-	0x46, 0x6e, 0xeb, 0x2d, 0x84, 0xdb, 0xba, 0x91, 0x62, 0xb9, 0x4b, 0x69, 0xf4, 0x2e, 0x6a, 0x37,
-	0x2d, 0x83, 0x06, 0x6d, 0x92, 0xe3, 0x6a, 0x1a, 0x47, 0x1b, 0xb9, 0xd8, 0xc2, 0x1e, 0xb2, 0xd9,
-	0x18, 0xe8, 0x08, 0x19, 0x1d, 0xc2, 0x8e, 0x39, 0xd3, 0xdb, 0x43, 0x30, 0x09, 0xa5, 0xb4, 0x56,
-	0xc7, 0xd3, 0x77, 0x9a, 0x48, 0xc3, 0x9e, 0xee, 0x89, 0x51, 0x5f, 0x4f, 0x8d, 0xaa, 0x81, 0x49,
-	0xbb, 0x7c, 0x3d, 0x6d, 0x60, 0xdd, 0x6c, 0x59, 0xf6, 0x40, 0x5e, 0xe5, 0xf7, 0xc7, 0xe1, 0xcc,
-	0x96, 0xa7, 0xbb, 0xde, 0xbb, 0x7c, 0xb8, 0xdb, 0x42, 0x2d, 0x95, 0x31, 0xc8, 0xe7, 0x60, 0xda,
-	0xb7, 0xad, 0x66, 0x99, 0x15, 0x69, 0x55, 0x5a, 0x9b, 0x54, 0xa7, 0xfc, 0xb6, 0xba, 0x29, 0x1b,
-	0x30, 0x83, 0x89, 0x0c, 0x8d, 0x0f, 0x52, 0x19, 0x5b, 0x95, 0xd6, 0xa6, 0xae, 0xbc, 0xe5, 0x3b,
-	0x8a, 0xc2, 0x48, 0x4c, 0xa1, 0xda, 0xc1, 0xe5, 0x5a, 0xdf, 0x91, 0xd5, 0x69, 0x2a, 0x54, 0xcc,
-	0xa3, 0x01, 0xf3, 0x6d, 0xdd, 0x45, 0xb6, 0xa7, 0xf9, 0x96, 0xd7, 0x2c, 0x7b, 0xd7, 0xa9, 0x64,
-	0xe8, 0x60, 0x5f, 0xa9, 0xa5, 0x41, 0x97, 0x1f, 0x91, 0x07, 0x97, 0x6b, 0x9b, 0x94, 0xdb, 0x1f,
-	0xa5, 0x6e, 0xef, 0x3a, 0xea, 0x6c, 0x3b, 0xd9, 0x28, 0x57, 0x60, 0x42, 0xf7, 0x88, 0x34, 0xaf,
-	0x92, 0x5d, 0x95, 0xd6, 0x72, 0xaa, 0xf8, 0x29, 0xb7, 0x40, 0xf1, 0x3d, 0x18, 0xcc, 0x02, 0x1d,
-	0xb5, 0x2d, 0x06, 0x7f, 0x1a, 0xc1, 0xb9, 0x4a, 0x8e, 0x4e, 0x68, 0xb9, 0xc6, 0x40, 0xb0, 0x26,
-	0x40, 0xb0, 0xb6, 0x2d, 0x40, 0xf0, 0x66, 0xf6, 0x93, 0x9f, 0x9d, 0x95, 0xd4, 0xb3, 0x87, 0x71,
-	0xcd, 0x6f, 0xfb, 0x92, 0x08, 0xad, 0xdc, 0x80, 0x25, 0xc3, 0xb1, 0x3d, 0xcb, 0xee, 0x20, 0x4d,
-	0xc7, 0x9a, 0x8d, 0x0e, 0x35, 0xcb, 0xb6, 0x3c, 0x4b, 0xf7, 0x1c, 0xb7, 0x32, 0xbe, 0x2a, 0xad,
-	0x15, 0xae, 0x5c, 0x8c, 0xda, 0x98, 0x66, 0x17, 0x51, 0x76, 0x83, 0xf3, 0xdd, 0xc0, 0x8f, 0xd0,
-	0x61, 0x5d, 0x30, 0xa9, 0x0b, 0x46, 0x6a, 0xbb, 0xfc, 0x10, 0xca, 0xa2, 0xc7, 0xd4, 0x38, 0x04,
-	0x55, 0x26, 0xa8, 0x1e, 0xab, 0xd1, 0x11, 0x78, 0x27, 0x19, 0xe3, 0x0e, 0xfb, 0x53, 0x2d, 0xf9,
-	0xac, 0xbc, 0x45, 0x7e, 0x0c, 0x0b, 0x4d, 0x1d, 0x7b, 0x9a, 0xe1, 0xb4, 0xda, 0x4d, 0x44, 0x2d,
-	0xe3, 0x22, 0xdc, 0x69, 0x7a, 0x95, 0x7c, 0x9a, 0x4c, 0x0e, 0x31, 0xd4, 0x47, 0xdd, 0xa6, 0xa3,
-	0x9b, 0x58, 0x9d, 0x23, 0xfc, 0x1b, 0x3e, 0xbb, 0x4a, 0xb9, 0xe5, 0xef, 0xc0, 0xca, 0xae, 0xe5,
-	0x62, 0x4f, 0xf3, 0xbd, 0x40, 0x50, 0x44, 0xdb, 0xd1, 0x8d, 0x7d, 0x67, 0x77, 0xb7, 0x32, 0x49,
-	0x85, 0x2f, 0x25, 0x0c, 0x7f, 0x8b, 0xaf, 0x4e, 0x37, 0xb3, 0x3f, 0x24, 0x76, 0xaf, 0x50, 0x19,
-	0x22, 0xec, 0xb6, 0x75, 0xbc, 0x7f, 0x93, 0x09, 0x50, 0x0e, 0xa1, 0xda, 0x2b, 0x24, 0x59, 0xd6,
-	0xc8, 0xf3, 0x30, 0xee, 0x76, 0xec, 0x20, 0x0f, 0x72, 0x6e, 0xc7, 0xae, 0x9b, 0xf2, 0x5b, 0x90,
-	0xa3, 0x50, 0xcc, 0x23, 0x7f, 0x2d, 0x35, 0x18, 0x29, 0x05, 0x0d, 0xfb, 0x86, 0xee, 0x9a, 0x1b,
-	0xe4, 0x97, 0xca, 0xd8, 0x94, 0xff, 0x94, 0x60, 0xe1, 0x2e, 0xf2, 0x1e, 0x32, 0x54, 0xd8, 0x22,
-	0xa0, 0x30, 0x42, 0xfe, 0xdd, 0x85, 0x49, 0x3f, 0x1a, 0xf9, 0x0c, 0x5e, 0xee, 0x65, 0xe1, 0xa4,
-	0x6a, 0x01, 0xaf, 0x7c, 0x15, 0x16, 0xd0, 0x51, 0x1b, 0x19, 0x1e, 0x32, 0x35, 0x1b, 0x1d, 0x79,
-	0x1a, 0x3a, 0x20, 0x09, 0x67, 0x99, 0x34, 0xc9, 0x32, 0xea, 0xac, 0xe8, 0x7d, 0x84, 0x8e, 0xbc,
-	0xdb, 0xa4, 0xaf, 0x6e, 0xca, 0x97, 0x60, 0xce, 0xe8, 0xb8, 0x34, 0x33, 0x77, 0x5c, 0xdd, 0x36,
-	0x1a, 0x9a, 0xe7, 0xec, 0x23, 0x9b, 0xe6, 0xce, 0xb4, 0x2a, 0xf3, 0xbe, 0x9b, 0xb4, 0x6b, 0x9b,
-	0xf4, 0x28, 0x3f, 0xcb, 0xc3, 0x62, 0x42, 0x5b, 0x6e, 0xe0, 0x88, 0x2e, 0xd2, 0x09, 0x74, 0xa9,
-	0xc3, 0x4c, 0x10, 0x25, 0xdd, 0x36, 0xe2, 0x86, 0x79, 0x71, 0x90, 0xb0, 0xed, 0x6e, 0x1b, 0xa9,
-	0xd3, 0x87, 0xa1, 0x5f, 0xb2, 0x02, 0x33, 0x69, 0xd6, 0x98, 0xb2, 0x43, 0x56, 0xf8, 0x1a, 0x2c,
-	0xb5, 0x5d, 0x74, 0x60, 0x39, 0x1d, 0xac, 0x51, 0xdc, 0x42, 0x66, 0x40, 0x9f, 0xa5, 0xf4, 0x0b,
-	0x82, 0x60, 0x8b, 0xf5, 0x0b, 0xd6, 0x8b, 0x30, 0x4b, 0xb3, 0x85, 0x85, 0xb6, 0xcf, 0x94, 0xa3,
-	0x4c, 0x25, 0xd2, 0x75, 0x87, 0xf4, 0x08, 0xf2, 0x0d, 0x00, 0x1a, 0xf5, 0x74, 0x07, 0x43, 0x61,
-	0x20, 0xa1, 0x95, 0xbf, 0xc1, 0x21, 0x8a, 0x91, 0x00, 0x7f, 0x87, 0xfc, 0x50, 0x27, 0x3d, 0xf1,
-	0xa7, 0xbc, 0x09, 0x65, 0xec, 0x59, 0xc6, 0x7e, 0x57, 0x0b, 0xc9, 0x9a, 0x18, 0x41, 0x56, 0x91,
-	0xb1, 0xfb, 0x0d, 0xf2, 0xaf, 0xc3, 0xab, 0x09, 0x89, 0x1a, 0x36, 0x1a, 0xc8, 0xec, 0x34, 0x91,
-	0xe6, 0x39, 0xcc, 0x2a, 0x14, 0x21, 0x9d, 0x8e, 0x57, 0x99, 0x1a, 0x2e, 0x57, 0xcf, 0xc7, 0x86,
-	0xd9, 0xe2, 0x02, 0xb7, 0x1d, 0x6a, 0xc4, 0x6d, 0x26, 0xad, 0x67, 0x0c, 0xce, 0xf4, 0x8a, 0x41,
-	0xf9, 0x5b, 0x50, 0xf0, 0xc3, 0x83, 0x2e, 0xc2, 0x95, 0x22, 0x05, 0xd4, 0xf4, 0x75, 0xc4, 0xc7,
-	0xd5, 0x44, 0xc8, 0xb1, 0xe8, 0xf5, 0x43, 0x8d, 0xfe, 0x94, 0xdf, 0x85, 0x62, 0x44, 0x78, 0x07,
-	0x57, 0x4a, 0x54, 0x7a, 0xad, 0x07, 0x5c, 0xa7, 0x8a, 0xed, 0x60, 0xb5, 0x10, 0x96, 0xdb, 0xc1,
-	0xf2, 0x07, 0x50, 0x3e, 0x20, 0x3b, 0x0a, 0xc7, 0xd6, 0xd8, 0x76, 0xce, 0x42, 0xb8, 0x52, 0xa6,
-	0xa6, 0xbc, 0x54, 0xeb, 0xb3, 0x77, 0x27, 0x63, 0x3c, 0x66, 0x8c, 0xf7, 0x04, 0x9f, 0x5a, 0x3a,
-	0x88, 0xb5, 0xc8, 0x6f, 0xc1, 0x69, 0x8b, 0x84, 0x6f, 0xdc, 0x8d, 0xc8, 0x26, 0x89, 0x6a, 0x56,
-	0xe4, 0x55, 0x69, 0x2d, 0xaf, 0x56, 0x2c, 0xbc, 0x15, 0xf5, 0xca, 0x6d, 0xd6, 0x2f, 0x7f, 0x05,
-	0x16, 0x13, 0x91, 0xec, 0x1d, 0x51, 0xb8, 0x9c, 0x65, 0x00, 0x12, 0x8d, 0xe6, 0xed, 0x23, 0x02,
-	0x9e, 0x57, 0x61, 0x81, 0x33, 0xf8, 0x4b, 0x2a, 0xc7, 0xd8, 0x39, 0x8a, 0x75, 0xb3, 0xb4, 0x37,
-	0x48, 0x72, 0x82, 0xb8, 0xf7, 0xb3, 0xf9, 0x7c, 0x69, 0xf2, 0x7e, 0x36, 0x3f, 0x59, 0x82, 0xfb,
-	0xd9, 0x3c, 0x94, 0xa6, 0xee, 0x67, 0xf3, 0xd3, 0xa5, 0x99, 0xfb, 0xd9, 0x7c, 0xa1, 0x54, 0x54,
-	0xfe, 0x4b, 0x82, 0xc5, 0x4d, 0xa7, 0xd9, 0xfc, 0x7f, 0x02, 0xa8, 0x3f, 0xca, 0x43, 0x25, 0xa9,
-	0xee, 0x97, 0x88, 0xfa, 0x25, 0xa2, 0x3e, 0x75, 0x44, 0x9d, 0xee, 0x89, 0xa8, 0xa9, 0xd8, 0x54,
-	0x78, 0x6a, 0xd8, 0xf4, 0x8b, 0x09, 0xd8, 0x7d, 0x10, 0xb1, 0x7c, 0x1c, 0x44, 0x94, 0x47, 0x43,
-	0xc4, 0x99, 0x52, 0x41, 0xf9, 0x9e, 0x04, 0x2b, 0x2a, 0xc2, 0xc8, 0x8b, 0x81, 0xf6, 0x73, 0xc0,
-	0x43, 0xa5, 0x0a, 0xa7, 0xd3, 0xa7, 0xc2, 0xb0, 0x4a, 0xf9, 0x51, 0x06, 0x56, 0x55, 0x64, 0x38,
-	0xae, 0x19, 0xde, 0x9e, 0xf3, 0xec, 0x1e, 0x61, 0xc2, 0xef, 0x81, 0x9c, 0x3c, 0xa8, 0x8d, 0x3e,
-	0xf3, 0x72, 0xe2, 0x84, 0x26, 0x9f, 0x85, 0x29, 0x3f, 0x05, 0x7d, 0xdc, 0x02, 0xd1, 0x54, 0x37,
-	0xe5, 0x45, 0x98, 0xa0, 0xe9, 0xea, 0x83, 0xd4, 0x38, 0xf9, 0x59, 0x37, 0xe5, 0x33, 0x00, 0xe2,
-	0x10, 0xce, 0xb1, 0x68, 0x52, 0x9d, 0xe4, 0x2d, 0x75, 0x53, 0xfe, 0x10, 0xa6, 0xdb, 0x4e, 0xb3,
-	0xe9, 0x9f, 0xa1, 0x19, 0x0c, 0xbd, 0x39, 0xf0, 0x0c, 0x4d, 0x70, 0x3f, 0x6c, 0xac, 0xb0, 0x6f,
-	0xd5, 0x29, 0x22, 0x52, 0xd8, 0xcd, 0x3f, 0xa4, 0x4c, 0x1c, 0xef, 0x90, 0xf2, 0x47, 0x79, 0x38,
-	0xd7, 0xc7, 0x39, 0x7c, 0xb9, 0x49, 0xac, 0x12, 0xd2, 0xb1, 0x57, 0x89, 0xbe, 0x2b, 0xc0, 0x58,
-	0xdf, 0x15, 0xe0, 0x35, 0x90, 0x85, 0x4f, 0xcc, 0xf8, 0x2a, 0x53, 0xf2, 0x7b, 0x04, 0xf5, 0x1a,
-	0x94, 0x7a, 0xac, 0x30, 0x05, 0x1c, 0x95, 0x9b, 0x58, 0xb8, 0x72, 0xc9, 0x85, 0x2b, 0x74, 0x7f,
-	0x30, 0x1e, 0xbd, 0x3f, 0xb8, 0x06, 0x15, 0x8e, 0xe8, 0xa1, 0xdb, 0x03, 0xbe, 0xb7, 0x9a, 0xa0,
-	0x7b, 0xab, 0x05, 0xd6, 0x1f, 0xdc, 0x08, 0xf0, 0x9d, 0xd5, 0x5e, 0x28, 0xa0, 0x59, 0x78, 0xd9,
-	0xbb, 0x0e, 0x3f, 0x4d, 0x7f, 0x6d, 0x10, 0xba, 0x6e, 0xbb, 0xba, 0x8d, 0x2d, 0x64, 0x47, 0xce,
-	0xbc, 0xf4, 0xfe, 0xa3, 0x74, 0x18, 0x6b, 0x91, 0xf7, 0xe0, 0x4c, 0xca, 0x15, 0x47, 0x68, 0x49,
-	0x9b, 0x1c, 0x61, 0x49, 0x5b, 0x4e, 0xe4, 0x4f, 0xb0, 0xba, 0x9d, 0x83, 0xe9, 0xc8, 0xc2, 0x32,
-	0x45, 0x17, 0x96, 0xa9, 0x9d, 0xd0, 0x8a, 0x72, 0x17, 0x0a, 0x81, 0x13, 0xe9, 0xd5, 0xca, 0xf4,
-	0x90, 0x57, 0x2b, 0x33, 0x3e, 0x1f, 0xbd, 0x48, 0xd9, 0x80, 0x69, 0xe1, 0x5f, 0x2a, 0x66, 0x66,
-	0x48, 0x31, 0x53, 0x9c, 0x8b, 0x0a, 0x71, 0x60, 0xe2, 0xa3, 0x0e, 0xe2, 0xab, 0x5a, 0x66, 0x6d,
-	0xea, 0xca, 0x37, 0x6b, 0x43, 0xdd, 0x96, 0xd7, 0x06, 0xe6, 0x4c, 0xed, 0x1d, 0x26, 0xf7, 0xb6,
-	0xed, 0xb9, 0x5d, 0x55, 0x8c, 0x12, 0xe4, 0x6b, 0xf1, 0x58, 0xf9, 0xba, 0xfc, 0x21, 0x4c, 0x87,
-	0x05, 0xcb, 0x25, 0xc8, 0xec, 0xa3, 0x2e, 0x87, 0x4b, 0xf2, 0xa7, 0x7c, 0x1d, 0x72, 0x07, 0x7a,
-	0xb3, 0xd3, 0x63, 0x27, 0x47, 0xef, 0xab, 0xc3, 0x29, 0x4a, 0xa4, 0x75, 0x55, 0xc6, 0x72, 0x7d,
-	0xec, 0x9a, 0xc4, 0x96, 0x99, 0x10, 0x68, 0xdf, 0x30, 0x3c, 0xeb, 0xc0, 0xf2, 0xba, 0x5f, 0x82,
-	0xf6, 0x10, 0xa0, 0x1d, 0x36, 0xd6, 0xb3, 0x03, 0xed, 0xbf, 0xcd, 0x0a, 0xd0, 0x4e, 0x75, 0x0e,
-	0x07, 0xed, 0x47, 0x50, 0x8c, 0xc1, 0x25, 0x87, 0xed, 0xf3, 0x51, 0x55, 0x42, 0xa0, 0xc2, 0x76,
-	0x66, 0x5d, 0x0a, 0x7a, 0x6a, 0x21, 0x0a, 0xa9, 0x89, 0x84, 0x1b, 0x3b, 0x4e, 0xc2, 0x85, 0x70,
-	0x34, 0x13, 0xc5, 0x51, 0x04, 0x55, 0xb1, 0x39, 0xe5, 0x4d, 0x5a, 0x0c, 0x28, 0xb2, 0x43, 0x0e,
-	0xb8, 0xc2, 0xe5, 0xdc, 0x60, 0x62, 0xb6, 0x22, 0xb0, 0xf1, 0x10, 0xca, 0x0d, 0xa4, 0xbb, 0xde,
-	0x0e, 0xd2, 0x3d, 0xcd, 0x44, 0x9e, 0x6e, 0x35, 0x31, 0xbf, 0xdd, 0x1d, 0x7c, 0x83, 0x59, 0xf2,
-	0x59, 0x6f, 0x31, 0xce, 0xe4, 0xca, 0x38, 0x7e, 0xec, 0x95, 0xf1, 0x62, 0x28, 0x55, 0xfc, 0x14,
-	0xa2, 0x21, 0x32, 0x19, 0xc4, 0xff, 0x23, 0xd1, 0x11, 0x04, 0x51, 0xfe, 0x78, 0x41, 0xf4, 0x13,
-	0x09, 0x5e, 0x60, 0xb1, 0x12, 0x81, 0x31, 0x7e, 0x3f, 0x3b, 0x52, 0x92, 0x3b, 0x50, 0xe2, 0xb7,
-	0xc2, 0x28, 0xf6, 0x5c, 0x70, 0x6b, 0x60, 0xd6, 0x0c, 0x31, 0x05, 0xb5, 0x28, 0xa4, 0xf3, 0x06,
-	0xe5, 0xb7, 0xc7, 0xe0, 0xc5, 0xfe, 0x8c, 0x3c, 0x07, 0x70, 0xb0, 0x09, 0x10, 0x8f, 0x24, 0x3c,
-	0x09, 0xee, 0x3d, 0x2d, 0xa0, 0x27, 0x67, 0xbc, 0x68, 0xe2, 0x21, 0x28, 0xe8, 0x3c, 0x2f, 0xe9,
-	0x22, 0x8b, 0x2b, 0x63, 0x74, 0x6d, 0x79, 0xeb, 0xb8, 0x10, 0xc2, 0x07, 0x9a, 0xd1, 0x43, 0x5d,
-	0x58, 0xf9, 0x2b, 0x89, 0x40, 0x74, 0xc2, 0x08, 0x77, 0x74, 0xab, 0x39, 0x92, 0xf7, 0x1a, 0x50,
-	0xd8, 0xa5, 0x3c, 0x31, 0xdf, 0xdd, 0x38, 0x8e, 0xef, 0x22, 0xa3, 0xab, 0x33, 0xbb, 0xe1, 0x9f,
-	0xca, 0x0b, 0x04, 0xb6, 0x7a, 0xb2, 0xf0, 0xe3, 0xc2, 0x4f, 0x24, 0x50, 0x92, 0xe0, 0x76, 0x4f,
-	0x24, 0xde, 0x08, 0x8a, 0xb5, 0xc3, 0xa9, 0x1e, 0xd5, 0x6d, 0x63, 0x08, 0xdd, 0x06, 0x4d, 0x21,
-	0x84, 0x06, 0x42, 0xc1, 0x4d, 0x92, 0x52, 0x7d, 0xf8, 0x78, 0x80, 0xbc, 0x0c, 0x25, 0x43, 0xb7,
-	0x0d, 0xe4, 0xaf, 0x31, 0x88, 0xcd, 0x3f, 0xaf, 0x16, 0x59, 0xbb, 0x2a, 0x9a, 0xc3, 0x59, 0x1a,
-	0x96, 0xf9, 0x9c, 0xb2, 0xb4, 0xdf, 0x14, 0x92, 0x59, 0xfa, 0x92, 0x9f, 0xa4, 0x3d, 0xf8, 0xb8,
-	0xc7, 0x43, 0x81, 0x1c, 0x26, 0xfc, 0xbf, 0x0f, 0xe4, 0x9e, 0xa3, 0xf7, 0x0e, 0xe4, 0x34, 0x16,
-	0xae, 0xd6, 0x5f, 0xd3, 0x40, 0x4e, 0xea, 0x4f, 0x3d, 0x3c, 0x92, 0x62, 0xbf, 0x06, 0x85, 0x68,
-	0xbc, 0x8c, 0x10, 0xc5, 0x83, 0xc6, 0x57, 0x67, 0x22, 0x21, 0xa7, 0x9c, 0x4f, 0x8f, 0x37, 0x9f,
-	0x89, 0x2b, 0xf7, 0x77, 0x63, 0x50, 0xdd, 0xb2, 0xf6, 0x6c, 0xbd, 0x79, 0x92, 0x47, 0xe6, 0x5d,
-	0x28, 0x60, 0x2a, 0x24, 0xa6, 0xd8, 0xdb, 0x83, 0x5f, 0x99, 0xfb, 0x8e, 0xad, 0xce, 0x30, 0xb1,
-	0x62, 0x2a, 0x16, 0xac, 0xa0, 0x23, 0x0f, 0xb9, 0x64, 0xa4, 0x94, 0xed, 0x68, 0x66, 0xd4, 0xed,
-	0xe8, 0x92, 0x90, 0x96, 0xe8, 0x92, 0x6b, 0x30, 0x6b, 0x34, 0xac, 0xa6, 0x19, 0x8c, 0xe3, 0xd8,
-	0xcd, 0x2e, 0xdd, 0xbb, 0xe4, 0xd5, 0x32, 0xed, 0x12, 0x4c, 0xdf, 0xb0, 0x9b, 0x5d, 0xe5, 0x1c,
-	0x9c, 0xed, 0xa9, 0x0b, 0xb7, 0xf5, 0x3f, 0x4a, 0x70, 0x81, 0xd3, 0x58, 0x5e, 0xe3, 0xc4, 0x2f,
-	0xfb, 0xdf, 0x95, 0x60, 0x89, 0x5b, 0xfd, 0xd0, 0xf2, 0x1a, 0x5a, 0xda, 0x33, 0xff, 0xbd, 0x61,
-	0x1d, 0x30, 0x68, 0x42, 0xea, 0x02, 0x8e, 0x12, 0x8a, 0x38, 0xbb, 0x01, 0x6b, 0x83, 0x45, 0xf4,
-	0x7d, 0xa0, 0x55, 0xfe, 0x46, 0x82, 0xb3, 0x2a, 0x6a, 0x39, 0x07, 0x88, 0x49, 0x3a, 0xe6, 0xc3,
-	0xc0, 0xb3, 0x3b, 0xa2, 0x44, 0x0f, 0x1a, 0x99, 0xd8, 0x41, 0x43, 0x51, 0x08, 0xec, 0xf5, 0x9a,
-	0xbe, 0xf0, 0xfd, 0x18, 0x9c, 0xdb, 0x46, 0x6e, 0xcb, 0xb2, 0x75, 0x0f, 0x9d, 0xc4, 0xeb, 0x0e,
-	0x94, 0x3d, 0x21, 0x27, 0xe6, 0xec, 0x9b, 0x03, 0x9d, 0x3d, 0x70, 0x06, 0x6a, 0xc9, 0x17, 0xfe,
-	0x0b, 0x90, 0x73, 0x2f, 0x82, 0xd2, 0x4f, 0x23, 0x6e, 0xfa, 0x3f, 0x96, 0xa0, 0x7a, 0x0b, 0x91,
-	0xa5, 0xea, 0x24, 0x76, 0x7f, 0x66, 0xd1, 0x45, 0x90, 0xa3, 0xe7, 0xf4, 0xb8, 0x0a, 0x7f, 0x2e,
-	0xc1, 0x19, 0x7a, 0x37, 0x7b, 0xc2, 0x4a, 0x20, 0x97, 0xc8, 0x18, 0xb9, 0x12, 0xa8, 0xef, 0xc8,
-	0xea, 0x34, 0x15, 0x2a, 0xe0, 0xe0, 0x0d, 0xa8, 0xf6, 0x22, 0xef, 0x0f, 0x02, 0x7f, 0x98, 0x81,
-	0xf3, 0x5c, 0x08, 0x5b, 0xa4, 0x4e, 0xa2, 0x6a, 0xab, 0xc7, 0x42, 0x7b, 0x67, 0x08, 0x5d, 0x87,
-	0x98, 0x42, 0x6c, 0xad, 0x95, 0xdf, 0x0c, 0xa5, 0x08, 0x2f, 0x02, 0x4a, 0xde, 0x6c, 0x56, 0x04,
-	0x49, 0x5d, 0x50, 0x88, 0x3b, 0xc9, 0x01, 0x19, 0x96, 0x7d, 0xf6, 0x19, 0x96, 0xeb, 0x95, 0x61,
-	0x6b, 0xf0, 0xd2, 0x20, 0x8b, 0xf0, 0x10, 0xfd, 0xc1, 0x18, 0xac, 0x88, 0x13, 0x7a, 0xf8, 0x54,
-	0xf0, 0x85, 0x00, 0xf0, 0xab, 0xb0, 0x60, 0x61, 0x2d, 0xa5, 0x3c, 0x89, 0xfa, 0x26, 0xaf, 0xce,
-	0x5a, 0xf8, 0x4e, 0xbc, 0xee, 0x28, 0x38, 0x98, 0x67, 0x8f, 0x77, 0x30, 0xaf, 0xc2, 0xe9, 0x74,
-	0x83, 0x70, 0x8b, 0xfd, 0x9b, 0x04, 0x17, 0x1e, 0x23, 0xd7, 0xda, 0xed, 0x26, 0xc6, 0xf6, 0xaf,
-	0x3a, 0xbe, 0x10, 0xd6, 0xf3, 0x0d, 0x91, 0x39, 0x9e, 0x21, 0x5e, 0x81, 0xb5, 0xc1, 0x7a, 0x72,
-	0xa3, 0xfc, 0x4f, 0x86, 0x1c, 0x36, 0xc8, 0xd1, 0x6b, 0x83, 0x04, 0xa3, 0x3f, 0x89, 0xe3, 0x1c,
-	0x94, 0x9e, 0x9d, 0x45, 0x6a, 0xc0, 0x8b, 0x13, 0x43, 0xe9, 0xee, 0x27, 0x7a, 0x99, 0x75, 0xf9,
-	0x69, 0x5e, 0x37, 0xe5, 0xf7, 0x61, 0x56, 0x1c, 0xaa, 0xcc, 0x93, 0x64, 0xb6, 0xec, 0x4b, 0x09,
-	0xe6, 0xb2, 0xe9, 0x1f, 0x07, 0xe9, 0x8b, 0x05, 0xbd, 0x1f, 0xcc, 0x8d, 0x72, 0x3f, 0x58, 0x0c,
-	0xd8, 0xd9, 0x05, 0xa1, 0xef, 0xef, 0xf1, 0x63, 0xf9, 0x5b, 0xbe, 0x06, 0x95, 0x84, 0x75, 0xf8,
-	0x93, 0x31, 0xbd, 0x06, 0xcb, 0xa8, 0x0b, 0x31, 0x13, 0xf1, 0x27, 0x66, 0xe5, 0x02, 0x59, 0x03,
-	0xfa, 0x3a, 0x9f, 0x87, 0xc9, 0x9f, 0x65, 0xe0, 0x22, 0x8b, 0xa9, 0x54, 0x4a, 0x0a, 0x4c, 0x44,
-	0xce, 0x48, 0xf1, 0xb2, 0x0d, 0xa5, 0x78, 0x15, 0xeb, 0xe8, 0xd1, 0x52, 0x8c, 0x55, 0xad, 0xca,
-	0x2a, 0x14, 0x19, 0xe4, 0x9e, 0x60, 0xcf, 0x54, 0x30, 0x22, 0x5a, 0xf6, 0x8a, 0xbf, 0x6c, 0xaf,
-	0xf8, 0xeb, 0xe7, 0x91, 0x5c, 0x3f, 0x8f, 0x9c, 0x34, 0x16, 0x94, 0x4b, 0x50, 0x1b, 0xd6, 0x4f,
-	0xdc, 0xb5, 0x7f, 0x22, 0xc1, 0xea, 0x2d, 0x84, 0x0d, 0xd7, 0xda, 0x39, 0xd1, 0x86, 0xed, 0x5b,
-	0x30, 0x31, 0xea, 0xf5, 0xc1, 0xa0, 0x61, 0x55, 0x21, 0x51, 0xf9, 0x41, 0x16, 0xce, 0xf5, 0xa1,
-	0xe6, 0x5b, 0x9d, 0x6f, 0x43, 0x29, 0x78, 0xa6, 0x33, 0x1c, 0x7b, 0xd7, 0xda, 0xe3, 0xb7, 0x96,
-	0x97, 0xd3, 0xe7, 0x92, 0xea, 0xfd, 0x0d, 0xca, 0xa8, 0x16, 0x51, 0xb4, 0x41, 0xde, 0x83, 0xc5,
-	0x94, 0xd7, 0x40, 0xfa, 0xf6, 0xc8, 0x14, 0x5e, 0x1f, 0x61, 0x10, 0xfa, 0xe2, 0x38, 0x7f, 0x98,
-	0xd6, 0x2c, 0x7f, 0x1b, 0xe4, 0x36, 0xb2, 0x4d, 0xcb, 0xde, 0xd3, 0xf8, 0xcd, 0xa5, 0x85, 0x70,
-	0x25, 0x43, 0xef, 0x42, 0x2f, 0xf6, 0x1e, 0x63, 0x93, 0xf1, 0x88, 0xeb, 0x07, 0x3a, 0x42, 0xb9,
-	0x1d, 0x69, 0xb4, 0x10, 0x96, 0xbf, 0x03, 0x25, 0x21, 0x9d, 0x46, 0xb9, 0x4b, 0xab, 0xa9, 0x88,
-	0xec, 0xab, 0x03, 0x65, 0x47, 0x83, 0x8a, 0x8e, 0x50, 0x6c, 0x87, 0xba, 0x5c, 0x64, 0xcb, 0x08,
-	0xe6, 0x85, 0xfc, 0xe8, 0xd2, 0x9f, 0x1b, 0xe4, 0x09, 0x3e, 0x48, 0xe2, 0x61, 0x76, 0xb6, 0x9d,
-	0xec, 0x50, 0x7e, 0x2b, 0x03, 0x15, 0x95, 0x7f, 0xb7, 0x80, 0x28, 0x8e, 0xe2, 0xc7, 0x57, 0xbe,
-	0x10, 0x8b, 0xd5, 0x2e, 0xcc, 0x47, 0x6b, 0x7f, 0xba, 0x9a, 0xe5, 0xa1, 0x96, 0xf0, 0xe0, 0x95,
-	0x91, 0xea, 0x7f, 0xba, 0x75, 0x0f, 0xb5, 0xd4, 0xd9, 0x83, 0x44, 0x1b, 0x96, 0xaf, 0xc1, 0x38,
-	0x5d, 0x7d, 0x30, 0x5f, 0xd7, 0x7a, 0x3e, 0xc3, 0xdc, 0xd2, 0x3d, 0xfd, 0x66, 0xd3, 0xd9, 0x51,
-	0x39, 0xbd, 0x7c, 0x07, 0x0a, 0x36, 0x3a, 0xa4, 0x65, 0x34, 0x5c, 0x42, 0x6e, 0x48, 0x09, 0xd3,
-	0x36, 0x3a, 0x54, 0x3b, 0x6c, 0xdd, 0xc2, 0xca, 0x0a, 0x2c, 0xa5, 0xb8, 0x20, 0x38, 0x06, 0x2e,
-	0x6c, 0x75, 0x6d, 0x83, 0x62, 0x14, 0xaf, 0x08, 0xe2, 0xee, 0x39, 0x0f, 0x05, 0xec, 0x74, 0x5c,
-	0x03, 0x69, 0x46, 0xb3, 0x83, 0x3d, 0xe4, 0x72, 0x07, 0xcd, 0xb0, 0xd6, 0x0d, 0xd6, 0x28, 0x2f,
-	0x41, 0x1e, 0x13, 0x66, 0x51, 0xe1, 0x90, 0x53, 0x27, 0xe8, 0xef, 0xba, 0x29, 0xdf, 0x80, 0x29,
-	0x56, 0x9a, 0xc4, 0x5e, 0xb8, 0x32, 0x43, 0xbe, 0x70, 0x01, 0x63, 0x22, 0xcd, 0xca, 0x12, 0x2c,
-	0x26, 0xa6, 0x27, 0x2e, 0x0f, 0x72, 0x30, 0x4b, 0xfa, 0x44, 0x2a, 0x8d, 0x10, 0x56, 0x67, 0x61,
-	0xca, 0x0f, 0x2b, 0x3e, 0xed, 0x49, 0x15, 0x44, 0x53, 0xdd, 0x0c, 0x1d, 0xc7, 0x32, 0xe1, 0xa2,
-	0xf9, 0x0a, 0x4c, 0x88, 0x05, 0x82, 0xad, 0x2a, 0xe2, 0x27, 0x19, 0x34, 0x78, 0xcf, 0x0b, 0x8a,
-	0x2c, 0xfc, 0x36, 0x5a, 0x92, 0x14, 0xaf, 0x0d, 0x18, 0x3f, 0x5e, 0x6d, 0xc0, 0x19, 0x00, 0xf1,
-	0xec, 0x63, 0x99, 0x7c, 0xef, 0x30, 0xc9, 0x5b, 0x68, 0x6d, 0x60, 0xf4, 0x25, 0x33, 0x7f, 0x9c,
-	0x97, 0xcc, 0x4d, 0x5e, 0x8f, 0x18, 0x3c, 0x31, 0x50, 0x59, 0x93, 0x43, 0xca, 0x2a, 0x13, 0x66,
-	0xff, 0x69, 0x80, 0x4a, 0xbc, 0x0e, 0x13, 0xe2, 0x41, 0x12, 0x86, 0x7c, 0x90, 0x14, 0x0c, 0xe1,
-	0x77, 0xd5, 0xa9, 0xe8, 0xbb, 0xea, 0x06, 0x4c, 0xb3, 0x6a, 0x35, 0xfe, 0x05, 0xc8, 0xf4, 0x90,
-	0x5f, 0x80, 0x4c, 0xd1, 0x22, 0x36, 0xfe, 0xf1, 0xc7, 0x25, 0xa0, 0x1f, 0x6f, 0x50, 0x24, 0x44,
-	0xae, 0x66, 0x99, 0xc8, 0xf6, 0x2c, 0xaf, 0x4b, 0x8b, 0x2e, 0x26, 0x55, 0x99, 0xf4, 0xbd, 0x4b,
-	0xbb, 0xea, 0xbc, 0x47, 0x7e, 0x17, 0x8a, 0x31, 0xf4, 0xe0, 0x75, 0x83, 0xb5, 0xd1, 0x70, 0x43,
-	0x2d, 0x44, 0x31, 0x43, 0x59, 0x80, 0xb9, 0x68, 0x4c, 0xf3, 0x60, 0xff, 0x9e, 0x04, 0x2b, 0x62,
-	0x69, 0x7d, 0xce, 0x25, 0xc2, 0xca, 0x7f, 0x4b, 0x70, 0x3a, 0x7d, 0x2e, 0x7c, 0x85, 0x6f, 0xc0,
-	0xac, 0xa1, 0x1b, 0x0d, 0x14, 0xfd, 0x66, 0x8c, 0x2f, 0xf2, 0xd7, 0x52, 0x2d, 0x14, 0xfa, 0xea,
-	0x2c, 0x3c, 0x7e, 0x44, 0x7c, 0x99, 0x0a, 0x0d, 0x37, 0xc9, 0x36, 0x2c, 0x98, 0xba, 0xa7, 0xef,
-	0xe8, 0x38, 0x3e, 0xd8, 0xd8, 0x09, 0x07, 0x9b, 0x13, 0x72, 0xc3, 0xad, 0xca, 0x3f, 0x49, 0xb0,
-	0x2c, 0x54, 0xe7, 0x2e, 0xbb, 0xe7, 0xe0, 0xf0, 0xb3, 0x5d, 0xc3, 0xc1, 0x9e, 0xa6, 0x9b, 0xa6,
-	0x8b, 0x30, 0x16, 0x5e, 0x20, 0x6d, 0x37, 0x58, 0x53, 0x3f, 0xb8, 0x8c, 0xfb, 0x30, 0x33, 0xec,
-	0x7a, 0x98, 0x7d, 0x0a, 0xf7, 0x6d, 0x9f, 0x8c, 0x05, 0x01, 0x16, 0xd1, 0x8c, 0xfb, 0xf4, 0x05,
-	0x98, 0xa1, 0xf3, 0xc4, 0x9a, 0xdd, 0x69, 0xed, 0xf0, 0xc5, 0x20, 0xa7, 0x4e, 0xb3, 0xc6, 0x47,
-	0xb4, 0x4d, 0x5e, 0x81, 0x49, 0xa1, 0x1c, 0x7b, 0x16, 0xce, 0xa9, 0x79, 0xae, 0x1d, 0x96, 0x3f,
-	0x80, 0x62, 0xa0, 0x1e, 0x75, 0x65, 0xdf, 0x0f, 0xe1, 0x7c, 0x5a, 0xa2, 0x82, 0x5f, 0x18, 0xb0,
-	0x41, 0xf8, 0xe8, 0x7e, 0xa3, 0x60, 0x47, 0xda, 0xe4, 0xd7, 0x61, 0x91, 0x8d, 0x6d, 0x38, 0xb6,
-	0xe7, 0x3a, 0xcd, 0x26, 0x72, 0x45, 0x61, 0x6c, 0x96, 0x1a, 0x72, 0x9e, 0x76, 0x6f, 0xf8, 0xbd,
-	0xbc, 0xde, 0x95, 0x60, 0x0b, 0x77, 0x17, 0x2b, 0x96, 0x11, 0x3f, 0x95, 0x1a, 0x94, 0x37, 0x9a,
-	0x0e, 0x46, 0x74, 0xf1, 0x11, 0x2e, 0x0e, 0xfb, 0x4f, 0x8a, 0xf8, 0x4f, 0x99, 0x03, 0x39, 0x4c,
-	0xcf, 0x33, 0xf7, 0x35, 0x28, 0xde, 0x45, 0xde, 0xb0, 0x32, 0x3e, 0x84, 0x52, 0x40, 0xcd, 0x4d,
-	0xff, 0x00, 0x80, 0x93, 0x93, 0x5d, 0x2c, 0xcb, 0xa2, 0x8b, 0xc3, 0x04, 0x36, 0x15, 0x43, 0x8d,
-	0xc5, 0xdc, 0x42, 0xfe, 0x54, 0xfe, 0x59, 0x82, 0x32, 0xbb, 0x98, 0x0f, 0x5f, 0x44, 0xf5, 0x9e,
-	0x92, 0x7c, 0x07, 0xf2, 0x64, 0xeb, 0xb0, 0x47, 0x40, 0x6e, 0x8c, 0x96, 0x18, 0xbf, 0xd2, 0xbf,
-	0x80, 0x99, 0x3d, 0xa9, 0x31, 0x0e, 0xd5, 0xe7, 0x0d, 0x57, 0x2c, 0x65, 0x22, 0x15, 0x4b, 0x75,
-	0x28, 0x1e, 0x58, 0xd8, 0xda, 0xb1, 0x9a, 0xb4, 0xa6, 0x60, 0x94, 0x62, 0x98, 0x42, 0xc0, 0x48,
-	0xb7, 0x0b, 0x73, 0x20, 0x87, 0x75, 0xe3, 0x2e, 0xf8, 0x44, 0x82, 0x33, 0x77, 0x91, 0xa7, 0x06,
-	0x1f, 0xd0, 0x3e, 0x64, 0x1f, 0xcf, 0xfa, 0x7b, 0x9d, 0x07, 0x30, 0x4e, 0x6b, 0xfa, 0x48, 0xca,
-	0x66, 0x7a, 0x86, 0x64, 0xe8, 0x0b, 0x5c, 0x76, 0x2b, 0xea, 0xff, 0xa4, 0xd5, 0x7f, 0x2a, 0x97,
-	0x41, 0x12, 0x99, 0x6f, 0x99, 0x68, 0xa9, 0x0b, 0xdf, 0x5f, 0x4c, 0xf1, 0x36, 0x12, 0xcb, 0xca,
-	0x8f, 0xc7, 0xa0, 0xda, 0x6b, 0x4a, 0xdc, 0xed, 0xbf, 0x01, 0x05, 0xe6, 0x12, 0xfe, 0xa5, 0xaf,
-	0x98, 0xdb, 0x7b, 0x43, 0xd6, 0x76, 0xf4, 0x17, 0xcf, 0x82, 0x43, 0xb4, 0xb2, 0x3a, 0x3e, 0x96,
-	0xe1, 0xa2, 0x6d, 0xb9, 0x0b, 0x72, 0x92, 0x28, 0x5c, 0x93, 0x97, 0x63, 0x35, 0x79, 0x0f, 0xa3,
-	0x35, 0x79, 0x6f, 0x8c, 0x68, 0x3b, 0x7f, 0x66, 0x41, 0x99, 0x9e, 0xf2, 0x31, 0xac, 0xde, 0x45,
-	0xde, 0xad, 0x07, 0xef, 0xf4, 0xf1, 0xd9, 0x63, 0xfe, 0x0d, 0x04, 0xc9, 0x0a, 0x61, 0x9b, 0x51,
-	0xc7, 0xf6, 0x4f, 0x2f, 0xf4, 0xb3, 0x08, 0xf2, 0x17, 0x56, 0x7e, 0x47, 0x82, 0x73, 0x7d, 0x06,
-	0xe7, 0xde, 0xf9, 0x10, 0xca, 0x21, 0xb1, 0xbc, 0x12, 0x46, 0x8a, 0x9f, 0xd0, 0x86, 0x9e, 0x84,
-	0x5a, 0x72, 0xa3, 0x0d, 0x58, 0xf9, 0xbe, 0x04, 0x73, 0xb4, 0x7e, 0x51, 0xe0, 0xf7, 0x08, 0x6b,
-	0xfd, 0x37, 0xe2, 0xc7, 0xfc, 0xaf, 0x0e, 0x3c, 0xe6, 0xa7, 0x0d, 0x15, 0x1c, 0xed, 0xf7, 0x61,
-	0x3e, 0x46, 0xc0, 0xed, 0xa0, 0x42, 0x3e, 0x56, 0x7b, 0xf4, 0xfa, 0xa8, 0x43, 0xf1, 0x02, 0x20,
-	0x5f, 0x8e, 0xf2, 0x07, 0x12, 0xcc, 0xa9, 0x48, 0x6f, 0xb7, 0x9b, 0xec, 0x32, 0x0e, 0x8f, 0xa0,
-	0xf9, 0x56, 0x5c, 0xf3, 0xf4, 0x5a, 0xe3, 0xf0, 0xc7, 0xe6, 0xcc, 0x1d, 0xc9, 0xe1, 0x02, 0xed,
-	0x17, 0x61, 0x3e, 0x46, 0xc0, 0x67, 0xfa, 0x97, 0x63, 0x30, 0xcf, 0x62, 0x25, 0x1e, 0x9d, 0xb7,
-	0x21, 0xeb, 0xd7, 0x92, 0x17, 0xc2, 0xe7, 0xe9, 0x34, 0xc4, 0xbc, 0x85, 0x74, 0xf3, 0x01, 0xf2,
-	0x3c, 0xe4, 0xd2, 0x9a, 0x28, 0x5a, 0x3e, 0x47, 0xd9, 0xfb, 0x6d, 0x17, 0x92, 0xe7, 0xb3, 0x4c,
-	0xda, 0xf9, 0xec, 0x0d, 0xa8, 0x58, 0x36, 0xa1, 0xb0, 0x0e, 0x90, 0x86, 0x6c, 0x1f, 0x4e, 0x82,
-	0xab, 0xb1, 0x79, 0xbf, 0xff, 0xb6, 0x2d, 0x92, 0xbd, 0x6e, 0xca, 0xaf, 0x40, 0xb9, 0xa5, 0x1f,
-	0x59, 0xad, 0x4e, 0x4b, 0x6b, 0x13, 0x7a, 0x6c, 0x7d, 0xcc, 0xbe, 0x14, 0xcf, 0xa9, 0x45, 0xde,
-	0xb1, 0xa9, 0xef, 0xa1, 0x2d, 0xeb, 0x63, 0x24, 0xbf, 0x04, 0x45, 0x5a, 0x64, 0x4e, 0x09, 0x59,
-	0x75, 0xf4, 0x38, 0xad, 0x8e, 0xa6, 0xb5, 0xe7, 0x84, 0x8c, 0x7d, 0xf6, 0xf5, 0x1f, 0xec, 0xab,
-	0xe1, 0x88, 0xbd, 0x78, 0x20, 0x3d, 0x25, 0x83, 0xa5, 0xe6, 0xe5, 0xd8, 0x53, 0xcc, 0xcb, 0x34,
-	0x5d, 0x33, 0x69, 0xba, 0xfe, 0x8b, 0x04, 0x8b, 0x9b, 0x1d, 0x77, 0x0f, 0xfd, 0x32, 0x46, 0x87,
-	0xb2, 0x0c, 0x95, 0xa4, 0x72, 0xa2, 0xe4, 0x69, 0x0c, 0x16, 0x1f, 0xa2, 0x5f, 0x52, 0xcd, 0x9f,
-	0x49, 0x5e, 0xdc, 0x84, 0x4a, 0xd2, 0x60, 0x3c, 0x31, 0x52, 0x64, 0x48, 0x69, 0x32, 0x7e, 0x4c,
-	0xbf, 0x9a, 0xda, 0x75, 0x11, 0x6e, 0x84, 0xef, 0xe0, 0x46, 0x01, 0xcf, 0xf7, 0xe3, 0xe0, 0xf9,
-	0xab, 0x43, 0x82, 0x67, 0xcf, 0x51, 0x03, 0x0c, 0xa5, 0x1f, 0x52, 0xa5, 0xd1, 0xf1, 0xa0, 0xf9,
-	0xa1, 0x04, 0xaf, 0xdc, 0x45, 0x36, 0x72, 0x75, 0x0f, 0x3d, 0xd0, 0xb1, 0x27, 0x4e, 0xc8, 0xb1,
-	0xf4, 0x7b, 0x1e, 0x07, 0xde, 0x8b, 0xf0, 0xea, 0x50, 0x33, 0xe3, 0x9a, 0xdc, 0x81, 0x95, 0xe8,
-	0xde, 0x2b, 0x7a, 0xaf, 0x76, 0x01, 0x8a, 0x2e, 0x6a, 0x39, 0x9e, 0x1f, 0x9f, 0x6c, 0xdf, 0x30,
-	0xa9, 0x16, 0x58, 0x33, 0x0f, 0x50, 0xac, 0x74, 0xe0, 0x74, 0xba, 0x1c, 0x1e, 0x18, 0xdf, 0x84,
-	0x71, 0x76, 0xfa, 0xe2, 0xfb, 0x8e, 0x37, 0x87, 0xdc, 0x18, 0xf2, 0xd3, 0x45, 0x5c, 0x2c, 0x17,
-	0xa6, 0xfc, 0x43, 0x0e, 0x16, 0xd2, 0x49, 0xfa, 0x9d, 0x12, 0xbe, 0x0a, 0x8b, 0x2d, 0xfd, 0x48,
-	0x8b, 0x63, 0x6f, 0xf0, 0xdd, 0xd3, 0x5c, 0x4b, 0x3f, 0x8a, 0xef, 0xbc, 0x4c, 0xf9, 0x3e, 0x94,
-	0x98, 0xc4, 0xa6, 0x63, 0xe8, 0xcd, 0xd1, 0xee, 0x09, 0xd9, 0xf6, 0xf8, 0x01, 0x61, 0xa4, 0x37,
-	0x4c, 0x1f, 0x27, 0x0d, 0xcb, 0xae, 0xcc, 0xdf, 0x39, 0x91, 0x61, 0x6a, 0x6a, 0xc4, 0x2d, 0x6c,
-	0xab, 0x1c, 0xf3, 0x95, 0xfc, 0xbb, 0x12, 0xcc, 0x36, 0x74, 0xdb, 0x74, 0x0e, 0xf8, 0xa6, 0x9f,
-	0x06, 0x21, 0x39, 0x52, 0x8e, 0xf2, 0xdd, 0x4d, 0x8f, 0x09, 0xdc, 0xe3, 0x82, 0xfd, 0x53, 0x30,
-	0x9f, 0x84, 0xdc, 0x48, 0x74, 0x2c, 0x7f, 0x5f, 0x82, 0xd9, 0x94, 0x09, 0xa7, 0x7c, 0x4a, 0xf3,
-	0x41, 0x74, 0xdb, 0x7e, 0xf7, 0x44, 0x73, 0xdc, 0x44, 0x2e, 0x1f, 0x2f, 0xb4, 0x8d, 0x5f, 0xfe,
-	0xae, 0x04, 0x8b, 0x3d, 0x26, 0x9f, 0x32, 0x21, 0x35, 0x3a, 0xa1, 0xaf, 0x0f, 0x39, 0xa1, 0xc4,
-	0x00, 0x74, 0x43, 0x1f, 0x3a, 0x4c, 0xbc, 0x07, 0xf3, 0xa9, 0x34, 0xf2, 0xdb, 0x70, 0xda, 0xf7,
-	0x59, 0x5a, 0xe0, 0x4a, 0x34, 0x70, 0x97, 0x04, 0x4d, 0x22, 0x7a, 0x95, 0x3f, 0x95, 0x60, 0x75,
-	0x90, 0x3d, 0x64, 0x05, 0x66, 0x74, 0x63, 0x1f, 0x99, 0x31, 0xb1, 0x53, 0xb4, 0x91, 0xa7, 0xc1,
-	0x07, 0xb0, 0x1c, 0xa2, 0x89, 0x9f, 0x86, 0x87, 0xfd, 0x16, 0x65, 0xd1, 0x17, 0xf9, 0x38, 0x7a,
-	0x2c, 0xfe, 0x3d, 0x09, 0x96, 0x55, 0xb4, 0xd3, 0xb1, 0x9a, 0xe6, 0xf3, 0xbe, 0x3c, 0x3c, 0x43,
-	0x16, 0xa9, 0x94, 0x99, 0x30, 0x4c, 0xbb, 0xd9, 0xfe, 0xf4, 0xb3, 0xea, 0xa9, 0x9f, 0x7e, 0x56,
-	0x3d, 0xf5, 0xf3, 0xcf, 0xaa, 0xd2, 0x6f, 0x3e, 0xa9, 0x4a, 0x7f, 0xf1, 0xa4, 0x2a, 0xfd, 0xfd,
-	0x93, 0xaa, 0xf4, 0xe9, 0x93, 0xaa, 0xf4, 0xaf, 0x4f, 0xaa, 0xd2, 0xbf, 0x3f, 0xa9, 0x9e, 0xfa,
-	0xf9, 0x93, 0xaa, 0xf4, 0xc9, 0xe7, 0xd5, 0x53, 0x9f, 0x7e, 0x5e, 0x3d, 0xf5, 0xd3, 0xcf, 0xab,
-	0xa7, 0xde, 0xbf, 0xbe, 0xe7, 0x04, 0x93, 0xb1, 0x9c, 0xbe, 0xff, 0x48, 0xee, 0x57, 0xa2, 0x2d,
-	0x3b, 0xe3, 0xd4, 0x9c, 0x57, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xf1, 0xed, 0x8b, 0x87,
-	0x4e, 0x00, 0x00,
+	// 4428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x49, 0x6c, 0x1c, 0x57,
+	0x76, 0x2a, 0x76, 0x37, 0xd9, 0xfd, 0x9a, 0xec, 0xa5, 0xb8, 0xb5, 0x48, 0xa9, 0x45, 0x95, 0x36,
+	0x5a, 0xb6, 0x5a, 0xdb, 0x8c, 0xed, 0x51, 0xc6, 0x76, 0x24, 0x6a, 0x6b, 0x8d, 0xa4, 0xa1, 0x8b,
+	0xb4, 0x6c, 0x78, 0xc6, 0x53, 0x2e, 0x56, 0x7d, 0xb2, 0x2b, 0xec, 0xae, 0x6a, 0xd7, 0xaf, 0x26,
+	0xd9, 0xce, 0x21, 0xcb, 0x20, 0x41, 0x32, 0x87, 0x8c, 0x81, 0x20, 0xc0, 0x60, 0x30, 0xb9, 0x04,
+	0xc8, 0x72, 0x09, 0x72, 0xc8, 0x69, 0x0e, 0xb9, 0xe4, 0x10, 0xe4, 0x14, 0x38, 0xb9, 0x64, 0x90,
+	0x1c, 0x26, 0x96, 0x81, 0x20, 0x41, 0x72, 0x98, 0x63, 0x80, 0x5c, 0x82, 0xbf, 0x55, 0xd7, 0xd6,
+	0x1b, 0x29, 0x45, 0x9e, 0x19, 0xdf, 0xd8, 0xff, 0xbf, 0xf7, 0xfe, 0xdb, 0xff, 0x7f, 0xff, 0xbf,
+	0x22, 0x7c, 0xdd, 0x43, 0xad, 0xb6, 0xe3, 0xea, 0xcd, 0xcb, 0x18, 0xb9, 0x7b, 0xc8, 0xbd, 0xac,
+	0xb7, 0xad, 0xcb, 0x0d, 0x0b, 0x7b, 0x8e, 0xdb, 0x25, 0x23, 0x96, 0x81, 0x2e, 0xef, 0x5d, 0xbd,
+	0xec, 0xa2, 0x8f, 0x3a, 0x08, 0x7b, 0x9a, 0x8b, 0x70, 0xdb, 0xb1, 0x31, 0xaa, 0xb5, 0x5d, 0xc7,
+	0x73, 0xe4, 0x73, 0x02, 0xbb, 0xc6, 0xb0, 0x6b, 0x7a, 0xdb, 0xaa, 0x85, 0xb1, 0x6b, 0x7b, 0x57,
+	0x97, 0xaa, 0x3b, 0x8e, 0xb3, 0xd3, 0x44, 0x97, 0x29, 0xd2, 0x56, 0x67, 0xfb, 0xb2, 0xd9, 0x71,
+	0x75, 0xcf, 0x72, 0x6c, 0x46, 0x66, 0xe9, 0x54, 0x74, 0xde, 0xb3, 0x5a, 0x08, 0x7b, 0x7a, 0xab,
+	0xcd, 0x01, 0x4e, 0x9b, 0xa8, 0x8d, 0x6c, 0x13, 0xd9, 0x86, 0x85, 0xf0, 0xe5, 0x1d, 0x67, 0xc7,
+	0xa1, 0xe3, 0xf4, 0x2f, 0x0e, 0x72, 0xd6, 0x17, 0x84, 0x48, 0x60, 0x38, 0xad, 0x96, 0x63, 0x13,
+	0xce, 0x5b, 0x08, 0x63, 0x7d, 0x87, 0x33, 0xbc, 0x74, 0x2e, 0x04, 0xc5, 0x39, 0x8d, 0x83, 0x5d,
+	0x08, 0x81, 0x79, 0x3a, 0xde, 0xfd, 0xa8, 0x83, 0x3a, 0x28, 0x0e, 0x18, 0x5e, 0x15, 0xd9, 0x9d,
+	0x16, 0x26, 0x40, 0xfb, 0x8e, 0xbb, 0xbb, 0xdd, 0x74, 0xf6, 0x39, 0xd4, 0xf9, 0x10, 0x94, 0x98,
+	0x8c, 0x53, 0x3b, 0x13, 0x82, 0xfb, 0xa8, 0x83, 0xdc, 0xee, 0x30, 0x11, 0xb6, 0x75, 0xab, 0xd9,
+	0x71, 0x13, 0x38, 0xbb, 0x98, 0x64, 0x58, 0xa3, 0xe9, 0x18, 0xbb, 0x71, 0xd8, 0x57, 0x06, 0x38,
+	0x41, 0x1c, 0xfa, 0xa5, 0x24, 0x68, 0x5f, 0x74, 0xa6, 0x79, 0x0e, 0xfa, 0xf2, 0x40, 0xd0, 0x88,
+	0x96, 0x2e, 0x0c, 0x04, 0x26, 0x46, 0xe0, 0x80, 0x97, 0x92, 0x00, 0xfb, 0x6b, 0xb5, 0x96, 0x04,
+	0x6e, 0xeb, 0x2d, 0x84, 0xdb, 0xba, 0x91, 0xa0, 0xb9, 0x2b, 0x49, 0xf0, 0x2e, 0x6a, 0x37, 0x2d,
+	0x83, 0x3a, 0x6d, 0x1c, 0xe3, 0x7a, 0x12, 0x46, 0x1b, 0xb9, 0xd8, 0xc2, 0x1e, 0xb2, 0xd9, 0x1a,
+	0xe8, 0x00, 0x19, 0x1d, 0x82, 0x8e, 0x39, 0xd2, 0x5b, 0x23, 0x20, 0x09, 0xa1, 0xb4, 0x56, 0xc7,
+	0xd3, 0xb7, 0x9a, 0x48, 0xc3, 0x9e, 0xee, 0x89, 0x55, 0x5f, 0x4d, 0xf4, 0xaa, 0xa1, 0x41, 0xbb,
+	0x74, 0x23, 0x69, 0x61, 0xdd, 0x6c, 0x59, 0xf6, 0x50, 0x5c, 0xe5, 0x1f, 0x27, 0xe1, 0xe4, 0x86,
+	0xa7, 0xbb, 0xde, 0xbb, 0x7c, 0xb9, 0x3b, 0x42, 0x2c, 0x95, 0x21, 0xc8, 0xa7, 0x61, 0xda, 0xd7,
+	0xad, 0x66, 0x99, 0x15, 0x69, 0x45, 0x5a, 0xcd, 0xa9, 0x79, 0x7f, 0xac, 0x6e, 0xca, 0x06, 0xcc,
+	0x60, 0x42, 0x43, 0xe3, 0x8b, 0x54, 0x26, 0x56, 0xa4, 0xd5, 0xfc, 0xb5, 0x37, 0x7d, 0x43, 0xd1,
+	0x34, 0x12, 0x11, 0xa8, 0xb6, 0x77, 0xb5, 0x36, 0x70, 0x65, 0x75, 0x9a, 0x12, 0x15, 0x7c, 0x34,
+	0x60, 0xbe, 0xad, 0xbb, 0xc8, 0xf6, 0x34, 0x5f, 0xf3, 0x9a, 0x65, 0x6f, 0x3b, 0x95, 0x14, 0x5d,
+	0xec, 0x2b, 0xb5, 0xa4, 0xd4, 0xe5, 0x7b, 0xe4, 0xde, 0xd5, 0xda, 0x3a, 0xc5, 0xf6, 0x57, 0xa9,
+	0xdb, 0xdb, 0x8e, 0x3a, 0xdb, 0x8e, 0x0f, 0xca, 0x15, 0x98, 0xd2, 0x3d, 0x42, 0xcd, 0xab, 0xa4,
+	0x57, 0xa4, 0xd5, 0x8c, 0x2a, 0x7e, 0xca, 0x2d, 0x50, 0x7c, 0x0b, 0xf6, 0xb8, 0x40, 0x07, 0x6d,
+	0x8b, 0xa5, 0x3f, 0x8d, 0xe4, 0xb9, 0x4a, 0x86, 0x32, 0xb4, 0x54, 0x63, 0x49, 0xb0, 0x26, 0x92,
+	0x60, 0x6d, 0x53, 0x24, 0xc1, 0x5b, 0xe9, 0x4f, 0x7e, 0x7a, 0x4a, 0x52, 0x4f, 0xed, 0x47, 0x25,
+	0xbf, 0xe3, 0x53, 0x22, 0xb0, 0x72, 0x03, 0x8e, 0x1b, 0x8e, 0xed, 0x59, 0x76, 0x07, 0x69, 0x3a,
+	0xd6, 0x6c, 0xb4, 0xaf, 0x59, 0xb6, 0xe5, 0x59, 0xba, 0xe7, 0xb8, 0x95, 0xc9, 0x15, 0x69, 0xb5,
+	0x70, 0xed, 0x52, 0x58, 0xc7, 0x34, 0xba, 0x88, 0xb0, 0x6b, 0x1c, 0xef, 0x26, 0x7e, 0x8c, 0xf6,
+	0xeb, 0x02, 0x49, 0x5d, 0x30, 0x12, 0xc7, 0xe5, 0x47, 0x50, 0x16, 0x33, 0xa6, 0xc6, 0x53, 0x50,
+	0x65, 0x8a, 0xca, 0xb1, 0x12, 0x5e, 0x81, 0x4f, 0x92, 0x35, 0xee, 0xb2, 0x3f, 0xd5, 0x92, 0x8f,
+	0xca, 0x47, 0xe4, 0x27, 0xb0, 0xd0, 0xd4, 0xb1, 0xa7, 0x19, 0x4e, 0xab, 0xdd, 0x44, 0x54, 0x33,
+	0x2e, 0xc2, 0x9d, 0xa6, 0x57, 0xc9, 0x26, 0xd1, 0xe4, 0x29, 0x86, 0xda, 0xa8, 0xdb, 0x74, 0x74,
+	0x13, 0xab, 0x73, 0x04, 0x7f, 0xcd, 0x47, 0x57, 0x29, 0xb6, 0xfc, 0x1d, 0x58, 0xde, 0xb6, 0x5c,
+	0xec, 0x69, 0xbe, 0x15, 0x48, 0x16, 0xd1, 0xb6, 0x74, 0x63, 0xd7, 0xd9, 0xde, 0xae, 0xe4, 0x28,
+	0xf1, 0xe3, 0x31, 0xc5, 0xdf, 0xe6, 0xbb, 0xd3, 0xad, 0xf4, 0x0f, 0x88, 0xde, 0x2b, 0x94, 0x86,
+	0x70, 0xbb, 0x4d, 0x1d, 0xef, 0xde, 0x62, 0x04, 0x88, 0xaf, 0xb7, 0x5d, 0xcb, 0x71, 0x2d, 0xaf,
+	0xab, 0xed, 0xa2, 0x6e, 0x05, 0xa8, 0xf9, 0xf3, 0x62, 0xec, 0x1b, 0xa8, 0x4b, 0x40, 0xb6, 0x75,
+	0xcb, 0xb5, 0x11, 0xc6, 0x14, 0x24, 0xcf, 0xc2, 0x41, 0x8c, 0x7d, 0x03, 0x75, 0x95, 0x7d, 0xa8,
+	0xf6, 0x73, 0x6c, 0x16, 0x7b, 0xf2, 0x3c, 0x4c, 0xba, 0x1d, 0xbb, 0x17, 0x4d, 0x19, 0xb7, 0x63,
+	0xd7, 0x4d, 0xf9, 0x4d, 0xc8, 0xd0, 0x84, 0xce, 0xe3, 0x67, 0x35, 0xd1, 0xa5, 0x29, 0x04, 0x0d,
+	0x9e, 0x86, 0xee, 0x9a, 0x6b, 0xe4, 0x97, 0xca, 0xd0, 0x94, 0xff, 0x92, 0x60, 0xe1, 0x1e, 0xf2,
+	0x1e, 0xb1, 0xdc, 0xb2, 0xe1, 0xe9, 0x1e, 0x1a, 0x23, 0x8a, 0xef, 0x41, 0xce, 0xf7, 0x69, 0xce,
+	0xc1, 0x4b, 0xfd, 0xec, 0x14, 0x17, 0xad, 0x87, 0x2b, 0x5f, 0x87, 0x05, 0x74, 0xd0, 0x46, 0x86,
+	0x87, 0x4c, 0xcd, 0x46, 0x07, 0x9e, 0x86, 0xf6, 0x48, 0xd8, 0x5a, 0x26, 0x0d, 0xd5, 0x94, 0x3a,
+	0x2b, 0x66, 0x1f, 0xa3, 0x03, 0xef, 0x0e, 0x99, 0xab, 0x9b, 0xf2, 0x15, 0x98, 0x33, 0x3a, 0x2e,
+	0x8d, 0xef, 0x2d, 0x57, 0xb7, 0x8d, 0x86, 0xe6, 0x39, 0xbb, 0xc8, 0xa6, 0x11, 0x38, 0xad, 0xca,
+	0x7c, 0xee, 0x16, 0x9d, 0xda, 0x24, 0x33, 0xca, 0x4f, 0xb3, 0xb0, 0x18, 0x93, 0x96, 0x2b, 0x38,
+	0x24, 0x8b, 0x74, 0x04, 0x59, 0xea, 0x30, 0xd3, 0xf3, 0xb5, 0x6e, 0x1b, 0x71, 0xc5, 0x9c, 0x1d,
+	0x46, 0x6c, 0xb3, 0xdb, 0x46, 0xea, 0xf4, 0x7e, 0xe0, 0x97, 0xac, 0xc0, 0x4c, 0x92, 0x36, 0xf2,
+	0x76, 0x40, 0x0b, 0x5f, 0x83, 0xe3, 0x6d, 0x17, 0xed, 0x59, 0x4e, 0x07, 0x6b, 0x34, 0xfb, 0x21,
+	0xb3, 0x07, 0x9f, 0xa6, 0xf0, 0x0b, 0x02, 0x60, 0x83, 0xcd, 0x0b, 0xd4, 0x4b, 0x30, 0x4b, 0x63,
+	0x8e, 0x05, 0x88, 0x8f, 0x94, 0xa1, 0x48, 0x25, 0x32, 0x75, 0x97, 0xcc, 0x08, 0xf0, 0x35, 0x00,
+	0x1a, 0x3b, 0xf4, 0x1c, 0x54, 0x99, 0x4c, 0x92, 0xca, 0x3f, 0x26, 0x11, 0xc1, 0x48, 0x98, 0xbc,
+	0x4d, 0x7e, 0xa8, 0x39, 0x4f, 0xfc, 0x29, 0xaf, 0x43, 0x19, 0x7b, 0x96, 0xb1, 0xdb, 0xd5, 0x02,
+	0xb4, 0xa6, 0xc6, 0xa0, 0x55, 0x64, 0xe8, 0xfe, 0x80, 0xfc, 0xeb, 0xf0, 0x72, 0x8c, 0xa2, 0x86,
+	0x8d, 0x06, 0x32, 0x3b, 0x4d, 0xa4, 0x79, 0x0e, 0xd3, 0x0a, 0xcd, 0xb3, 0x4e, 0xc7, 0xab, 0xe4,
+	0x47, 0x8b, 0xf8, 0x73, 0x91, 0x65, 0x36, 0x38, 0xc1, 0x4d, 0x87, 0x2a, 0x71, 0x93, 0x51, 0xeb,
+	0xeb, 0x83, 0x33, 0xfd, 0x7c, 0x50, 0xfe, 0x16, 0x14, 0x7c, 0xf7, 0xa0, 0x5b, 0x79, 0xa5, 0x48,
+	0xd3, 0x72, 0xf2, 0x6e, 0xe4, 0x67, 0xe7, 0x98, 0xcb, 0x31, 0xef, 0xf5, 0x5d, 0x8d, 0xfe, 0x94,
+	0xdf, 0x85, 0x62, 0x88, 0x78, 0x07, 0x57, 0x4a, 0x94, 0x7a, 0xad, 0x4f, 0xd2, 0x4f, 0x24, 0xdb,
+	0xc1, 0x6a, 0x21, 0x48, 0xb7, 0x83, 0xe5, 0x0f, 0xa0, 0xbc, 0x47, 0xce, 0x25, 0x8e, 0xad, 0xb1,
+	0x43, 0xa1, 0x85, 0x70, 0xa5, 0x4c, 0x55, 0x79, 0xa5, 0x36, 0xa0, 0x02, 0x20, 0x6b, 0x3c, 0x61,
+	0x88, 0xf7, 0x05, 0x9e, 0x5a, 0xda, 0x8b, 0x8c, 0xc8, 0x6f, 0xc2, 0x09, 0x0b, 0x6b, 0x4c, 0xe5,
+	0x41, 0x33, 0x22, 0x9b, 0x04, 0xaa, 0x59, 0x91, 0x57, 0xa4, 0xd5, 0xac, 0x5a, 0xb1, 0xf0, 0x46,
+	0xd8, 0x2a, 0x77, 0xd8, 0xbc, 0xfc, 0x15, 0x58, 0x8c, 0x79, 0xb2, 0x77, 0x40, 0xd3, 0xe5, 0x2c,
+	0x4b, 0x20, 0x61, 0x6f, 0xde, 0x3c, 0x20, 0xc9, 0xf3, 0x3a, 0x2c, 0x70, 0x04, 0x7f, 0x63, 0xe6,
+	0x39, 0x76, 0x8e, 0xe6, 0xba, 0x59, 0x3a, 0xdb, 0x0b, 0x72, 0x92, 0x71, 0x1f, 0xa4, 0xb3, 0xd9,
+	0x52, 0xee, 0x41, 0x3a, 0x9b, 0x2b, 0xc1, 0x83, 0x74, 0x16, 0x4a, 0xf9, 0x07, 0xe9, 0xec, 0x74,
+	0x69, 0xe6, 0x41, 0x3a, 0x5b, 0x28, 0x15, 0x95, 0xff, 0x96, 0x60, 0x71, 0xdd, 0x69, 0x36, 0x7f,
+	0x49, 0x12, 0xea, 0x0f, 0xb3, 0x50, 0x89, 0x8b, 0xfb, 0x65, 0x46, 0xfd, 0x32, 0xa3, 0x3e, 0xf3,
+	0x8c, 0x3a, 0xdd, 0x37, 0xa3, 0x26, 0xe6, 0xa6, 0xc2, 0x33, 0xcb, 0x4d, 0x3f, 0x9f, 0x09, 0x7b,
+	0x40, 0x46, 0x2c, 0x1f, 0x26, 0x23, 0xca, 0xe3, 0x65, 0xc4, 0x99, 0x52, 0x41, 0xf9, 0x7d, 0x09,
+	0x96, 0x55, 0x84, 0x91, 0x17, 0x49, 0xda, 0x2f, 0x20, 0x1f, 0x2a, 0x55, 0x38, 0x91, 0xcc, 0x0a,
+	0xcb, 0x55, 0xca, 0x0f, 0x53, 0xb0, 0xa2, 0x22, 0xc3, 0x71, 0xcd, 0xe0, 0x21, 0x9f, 0x47, 0xf7,
+	0x18, 0x0c, 0xbf, 0x07, 0x72, 0xbc, 0xdc, 0x1b, 0x9f, 0xf3, 0x72, 0xac, 0xce, 0x93, 0x4f, 0x41,
+	0xde, 0x0f, 0x41, 0x3f, 0x6f, 0x81, 0x18, 0xaa, 0x9b, 0xf2, 0x22, 0x4c, 0xd1, 0x70, 0xf5, 0x93,
+	0xd4, 0x24, 0xf9, 0x59, 0x37, 0xe5, 0x93, 0x00, 0xa2, 0x94, 0xe7, 0xb9, 0x28, 0xa7, 0xe6, 0xf8,
+	0x48, 0xdd, 0x94, 0x3f, 0x84, 0xe9, 0xb6, 0xd3, 0x6c, 0xfa, 0x95, 0x38, 0x4b, 0x43, 0x6f, 0x0c,
+	0xad, 0xc4, 0x49, 0xde, 0x0f, 0x2a, 0x2b, 0x68, 0x5b, 0x35, 0x4f, 0x48, 0x0a, 0xbd, 0xf9, 0x45,
+	0xca, 0xd4, 0xe1, 0x8a, 0x94, 0x3f, 0xca, 0xc2, 0xe9, 0x01, 0xc6, 0xe1, 0xdb, 0x4d, 0x6c, 0x97,
+	0x90, 0x0e, 0xbd, 0x4b, 0x0c, 0xdc, 0x01, 0x26, 0x06, 0xee, 0x00, 0xaf, 0x80, 0x2c, 0x6c, 0x62,
+	0x46, 0x77, 0x99, 0x92, 0x3f, 0x23, 0xa0, 0x57, 0xa1, 0xd4, 0x67, 0x87, 0x29, 0xe0, 0x30, 0xdd,
+	0xd8, 0xc6, 0x95, 0x89, 0x6f, 0x5c, 0x81, 0x5b, 0x88, 0xc9, 0xf0, 0x2d, 0xc4, 0xeb, 0x50, 0xe1,
+	0x19, 0xbd, 0x17, 0xd8, 0xe2, 0x6c, 0x35, 0x45, 0xcf, 0x56, 0x0b, 0x6c, 0xbe, 0x77, 0xaf, 0xc0,
+	0x66, 0xe5, 0x9d, 0x80, 0x43, 0x33, 0xf7, 0x22, 0x17, 0x28, 0xac, 0x26, 0xff, 0xda, 0xb0, 0xec,
+	0xba, 0xe9, 0xea, 0x36, 0xb6, 0x90, 0x1d, 0xaa, 0x9c, 0xe9, 0x2d, 0x4a, 0x69, 0x3f, 0x32, 0x22,
+	0xef, 0xc0, 0xc9, 0x84, 0x8b, 0x92, 0xc0, 0x96, 0x96, 0x1b, 0x63, 0x4b, 0x5b, 0x8a, 0xc5, 0x8f,
+	0x3f, 0x47, 0xa2, 0x38, 0xb4, 0xb1, 0xe4, 0xe9, 0xc6, 0x92, 0xdf, 0x0a, 0xec, 0x28, 0xf7, 0xa0,
+	0xd0, 0x33, 0x22, 0xbd, 0xa0, 0x99, 0x1e, 0xf1, 0x82, 0x66, 0xc6, 0xc7, 0x23, 0x33, 0xf2, 0x1a,
+	0x4c, 0x0b, 0xfb, 0x52, 0x32, 0x33, 0x23, 0x92, 0xc9, 0x73, 0x2c, 0x4a, 0xc4, 0x81, 0x29, 0x72,
+	0x0f, 0xcc, 0x76, 0xb5, 0xd4, 0x6a, 0xfe, 0xda, 0x3b, 0xb5, 0x91, 0xee, 0xdc, 0x6b, 0x43, 0x63,
+	0xa6, 0xf6, 0x36, 0xa3, 0x7b, 0xc7, 0xf6, 0xdc, 0xae, 0x2a, 0x56, 0xe9, 0xc5, 0x6b, 0xf1, 0x50,
+	0xf1, 0xba, 0xf4, 0x21, 0x4c, 0x07, 0x09, 0xcb, 0x25, 0x48, 0x91, 0x7b, 0x0f, 0x96, 0x2e, 0xc9,
+	0x9f, 0xf2, 0x0d, 0xc8, 0xec, 0xe9, 0xcd, 0x4e, 0x9f, 0x93, 0x1c, 0xbd, 0xf5, 0x0e, 0x86, 0x28,
+	0xa1, 0xd6, 0x55, 0x19, 0xca, 0x8d, 0x89, 0xd7, 0x25, 0xb6, 0xcd, 0x04, 0x92, 0xf6, 0x4d, 0xc3,
+	0xb3, 0xf6, 0x2c, 0xaf, 0xfb, 0x65, 0xd2, 0x1e, 0x21, 0x69, 0x07, 0x95, 0xf5, 0xfc, 0x92, 0xf6,
+	0xdf, 0xa6, 0x45, 0xd2, 0x4e, 0x34, 0x0e, 0x4f, 0xda, 0x8f, 0xa1, 0x18, 0x49, 0x97, 0x3c, 0x6d,
+	0x9f, 0x0b, 0x8b, 0x12, 0x48, 0x2a, 0xec, 0x64, 0xd6, 0xa5, 0x49, 0x4f, 0x2d, 0x84, 0x53, 0x6a,
+	0x2c, 0xe0, 0x26, 0x0e, 0x13, 0x70, 0x81, 0x3c, 0x9a, 0x0a, 0xe7, 0x51, 0x04, 0x55, 0x71, 0x38,
+	0xe5, 0x43, 0x5a, 0x24, 0x51, 0xa4, 0x47, 0x5c, 0x70, 0x99, 0xd3, 0xb9, 0xc9, 0xc8, 0x6c, 0x84,
+	0xd2, 0xc6, 0x23, 0x28, 0x37, 0x90, 0xee, 0x7a, 0x5b, 0x48, 0xf7, 0x34, 0x13, 0x79, 0xba, 0xd5,
+	0xc4, 0x95, 0xcc, 0x88, 0xf7, 0xa0, 0x25, 0x1f, 0xf5, 0x36, 0xc3, 0x8c, 0xef, 0x8c, 0x93, 0x87,
+	0xde, 0x19, 0x2f, 0x05, 0x42, 0xc5, 0x0f, 0x21, 0xea, 0x22, 0xb9, 0x9e, 0xff, 0x3f, 0x16, 0x13,
+	0x3d, 0x27, 0xca, 0x1e, 0xce, 0x89, 0x7e, 0x2c, 0xc1, 0x19, 0xe6, 0x2b, 0xa1, 0x34, 0xc6, 0x6f,
+	0x79, 0xc7, 0x0a, 0x72, 0x07, 0x4a, 0xfc, 0x6e, 0x19, 0x45, 0x1e, 0x1d, 0x6e, 0x0f, 0x8d, 0x9a,
+	0x11, 0x58, 0x50, 0x8b, 0x82, 0x3a, 0x1f, 0x50, 0x7e, 0x7b, 0x02, 0xce, 0x0e, 0x46, 0xe4, 0x31,
+	0x80, 0x7b, 0x87, 0x00, 0xf1, 0xd4, 0xc2, 0x83, 0xe0, 0xfe, 0xb3, 0x4a, 0xf4, 0xa4, 0xc6, 0x0b,
+	0x07, 0x1e, 0x82, 0x82, 0xce, 0xe3, 0x92, 0x6e, 0xb2, 0xb8, 0x32, 0xb1, 0x92, 0x1a, 0xe9, 0x05,
+	0xa6, 0x4f, 0x0a, 0xe1, 0x0b, 0xcd, 0xe8, 0x81, 0x29, 0xac, 0xfc, 0x95, 0x04, 0x2b, 0x6c, 0x2e,
+	0xc4, 0x1e, 0xb9, 0xf5, 0x1f, 0xcb, 0x7a, 0x0d, 0x28, 0x6c, 0x53, 0x9c, 0x88, 0xed, 0x6e, 0x1e,
+	0xc6, 0x76, 0xa1, 0xd5, 0xd5, 0x99, 0xed, 0xe0, 0x4f, 0xe5, 0x0c, 0x9c, 0x1e, 0x80, 0xc2, 0xcb,
+	0x85, 0x1f, 0x4b, 0xa0, 0xc4, 0x93, 0xdb, 0x7d, 0x11, 0x78, 0x63, 0x08, 0xd6, 0x0e, 0x86, 0x7a,
+	0x58, 0xb6, 0xb5, 0x11, 0x64, 0x1b, 0xc6, 0x42, 0x20, 0x1b, 0x08, 0x01, 0xd7, 0xe1, 0xcc, 0x40,
+	0x3c, 0xee, 0x20, 0x2f, 0x41, 0xc9, 0xd0, 0x6d, 0x03, 0xf9, 0x7b, 0x0c, 0x62, 0xfc, 0x67, 0xd5,
+	0x22, 0x1b, 0x57, 0xc5, 0x70, 0x30, 0x4a, 0x83, 0x34, 0x5f, 0x50, 0x94, 0x0e, 0x62, 0x21, 0x1e,
+	0xa5, 0xe7, 0xe1, 0xec, 0x60, 0x3c, 0x6e, 0xf1, 0x80, 0x23, 0x07, 0x01, 0xff, 0xff, 0x1d, 0xb9,
+	0xef, 0xea, 0xfd, 0x1d, 0x39, 0x09, 0x85, 0x8b, 0xf5, 0xd7, 0xd4, 0x91, 0xe3, 0xf2, 0x53, 0x0b,
+	0x8f, 0x25, 0xd8, 0xaf, 0x41, 0x21, 0xec, 0x2f, 0x63, 0x78, 0xf1, 0xb0, 0xf5, 0xd5, 0x99, 0x90,
+	0xcb, 0x29, 0xe7, 0x92, 0xfd, 0xcd, 0x47, 0xe2, 0xc2, 0xfd, 0xdd, 0x04, 0x54, 0x37, 0xac, 0x1d,
+	0x5b, 0x6f, 0x1e, 0xe5, 0xa9, 0x7a, 0x1b, 0x0a, 0x98, 0x12, 0x89, 0x08, 0xf6, 0xd6, 0xf0, 0xb7,
+	0xea, 0x81, 0x6b, 0xab, 0x33, 0x8c, 0xac, 0x60, 0xc5, 0x82, 0x65, 0x74, 0xe0, 0x21, 0x97, 0xac,
+	0x94, 0x70, 0x1c, 0x4d, 0x8d, 0x7b, 0x1c, 0x3d, 0x2e, 0xa8, 0xc5, 0xa6, 0xe4, 0x1a, 0xcc, 0x1a,
+	0x0d, 0xab, 0x69, 0xf6, 0xd6, 0x71, 0xec, 0x66, 0x97, 0x9e, 0x5d, 0xb2, 0x6a, 0x99, 0x4e, 0x09,
+	0xa4, 0x6f, 0xda, 0xcd, 0xae, 0x72, 0x1a, 0x4e, 0xf5, 0x95, 0x85, 0xeb, 0xfa, 0x07, 0x13, 0x70,
+	0x81, 0xc3, 0x58, 0x5e, 0xe3, 0xc8, 0xfd, 0x01, 0xdf, 0x95, 0xe0, 0x38, 0xd7, 0xfa, 0xbe, 0xe5,
+	0x35, 0xb4, 0xa4, 0x66, 0x81, 0xfb, 0xa3, 0x1a, 0x60, 0x18, 0x43, 0xea, 0x02, 0x0e, 0x03, 0x06,
+	0x18, 0x0d, 0x3d, 0xee, 0xa6, 0x86, 0x3f, 0xee, 0xa6, 0xe3, 0x8f, 0xbb, 0x37, 0x61, 0x75, 0x38,
+	0x23, 0x03, 0x9f, 0x79, 0x95, 0xbf, 0x91, 0xe0, 0x94, 0x8a, 0x5a, 0xce, 0x1e, 0x62, 0x94, 0x0e,
+	0xf9, 0xbc, 0xf0, 0xfc, 0x0a, 0x9d, 0x70, 0xb9, 0x92, 0x8a, 0x94, 0x2b, 0x8a, 0x02, 0x2b, 0xfd,
+	0xd9, 0xe7, 0x1e, 0xf4, 0x4f, 0x13, 0x70, 0x7a, 0x13, 0xb9, 0x2d, 0xcb, 0xd6, 0x3d, 0x74, 0x14,
+	0xdf, 0x71, 0xa0, 0xec, 0x09, 0x3a, 0x11, 0x97, 0xb9, 0x35, 0xd4, 0x65, 0x86, 0x72, 0xa0, 0x96,
+	0x7c, 0xe2, 0x3f, 0x07, 0x91, 0x7b, 0x16, 0x94, 0x41, 0x12, 0x71, 0xd5, 0xff, 0xb1, 0x04, 0xd5,
+	0xdb, 0xa8, 0x89, 0x8e, 0xa6, 0xf7, 0xe7, 0xe6, 0x5d, 0x24, 0xff, 0xf4, 0x65, 0x8f, 0x8b, 0xf0,
+	0xe7, 0x12, 0x9c, 0xa4, 0x37, 0xbc, 0x47, 0xec, 0x4a, 0x72, 0x09, 0x8d, 0xb1, 0xbb, 0x92, 0x06,
+	0xae, 0xac, 0x4e, 0x53, 0xa2, 0x62, 0xf3, 0x7a, 0x0d, 0xaa, 0xfd, 0xc0, 0x07, 0x27, 0x81, 0x3f,
+	0x4c, 0xc1, 0x39, 0x4e, 0x84, 0x6d, 0x75, 0x47, 0x11, 0xb5, 0xd5, 0x67, 0xbb, 0xbe, 0x3b, 0x82,
+	0xac, 0x23, 0xb0, 0x10, 0xd9, 0xb1, 0xe5, 0x37, 0x02, 0x21, 0xc2, 0x1b, 0x92, 0xe2, 0xf7, 0xa3,
+	0x15, 0x01, 0x52, 0x17, 0x10, 0xe2, 0x66, 0x73, 0x48, 0x84, 0xa5, 0x9f, 0x7f, 0x84, 0x65, 0xfa,
+	0x45, 0xd8, 0x2a, 0x9c, 0x1f, 0xa6, 0x11, 0xee, 0xa2, 0xdf, 0x9f, 0x80, 0x65, 0x51, 0xe7, 0x07,
+	0x6b, 0x8b, 0x2f, 0x44, 0x02, 0xbf, 0x0e, 0x0b, 0x16, 0xd6, 0x12, 0x5a, 0xa5, 0xa8, 0x6d, 0xb2,
+	0xea, 0xac, 0x85, 0xef, 0x46, 0x7b, 0xa0, 0x7a, 0xe5, 0x7d, 0xfa, 0x70, 0xe5, 0x7d, 0x15, 0x4e,
+	0x24, 0x2b, 0x84, 0x6b, 0xec, 0xdf, 0x25, 0xb8, 0xf0, 0x04, 0xb9, 0xd6, 0x76, 0x37, 0xb6, 0xb6,
+	0xc0, 0xfb, 0x62, 0xdc, 0xf3, 0xf9, 0x8a, 0x48, 0x1d, 0x4e, 0x11, 0x17, 0x61, 0x75, 0xb8, 0x9c,
+	0x5c, 0x29, 0xff, 0x9b, 0x82, 0xb3, 0xac, 0x80, 0x5b, 0x23, 0xce, 0xe8, 0x33, 0x71, 0x98, 0x72,
+	0xeb, 0xf9, 0x69, 0xa4, 0x06, 0xbc, 0x51, 0x32, 0x10, 0xee, 0x7e, 0xa0, 0x97, 0xd9, 0x94, 0x1f,
+	0xe6, 0x75, 0x53, 0x7e, 0x1f, 0x66, 0x45, 0x69, 0x66, 0x1e, 0x25, 0xb2, 0x65, 0x9f, 0x4a, 0x8f,
+	0x97, 0x75, 0xbf, 0xa8, 0xa4, 0xef, 0x1e, 0xf4, 0x96, 0x31, 0x33, 0xce, 0x2d, 0x63, 0xb1, 0x87,
+	0x4e, 0x07, 0x7a, 0xf6, 0x9e, 0x3c, 0x94, 0xbd, 0xc9, 0x7b, 0x4c, 0x4c, 0x3b, 0xfc, 0xe1, 0xb9,
+	0x32, 0xc5, 0xdf, 0x97, 0xc2, 0x2a, 0xe2, 0x0f, 0xd5, 0xca, 0x05, 0x38, 0x37, 0xc4, 0xf8, 0xdc,
+	0x4d, 0xfe, 0x2c, 0x05, 0x97, 0x98, 0x4f, 0x25, 0x42, 0xd2, 0xc4, 0x44, 0xe8, 0x8c, 0xe5, 0x2f,
+	0x9b, 0x50, 0x8a, 0x76, 0xd4, 0x8e, 0xef, 0x2d, 0xc5, 0x48, 0x07, 0xad, 0xac, 0x42, 0x91, 0xa5,
+	0xdc, 0x23, 0x9c, 0x99, 0x0a, 0x46, 0x48, 0xca, 0x7e, 0xfe, 0x97, 0xee, 0xe7, 0x7f, 0x83, 0x2c,
+	0x92, 0x19, 0x64, 0x91, 0xa3, 0xfa, 0x82, 0x72, 0x05, 0x6a, 0xa3, 0xda, 0x89, 0x9b, 0xf6, 0x4f,
+	0x24, 0x58, 0xb9, 0x8d, 0xb0, 0xe1, 0x5a, 0x5b, 0x47, 0x3a, 0xb0, 0x7d, 0x0b, 0xa6, 0xc6, 0xbd,
+	0x84, 0x18, 0xb6, 0xac, 0x2a, 0x28, 0x2a, 0xdf, 0x4f, 0xc3, 0xe9, 0x01, 0xd0, 0xfc, 0xa8, 0xf3,
+	0x6d, 0x28, 0xf5, 0x1e, 0xfb, 0x0c, 0xc7, 0xde, 0xb6, 0x76, 0xf8, 0xdd, 0xe7, 0xd5, 0x64, 0x5e,
+	0x12, 0xad, 0xbf, 0x46, 0x11, 0xd5, 0x22, 0x0a, 0x0f, 0xc8, 0x3b, 0xb0, 0x98, 0xf0, 0xa6, 0x48,
+	0x5f, 0x30, 0x99, 0xc0, 0x97, 0xc7, 0x58, 0x84, 0xbe, 0x5b, 0xce, 0xef, 0x27, 0x0d, 0xcb, 0xdf,
+	0x06, 0xb9, 0x8d, 0x6c, 0xd3, 0xb2, 0x77, 0x34, 0x7e, 0xff, 0x49, 0x5e, 0xeb, 0x52, 0xf4, 0x46,
+	0xf5, 0x52, 0xff, 0x35, 0xd6, 0x19, 0x8e, 0xb8, 0xc4, 0xa0, 0x2b, 0x94, 0xdb, 0xa1, 0x41, 0xf2,
+	0x1e, 0xf7, 0x1d, 0x28, 0x09, 0xea, 0xd4, 0xcb, 0x5d, 0xda, 0x93, 0x45, 0x68, 0x5f, 0x1f, 0x4a,
+	0x3b, 0xec, 0x54, 0x74, 0x85, 0x62, 0x3b, 0x30, 0xe5, 0x22, 0x5b, 0x46, 0x30, 0x2f, 0xe8, 0x87,
+	0xb7, 0xfe, 0xcc, 0x30, 0x4b, 0xf0, 0x45, 0x62, 0xcf, 0xbb, 0xb3, 0xed, 0xf8, 0x84, 0xf2, 0x5b,
+	0x29, 0xa8, 0xa8, 0xfc, 0x1b, 0x0a, 0x44, 0xf3, 0x28, 0x7e, 0x72, 0xed, 0x0b, 0xb1, 0x59, 0x6d,
+	0xc3, 0x7c, 0xb8, 0x83, 0xa8, 0xab, 0x59, 0x1e, 0x6a, 0x09, 0x0b, 0x5e, 0x1b, 0xab, 0x8b, 0xa8,
+	0x5b, 0xf7, 0x50, 0x4b, 0x9d, 0xdd, 0x8b, 0x8d, 0x61, 0xf9, 0x75, 0x98, 0xa4, 0xbb, 0x0f, 0xae,
+	0xa4, 0x07, 0x3f, 0xe6, 0xdc, 0xd6, 0x3d, 0xfd, 0x56, 0xd3, 0xd9, 0x52, 0x39, 0xbc, 0x7c, 0x17,
+	0x0a, 0xa4, 0x97, 0x9f, 0x94, 0x05, 0x9c, 0x42, 0x66, 0x44, 0x0a, 0xd3, 0x36, 0xda, 0x57, 0x3b,
+	0x6c, 0xdf, 0xc2, 0xca, 0x32, 0x1c, 0x4f, 0x30, 0x41, 0xaf, 0x0c, 0x5c, 0xd8, 0xe8, 0xda, 0x06,
+	0xcd, 0x51, 0xbc, 0xaf, 0x88, 0x9b, 0xe7, 0x1c, 0x14, 0xb0, 0xd3, 0x71, 0x0d, 0xa4, 0x19, 0xcd,
+	0x0e, 0xf6, 0x90, 0xcb, 0x0d, 0x34, 0xc3, 0x46, 0xd7, 0xd8, 0xa0, 0x7c, 0x1c, 0xb2, 0x98, 0x20,
+	0x8b, 0x3e, 0x89, 0x8c, 0x3a, 0x45, 0x7f, 0xd7, 0x4d, 0xf9, 0x26, 0xe4, 0x59, 0x83, 0x13, 0x7b,
+	0x27, 0x4b, 0x8d, 0xf8, 0x4e, 0x06, 0x0c, 0x89, 0x0c, 0x2b, 0xc7, 0x61, 0x31, 0xc6, 0x9e, 0xb8,
+	0x3c, 0xc8, 0xc0, 0x2c, 0x99, 0x13, 0xa1, 0x34, 0x86, 0x5b, 0x9d, 0x82, 0xbc, 0xef, 0x56, 0x9c,
+	0xed, 0x9c, 0x0a, 0x62, 0xa8, 0x6e, 0x06, 0xca, 0xb1, 0x54, 0xb0, 0xf5, 0xbe, 0x02, 0x53, 0x62,
+	0x83, 0x60, 0xbb, 0x8a, 0xf8, 0x49, 0x16, 0xed, 0xbd, 0x0a, 0xf6, 0x5a, 0x35, 0xfc, 0x31, 0xda,
+	0xd8, 0x14, 0xed, 0x30, 0x98, 0x3c, 0x5c, 0x87, 0xc1, 0x49, 0x00, 0xf1, 0x78, 0x64, 0x99, 0xfc,
+	0xec, 0x90, 0xe3, 0x23, 0x75, 0x33, 0xf6, 0x1e, 0x9a, 0x3d, 0xcc, 0x7b, 0xe8, 0x3a, 0xef, 0x6a,
+	0xec, 0x3d, 0x54, 0x50, 0x5a, 0xb9, 0x11, 0x69, 0x95, 0x09, 0xb2, 0xff, 0xc0, 0x40, 0x29, 0xde,
+	0x80, 0x29, 0xf1, 0xac, 0x09, 0x23, 0x3e, 0x6b, 0x0a, 0x84, 0xe0, 0xeb, 0x6c, 0x3e, 0xfc, 0x3a,
+	0xbb, 0x06, 0xd3, 0x94, 0x4f, 0xf1, 0x35, 0xca, 0xf4, 0x88, 0x5f, 0xa3, 0xe4, 0x69, 0x2b, 0x1c,
+	0xfb, 0x41, 0xfa, 0x0f, 0x29, 0x11, 0xe2, 0x00, 0xc8, 0xd5, 0x2c, 0x13, 0xd9, 0x9e, 0xe5, 0x75,
+	0x69, 0xeb, 0x46, 0x4e, 0x95, 0xc9, 0xdc, 0xbb, 0x74, 0xaa, 0xce, 0x67, 0x48, 0x0f, 0x5f, 0x24,
+	0x7b, 0xf0, 0xee, 0xc3, 0xda, 0x78, 0x79, 0x43, 0x2d, 0x84, 0x73, 0x86, 0xb2, 0x00, 0x73, 0x61,
+	0x9f, 0xe6, 0xce, 0x4e, 0x1a, 0xeb, 0xc4, 0xd6, 0xfa, 0x82, 0x1b, 0x8d, 0x95, 0xff, 0x91, 0xe0,
+	0x44, 0x32, 0x2f, 0x7c, 0x87, 0x6f, 0xc0, 0xac, 0xa1, 0x1b, 0x0d, 0x14, 0xfe, 0x7e, 0x8d, 0x6f,
+	0xf2, 0xaf, 0x27, 0x6a, 0x28, 0xf0, 0x05, 0x5c, 0x70, 0xfd, 0x10, 0xf9, 0x32, 0x25, 0x1a, 0x1c,
+	0x92, 0x6d, 0x58, 0x30, 0x75, 0x4f, 0xdf, 0xd2, 0x71, 0x74, 0xb1, 0x89, 0x23, 0x2e, 0x36, 0x27,
+	0xe8, 0x06, 0x47, 0x95, 0x7f, 0x96, 0x60, 0x49, 0x88, 0xce, 0x4d, 0x76, 0xdf, 0xc1, 0xc1, 0xcb,
+	0xe3, 0x86, 0x83, 0x3d, 0x4d, 0x37, 0x4d, 0x17, 0x61, 0x2c, 0xac, 0x40, 0xc6, 0x6e, 0xb2, 0xa1,
+	0x41, 0xe9, 0x32, 0x6a, 0xc3, 0xd4, 0xa8, 0xfb, 0x61, 0xfa, 0x19, 0xdc, 0xb7, 0x7d, 0x32, 0x01,
+	0xcb, 0x89, 0x92, 0x71, 0x9b, 0x9e, 0x81, 0x19, 0xca, 0x27, 0xd6, 0xec, 0x4e, 0x6b, 0x8b, 0x6f,
+	0x06, 0x19, 0x75, 0x9a, 0x0d, 0x3e, 0xa6, 0x63, 0xf2, 0x32, 0xe4, 0x84, 0x70, 0xec, 0x71, 0x39,
+	0xa3, 0x66, 0xb9, 0x74, 0xe4, 0x7b, 0x82, 0x62, 0x4f, 0x3c, 0x6a, 0xca, 0x81, 0x1f, 0xe5, 0xf9,
+	0xb0, 0x44, 0x04, 0xbf, 0xbd, 0x60, 0x8d, 0xe0, 0xd1, 0xf3, 0x46, 0xc1, 0x0e, 0x8d, 0xc9, 0xaf,
+	0xc2, 0x22, 0x5b, 0xdb, 0x70, 0x6c, 0xcf, 0x75, 0x9a, 0x4d, 0xe4, 0x8a, 0xf6, 0x5a, 0x76, 0x41,
+	0x3f, 0x4f, 0xa7, 0xd7, 0xfc, 0x59, 0xde, 0x35, 0x4b, 0x72, 0x0b, 0x37, 0x17, 0x6b, 0xb9, 0x11,
+	0x3f, 0x95, 0x1a, 0x94, 0xd7, 0x9a, 0x0e, 0x46, 0x74, 0xf3, 0x11, 0x26, 0x0e, 0xda, 0x4f, 0x0a,
+	0xd9, 0x4f, 0x99, 0x03, 0x39, 0x08, 0xcf, 0x23, 0xf7, 0x15, 0x28, 0xde, 0x43, 0xde, 0xa8, 0x34,
+	0x3e, 0x84, 0x52, 0x0f, 0x9a, 0xab, 0xfe, 0x21, 0x00, 0x07, 0x27, 0xa7, 0x58, 0x16, 0x45, 0x97,
+	0x46, 0x71, 0x6c, 0x4a, 0x86, 0x2a, 0x2b, 0x87, 0xc5, 0x9f, 0xca, 0xbf, 0x48, 0x50, 0x66, 0x17,
+	0xf3, 0xc1, 0x8b, 0xa8, 0xfe, 0x2c, 0xc9, 0x77, 0x21, 0x6b, 0xe8, 0x1e, 0xda, 0x21, 0x49, 0x6e,
+	0x82, 0x36, 0x2a, 0x5f, 0x1c, 0xdc, 0x06, 0xcd, 0x1e, 0xe6, 0x18, 0x86, 0xea, 0xe3, 0x06, 0xfb,
+	0x9e, 0x52, 0xa1, 0xbe, 0xa7, 0x3a, 0x14, 0xf7, 0x2c, 0x6c, 0x6d, 0x59, 0x4d, 0xda, 0x99, 0x30,
+	0x4e, 0x4b, 0x4d, 0xa1, 0x87, 0x48, 0x8f, 0x0b, 0x73, 0x20, 0x07, 0x65, 0xe3, 0x26, 0xf8, 0x44,
+	0x82, 0x93, 0xf7, 0x90, 0xa7, 0xf6, 0x3e, 0xe6, 0x7d, 0xc4, 0x3e, 0xe4, 0xf5, 0xcf, 0x3a, 0x0f,
+	0x61, 0x92, 0x76, 0x06, 0x92, 0x90, 0x4d, 0xf5, 0x75, 0xc9, 0xc0, 0xd7, 0xc0, 0xec, 0x56, 0xd4,
+	0xff, 0x49, 0x7b, 0x08, 0x55, 0x4e, 0x83, 0x04, 0x32, 0x3f, 0x32, 0xd1, 0x86, 0x19, 0x7e, 0xbe,
+	0xc8, 0xf3, 0x31, 0xe2, 0xcb, 0xca, 0x8f, 0x26, 0xa0, 0xda, 0x8f, 0x25, 0x6e, 0xf6, 0xdf, 0x80,
+	0x02, 0x33, 0x09, 0xff, 0xea, 0x58, 0xf0, 0xf6, 0xde, 0x88, 0x1d, 0x22, 0x83, 0xc9, 0x33, 0xe7,
+	0x10, 0xa3, 0xac, 0x1b, 0x70, 0x06, 0x07, 0xc7, 0x96, 0xba, 0x20, 0xc7, 0x81, 0x82, 0x9d, 0x7d,
+	0x19, 0xd6, 0xd9, 0xf7, 0x28, 0xdc, 0xd9, 0xf7, 0xda, 0x98, 0xba, 0xf3, 0x39, 0xeb, 0x35, 0xfb,
+	0x29, 0x1f, 0xc3, 0xca, 0x3d, 0xe4, 0xdd, 0x7e, 0xf8, 0xf6, 0x00, 0x9b, 0x3d, 0xe1, 0x5f, 0x52,
+	0x90, 0xa8, 0x10, 0xba, 0x19, 0x77, 0x6d, 0xbf, 0x7a, 0xc9, 0x79, 0xfc, 0x2f, 0xac, 0xfc, 0x8e,
+	0x04, 0xa7, 0x07, 0x2c, 0xce, 0xad, 0xf3, 0x21, 0x94, 0x03, 0x64, 0x79, 0x3f, 0x8d, 0x14, 0xad,
+	0xd0, 0x46, 0x66, 0x42, 0x2d, 0xb9, 0xe1, 0x01, 0xac, 0x7c, 0x4f, 0x82, 0x39, 0xda, 0x05, 0x29,
+	0xf2, 0xf7, 0x18, 0x7b, 0xfd, 0x37, 0xa3, 0x65, 0xfe, 0x57, 0x87, 0x96, 0xf9, 0x49, 0x4b, 0xf5,
+	0x4a, 0xfb, 0x5d, 0x98, 0x8f, 0x00, 0x70, 0x3d, 0xa8, 0x90, 0x8d, 0x74, 0x30, 0xbd, 0x3a, 0xee,
+	0x52, 0x0c, 0x5b, 0xf5, 0xe9, 0x28, 0x7f, 0x20, 0xc1, 0x9c, 0x8a, 0xf4, 0x76, 0xbb, 0xc9, 0x2e,
+	0xe3, 0xf0, 0x18, 0x92, 0x6f, 0x44, 0x25, 0x4f, 0xee, 0x58, 0x0e, 0x7e, 0xf8, 0xce, 0xcc, 0x11,
+	0x5f, 0xae, 0x27, 0xfd, 0x22, 0xcc, 0x47, 0x00, 0x38, 0xa7, 0x7f, 0x39, 0x01, 0xf3, 0xcc, 0x57,
+	0xa2, 0xde, 0x79, 0x07, 0xd2, 0x7e, 0x47, 0x7a, 0x21, 0x58, 0x4f, 0x27, 0x65, 0xcc, 0xdb, 0x48,
+	0x37, 0x1f, 0x22, 0xcf, 0x43, 0x2e, 0xed, 0xac, 0xa2, 0x4d, 0x78, 0x14, 0x7d, 0xd0, 0x71, 0x21,
+	0x5e, 0x9f, 0xa5, 0x92, 0xea, 0xb3, 0xd7, 0xa0, 0x62, 0xd9, 0x04, 0xc2, 0xda, 0x43, 0x1a, 0xb2,
+	0xfd, 0x74, 0xd2, 0xbb, 0x1a, 0x9b, 0xf7, 0xe7, 0xef, 0xd8, 0x22, 0xd8, 0xeb, 0xa6, 0x7c, 0x11,
+	0xca, 0x2d, 0xfd, 0xc0, 0x6a, 0x75, 0x5a, 0x5a, 0x9b, 0xc0, 0x63, 0xeb, 0x63, 0xf6, 0xd5, 0x7a,
+	0x46, 0x2d, 0xf2, 0x89, 0x75, 0x7d, 0x07, 0x6d, 0x58, 0x1f, 0x23, 0xf9, 0x3c, 0x14, 0x69, 0xab,
+	0x3a, 0x05, 0x64, 0x3d, 0xd6, 0x93, 0xb4, 0xc7, 0x9a, 0x76, 0xb0, 0x13, 0x30, 0xf6, 0xf1, 0xd8,
+	0x7f, 0xb2, 0x6f, 0x8f, 0x43, 0xfa, 0xe2, 0x8e, 0xf4, 0x8c, 0x14, 0x96, 0x18, 0x97, 0x13, 0xcf,
+	0x30, 0x2e, 0x93, 0x64, 0x4d, 0x25, 0xc9, 0xfa, 0xaf, 0xe4, 0xbb, 0xc0, 0x8e, 0xbb, 0x83, 0x7e,
+	0x11, 0xbd, 0x43, 0x59, 0x82, 0x4a, 0x5c, 0x38, 0xd1, 0x38, 0x35, 0x01, 0x8b, 0x8f, 0xd0, 0x2f,
+	0xa8, 0xe4, 0xcf, 0x25, 0x2e, 0x6e, 0x41, 0xe5, 0x11, 0x4a, 0xd6, 0x66, 0x12, 0x0d, 0x29, 0x89,
+	0xc6, 0x8f, 0xe8, 0xb7, 0x57, 0xdb, 0x2e, 0xc2, 0x8d, 0xe0, 0x1d, 0xdc, 0x38, 0xc9, 0xf3, 0xfd,
+	0x68, 0xf2, 0xfc, 0xd5, 0x11, 0x93, 0x67, 0xdf, 0x55, 0x7b, 0x39, 0x94, 0x7e, 0x8e, 0x95, 0x04,
+	0x27, 0xba, 0x89, 0x24, 0xb8, 0x78, 0x0f, 0xd9, 0xc8, 0xd5, 0x3d, 0xf4, 0x90, 0xdc, 0x1e, 0xf0,
+	0x0a, 0x39, 0x12, 0x7e, 0x2f, 0xa2, 0xe0, 0xbd, 0x04, 0x2f, 0x8f, 0xc4, 0x19, 0x97, 0xe4, 0x2e,
+	0x2c, 0x87, 0xcf, 0x5e, 0xe1, 0x7b, 0xb5, 0x0b, 0x50, 0x74, 0x51, 0xcb, 0xf1, 0x7c, 0xff, 0x64,
+	0xe7, 0x86, 0x9c, 0x5a, 0x60, 0xc3, 0xdc, 0x41, 0xb1, 0xd2, 0x81, 0x13, 0xc9, 0x74, 0xb8, 0x63,
+	0xbc, 0x03, 0x93, 0xac, 0xfa, 0xe2, 0xe7, 0x8e, 0x37, 0x46, 0x3c, 0x18, 0xf2, 0xea, 0x22, 0x4a,
+	0x96, 0x13, 0x53, 0xfe, 0x21, 0x03, 0x0b, 0xc9, 0x20, 0x83, 0xaa, 0x84, 0xaf, 0xc2, 0x62, 0x4b,
+	0x3f, 0xd0, 0xa2, 0xb9, 0xb7, 0xf7, 0xf5, 0xd4, 0x5c, 0x4b, 0x3f, 0x88, 0x9e, 0xbc, 0x4c, 0xf9,
+	0x01, 0x94, 0x18, 0xc5, 0xa6, 0x63, 0xe8, 0xcd, 0xf1, 0xee, 0x09, 0xd9, 0xf1, 0xf8, 0x21, 0x41,
+	0x24, 0x53, 0xf2, 0xc7, 0x71, 0xc5, 0xb2, 0x2b, 0xf3, 0xb7, 0x8f, 0xa4, 0x98, 0x9a, 0x1a, 0x32,
+	0x0b, 0x3b, 0x2a, 0x47, 0x6c, 0x25, 0xff, 0xae, 0x04, 0xb3, 0x0d, 0xdd, 0x36, 0x9d, 0x3d, 0x7e,
+	0xe8, 0xa7, 0x4e, 0x48, 0x4a, 0xca, 0x71, 0xbe, 0xde, 0xe9, 0xc3, 0xc0, 0x7d, 0x4e, 0xd8, 0xaf,
+	0x82, 0x39, 0x13, 0x72, 0x23, 0x36, 0xb1, 0xf4, 0x3d, 0x09, 0x66, 0x13, 0x18, 0x4e, 0xf8, 0x20,
+	0xe7, 0x83, 0xf0, 0xb1, 0xfd, 0xde, 0x91, 0x78, 0x5c, 0x47, 0x2e, 0x5f, 0x2f, 0x70, 0x8c, 0x5f,
+	0xfa, 0xae, 0x04, 0x8b, 0x7d, 0x98, 0x4f, 0x60, 0x48, 0x0d, 0x33, 0xf4, 0xf5, 0x11, 0x19, 0x8a,
+	0x2d, 0x40, 0x0f, 0xf4, 0x81, 0x62, 0xe2, 0x3d, 0x98, 0x4f, 0x84, 0x91, 0xdf, 0x82, 0x13, 0xbe,
+	0xcd, 0x92, 0x1c, 0x57, 0xa2, 0x8e, 0x7b, 0x5c, 0xc0, 0xc4, 0xbc, 0x57, 0xf9, 0x53, 0x09, 0x56,
+	0x86, 0xe9, 0x83, 0x7c, 0xc6, 0xa7, 0x1b, 0xbb, 0xc8, 0x8c, 0x90, 0xcd, 0xd3, 0x41, 0x1e, 0x06,
+	0x1f, 0xc0, 0x52, 0x00, 0x26, 0x5a, 0x0d, 0x8f, 0xfa, 0x45, 0xcb, 0xa2, 0x4f, 0xf2, 0x49, 0xb8,
+	0x2c, 0xfe, 0x3d, 0x09, 0x96, 0x54, 0xb4, 0xd5, 0xb1, 0x9a, 0xe6, 0x8b, 0xbe, 0x3c, 0x3c, 0x09,
+	0xcb, 0x89, 0x9c, 0x88, 0xf7, 0x88, 0x09, 0x38, 0xff, 0x4e, 0x1b, 0xa3, 0x84, 0x86, 0xc9, 0x47,
+	0xc8, 0xd3, 0x4d, 0xdd, 0xd3, 0x5f, 0x00, 0xd7, 0xf2, 0x3b, 0x50, 0xc6, 0x48, 0x77, 0x8d, 0x86,
+	0xa6, 0x7b, 0x9e, 0x6b, 0x6d, 0x75, 0x3c, 0xfa, 0xd6, 0x17, 0x79, 0xfc, 0x0d, 0x13, 0xdc, 0xa0,
+	0x08, 0x37, 0x7d, 0x78, 0xb5, 0x84, 0x23, 0x23, 0xf2, 0x15, 0x48, 0xb7, 0x50, 0xcb, 0xe1, 0xd7,
+	0x1d, 0x27, 0xfa, 0x51, 0x7a, 0x84, 0x5a, 0x8e, 0x4a, 0x21, 0x95, 0x97, 0xe0, 0xc2, 0x50, 0xf5,
+	0x30, 0x55, 0xde, 0x6a, 0x7f, 0xfa, 0x59, 0xf5, 0xd8, 0x4f, 0x3e, 0xab, 0x1e, 0xfb, 0xd9, 0x67,
+	0x55, 0xe9, 0x37, 0x9f, 0x56, 0xa5, 0xbf, 0x78, 0x5a, 0x95, 0xfe, 0xfe, 0x69, 0x55, 0xfa, 0xf4,
+	0x69, 0x55, 0xfa, 0xb7, 0xa7, 0x55, 0xe9, 0x3f, 0x9e, 0x56, 0x8f, 0xfd, 0xec, 0x69, 0x55, 0xfa,
+	0xe4, 0xf3, 0xea, 0xb1, 0x4f, 0x3f, 0xaf, 0x1e, 0xfb, 0xc9, 0xe7, 0xd5, 0x63, 0xef, 0xdf, 0xd8,
+	0x71, 0x7a, 0x6c, 0x58, 0xce, 0xc0, 0xff, 0x0f, 0xf8, 0x2b, 0xe1, 0x91, 0xad, 0x49, 0xea, 0x99,
+	0xd7, 0xff, 0x6f, 0x00, 0x28, 0x0e, 0x58, 0x38, 0x5e, 0x50, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -5579,6 +5730,12 @@ func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
 	} else if that1.FirstWorkflowTaskBackoff != nil {
 		return false
 	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -6457,6 +6614,12 @@ func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) boo
 	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
 		return false
 	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -8253,11 +8416,65 @@ func (this *RebuildMutableStateResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpsertWorkflowExecutionMetadataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpsertWorkflowExecutionMetadataRequest)
+	if !ok {
+		that2, ok := that.(UpsertWorkflowExecutionMetadataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if !this.SearchAttributes.Equal(that1.SearchAttributes) {
+		return false
+	}
+	if !this.Memo.Equal(that1.Memo) {
+		return false
+	}
+	return true
+}
+func (this *UpsertWorkflowExecutionMetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpsertWorkflowExecutionMetadataResponse)
+	if !ok {
+		that2, ok := that.(UpsertWorkflowExecutionMetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.StartRequest != nil {
@@ -8276,6 +8493,8 @@ func (this *StartWorkflowExecutionRequest) GoString() string {
 		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
 	}
 	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8692,12 +8911,14 @@ func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.SignalWithStartRequest != nil {
 		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
 	}
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9524,6 +9745,34 @@ func (this *RebuildMutableStateResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpsertWorkflowExecutionMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.UpsertWorkflowExecutionMetadataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	}
+	if this.Memo != nil {
+		s = append(s, "Memo: "+fmt.Sprintf("%#v", this.Memo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertWorkflowExecutionMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UpsertWorkflowExecutionMetadataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -9552,6 +9801,18 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.FirstWorkflowTaskBackoff != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
 		if err1 != nil {
@@ -11116,6 +11377,18 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SignalWithStartRequest != nil {
 		{
 			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -13784,6 +14057,95 @@ func (m *RebuildMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *UpsertWorkflowExecutionMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowExecutionMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowExecutionMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowExecutionMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowExecutionMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestResponse(v)
 	base := offset
@@ -13835,6 +14197,13 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PriorityKey != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -14444,6 +14813,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 		l = m.SignalWithStartRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PriorityKey != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -15549,6 +15925,40 @@ func (m *RebuildMutableStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpsertWorkflowExecutionMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpsertWorkflowExecutionMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -15569,6 +15979,8 @@ func (this *StartWorkflowExecutionRequest) String() string {
 		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
 		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
 		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15916,6 +16328,8 @@ func (this *SignalWithStartWorkflowExecutionRequest) String() string {
 	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16681,6 +17095,28 @@ func (this *RebuildMutableStateResponse) String() string {
 	}, "")
 	return s
 }
+func (this *UpsertWorkflowExecutionMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowExecutionMetadataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`SearchAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributes), "SearchAttributes", "v14.SearchAttributes", 1) + `,`,
+		`Memo:` + strings.Replace(fmt.Sprintf("%v", this.Memo), "Memo", "v14.Memo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertWorkflowExecutionMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowExecutionMetadataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -17004,6 +17440,57 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21443,6 +21930,57 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -29053,6 +29591,246 @@ func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: RebuildMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowExecutionMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowExecutionMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowExecutionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &v14.SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &v14.Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertWorkflowExecutionMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertWorkflowExecutionMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertWorkflowExecutionMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -54,82 +54,84 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0x4f, 0x6b, 0x24, 0x45,
-	0x18, 0xc6, 0xa7, 0x2e, 0x22, 0x85, 0xae, 0xda, 0x8a, 0x7f, 0x56, 0x6d, 0x44, 0xd1, 0xe3, 0x84,
-	0xdd, 0x05, 0xcd, 0xee, 0x66, 0x5d, 0x33, 0x93, 0x64, 0x92, 0xdd, 0x44, 0xcd, 0x4c, 0x5c, 0xc1,
-	0x8b, 0xf4, 0xf4, 0xbc, 0xc9, 0x14, 0xe9, 0x4c, 0xb7, 0x55, 0xd5, 0xa3, 0x73, 0x10, 0x04, 0x4f,
-	0x82, 0xa0, 0x08, 0x82, 0x27, 0xc1, 0x93, 0x22, 0x08, 0x82, 0x20, 0x08, 0x82, 0x27, 0xc1, 0x93,
-	0xe4, 0xe6, 0x1e, 0xcd, 0xe4, 0xe2, 0x31, 0x1f, 0x41, 0x66, 0x7a, 0xaa, 0x32, 0xd5, 0x5d, 0x3d,
-	0xa9, 0xaa, 0x99, 0xdb, 0xee, 0xa4, 0x9e, 0x5f, 0x3d, 0x55, 0xf5, 0xce, 0x5b, 0x0f, 0x35, 0xf8,
-	0x1a, 0x87, 0xa3, 0x24, 0xa6, 0x41, 0xb4, 0xc4, 0x80, 0xf6, 0x81, 0x2e, 0x05, 0x09, 0x59, 0xea,
-	0x12, 0xc6, 0x63, 0x3a, 0x18, 0x7d, 0x42, 0x42, 0x58, 0xea, 0x5f, 0x59, 0x9a, 0xfc, 0xb3, 0x9a,
-	0xd0, 0x98, 0xc7, 0xde, 0xcb, 0x42, 0x54, 0xcd, 0x44, 0xd5, 0x20, 0x21, 0x55, 0x55, 0x54, 0xed,
-	0x5f, 0xb9, 0xbc, 0x62, 0xc6, 0xa6, 0xf0, 0x41, 0x0a, 0x8c, 0xbf, 0x4f, 0x81, 0x25, 0x71, 0x8f,
-	0x4d, 0x26, 0xb9, 0x7a, 0xb6, 0x8c, 0x2f, 0x6d, 0x66, 0x83, 0x5b, 0xd9, 0x60, 0xef, 0x7b, 0x84,
-	0x9f, 0x6c, 0xf1, 0x80, 0xf2, 0x77, 0x63, 0x7a, 0xb8, 0x1f, 0xc5, 0x1f, 0xae, 0x7f, 0x04, 0x61,
-	0xca, 0x49, 0xdc, 0xf3, 0xd6, 0xaa, 0x46, 0x9e, 0xaa, 0x7a, 0x79, 0x33, 0xb3, 0x70, 0x79, 0x7d,
-	0x4e, 0x4a, 0xb6, 0x80, 0x17, 0x2b, 0xde, 0x57, 0x08, 0x3f, 0xd2, 0x00, 0xbe, 0x93, 0xf2, 0xa0,
-	0x1d, 0x41, 0x8b, 0x07, 0x1c, 0xbc, 0x5b, 0x86, 0xf0, 0x9c, 0x4e, 0x78, 0x7b, 0xdd, 0x55, 0x2e,
-	0x4d, 0x7d, 0x8d, 0xf0, 0xa3, 0x6f, 0xc7, 0x51, 0xa4, 0xb8, 0x32, 0xc5, 0xe6, 0x85, 0xc2, 0xd6,
-	0x6d, 0x67, 0xbd, 0xf4, 0xf5, 0x1d, 0xc2, 0x4f, 0x34, 0x81, 0x01, 0x6f, 0x71, 0x12, 0x1e, 0x0e,
-	0xf6, 0x02, 0x76, 0xb8, 0x9b, 0x42, 0x0a, 0x5e, 0xcd, 0x90, 0xad, 0x13, 0x0b, 0x7f, 0xf5, 0xb9,
-	0x18, 0xd2, 0xe3, 0xcf, 0x08, 0x3f, 0xd3, 0x84, 0x30, 0xa6, 0x1d, 0x71, 0xec, 0xa3, 0x51, 0xe3,
-	0x3a, 0x80, 0x8e, 0xd7, 0x30, 0x9e, 0xa4, 0x84, 0x20, 0xdc, 0x6e, 0xce, 0x0f, 0xd2, 0x58, 0x5e,
-	0x0d, 0x39, 0xe9, 0x13, 0x3e, 0x70, 0xb7, 0xac, 0x21, 0xb8, 0x59, 0xd6, 0x82, 0xa4, 0xe5, 0xdf,
-	0x10, 0x7e, 0x2e, 0xfb, 0xaf, 0xb2, 0xb6, 0x7a, 0x7c, 0x94, 0x44, 0x30, 0x72, 0x7d, 0xc7, 0xfc,
-	0x34, 0x4b, 0x21, 0xc2, 0xf8, 0xdd, 0x85, 0xb0, 0x72, 0xdb, 0x5d, 0x18, 0xba, 0x11, 0x90, 0xc8,
-	0x6a, 0xbb, 0x4b, 0x08, 0xf6, 0xdb, 0x5d, 0x0a, 0x92, 0x96, 0x7f, 0x45, 0xf8, 0xd9, 0xe2, 0xb1,
-	0x6c, 0x42, 0x40, 0x79, 0x1b, 0x02, 0xee, 0x6d, 0x39, 0x1f, 0xad, 0x64, 0x08, 0xdb, 0x77, 0x16,
-	0x81, 0xd2, 0xd5, 0xc9, 0xf4, 0x50, 0xe7, 0x3a, 0xd1, 0x42, 0x1c, 0xeb, 0xa4, 0x84, 0xa5, 0xab,
-	0x93, 0xe9, 0xa1, 0x6e, 0x75, 0x52, 0x24, 0x38, 0xd6, 0x89, 0x0e, 0x94, 0xab, 0x93, 0xe2, 0xea,
-	0x82, 0x5e, 0x08, 0x23, 0xd3, 0x5b, 0x73, 0xec, 0xd0, 0x84, 0x61, 0x5f, 0x27, 0x33, 0x50, 0xd2,
-	0xf8, 0x8f, 0x08, 0x3f, 0xd5, 0x22, 0x07, 0xbd, 0x20, 0x2a, 0x26, 0x06, 0xe3, 0xbb, 0x5e, 0xaf,
-	0x17, 0x86, 0x37, 0xe6, 0xc5, 0x48, 0xb3, 0x7f, 0x22, 0xfc, 0xc2, 0x64, 0x14, 0xe1, 0xdd, 0x92,
-	0x9c, 0xf3, 0xa6, 0xdd, 0x74, 0xa5, 0x20, 0x61, 0xff, 0xad, 0x85, 0xf1, 0xe4, 0x3a, 0x7e, 0x42,
-	0xf8, 0xe9, 0x26, 0x1c, 0xc5, 0x7d, 0xc8, 0x44, 0x4a, 0xdc, 0xd8, 0x30, 0x3e, 0x5f, 0x3d, 0x40,
-	0xf8, 0x6e, 0xcc, 0xcd, 0x91, 0x7e, 0x7f, 0x41, 0xf8, 0xf2, 0x1e, 0xd0, 0x23, 0xd2, 0x0b, 0x38,
-	0x14, 0x77, 0xdc, 0xf4, 0x8b, 0x54, 0x8e, 0x10, 0x9e, 0xb7, 0x16, 0x40, 0x52, 0x4a, 0x7b, 0x0d,
-	0x46, 0xcd, 0xc5, 0xbd, 0xb4, 0x4b, 0xf4, 0xb6, 0xa5, 0x5d, 0x8a, 0x91, 0x66, 0x47, 0xc1, 0x7d,
-	0x1c, 0xb0, 0xdc, 0x83, 0xbb, 0x5e, 0x6e, 0x1b, 0xdc, 0xcb, 0x28, 0xd2, 0xe9, 0x1f, 0x08, 0xfb,
-	0x13, 0x68, 0xd6, 0x4f, 0x8a, 0x8e, 0xb7, 0x8d, 0xe7, 0x9a, 0x85, 0x11, 0xce, 0x77, 0x16, 0x44,
-	0x53, 0xd2, 0x74, 0x2b, 0xec, 0x42, 0x27, 0x8d, 0x60, 0xfa, 0xf6, 0x37, 0x4e, 0xd3, 0x3a, 0xb1,
-	0x6d, 0x9a, 0xd6, 0x33, 0x94, 0x56, 0x77, 0x0f, 0x28, 0xd9, 0x1f, 0x6c, 0x10, 0xca, 0xb8, 0x92,
-	0x63, 0x27, 0xca, 0x8e, 0x71, 0xab, 0xbb, 0x08, 0x64, 0xdb, 0xea, 0x2e, 0xe6, 0xc9, 0x75, 0xfc,
-	0x8e, 0xf0, 0xf3, 0x59, 0x62, 0xa9, 0x77, 0x49, 0xd4, 0x91, 0xc7, 0x71, 0x1e, 0x44, 0xee, 0x5a,
-	0xe5, 0x9e, 0x12, 0x8a, 0x58, 0xc1, 0xf6, 0x62, 0x60, 0xd2, 0xfe, 0x3f, 0x08, 0xbf, 0x92, 0xad,
-	0x56, 0x3b, 0x76, 0x5c, 0x57, 0x23, 0x12, 0x74, 0xbc, 0x3d, 0xab, 0xcd, 0xbb, 0x08, 0x27, 0x16,
-	0xf4, 0xce, 0x82, 0xa9, 0x4a, 0xc8, 0x5a, 0x03, 0x16, 0x52, 0xd2, 0xd6, 0xf4, 0xc7, 0x86, 0x71,
-	0x63, 0x2b, 0x21, 0xd8, 0x86, 0xac, 0x19, 0x20, 0x69, 0xf9, 0x1b, 0x84, 0x1f, 0x6b, 0x42, 0x12,
-	0x91, 0x30, 0xe0, 0xb0, 0xde, 0x87, 0x1e, 0x67, 0xf7, 0xae, 0x7a, 0xb7, 0x8d, 0x8f, 0x3c, 0xa7,
-	0x14, 0x16, 0xdf, 0x70, 0x07, 0x28, 0xaf, 0x19, 0xad, 0x41, 0x2f, 0x6c, 0x75, 0x03, 0xda, 0x19,
-	0x5d, 0x9f, 0x29, 0x33, 0x7e, 0xcd, 0xc8, 0xe9, 0x6c, 0x5f, 0x33, 0x0a, 0x72, 0x69, 0xea, 0x33,
-	0x84, 0x1f, 0x1a, 0xfd, 0x55, 0x44, 0x40, 0xef, 0x86, 0x05, 0x52, 0x88, 0x84, 0x9d, 0x9b, 0x4e,
-	0x5a, 0xa5, 0xe7, 0x8a, 0x33, 0x56, 0xe2, 0x4e, 0xcd, 0xb2, 0x40, 0x74, 0x51, 0xa7, 0x3e, 0x17,
-	0x43, 0x7a, 0xfc, 0x16, 0xe1, 0xc7, 0xc5, 0x90, 0xc9, 0xbb, 0xda, 0x66, 0xcc, 0xb8, 0xb7, 0x6a,
-	0x89, 0x9f, 0xd2, 0x0a, 0x87, 0xb5, 0x79, 0x10, 0xd2, 0xe0, 0xa7, 0x08, 0xe3, 0x7a, 0x14, 0x33,
-	0x18, 0x9f, 0xb7, 0xb7, 0x6c, 0x08, 0x3d, 0x97, 0x08, 0x3b, 0xd7, 0x1d, 0x94, 0xd2, 0xc5, 0xc7,
-	0xf8, 0xc1, 0x06, 0xf0, 0xcc, 0xc2, 0xab, 0xe6, 0x4f, 0x6e, 0x8a, 0x81, 0xd7, 0xac, 0x75, 0xca,
-	0x26, 0x64, 0x99, 0x75, 0x7c, 0x67, 0x2f, 0x5b, 0xc5, 0xdc, 0xe9, 0x9b, 0xfa, 0xba, 0x83, 0x52,
-	0xc9, 0x6b, 0x0d, 0xe0, 0xa2, 0x27, 0x90, 0xb8, 0xb7, 0x03, 0x8c, 0x05, 0x07, 0xc0, 0x8c, 0xf3,
-	0x9a, 0x5e, 0x6e, 0x9b, 0xd7, 0xca, 0x28, 0x4a, 0xa3, 0x6f, 0x00, 0x5f, 0xdb, 0xde, 0xd5, 0x99,
-	0x6d, 0x98, 0x4f, 0xa3, 0x27, 0xd8, 0x36, 0xfa, 0x19, 0x20, 0x69, 0xf9, 0x73, 0x84, 0x1f, 0xde,
-	0x4d, 0x81, 0x0e, 0xc4, 0x6d, 0xe0, 0x99, 0x76, 0x1f, 0x45, 0x25, 0xac, 0xad, 0xb8, 0x89, 0x15,
-	0x3b, 0x4d, 0x08, 0x92, 0x24, 0x1a, 0x64, 0xad, 0xdf, 0xd8, 0x8e, 0xa2, 0xb2, 0xb5, 0x93, 0x13,
-	0x4b, 0x3b, 0x5f, 0x20, 0x7c, 0x29, 0xdb, 0x45, 0x79, 0x8a, 0x2b, 0x56, 0x9b, 0x9f, 0x3f, 0xba,
-	0x5b, 0x8e, 0x6a, 0xf5, 0xd9, 0x3c, 0xa5, 0x07, 0x30, 0xed, 0xc9, 0xf8, 0xd9, 0x3c, 0x27, 0xb4,
-	0x7e, 0x36, 0x2f, 0xe8, 0x15, 0x5f, 0x3b, 0xe0, 0xe8, 0x2b, 0x2f, 0xb4, 0xf5, 0x55, 0xd4, 0xe7,
-	0x9e, 0xf3, 0xf7, 0x29, 0xb0, 0xee, 0x74, 0x7e, 0x66, 0x16, 0xcf, 0xf9, 0x45, 0xb1, 0xfd, 0x73,
-	0xbe, 0x8e, 0x21, 0x3d, 0xfe, 0x8d, 0xf0, 0x4b, 0x0d, 0xe8, 0x01, 0x0d, 0x38, 0x6c, 0x07, 0x8c,
-	0x4f, 0x6e, 0xa4, 0xa9, 0x2f, 0x6e, 0x66, 0x79, 0xd7, 0xb8, 0x78, 0x2e, 0x64, 0x89, 0x15, 0x34,
-	0x17, 0x89, 0x54, 0x36, 0x5d, 0x6d, 0x96, 0x93, 0x9c, 0x56, 0x73, 0xea, 0xb4, 0x6a, 0x58, 0xab,
-	0xcf, 0xc5, 0x50, 0x12, 0x48, 0x13, 0xda, 0x29, 0x89, 0x3a, 0x4a, 0x48, 0x5a, 0x35, 0x3e, 0xd3,
-	0x82, 0xd6, 0x36, 0x81, 0x68, 0x11, 0xc2, 0x60, 0x2d, 0x39, 0x3e, 0xf1, 0x2b, 0xf7, 0x4f, 0xfc,
-	0xca, 0xd9, 0x89, 0x8f, 0x3e, 0x19, 0xfa, 0xe8, 0x87, 0xa1, 0x8f, 0xfe, 0x1a, 0xfa, 0xe8, 0x78,
-	0xe8, 0xa3, 0x7f, 0x87, 0x3e, 0xfa, 0x6f, 0xe8, 0x57, 0xce, 0x86, 0x3e, 0xfa, 0xf2, 0xd4, 0xaf,
-	0x1c, 0x9f, 0xfa, 0x95, 0xfb, 0xa7, 0x7e, 0xe5, 0xbd, 0x1b, 0x07, 0xf1, 0xf9, 0xec, 0x24, 0x9e,
-	0xf9, 0x63, 0xe7, 0x4d, 0xf5, 0x93, 0xf6, 0x03, 0xe3, 0xdf, 0x3a, 0xaf, 0xfd, 0x1f, 0x00, 0x00,
-	0xff, 0xff, 0x60, 0x0a, 0x68, 0x83, 0x87, 0x1d, 0x00, 0x00,
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6b, 0x24, 0x45,
+	0x1b, 0xc7, 0xa7, 0x2e, 0x2f, 0x2f, 0x85, 0xae, 0xda, 0x8a, 0x3f, 0xa2, 0xb6, 0xa2, 0xe8, 0x71,
+	0xc2, 0xee, 0x82, 0xee, 0x66, 0xb3, 0xae, 0xc9, 0x24, 0x99, 0x64, 0x37, 0xb3, 0x9a, 0x99, 0xec,
+	0x0a, 0x5e, 0xa4, 0xd2, 0xf3, 0x24, 0x53, 0xa4, 0x33, 0xdd, 0x56, 0x55, 0x8f, 0xce, 0x41, 0x10,
+	0x3c, 0x09, 0x82, 0x22, 0x08, 0x9e, 0x04, 0x4f, 0x8a, 0x20, 0x08, 0xa2, 0x20, 0x08, 0x82, 0x20,
+	0x78, 0x92, 0xdc, 0xdc, 0xa3, 0x99, 0x5c, 0x3c, 0xee, 0x9f, 0x20, 0x33, 0x3d, 0x55, 0x99, 0x9a,
+	0xae, 0xce, 0x54, 0x75, 0xcf, 0x6d, 0x77, 0xb6, 0xbe, 0x9f, 0xfe, 0x54, 0xd5, 0x33, 0xd5, 0xcf,
+	0xd6, 0xe0, 0xcb, 0x02, 0x8e, 0xe2, 0x88, 0x91, 0x70, 0x91, 0x03, 0xeb, 0x01, 0x5b, 0x24, 0x31,
+	0x5d, 0xec, 0x50, 0x2e, 0x22, 0xd6, 0x1f, 0x7e, 0x42, 0x03, 0x58, 0xec, 0x5d, 0x5c, 0x1c, 0xff,
+	0xb1, 0x1a, 0xb3, 0x48, 0x44, 0xde, 0x4b, 0x32, 0x54, 0x4d, 0x43, 0x55, 0x12, 0xd3, 0xaa, 0x1e,
+	0xaa, 0xf6, 0x2e, 0x2e, 0x2c, 0xdb, 0xb1, 0x19, 0xbc, 0x9b, 0x00, 0x17, 0xef, 0x30, 0xe0, 0x71,
+	0xd4, 0xe5, 0xe3, 0x87, 0x5c, 0xfa, 0x69, 0x09, 0x5f, 0xd8, 0x4c, 0x07, 0xb7, 0xd2, 0xc1, 0xde,
+	0x37, 0x08, 0x3f, 0xde, 0x12, 0x84, 0x89, 0xb7, 0x22, 0x76, 0xb8, 0x1f, 0x46, 0xef, 0xad, 0xbf,
+	0x0f, 0x41, 0x22, 0x68, 0xd4, 0xf5, 0xd6, 0xaa, 0x56, 0x4e, 0x55, 0x73, 0xbc, 0x99, 0x2a, 0x2c,
+	0xac, 0x97, 0xa4, 0xa4, 0x13, 0x78, 0xa1, 0xe2, 0x7d, 0x8e, 0xf0, 0x43, 0x75, 0x10, 0x8d, 0x44,
+	0x90, 0xbd, 0x10, 0x5a, 0x82, 0x08, 0xf0, 0xae, 0x5b, 0xc2, 0xa7, 0x72, 0xd2, 0xed, 0xb5, 0xa2,
+	0x71, 0x25, 0xf5, 0x05, 0xc2, 0x0f, 0xbf, 0x19, 0x85, 0xa1, 0x66, 0x65, 0x8b, 0x9d, 0x0e, 0x4a,
+	0xad, 0x1b, 0x85, 0xf3, 0xca, 0xeb, 0x6b, 0x84, 0x1f, 0x6b, 0x02, 0x07, 0xd1, 0x12, 0x34, 0x38,
+	0xec, 0xef, 0x12, 0x7e, 0xb8, 0x93, 0x40, 0x02, 0xde, 0xaa, 0x25, 0xdb, 0x14, 0x96, 0x7e, 0xb5,
+	0x52, 0x0c, 0xe5, 0xf8, 0x03, 0xc2, 0x4f, 0x35, 0x21, 0x88, 0x58, 0x5b, 0x6e, 0xfb, 0x70, 0xd4,
+	0xa8, 0x0e, 0xa0, 0xed, 0xd5, 0xad, 0x1f, 0x92, 0x43, 0x90, 0xb6, 0x9b, 0xe5, 0x41, 0x06, 0xe5,
+	0x95, 0x40, 0xd0, 0x1e, 0x15, 0xfd, 0xe2, 0xca, 0x06, 0x42, 0x31, 0x65, 0x23, 0x48, 0x29, 0xff,
+	0x82, 0xf0, 0x33, 0xe9, 0x5f, 0xb5, 0xb9, 0xd5, 0xa2, 0xa3, 0x38, 0x84, 0xa1, 0xf5, 0x4d, 0xfb,
+	0xdd, 0xcc, 0x85, 0x48, 0xf1, 0x5b, 0x73, 0x61, 0x4d, 0x2d, 0x77, 0x66, 0xe8, 0x06, 0xa1, 0xa1,
+	0xd3, 0x72, 0xe7, 0x10, 0xdc, 0x97, 0x3b, 0x17, 0xa4, 0x94, 0x7f, 0x46, 0xf8, 0xe9, 0xec, 0xb6,
+	0x6c, 0x02, 0x61, 0x62, 0x0f, 0x88, 0xf0, 0xb6, 0x0a, 0x6f, 0xad, 0x62, 0x48, 0xed, 0x9b, 0xf3,
+	0x40, 0x99, 0xea, 0x64, 0x72, 0x68, 0xe1, 0x3a, 0x31, 0x42, 0x0a, 0xd6, 0x49, 0x0e, 0xcb, 0x54,
+	0x27, 0x93, 0x43, 0x8b, 0xd5, 0x49, 0x96, 0x50, 0xb0, 0x4e, 0x4c, 0xa0, 0xa9, 0x3a, 0xc9, 0xce,
+	0x8e, 0x74, 0x03, 0x18, 0x4a, 0x6f, 0x95, 0x58, 0xa1, 0x31, 0xc3, 0xbd, 0x4e, 0xce, 0x41, 0x29,
+	0xf1, 0xef, 0x10, 0x7e, 0xa2, 0x45, 0x0f, 0xba, 0x24, 0xcc, 0x76, 0x0c, 0xd6, 0xef, 0x7a, 0x73,
+	0x5e, 0x0a, 0x6f, 0x94, 0xc5, 0x28, 0xd9, 0x3f, 0x10, 0x7e, 0x7e, 0x3c, 0x8a, 0x8a, 0x4e, 0x4e,
+	0x9f, 0x73, 0xdb, 0xed, 0x71, 0xb9, 0x20, 0xa9, 0xff, 0xc6, 0xdc, 0x78, 0x6a, 0x1e, 0xdf, 0x23,
+	0xfc, 0x64, 0x13, 0x8e, 0xa2, 0x1e, 0xa4, 0x21, 0xad, 0xdd, 0xd8, 0xb0, 0xde, 0x5f, 0x33, 0x40,
+	0x7a, 0xd7, 0x4b, 0x73, 0x94, 0xef, 0x8f, 0x08, 0x2f, 0xec, 0x02, 0x3b, 0xa2, 0x5d, 0x22, 0x20,
+	0xbb, 0xe2, 0xb6, 0x5f, 0xa4, 0x7c, 0x84, 0x74, 0xde, 0x9a, 0x03, 0x49, 0x2b, 0xed, 0x35, 0x08,
+	0x41, 0x40, 0xf1, 0xd2, 0xce, 0xc9, 0xbb, 0x96, 0x76, 0x2e, 0x46, 0xc9, 0x0e, 0x1b, 0xf7, 0x51,
+	0x83, 0x55, 0xbc, 0x71, 0x37, 0xc7, 0x5d, 0x1b, 0xf7, 0x3c, 0x8a, 0x32, 0xfd, 0x0d, 0x61, 0x7f,
+	0x0c, 0x4d, 0xcf, 0x93, 0xac, 0xf1, 0xb6, 0xf5, 0xb3, 0xce, 0xc3, 0x48, 0xf3, 0xc6, 0x9c, 0x68,
+	0x5a, 0x37, 0xdd, 0x0a, 0x3a, 0xd0, 0x4e, 0x42, 0x98, 0x7c, 0xfb, 0x5b, 0x77, 0xd3, 0xa6, 0xb0,
+	0x6b, 0x37, 0x6d, 0x66, 0x68, 0x47, 0xdd, 0x5d, 0x60, 0x74, 0xbf, 0xbf, 0x41, 0x19, 0x17, 0x5a,
+	0x1f, 0x3b, 0x4e, 0xb6, 0xad, 0x8f, 0xba, 0x59, 0x20, 0xd7, 0xa3, 0x6e, 0x36, 0x4f, 0xcd, 0xe3,
+	0x57, 0x84, 0x9f, 0x4d, 0x3b, 0x96, 0x5a, 0x87, 0x86, 0x6d, 0xb5, 0x1d, 0x67, 0x8d, 0xc8, 0x2d,
+	0xa7, 0xbe, 0x27, 0x87, 0x22, 0x67, 0xb0, 0x3d, 0x1f, 0x98, 0xd2, 0xff, 0x1b, 0xe1, 0x97, 0xd3,
+	0xd9, 0x1a, 0xc7, 0x8e, 0xea, 0x6a, 0x48, 0x82, 0xb6, 0xb7, 0xeb, 0xb4, 0x78, 0xb3, 0x70, 0x72,
+	0x42, 0x77, 0xe6, 0x4c, 0xd5, 0x9a, 0xac, 0x35, 0xe0, 0x01, 0xa3, 0x7b, 0x86, 0xf3, 0xb1, 0x6e,
+	0x7d, 0xb0, 0xe5, 0x10, 0x5c, 0x9b, 0xac, 0x73, 0x40, 0x4a, 0xf9, 0x4b, 0x84, 0x1f, 0x69, 0x42,
+	0x1c, 0xd2, 0x80, 0x08, 0x58, 0xef, 0x41, 0x57, 0xf0, 0xbb, 0x97, 0xbc, 0x1b, 0xd6, 0x5b, 0x3e,
+	0x95, 0x94, 0x8a, 0xaf, 0x17, 0x07, 0x68, 0xb7, 0x19, 0xad, 0x7e, 0x37, 0x68, 0x75, 0x08, 0x6b,
+	0x0f, 0x5f, 0x9f, 0x09, 0xb7, 0xbe, 0xcd, 0x98, 0xca, 0xb9, 0xde, 0x66, 0x64, 0xe2, 0x4a, 0xea,
+	0x63, 0x84, 0x1f, 0x18, 0xfe, 0xab, 0x6c, 0x01, 0xbd, 0x25, 0x07, 0xa4, 0x0c, 0x49, 0x9d, 0x6b,
+	0x85, 0xb2, 0xda, 0x99, 0x2b, 0xf7, 0x58, 0x6b, 0x77, 0x56, 0x1d, 0x0b, 0xc4, 0xd4, 0xea, 0xd4,
+	0x4a, 0x31, 0x94, 0xe3, 0x57, 0x08, 0x3f, 0x2a, 0x87, 0x8c, 0xef, 0xd5, 0x36, 0x23, 0x2e, 0xbc,
+	0x15, 0x47, 0xfc, 0x44, 0x56, 0x1a, 0xae, 0x96, 0x41, 0x28, 0xc1, 0x8f, 0x10, 0xc6, 0xb5, 0x30,
+	0xe2, 0x30, 0xda, 0x6f, 0xef, 0x8a, 0x25, 0xf4, 0x2c, 0x22, 0x75, 0xae, 0x16, 0x48, 0x2a, 0x8b,
+	0x0f, 0xf0, 0xff, 0xeb, 0x20, 0x52, 0x85, 0x57, 0xec, 0xaf, 0xdc, 0x34, 0x81, 0x57, 0x9d, 0x73,
+	0xda, 0x22, 0xa4, 0x3d, 0xeb, 0xe8, 0x9d, 0x7d, 0xc5, 0xa9, 0xcd, 0x9d, 0x7c, 0x53, 0x5f, 0x2d,
+	0x90, 0xd4, 0xfa, 0xb5, 0x3a, 0x08, 0x79, 0x26, 0xd0, 0xa8, 0xdb, 0x00, 0xce, 0xc9, 0x01, 0x70,
+	0xeb, 0x7e, 0xcd, 0x1c, 0x77, 0xed, 0xd7, 0xf2, 0x28, 0xda, 0x41, 0x5f, 0x07, 0xb1, 0xb6, 0xbd,
+	0x63, 0x92, 0xad, 0xdb, 0x3f, 0xc6, 0x4c, 0x70, 0x3d, 0xe8, 0xcf, 0x01, 0x29, 0xe5, 0x4f, 0x10,
+	0x7e, 0x70, 0x27, 0x01, 0xd6, 0x97, 0x6f, 0x03, 0xcf, 0xf6, 0xf4, 0xd1, 0x52, 0x52, 0x6d, 0xb9,
+	0x58, 0x58, 0xd3, 0x69, 0x02, 0x89, 0xe3, 0xb0, 0x9f, 0x1e, 0xfd, 0xd6, 0x3a, 0x5a, 0xca, 0x55,
+	0x67, 0x2a, 0xac, 0x74, 0x3e, 0x45, 0xf8, 0x42, 0xba, 0x8a, 0x6a, 0x17, 0x97, 0x9d, 0x16, 0x7f,
+	0x7a, 0xeb, 0xae, 0x17, 0x4c, 0xeb, 0xd7, 0xe6, 0x09, 0x3b, 0x80, 0x49, 0x27, 0xeb, 0x6b, 0xf3,
+	0xa9, 0xa0, 0xf3, 0xb5, 0x79, 0x26, 0xaf, 0x79, 0x35, 0xa0, 0xa0, 0x57, 0x03, 0xca, 0x79, 0x35,
+	0x20, 0xd7, 0x2b, 0xbd, 0xce, 0xdf, 0x67, 0xc0, 0x3b, 0x93, 0xfd, 0x33, 0x77, 0xb8, 0xce, 0xcf,
+	0x86, 0xdd, 0xaf, 0xf3, 0x4d, 0x0c, 0xe5, 0xf8, 0x17, 0xc2, 0x2f, 0xd6, 0xa1, 0x0b, 0x8c, 0x08,
+	0xd8, 0x26, 0x5c, 0x8c, 0xdf, 0x48, 0x13, 0x5f, 0xdc, 0x54, 0x79, 0xc7, 0xba, 0x78, 0x66, 0xb2,
+	0xe4, 0x0c, 0x9a, 0xf3, 0x44, 0x6a, 0x8b, 0xae, 0x1f, 0x96, 0xe3, 0x3e, 0x6d, 0xb5, 0xd0, 0x49,
+	0xab, 0x37, 0x6b, 0xb5, 0x52, 0x0c, 0xad, 0x03, 0x69, 0xc2, 0x5e, 0x42, 0xc3, 0xb6, 0xd6, 0x24,
+	0xad, 0x58, 0xef, 0x69, 0x26, 0xeb, 0xda, 0x81, 0x18, 0x11, 0x4a, 0xf0, 0x77, 0x84, 0x9f, 0xbb,
+	0x13, 0x73, 0x30, 0xdc, 0x6f, 0x35, 0x40, 0x90, 0x36, 0x11, 0xc4, 0xb3, 0xfd, 0xff, 0xfa, 0x0c,
+	0x8e, 0x14, 0xbf, 0x3d, 0x2f, 0x9c, 0x9c, 0xc4, 0x6a, 0x7c, 0x7c, 0xe2, 0x57, 0xee, 0x9d, 0xf8,
+	0x95, 0xfb, 0x27, 0x3e, 0xfa, 0x70, 0xe0, 0xa3, 0x6f, 0x07, 0x3e, 0xfa, 0x73, 0xe0, 0xa3, 0xe3,
+	0x81, 0x8f, 0xfe, 0x19, 0xf8, 0xe8, 0xdf, 0x81, 0x5f, 0xb9, 0x3f, 0xf0, 0xd1, 0x67, 0xa7, 0x7e,
+	0xe5, 0xf8, 0xd4, 0xaf, 0xdc, 0x3b, 0xf5, 0x2b, 0x6f, 0x2f, 0x1d, 0x44, 0x67, 0x26, 0x34, 0x3a,
+	0xf7, 0x17, 0xdb, 0x6b, 0xfa, 0x27, 0x7b, 0xff, 0x1b, 0xfd, 0x60, 0x7b, 0xf9, 0xbf, 0x01, 0x00,
+	0x5c, 0x11, 0x40, 0x0e, 0x4c, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +298,9 @@ type HistoryServiceClient interface {
 	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	RebuildMutableState(ctx context.Context, in *RebuildMutableStateRequest, opts ...grpc.CallOption) (*RebuildMutableStateResponse, error)
+	// UpsertWorkflowExecutionMetadata merges search attributes and memo into those of a running workflow
+	// as a signal event with a reserved name, which is replicated and reapplied on reset.
+	UpsertWorkflowExecutionMetadata(ctx context.Context, in *UpsertWorkflowExecutionMetadataRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionMetadataResponse, error)
 }
 
 type historyServiceClient struct {
@@ -693,6 +698,15 @@ func (c *historyServiceClient) RebuildMutableState(ctx context.Context, in *Rebu
 	return out, nil
 }
 
+func (c *historyServiceClient) UpsertWorkflowExecutionMetadata(ctx context.Context, in *UpsertWorkflowExecutionMetadataRequest, opts ...grpc.CallOption) (*UpsertWorkflowExecutionMetadataResponse, error) {
+	out := new(UpsertWorkflowExecutionMetadataResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowExecutionMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -847,6 +861,9 @@ type HistoryServiceServer interface {
 	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
 	RebuildMutableState(context.Context, *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error)
+	// UpsertWorkflowExecutionMetadata merges search attributes and memo into those of a running workflow
+	// as a signal event with a reserved name, which is replicated and reapplied on reset.
+	UpsertWorkflowExecutionMetadata(context.Context, *UpsertWorkflowExecutionMetadataRequest) (*UpsertWorkflowExecutionMetadataResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -982,6 +999,9 @@ func (*UnimplementedHistoryServiceServer) GetReplicationStatus(ctx context.Conte
 func (*UnimplementedHistoryServiceServer) RebuildMutableState(ctx context.Context, req *RebuildMutableStateRequest) (*RebuildMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildMutableState not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UpsertWorkflowExecutionMetadata(ctx context.Context, req *UpsertWorkflowExecutionMetadataRequest) (*UpsertWorkflowExecutionMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowExecutionMetadata not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1761,6 +1781,24 @@ func _HistoryService_RebuildMutableState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpsertWorkflowExecutionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowExecutionMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowExecutionMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowExecutionMetadata(ctx, req.(*UpsertWorkflowExecutionMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1937,6 +1975,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RebuildMutableState",
 			Handler:    _HistoryService_RebuildMutableState_Handler,
 		},
+		{
+			MethodName: "UpsertWorkflowExecutionMetadata",
+			Handler:    _HistoryService_UpsertWorkflowExecutionMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
@@ -880,6 +880,26 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UpsertWorkflowExecutionMetadata mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowExecutionMetadata(ctx context.Context, in *historyservice.UpsertWorkflowExecutionMetadataRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowExecutionMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionMetadata", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionMetadata indicates an expected call of UpsertWorkflowExecutionMetadata.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowExecutionMetadata(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionMetadata", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowExecutionMetadata), varargs...)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceClient) VerifyChildExecutionCompletionRecorded(ctx context.Context, in *historyservice.VerifyChildExecutionCompletionRecordedRequest, opts ...grpc.CallOption) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -1558,6 +1578,21 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
 
+// UpsertWorkflowExecutionMetadata mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowExecutionMetadata(arg0 context.Context, arg1 *historyservice.UpsertWorkflowExecutionMetadataRequest) (*historyservice.UpsertWorkflowExecutionMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowExecutionMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowExecutionMetadata indicates an expected call of UpsertWorkflowExecutionMetadata.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowExecutionMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionMetadata", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowExecutionMetadata), arg0, arg1)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockHistoryServiceServer) VerifyChildExecutionCompletionRecorded(arg0 context.Context, arg1 *historyservice.VerifyChildExecutionCompletionRecordedRequest) (*historyservice.VerifyChildExecutionCompletionRecordedResponse, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ package matchingservice
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -43,13 +44,14 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/common/v1"
-	v17 "go.temporal.io/api/enums/v1"
+	v18 "go.temporal.io/api/enums/v1"
 	v12 "go.temporal.io/api/query/v1"
 	v14 "go.temporal.io/api/taskqueue/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
-	v16 "go.temporal.io/server/api/clock/v1"
-	v15 "go.temporal.io/server/api/enums/v1"
+	v17 "go.temporal.io/server/api/clock/v1"
+	v16 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v15 "go.temporal.io/server/api/persistence/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -148,6 +150,10 @@ type PollWorkflowTaskQueueResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery  `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Partition counts of the task queue, set when they are managed by the adaptive partition controller.
+	PartitionConfig *v15.TaskQueuePartitionConfig `protobuf:"bytes,18,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Set when the adaptive partition controller is disabled for the task queue, clients then drop the recorded partition counts.
+	AdaptivePartitionsDisabled bool `protobuf:"varint,19,opt,name=adaptive_partitions_disabled,json=adaptivePartitionsDisabled,proto3" json:"adaptive_partitions_disabled,omitempty"`
 }
 
 func (m *PollWorkflowTaskQueueResponse) Reset()      { *m = PollWorkflowTaskQueueResponse{} }
@@ -294,6 +300,20 @@ func (m *PollWorkflowTaskQueueResponse) GetQueries() map[string]*v12.WorkflowQue
 	return nil
 }
 
+func (m *PollWorkflowTaskQueueResponse) GetPartitionConfig() *v15.TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *PollWorkflowTaskQueueResponse) GetAdaptivePartitionsDisabled() bool {
+	if m != nil {
+		return m.AdaptivePartitionsDisabled
+	}
+	return false
+}
+
 type PollActivityTaskQueueRequest struct {
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId        string                           `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -382,6 +402,10 @@ type PollActivityTaskQueueResponse struct {
 	WorkflowType                *v11.WorkflowType `protobuf:"bytes,14,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	WorkflowNamespace           string            `protobuf:"bytes,15,opt,name=workflow_namespace,json=workflowNamespace,proto3" json:"workflow_namespace,omitempty"`
 	Header                      *v11.Header       `protobuf:"bytes,16,opt,name=header,proto3" json:"header,omitempty"`
+	// Partition counts of the task queue, set when they are managed by the adaptive partition controller.
+	PartitionConfig *v15.TaskQueuePartitionConfig `protobuf:"bytes,17,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Set when the adaptive partition controller is disabled for the task queue, clients then drop the recorded partition counts.
+	AdaptivePartitionsDisabled bool `protobuf:"varint,18,opt,name=adaptive_partitions_disabled,json=adaptivePartitionsDisabled,proto3" json:"adaptive_partitions_disabled,omitempty"`
 }
 
 func (m *PollActivityTaskQueueResponse) Reset()      { *m = PollActivityTaskQueueResponse{} }
@@ -528,6 +552,20 @@ func (m *PollActivityTaskQueueResponse) GetHeader() *v11.Header {
 	return nil
 }
 
+func (m *PollActivityTaskQueueResponse) GetPartitionConfig() *v15.TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *PollActivityTaskQueueResponse) GetAdaptivePartitionsDisabled() bool {
+	if m != nil {
+		return m.AdaptivePartitionsDisabled
+	}
+	return false
+}
+
 type AddWorkflowTaskRequest struct {
 	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution   *v11.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -537,8 +575,14 @@ type AddWorkflowTaskRequest struct {
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	ScheduleToStartTimeout *time.Duration  `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string          `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
-	Source                 v15.TaskSource  `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
-	Clock                  *v16.ShardClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	Source                 v16.TaskSource  `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	Clock                  *v17.ShardClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Build id of the worker that last completed a workflow task of this workflow.
+	BuildId string `protobuf:"bytes,10,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Priority of the task from 1 (highest) to 5 (lowest), 0 means the default priority.
+	PriorityKey int32 `protobuf:"varint,11,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Tasks are dispatched round robin across fairness keys within a priority level.
+	FairnessKey string `protobuf:"bytes,12,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -615,21 +659,46 @@ func (m *AddWorkflowTaskRequest) GetForwardedSource() string {
 	return ""
 }
 
-func (m *AddWorkflowTaskRequest) GetSource() v15.TaskSource {
+func (m *AddWorkflowTaskRequest) GetSource() v16.TaskSource {
 	if m != nil {
 		return m.Source
 	}
-	return v15.TASK_SOURCE_UNSPECIFIED
+	return v16.TASK_SOURCE_UNSPECIFIED
 }
 
-func (m *AddWorkflowTaskRequest) GetClock() *v16.ShardClock {
+func (m *AddWorkflowTaskRequest) GetClock() *v17.ShardClock {
 	if m != nil {
 		return m.Clock
 	}
 	return nil
 }
 
+func (m *AddWorkflowTaskRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *AddWorkflowTaskRequest) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *AddWorkflowTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddWorkflowTaskResponse struct {
+	// Partition counts of the task queue, set when they are managed by the adaptive partition controller.
+	PartitionConfig *v15.TaskQueuePartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Set when the adaptive partition controller is disabled for the task queue, clients then drop the recorded partition counts.
+	AdaptivePartitionsDisabled bool `protobuf:"varint,2,opt,name=adaptive_partitions_disabled,json=adaptivePartitionsDisabled,proto3" json:"adaptive_partitions_disabled,omitempty"`
 }
 
 func (m *AddWorkflowTaskResponse) Reset()      { *m = AddWorkflowTaskResponse{} }
@@ -664,6 +733,20 @@ func (m *AddWorkflowTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddWorkflowTaskResponse proto.InternalMessageInfo
 
+func (m *AddWorkflowTaskResponse) GetPartitionConfig() *v15.TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *AddWorkflowTaskResponse) GetAdaptivePartitionsDisabled() bool {
+	if m != nil {
+		return m.AdaptivePartitionsDisabled
+	}
+	return false
+}
+
 type AddActivityTaskRequest struct {
 	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution   *v11.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -675,8 +758,12 @@ type AddActivityTaskRequest struct {
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	ScheduleToStartTimeout *time.Duration  `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string          `protobuf:"bytes,7,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
-	Source                 v15.TaskSource  `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
-	Clock                  *v16.ShardClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	Source                 v16.TaskSource  `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	Clock                  *v17.ShardClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Priority of the task from 1 (highest) to 5 (lowest), 0 means the default priority.
+	PriorityKey int32 `protobuf:"varint,10,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Tasks are dispatched round robin across fairness keys within a priority level.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -760,21 +847,39 @@ func (m *AddActivityTaskRequest) GetForwardedSource() string {
 	return ""
 }
 
-func (m *AddActivityTaskRequest) GetSource() v15.TaskSource {
+func (m *AddActivityTaskRequest) GetSource() v16.TaskSource {
 	if m != nil {
 		return m.Source
 	}
-	return v15.TASK_SOURCE_UNSPECIFIED
+	return v16.TASK_SOURCE_UNSPECIFIED
 }
 
-func (m *AddActivityTaskRequest) GetClock() *v16.ShardClock {
+func (m *AddActivityTaskRequest) GetClock() *v17.ShardClock {
 	if m != nil {
 		return m.Clock
 	}
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 type AddActivityTaskResponse struct {
+	// Partition counts of the task queue, set when they are managed by the adaptive partition controller.
+	PartitionConfig *v15.TaskQueuePartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Set when the adaptive partition controller is disabled for the task queue, clients then drop the recorded partition counts.
+	AdaptivePartitionsDisabled bool `protobuf:"varint,2,opt,name=adaptive_partitions_disabled,json=adaptivePartitionsDisabled,proto3" json:"adaptive_partitions_disabled,omitempty"`
 }
 
 func (m *AddActivityTaskResponse) Reset()      { *m = AddActivityTaskResponse{} }
@@ -809,6 +914,20 @@ func (m *AddActivityTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddActivityTaskResponse proto.InternalMessageInfo
 
+func (m *AddActivityTaskResponse) GetPartitionConfig() *v15.TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *AddActivityTaskResponse) GetAdaptivePartitionsDisabled() bool {
+	if m != nil {
+		return m.AdaptivePartitionsDisabled
+	}
+	return false
+}
+
 type QueryWorkflowRequest struct {
 	NamespaceId     string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue       *v14.TaskQueue           `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1031,7 +1150,7 @@ var xxx_messageInfo_RespondQueryTaskCompletedResponse proto.InternalMessageInfo
 
 type CancelOutstandingPollRequest struct {
 	NamespaceId   string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	TaskQueueType v17.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	TaskQueueType v18.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 	TaskQueue     *v14.TaskQueue    `protobuf:"bytes,3,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	PollerId      string            `protobuf:"bytes,4,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
 }
@@ -1075,11 +1194,11 @@ func (m *CancelOutstandingPollRequest) GetNamespaceId() string {
 	return ""
 }
 
-func (m *CancelOutstandingPollRequest) GetTaskQueueType() v17.TaskQueueType {
+func (m *CancelOutstandingPollRequest) GetTaskQueueType() v18.TaskQueueType {
 	if m != nil {
 		return m.TaskQueueType
 	}
-	return v17.TASK_QUEUE_TYPE_UNSPECIFIED
+	return v18.TASK_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *CancelOutstandingPollRequest) GetTaskQueue() *v14.TaskQueue {
@@ -1134,6 +1253,8 @@ var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 type DescribeTaskQueueRequest struct {
 	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	DescRequest *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
+	// When set on the root partition, the backlog stats of all the partitions of the task queue are aggregated.
+	AggregatePartitions bool `protobuf:"varint,3,opt,name=aggregate_partitions,json=aggregatePartitions,proto3" json:"aggregate_partitions,omitempty"`
 }
 
 func (m *DescribeTaskQueueRequest) Reset()      { *m = DescribeTaskQueueRequest{} }
@@ -1182,9 +1303,24 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 	return nil
 }
 
+func (m *DescribeTaskQueueRequest) GetAggregatePartitions() bool {
+	if m != nil {
+		return m.AggregatePartitions
+	}
+	return false
+}
+
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Number of backlog tasks loaded for dispatch per fairness key. Only the tasks read from persistence into
+	// the dispatch buffer of the partition are counted, tasks still waiting in persistence are not, see
+	// backlog_stats for the size of the whole backlog.
+	FairnessKeyBacklogs []*FairnessKeyBacklog `protobuf:"bytes,3,rep,name=fairness_key_backlogs,json=fairnessKeyBacklogs,proto3" json:"fairness_key_backlogs,omitempty"`
+	// Counters used by the adaptive partition controller to observe the load of the partition.
+	PartitionStats *TaskQueuePartitionStats `protobuf:"bytes,4,opt,name=partition_stats,json=partitionStats,proto3" json:"partition_stats,omitempty"`
+	// Backlog stats of the partition, or of all the partitions of the task queue when aggregate_partitions is set.
+	BacklogStats *TaskQueueBacklogStats `protobuf:"bytes,5,opt,name=backlog_stats,json=backlogStats,proto3" json:"backlog_stats,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1233,6 +1369,211 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetFairnessKeyBacklogs() []*FairnessKeyBacklog {
+	if m != nil {
+		return m.FairnessKeyBacklogs
+	}
+	return nil
+}
+
+func (m *DescribeTaskQueueResponse) GetPartitionStats() *TaskQueuePartitionStats {
+	if m != nil {
+		return m.PartitionStats
+	}
+	return nil
+}
+
+func (m *DescribeTaskQueueResponse) GetBacklogStats() *TaskQueueBacklogStats {
+	if m != nil {
+		return m.BacklogStats
+	}
+	return nil
+}
+
+type FairnessKeyBacklog struct {
+	FairnessKey  string `protobuf:"bytes,1,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	BacklogCount int64  `protobuf:"varint,2,opt,name=backlog_count,json=backlogCount,proto3" json:"backlog_count,omitempty"`
+}
+
+func (m *FairnessKeyBacklog) Reset()      { *m = FairnessKeyBacklog{} }
+func (*FairnessKeyBacklog) ProtoMessage() {}
+func (*FairnessKeyBacklog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{16}
+}
+func (m *FairnessKeyBacklog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FairnessKeyBacklog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FairnessKeyBacklog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FairnessKeyBacklog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FairnessKeyBacklog.Merge(m, src)
+}
+func (m *FairnessKeyBacklog) XXX_Size() int {
+	return m.Size()
+}
+func (m *FairnessKeyBacklog) XXX_DiscardUnknown() {
+	xxx_messageInfo_FairnessKeyBacklog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FairnessKeyBacklog proto.InternalMessageInfo
+
+func (m *FairnessKeyBacklog) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *FairnessKeyBacklog) GetBacklogCount() int64 {
+	if m != nil {
+		return m.BacklogCount
+	}
+	return 0
+}
+
+type TaskQueuePartitionStats struct {
+	// Number of tasks added to the partition since it was loaded, not counting tasks forwarded from child partitions.
+	TasksAdded int64 `protobuf:"varint,1,opt,name=tasks_added,json=tasksAdded,proto3" json:"tasks_added,omitempty"`
+	// Number of tasks dispatched to pollers since it was loaded, not counting tasks dispatched by parent partitions.
+	TasksDispatched int64 `protobuf:"varint,2,opt,name=tasks_dispatched,json=tasksDispatched,proto3" json:"tasks_dispatched,omitempty"`
+	// True when all tasks persisted in the partition are completed.
+	BacklogDrained bool `protobuf:"varint,3,opt,name=backlog_drained,json=backlogDrained,proto3" json:"backlog_drained,omitempty"`
+}
+
+func (m *TaskQueuePartitionStats) Reset()      { *m = TaskQueuePartitionStats{} }
+func (*TaskQueuePartitionStats) ProtoMessage() {}
+func (*TaskQueuePartitionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{17}
+}
+func (m *TaskQueuePartitionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueuePartitionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueuePartitionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueuePartitionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueuePartitionStats.Merge(m, src)
+}
+func (m *TaskQueuePartitionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueuePartitionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueuePartitionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueuePartitionStats proto.InternalMessageInfo
+
+func (m *TaskQueuePartitionStats) GetTasksAdded() int64 {
+	if m != nil {
+		return m.TasksAdded
+	}
+	return 0
+}
+
+func (m *TaskQueuePartitionStats) GetTasksDispatched() int64 {
+	if m != nil {
+		return m.TasksDispatched
+	}
+	return 0
+}
+
+func (m *TaskQueuePartitionStats) GetBacklogDrained() bool {
+	if m != nil {
+		return m.BacklogDrained
+	}
+	return false
+}
+
+type TaskQueueBacklogStats struct {
+	// Approximate number of tasks persisted in the backlog which are not completed yet.
+	ApproximateBacklogCount int64 `protobuf:"varint,1,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
+	// Age of the oldest task loaded from the backlog, unset when there is none.
+	ApproximateBacklogAge *time.Duration `protobuf:"bytes,2,opt,name=approximate_backlog_age,json=approximateBacklogAge,proto3,stdduration" json:"approximate_backlog_age,omitempty"`
+	// Rate per second of the tasks added over the last minute, not counting tasks forwarded from child partitions.
+	TasksAddRate float64 `protobuf:"fixed64,3,opt,name=tasks_add_rate,json=tasksAddRate,proto3" json:"tasks_add_rate,omitempty"`
+	// Rate per second of the tasks dispatched to pollers over the last minute.
+	TasksDispatchRate float64 `protobuf:"fixed64,4,opt,name=tasks_dispatch_rate,json=tasksDispatchRate,proto3" json:"tasks_dispatch_rate,omitempty"`
+}
+
+func (m *TaskQueueBacklogStats) Reset()      { *m = TaskQueueBacklogStats{} }
+func (*TaskQueueBacklogStats) ProtoMessage() {}
+func (*TaskQueueBacklogStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18}
+}
+func (m *TaskQueueBacklogStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueBacklogStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueBacklogStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueBacklogStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueBacklogStats.Merge(m, src)
+}
+func (m *TaskQueueBacklogStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueBacklogStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueBacklogStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueBacklogStats proto.InternalMessageInfo
+
+func (m *TaskQueueBacklogStats) GetApproximateBacklogCount() int64 {
+	if m != nil {
+		return m.ApproximateBacklogCount
+	}
+	return 0
+}
+
+func (m *TaskQueueBacklogStats) GetApproximateBacklogAge() *time.Duration {
+	if m != nil {
+		return m.ApproximateBacklogAge
+	}
+	return nil
+}
+
+func (m *TaskQueueBacklogStats) GetTasksAddRate() float64 {
+	if m != nil {
+		return m.TasksAddRate
+	}
+	return 0
+}
+
+func (m *TaskQueueBacklogStats) GetTasksDispatchRate() float64 {
+	if m != nil {
+		return m.TasksDispatchRate
+	}
+	return 0
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1241,7 +1582,7 @@ type ListTaskQueuePartitionsRequest struct {
 func (m *ListTaskQueuePartitionsRequest) Reset()      { *m = ListTaskQueuePartitionsRequest{} }
 func (*ListTaskQueuePartitionsRequest) ProtoMessage() {}
 func (*ListTaskQueuePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{16}
+	return fileDescriptor_a429a3813476c583, []int{19}
 }
 func (m *ListTaskQueuePartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1292,7 +1633,7 @@ type ListTaskQueuePartitionsResponse struct {
 func (m *ListTaskQueuePartitionsResponse) Reset()      { *m = ListTaskQueuePartitionsResponse{} }
 func (*ListTaskQueuePartitionsResponse) ProtoMessage() {}
 func (*ListTaskQueuePartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{17}
+	return fileDescriptor_a429a3813476c583, []int{20}
 }
 func (m *ListTaskQueuePartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1335,162 +1676,410 @@ func (m *ListTaskQueuePartitionsResponse) GetWorkflowTaskQueuePartitions() []*v1
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
-	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
-	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
-	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
-	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
-	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
-	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
-	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
-	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
-	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+type UpdateWorkerBuildIdOrderingRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	BuildId     string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// When set, build_id is added to the version set that contains this build id.
+	// Otherwise build_id starts a new version set that is incompatible with the existing ones.
+	PreviousCompatible string `protobuf:"bytes,4,opt,name=previous_compatible,json=previousCompatible,proto3" json:"previous_compatible,omitempty"`
+	// When true, the version set that contains build_id becomes the default one.
+	BecomeDefault bool `protobuf:"varint,5,opt,name=become_default,json=becomeDefault,proto3" json:"become_default,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+func (m *UpdateWorkerBuildIdOrderingRequest) Reset()      { *m = UpdateWorkerBuildIdOrderingRequest{} }
+func (*UpdateWorkerBuildIdOrderingRequest) ProtoMessage() {}
+func (*UpdateWorkerBuildIdOrderingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{21}
 }
-
-var fileDescriptor_a429a3813476c583 = []byte{
-	// 1752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x73, 0xe3, 0x56,
-	0x1d, 0x8f, 0x1c, 0xc7, 0x89, 0x9f, 0xec, 0xac, 0xa3, 0x42, 0xaa, 0x64, 0x37, 0x4a, 0xd6, 0x2d,
-	0x6d, 0xda, 0x29, 0xf2, 0x6c, 0x98, 0xee, 0xb4, 0x85, 0x02, 0x59, 0x6f, 0xa6, 0x35, 0x6c, 0x4b,
-	0x56, 0xf1, 0x00, 0xb3, 0xc3, 0x8c, 0xfa, 0x22, 0xbd, 0xd8, 0xc2, 0xb2, 0x9e, 0xa3, 0xf7, 0xe4,
-	0xd4, 0x9c, 0x98, 0xe9, 0x70, 0xef, 0x0c, 0x17, 0x18, 0x8e, 0x5c, 0xe0, 0xce, 0x1f, 0xc1, 0x81,
-	0xc3, 0x1e, 0x7b, 0x83, 0xcd, 0x5e, 0x98, 0xe1, 0x52, 0x86, 0x7f, 0x80, 0x79, 0x3f, 0x24, 0x4b,
-	0xb2, 0x9d, 0x38, 0xd9, 0x0c, 0xcb, 0x2d, 0xfa, 0xfe, 0xf8, 0xbc, 0xef, 0xfb, 0xfe, 0x7e, 0x0e,
-	0xf8, 0x90, 0xa2, 0xfe, 0x00, 0x87, 0xd0, 0x6f, 0x10, 0x14, 0x0e, 0x51, 0xd8, 0x80, 0x03, 0xaf,
-	0xd1, 0x87, 0xd4, 0xe9, 0x7a, 0x41, 0x87, 0x91, 0x3c, 0x07, 0x35, 0x86, 0xf7, 0x1a, 0x21, 0x3a,
-	0x8d, 0x10, 0xa1, 0x76, 0x88, 0xc8, 0x00, 0x07, 0x04, 0x99, 0x83, 0x10, 0x53, 0xac, 0xbd, 0x11,
-	0xab, 0x9b, 0x42, 0xdd, 0x84, 0x03, 0xcf, 0xcc, 0xa9, 0x9b, 0xc3, 0x7b, 0x9b, 0x46, 0x07, 0xe3,
-	0x8e, 0x8f, 0x1a, 0x5c, 0xeb, 0x38, 0x3a, 0x69, 0xb8, 0x51, 0x08, 0xa9, 0x87, 0x03, 0x81, 0xb3,
-	0xb9, 0x9d, 0xe7, 0x53, 0xaf, 0x8f, 0x08, 0x85, 0xfd, 0x81, 0x14, 0xb8, 0xeb, 0xa2, 0x01, 0x0a,
-	0x5c, 0x14, 0x38, 0x1e, 0x22, 0x8d, 0x0e, 0xee, 0x60, 0x4e, 0xe7, 0x7f, 0x49, 0x91, 0xd7, 0x93,
-	0xab, 0xb0, 0x3b, 0x38, 0xb8, 0xdf, 0xc7, 0x01, 0x33, 0xbd, 0x8f, 0x08, 0x81, 0x1d, 0x69, 0xf1,
-	0xe6, 0x1b, 0x19, 0x29, 0x14, 0x44, 0x7d, 0xc2, 0x84, 0x28, 0x24, 0x3d, 0xfb, 0x34, 0x42, 0x51,
-	0x2c, 0xf7, 0x66, 0x46, 0x8e, 0xb1, 0x39, 0x77, 0x12, 0xf0, 0xb5, 0x8c, 0xe0, 0x69, 0x84, 0xc2,
-	0xd1, 0xa4, 0xd0, 0xdb, 0xd3, 0xdc, 0xec, 0xf8, 0xd8, 0xe9, 0x4d, 0xca, 0xbe, 0x39, 0x4d, 0x36,
-	0x63, 0xa8, 0x14, 0x7c, 0x67, 0x9a, 0x60, 0xd7, 0x23, 0x14, 0x4f, 0x33, 0xe1, 0x7e, 0xc6, 0xce,
-	0x33, 0x1c, 0xf6, 0x4e, 0x7c, 0x7c, 0x76, 0x69, 0x88, 0xeb, 0xff, 0x52, 0xc0, 0x9d, 0x43, 0xec,
-	0xfb, 0x3f, 0x93, 0x1a, 0x6d, 0x48, 0x7a, 0x8f, 0x99, 0x2b, 0x2c, 0x21, 0xaf, 0xdd, 0x05, 0x95,
-	0x00, 0xf6, 0x11, 0x19, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x3b, 0xca, 0x6e, 0xd9, 0x52, 0x13,
-	0x5a, 0xcb, 0xd5, 0x6e, 0x83, 0xf2, 0x00, 0xfb, 0x3e, 0x0a, 0x19, 0xbf, 0xc0, 0xf9, 0x2b, 0x82,
-	0xd0, 0x72, 0xb5, 0xcf, 0x40, 0x85, 0xfd, 0x6d, 0xcb, 0xf3, 0xf5, 0xc5, 0x1d, 0x65, 0x57, 0xdd,
-	0xfb, 0xd0, 0x4c, 0x52, 0x8b, 0xe5, 0x54, 0xce, 0x5e, 0x73, 0x78, 0xcf, 0xbc, 0xc8, 0x28, 0x4b,
-	0x65, 0x90, 0xb1, 0x85, 0x6f, 0x81, 0xda, 0x09, 0x0e, 0xcf, 0x60, 0xe8, 0x22, 0xd7, 0x26, 0x38,
-	0x0a, 0x1d, 0xa4, 0x17, 0xb9, 0x15, 0xb7, 0x12, 0xfa, 0x11, 0x27, 0xd7, 0xbf, 0x28, 0x83, 0xad,
-	0x19, 0xc0, 0xc2, 0x2b, 0xda, 0x16, 0x00, 0x3c, 0x59, 0x28, 0xee, 0xa1, 0x80, 0x5f, 0xb6, 0x62,
-	0x95, 0x19, 0xa5, 0xcd, 0x08, 0xda, 0xcf, 0x81, 0x16, 0xdb, 0x6a, 0xa3, 0xcf, 0x91, 0x13, 0xb1,
-	0x2c, 0xe7, 0x77, 0x56, 0xf7, 0xde, 0xca, 0xde, 0x49, 0xa4, 0x28, 0xbb, 0x4a, 0x7c, 0xda, 0x41,
-	0xac, 0x60, 0xad, 0x9d, 0xe5, 0x49, 0x5a, 0x0b, 0x54, 0x13, 0x64, 0x3a, 0x1a, 0x20, 0xe9, 0xa8,
-	0xd7, 0x2f, 0x03, 0x6d, 0x8f, 0x06, 0xc8, 0xaa, 0x9c, 0xa5, 0xbe, 0xb4, 0xf7, 0xc1, 0xc6, 0x20,
-	0x44, 0x43, 0x0f, 0x47, 0xc4, 0x26, 0x14, 0x86, 0x14, 0xb9, 0x36, 0x1a, 0xa2, 0x80, 0xb2, 0xf8,
-	0x30, 0xcf, 0x2c, 0x5a, 0xeb, 0xb1, 0xc0, 0x91, 0xe0, 0x1f, 0x30, 0x76, 0xcb, 0xd5, 0x76, 0x41,
-	0x6d, 0x42, 0x63, 0x89, 0x6b, 0xac, 0x92, 0xac, 0xa4, 0x0e, 0x96, 0x21, 0x65, 0xb6, 0x51, 0xbd,
-	0xb4, 0xa3, 0xec, 0x2e, 0x59, 0xf1, 0xa7, 0x56, 0x07, 0xd5, 0x00, 0x7d, 0x4e, 0xc7, 0x00, 0xcb,
-	0x1c, 0x40, 0x65, 0xc4, 0x58, 0xfb, 0x1d, 0xa0, 0x1d, 0x43, 0xa7, 0xe7, 0xe3, 0x8e, 0xed, 0xe0,
-	0x28, 0xa0, 0x76, 0xd7, 0x0b, 0xa8, 0xbe, 0xc2, 0x05, 0x6b, 0x92, 0xd3, 0x64, 0x8c, 0x8f, 0xbd,
-	0x80, 0x6a, 0xef, 0x01, 0x9d, 0x50, 0xcf, 0xe9, 0x8d, 0xc6, 0x3e, 0xb7, 0x51, 0x00, 0x8f, 0x7d,
-	0xe4, 0xea, 0xe5, 0x1d, 0x65, 0x77, 0xc5, 0x5a, 0x17, 0xfc, 0xc4, 0x9d, 0x07, 0x82, 0xab, 0x7d,
-	0x00, 0x96, 0x78, 0xcd, 0xea, 0x60, 0x9a, 0x37, 0x39, 0x2b, 0xed, 0xcc, 0xc7, 0x8c, 0x60, 0x09,
-	0x15, 0xad, 0x93, 0x8a, 0x35, 0xcf, 0x09, 0x2f, 0x38, 0xc1, 0xba, 0xca, 0x81, 0xde, 0x37, 0xa7,
-	0xb5, 0x46, 0x59, 0x9d, 0x0c, 0xb1, 0x1d, 0xc2, 0x80, 0x78, 0x28, 0xa0, 0xe9, 0x54, 0x6b, 0x05,
-	0x27, 0xd8, 0xaa, 0x9d, 0xe5, 0x28, 0x5a, 0x07, 0x6c, 0x4d, 0x26, 0x95, 0x3d, 0xee, 0x59, 0x7a,
-	0x65, 0x9a, 0xf1, 0x49, 0xd3, 0xe2, 0xc7, 0x25, 0x89, 0xbc, 0x39, 0x91, 0x5a, 0x09, 0x8f, 0xd5,
-	0xf2, 0x71, 0x08, 0x03, 0xa7, 0x2b, 0xd3, 0x7b, 0x95, 0xa7, 0xb7, 0x2a, 0x68, 0x22, 0xc1, 0x3f,
-	0x02, 0xab, 0xc4, 0xe9, 0x22, 0x37, 0xf2, 0x91, 0x6b, 0xb3, 0x36, 0xad, 0xdf, 0xe2, 0x87, 0x6f,
-	0x9a, 0xa2, 0x87, 0x9b, 0x71, 0x0f, 0x37, 0xdb, 0x71, 0x0f, 0x7f, 0x50, 0xfc, 0xf2, 0xef, 0xdb,
-	0x8a, 0x55, 0x4d, 0xf4, 0x18, 0x47, 0x6b, 0x82, 0x4a, 0x9c, 0x49, 0x1c, 0xa6, 0x36, 0x27, 0x8c,
-	0x2a, 0xb5, 0x38, 0x88, 0x0f, 0x96, 0x59, 0x2c, 0x3c, 0x44, 0xf4, 0xb5, 0x9d, 0xc5, 0x5d, 0x75,
-	0xcf, 0x32, 0xe7, 0x1b, 0x49, 0xe6, 0x85, 0x55, 0x6e, 0x3e, 0x16, 0xa0, 0x07, 0x01, 0x0d, 0x47,
-	0x56, 0x7c, 0xc4, 0xe6, 0x67, 0xa0, 0x92, 0x66, 0x68, 0x35, 0xb0, 0xd8, 0x43, 0x23, 0xd9, 0xf1,
-	0xd8, 0x9f, 0x2c, 0x9d, 0x86, 0xd0, 0x8f, 0x90, 0xac, 0xf8, 0x39, 0xd3, 0x89, 0xab, 0x7c, 0x50,
-	0x78, 0x4f, 0xf9, 0x51, 0x71, 0xa5, 0x5a, 0x5b, 0x4d, 0x7a, 0xee, 0xbe, 0x43, 0xbd, 0xa1, 0x47,
-	0x47, 0xff, 0x57, 0x3d, 0x77, 0x96, 0x51, 0xd7, 0xee, 0xb9, 0x7f, 0x5b, 0x11, 0x3d, 0x77, 0x0a,
-	0xf0, 0xcb, 0xee, 0xb9, 0xdb, 0x40, 0x85, 0xd2, 0x2a, 0xe6, 0xc6, 0x45, 0x7e, 0x01, 0x10, 0x93,
-	0x5a, 0x2e, 0x6b, 0xca, 0x89, 0x00, 0x6f, 0xca, 0xc5, 0x8b, 0x9b, 0x72, 0x72, 0x47, 0xde, 0x94,
-	0x61, 0xea, 0x4b, 0xbb, 0x0f, 0x96, 0xbc, 0x60, 0x10, 0x51, 0xde, 0x4e, 0xd5, 0xbd, 0x9d, 0x59,
-	0x10, 0x87, 0x70, 0xe4, 0x63, 0xe8, 0x12, 0x4b, 0x88, 0x4f, 0x29, 0xc8, 0xd2, 0xf5, 0x0a, 0xf2,
-	0x09, 0xd8, 0x88, 0x09, 0x36, 0xc5, 0xb6, 0xe3, 0x63, 0x82, 0x38, 0x20, 0x8e, 0x28, 0x6f, 0xd1,
-	0xea, 0xde, 0xc6, 0x04, 0xe6, 0x43, 0xb9, 0xc8, 0x3d, 0x28, 0xfe, 0x8e, 0x41, 0xae, 0xc7, 0x08,
-	0x6d, 0xdc, 0x64, 0xfa, 0x6d, 0xa1, 0x3e, 0x51, 0xec, 0x2b, 0xd7, 0x29, 0xf6, 0x36, 0x58, 0xe7,
-	0x9f, 0x93, 0xd6, 0x95, 0xe7, 0xb3, 0xee, 0x15, 0xae, 0x9e, 0x33, 0xed, 0x11, 0x58, 0xeb, 0x22,
-	0x18, 0xd2, 0x63, 0x04, 0x69, 0x02, 0x08, 0xe6, 0x03, 0xac, 0x25, 0x9a, 0x31, 0x5a, 0x6a, 0xea,
-	0xa9, 0xd9, 0xa9, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x46, 0x9e, 0x24, 0xd9, 0xb9, 0xb8, 0x55,
-	0xe6, 0x74, 0xca, 0x6d, 0x89, 0xb3, 0x2f, 0x60, 0x8e, 0x32, 0x51, 0xfc, 0x24, 0x7d, 0x1d, 0x17,
-	0x51, 0xe8, 0xf9, 0x44, 0xaf, 0xce, 0x99, 0x52, 0xe3, 0xfb, 0x3c, 0x14, 0x9a, 0x93, 0x5b, 0xc7,
-	0xea, 0xb5, 0xb7, 0x8e, 0x6f, 0xa7, 0xca, 0x34, 0xe9, 0x54, 0x7c, 0x7a, 0x94, 0xc7, 0xb5, 0xf7,
-	0x69, 0xcc, 0xd0, 0xee, 0x83, 0x52, 0x17, 0x41, 0x17, 0x85, 0x72, 0x32, 0x18, 0xb3, 0x8e, 0xfc,
-	0x98, 0x4b, 0x59, 0x52, 0xba, 0xfe, 0x9f, 0x45, 0xb0, 0xbe, 0xef, 0xba, 0xe9, 0xde, 0x7e, 0x85,
-	0xb6, 0xf9, 0x11, 0x28, 0xbf, 0x40, 0x0b, 0x19, 0xeb, 0x6a, 0x4d, 0xd9, 0xb3, 0xc4, 0x80, 0x5e,
-	0xbc, 0xc2, 0x80, 0xe6, 0x9d, 0x4d, 0xcc, 0xe3, 0x6d, 0xa0, 0x26, 0x25, 0x99, 0xac, 0x66, 0x20,
-	0x26, 0xb5, 0xdc, 0x7c, 0xcd, 0xca, 0xf2, 0x90, 0x49, 0xbc, 0x74, 0xe5, 0x9a, 0xe5, 0xcb, 0x5e,
-	0x9c, 0xca, 0xd3, 0x5a, 0x78, 0x69, 0x6a, 0x0b, 0xd7, 0x7e, 0x08, 0x4a, 0x52, 0x80, 0xf5, 0x89,
-	0xd5, 0xbd, 0xdd, 0xa9, 0x53, 0x98, 0x3f, 0x62, 0xe2, 0xbb, 0x0a, 0x4d, 0x4b, 0xea, 0x69, 0xdf,
-	0x07, 0x4b, 0xfc, 0x3d, 0x24, 0x4b, 0x79, 0x3a, 0x00, 0x97, 0x60, 0x00, 0x47, 0x5d, 0x18, 0xba,
-	0x4d, 0xf6, 0x65, 0x09, 0xb5, 0xfa, 0x06, 0x78, 0x75, 0x22, 0xe8, 0x62, 0x7a, 0xd4, 0xff, 0x58,
-	0xe4, 0x09, 0x91, 0x1e, 0x2f, 0x2f, 0x23, 0x21, 0x4c, 0xf0, 0x8a, 0xb8, 0xab, 0x9d, 0x39, 0x52,
-	0xcc, 0x94, 0x35, 0xc1, 0xfa, 0x34, 0x75, 0x70, 0x36, 0x81, 0x8a, 0x37, 0x92, 0x40, 0x4b, 0x57,
-	0x4b, 0xa0, 0xd2, 0xcd, 0x27, 0xd0, 0xf2, 0x65, 0x09, 0xb4, 0xf2, 0x52, 0x13, 0x28, 0x9b, 0x24,
-	0x32, 0x81, 0x7e, 0x53, 0x00, 0xdf, 0xe0, 0x9b, 0x5a, 0x1c, 0xdf, 0x2b, 0xa4, 0x4f, 0x36, 0x8a,
-	0x85, 0xeb, 0x45, 0xf1, 0x09, 0xa8, 0xf2, 0xd5, 0x31, 0xb7, 0xaf, 0xbd, 0x7b, 0xe9, 0xbe, 0x36,
-	0xcd, 0x6a, 0xab, 0xc2, 0xb1, 0xae, 0xb1, 0xa8, 0xfd, 0x59, 0x01, 0xdf, 0xcc, 0x21, 0xca, 0x05,
-	0xad, 0x09, 0x2a, 0xb1, 0x81, 0x24, 0xf2, 0x29, 0x77, 0xc4, 0x3c, 0xf3, 0x46, 0x95, 0xa6, 0x30,
-	0x25, 0xed, 0xc7, 0x60, 0x35, 0x06, 0xf9, 0x25, 0x72, 0x28, 0x72, 0x2f, 0x59, 0xa2, 0xc5, 0xf2,
-	0x2c, 0x65, 0xad, 0xea, 0x69, 0xfa, 0xb3, 0xfe, 0xdb, 0x02, 0xd8, 0x11, 0xe6, 0xb9, 0x5c, 0x8e,
-	0xf9, 0xb5, 0x89, 0xfb, 0x03, 0x1f, 0x31, 0xe1, 0xff, 0x71, 0xfc, 0x5e, 0x05, 0xcb, 0xe2, 0x7d,
-	0x18, 0x97, 0x7b, 0x89, 0x7d, 0xb6, 0x5c, 0x2d, 0x00, 0x6b, 0x4e, 0x6c, 0x54, 0x12, 0x5c, 0x51,
-	0xea, 0xfb, 0x97, 0x06, 0xf7, 0xb2, 0xeb, 0x59, 0x35, 0x27, 0x47, 0xa9, 0xbf, 0x06, 0xee, 0x5e,
-	0xa0, 0x25, 0xd3, 0xfd, 0xdf, 0x0a, 0xb8, 0xd3, 0x84, 0x81, 0x83, 0xfc, 0x9f, 0x44, 0x94, 0x50,
-	0x18, 0xb8, 0x5e, 0xd0, 0x39, 0x4c, 0xed, 0xf6, 0x73, 0xb8, 0xed, 0x11, 0xb8, 0x35, 0x76, 0x9b,
-	0x58, 0x1c, 0x0a, 0xbc, 0xb0, 0x73, 0xbe, 0xcb, 0x54, 0x34, 0x77, 0x16, 0x5f, 0x1c, 0xaa, 0x34,
-	0xfd, 0x79, 0x33, 0xb3, 0x34, 0xf3, 0x20, 0x2a, 0x66, 0x1f, 0x44, 0xf5, 0x6d, 0xb0, 0x35, 0xe3,
-	0xca, 0xd2, 0x29, 0x7f, 0x50, 0x80, 0xfe, 0x10, 0x11, 0x27, 0xf4, 0x8e, 0xd1, 0x75, 0x9e, 0x63,
-	0xbf, 0x00, 0x15, 0x17, 0x11, 0x27, 0x09, 0x72, 0x21, 0xff, 0x2b, 0xc1, 0x8c, 0x20, 0xcf, 0x3a,
-	0xd3, 0x52, 0x19, 0x5c, 0x1c, 0xd7, 0xbf, 0x28, 0x60, 0x63, 0x8a, 0xa4, 0xac, 0xce, 0x1f, 0x80,
-	0x65, 0x71, 0x51, 0xa2, 0x2b, 0xfc, 0x91, 0xfc, 0xad, 0x0b, 0x7c, 0x77, 0x28, 0x5c, 0x12, 0x9c,
-	0x60, 0x2b, 0xd6, 0xd2, 0x7e, 0x0a, 0xd6, 0x52, 0xd1, 0x24, 0x14, 0xd2, 0x88, 0xc8, 0x1b, 0xbc,
-	0x3d, 0x4f, 0x18, 0x8e, 0xb8, 0x86, 0x75, 0x8b, 0x66, 0x09, 0xf5, 0x2f, 0x14, 0x60, 0x3c, 0xf2,
-	0x08, 0x4d, 0x04, 0x0f, 0x61, 0x48, 0x3d, 0x36, 0x59, 0x48, 0xec, 0xda, 0x3b, 0xa0, 0x3c, 0xde,
-	0x15, 0x85, 0x5f, 0xc7, 0x84, 0x1b, 0xa9, 0xce, 0xfa, 0xef, 0x0b, 0x60, 0x7b, 0xa6, 0x15, 0xd2,
-	0x85, 0xbf, 0x02, 0xc6, 0xf8, 0x9d, 0x37, 0x76, 0xc5, 0x20, 0x91, 0x94, 0x9e, 0x7d, 0x77, 0x9e,
-	0xc3, 0x13, 0xfc, 0x4f, 0x10, 0x85, 0x2e, 0xa4, 0xd0, 0xba, 0x0d, 0xf3, 0x6f, 0xdf, 0xb1, 0x0d,
-	0xec, 0xec, 0xec, 0xcf, 0x4c, 0x13, 0x67, 0x17, 0x5e, 0xe8, 0xec, 0xb3, 0xfc, 0xaf, 0x20, 0xe3,
-	0xb3, 0x1f, 0x84, 0x4f, 0x9f, 0x19, 0x0b, 0x5f, 0x3d, 0x33, 0x16, 0xbe, 0x7e, 0x66, 0x28, 0xbf,
-	0x3e, 0x37, 0x94, 0x3f, 0x9d, 0x1b, 0xca, 0x5f, 0xcf, 0x0d, 0xe5, 0xe9, 0xb9, 0xa1, 0xfc, 0xe3,
-	0xdc, 0x50, 0xfe, 0x79, 0x6e, 0x2c, 0x7c, 0x7d, 0x6e, 0x28, 0x5f, 0x3e, 0x37, 0x16, 0x9e, 0x3e,
-	0x37, 0x16, 0xbe, 0x7a, 0x6e, 0x2c, 0x3c, 0xf9, 0x5e, 0x07, 0x8f, 0x6d, 0xf1, 0xf0, 0xc5, 0xff,
-	0x5b, 0xf8, 0x6e, 0x8e, 0x74, 0x5c, 0xe2, 0x7b, 0xc6, 0x77, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x80, 0x18, 0x91, 0x0a, 0x9c, 0x18, 0x00, 0x00,
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.DiscardUnknown(m)
 }
 
-func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *UpdateWorkerBuildIdOrderingRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if that1 == nil {
-		return this == nil
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetPreviousCompatible() string {
+	if m != nil {
+		return m.PreviousCompatible
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetBecomeDefault() bool {
+	if m != nil {
+		return m.BecomeDefault
+	}
+	return false
+}
+
+type UpdateWorkerBuildIdOrderingResponse struct {
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) Reset()      { *m = UpdateWorkerBuildIdOrderingResponse{} }
+func (*UpdateWorkerBuildIdOrderingResponse) ProtoMessage() {}
+func (*UpdateWorkerBuildIdOrderingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{22}
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse proto.InternalMessageInfo
+
+type GetWorkerBuildIdOrderingRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) Reset()      { *m = GetWorkerBuildIdOrderingRequest{} }
+func (*GetWorkerBuildIdOrderingRequest) ProtoMessage() {}
+func (*GetWorkerBuildIdOrderingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{23}
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdOrderingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdOrderingRequest.Merge(m, src)
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdOrderingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdOrderingRequest proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdOrderingRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+type GetWorkerBuildIdOrderingResponse struct {
+	VersioningData *v15.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Reset()      { *m = GetWorkerBuildIdOrderingResponse{} }
+func (*GetWorkerBuildIdOrderingResponse) ProtoMessage() {}
+func (*GetWorkerBuildIdOrderingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{24}
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdOrderingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdOrderingResponse.Merge(m, src)
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdOrderingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdOrderingResponse proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdOrderingResponse) GetVersioningData() *v15.VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
+	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
+	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
+	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
+	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
+	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
+	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
+	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
+	proto.RegisterType((*FairnessKeyBacklog)(nil), "temporal.server.api.matchingservice.v1.FairnessKeyBacklog")
+	proto.RegisterType((*TaskQueuePartitionStats)(nil), "temporal.server.api.matchingservice.v1.TaskQueuePartitionStats")
+	proto.RegisterType((*TaskQueueBacklogStats)(nil), "temporal.server.api.matchingservice.v1.TaskQueueBacklogStats")
+	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
+	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*UpdateWorkerBuildIdOrderingRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdOrderingRequest")
+	proto.RegisterType((*UpdateWorkerBuildIdOrderingResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdOrderingResponse")
+	proto.RegisterType((*GetWorkerBuildIdOrderingRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdOrderingRequest")
+	proto.RegisterType((*GetWorkerBuildIdOrderingResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdOrderingResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+}
+
+var fileDescriptor_a429a3813476c583 = []byte{
+	// 2304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcb, 0x73, 0x1c, 0x47,
+	0xf9, 0x9a, 0x5d, 0xbd, 0xf6, 0xdb, 0x87, 0xa4, 0x56, 0x6c, 0xaf, 0x64, 0x6b, 0x25, 0xaf, 0xed,
+	0x58, 0x49, 0xe5, 0xb7, 0x2a, 0xeb, 0x57, 0x71, 0x25, 0x26, 0x21, 0xd1, 0xc3, 0x38, 0xc2, 0x4e,
+	0x62, 0x8f, 0x45, 0x42, 0x99, 0x54, 0x4d, 0x7a, 0x67, 0x5a, 0xab, 0x41, 0xb3, 0x33, 0xe3, 0xe9,
+	0xde, 0x95, 0xc5, 0x05, 0x0a, 0x8a, 0x2b, 0x95, 0x2a, 0x2e, 0xf0, 0x1f, 0xc0, 0x85, 0xbf, 0x82,
+	0x03, 0x27, 0xca, 0x07, 0x0e, 0xe1, 0x04, 0x96, 0x2f, 0x50, 0x5c, 0x02, 0x17, 0xae, 0x54, 0x3f,
+	0x66, 0x76, 0x66, 0x67, 0x56, 0xbb, 0x52, 0x0c, 0x86, 0xdb, 0xce, 0xd7, 0xdf, 0xfb, 0xdd, 0x2d,
+	0xc1, 0xbb, 0x8c, 0xb4, 0x7d, 0x2f, 0xc0, 0xce, 0x1a, 0x25, 0x41, 0x97, 0x04, 0x6b, 0xd8, 0xb7,
+	0xd7, 0xda, 0x98, 0x99, 0xfb, 0xb6, 0xdb, 0xe2, 0x20, 0xdb, 0x24, 0x6b, 0xdd, 0x1b, 0x6b, 0x01,
+	0x79, 0xdc, 0x21, 0x94, 0x19, 0x01, 0xa1, 0xbe, 0xe7, 0x52, 0xd2, 0xf0, 0x03, 0x8f, 0x79, 0xe8,
+	0xd5, 0x90, 0xbc, 0x21, 0xc9, 0x1b, 0xd8, 0xb7, 0x1b, 0x7d, 0xe4, 0x8d, 0xee, 0x8d, 0xc5, 0x5a,
+	0xcb, 0xf3, 0x5a, 0x0e, 0x59, 0x13, 0x54, 0xcd, 0xce, 0xde, 0x9a, 0xd5, 0x09, 0x30, 0xb3, 0x3d,
+	0x57, 0xf2, 0x59, 0x5c, 0xee, 0x3f, 0x67, 0x76, 0x9b, 0x50, 0x86, 0xdb, 0xbe, 0x42, 0xb8, 0x6c,
+	0x11, 0x9f, 0xb8, 0x16, 0x71, 0x4d, 0x9b, 0xd0, 0xb5, 0x96, 0xd7, 0xf2, 0x04, 0x5c, 0xfc, 0x52,
+	0x28, 0x57, 0x23, 0x53, 0xb8, 0x0d, 0xa6, 0xd7, 0x6e, 0x7b, 0x2e, 0x57, 0xbd, 0x4d, 0x28, 0xc5,
+	0x2d, 0xa5, 0xf1, 0xe2, 0xab, 0x09, 0x2c, 0xe2, 0x76, 0xda, 0x94, 0x23, 0x31, 0x4c, 0x0f, 0x8c,
+	0xc7, 0x1d, 0xd2, 0x09, 0xf1, 0xae, 0x27, 0xf0, 0xf8, 0xb1, 0x38, 0x4d, 0x33, 0xbc, 0x92, 0x40,
+	0x7c, 0xdc, 0x21, 0xc1, 0x51, 0x1a, 0xe9, 0xf5, 0x2c, 0x37, 0x9b, 0x8e, 0x67, 0x1e, 0xa4, 0x71,
+	0xaf, 0x67, 0xe1, 0x26, 0x14, 0x55, 0x88, 0x6f, 0x64, 0x21, 0xee, 0xdb, 0x94, 0x79, 0x59, 0x2a,
+	0xdc, 0x4c, 0xe8, 0x79, 0xe8, 0x05, 0x07, 0x7b, 0x8e, 0x77, 0x38, 0x34, 0xc4, 0x8b, 0x8d, 0x2c,
+	0x29, 0x3e, 0x09, 0xa8, 0x4d, 0x19, 0x71, 0x4d, 0x12, 0x2a, 0x45, 0x25, 0x7e, 0xfd, 0x6f, 0x1a,
+	0x5c, 0xba, 0xef, 0x39, 0xce, 0xa7, 0x4a, 0xc2, 0x2e, 0xa6, 0x07, 0x0f, 0xb8, 0xeb, 0x74, 0xc9,
+	0x1f, 0x5d, 0x86, 0x92, 0x8b, 0xdb, 0x84, 0xfa, 0xd8, 0x24, 0x86, 0x6d, 0x55, 0xb5, 0x15, 0x6d,
+	0xb5, 0xa0, 0x17, 0x23, 0xd8, 0x8e, 0x85, 0x2e, 0x42, 0xc1, 0xf7, 0x1c, 0x87, 0x04, 0xfc, 0x3c,
+	0x27, 0xce, 0xa7, 0x25, 0x60, 0xc7, 0x42, 0x9f, 0x43, 0x89, 0xff, 0x36, 0x94, 0xbe, 0xd5, 0xfc,
+	0x8a, 0xb6, 0x5a, 0x5c, 0x7f, 0x37, 0xd2, 0x53, 0xe4, 0x60, 0x9f, 0x7d, 0x8d, 0xee, 0x8d, 0xc6,
+	0x49, 0x4a, 0xe9, 0x45, 0xce, 0x32, 0xd4, 0xf0, 0x35, 0x98, 0xdd, 0xf3, 0x82, 0x43, 0x1c, 0x58,
+	0xc4, 0x32, 0xa8, 0xd7, 0x09, 0x4c, 0x52, 0x1d, 0x17, 0x5a, 0xcc, 0x44, 0xf0, 0x87, 0x02, 0x5c,
+	0xff, 0x0d, 0xc0, 0xd2, 0x00, 0xc6, 0xd2, 0x8b, 0x68, 0x09, 0x40, 0x24, 0x17, 0xf3, 0x0e, 0x88,
+	0x2b, 0x8c, 0x2d, 0xe9, 0x05, 0x0e, 0xd9, 0xe5, 0x00, 0xf4, 0x5d, 0x40, 0xa1, 0xae, 0x06, 0x79,
+	0x42, 0xcc, 0x0e, 0xaf, 0x0a, 0x61, 0x73, 0x71, 0xfd, 0xb5, 0xa4, 0x4d, 0x32, 0xa5, 0xb9, 0x29,
+	0xa1, 0xb4, 0xdb, 0x21, 0x81, 0x3e, 0x77, 0xd8, 0x0f, 0x42, 0x3b, 0x50, 0x8e, 0x38, 0xb3, 0x23,
+	0x9f, 0x28, 0x47, 0x5d, 0x1d, 0xc6, 0x74, 0xf7, 0xc8, 0x27, 0x7a, 0xe9, 0x30, 0xf6, 0x85, 0xde,
+	0x86, 0x05, 0x3f, 0x20, 0x5d, 0xdb, 0xeb, 0x50, 0x83, 0x32, 0x1c, 0x30, 0x62, 0x19, 0xa4, 0x4b,
+	0x5c, 0xc6, 0xe3, 0xc3, 0x3d, 0x93, 0xd7, 0xcf, 0x87, 0x08, 0x0f, 0xe5, 0xf9, 0x6d, 0x7e, 0xbc,
+	0x63, 0xa1, 0x55, 0x98, 0x4d, 0x51, 0x4c, 0x08, 0x8a, 0x0a, 0x4d, 0x62, 0x56, 0x61, 0x0a, 0x33,
+	0xae, 0x1b, 0xab, 0x4e, 0xae, 0x68, 0xab, 0x13, 0x7a, 0xf8, 0x89, 0xea, 0x50, 0x76, 0xc9, 0x13,
+	0xd6, 0x63, 0x30, 0x25, 0x18, 0x14, 0x39, 0x30, 0xa4, 0x7e, 0x03, 0x50, 0x13, 0x9b, 0x07, 0x8e,
+	0xd7, 0x32, 0x4c, 0xaf, 0xe3, 0x32, 0x63, 0xdf, 0x76, 0x59, 0x75, 0x5a, 0x20, 0xce, 0xaa, 0x93,
+	0x2d, 0x7e, 0xf0, 0x81, 0xed, 0x32, 0xf4, 0x16, 0x54, 0x29, 0xb3, 0xcd, 0x83, 0xa3, 0x9e, 0xcf,
+	0x0d, 0xe2, 0xe2, 0xa6, 0x43, 0xac, 0x6a, 0x61, 0x45, 0x5b, 0x9d, 0xd6, 0xcf, 0xcb, 0xf3, 0xc8,
+	0x9d, 0xb7, 0xe5, 0x29, 0xba, 0x05, 0x13, 0xa2, 0xc6, 0xab, 0x90, 0xe5, 0x4d, 0x71, 0x14, 0x77,
+	0xe6, 0x03, 0x0e, 0xd0, 0x25, 0x09, 0x6a, 0xc5, 0x62, 0x2d, 0x72, 0xc2, 0x76, 0xf7, 0xbc, 0x6a,
+	0x51, 0x30, 0x7a, 0xbb, 0x91, 0xd5, 0x4a, 0x55, 0x35, 0x73, 0x8e, 0xbb, 0x01, 0x76, 0xa9, 0x4d,
+	0x5c, 0x16, 0x4f, 0xb5, 0x1d, 0x77, 0xcf, 0xd3, 0x67, 0x0f, 0xfb, 0x20, 0xa8, 0x05, 0x4b, 0xe9,
+	0xa4, 0x32, 0x7a, 0x3d, 0xae, 0x5a, 0xca, 0x52, 0x3e, 0x6a, 0x72, 0x42, 0x5c, 0x94, 0xc8, 0x8b,
+	0xa9, 0xd4, 0x8a, 0xce, 0x78, 0x2d, 0x37, 0x03, 0xec, 0x9a, 0xfb, 0x2a, 0xbd, 0x2b, 0x22, 0xbd,
+	0x8b, 0x12, 0x26, 0x13, 0xfc, 0x0e, 0x54, 0xa8, 0xb9, 0x4f, 0xac, 0x8e, 0x43, 0x2c, 0x83, 0xb7,
+	0xf5, 0xea, 0x8c, 0x10, 0xbe, 0xd8, 0x90, 0x3d, 0xbf, 0x11, 0xf6, 0xfc, 0xc6, 0x6e, 0xd8, 0xf3,
+	0x37, 0xc7, 0xbf, 0xf8, 0xd3, 0xb2, 0xa6, 0x97, 0x23, 0x3a, 0x7e, 0x82, 0xb6, 0xa0, 0x14, 0x66,
+	0x92, 0x60, 0x33, 0x3b, 0x22, 0x9b, 0xa2, 0xa2, 0x12, 0x4c, 0x1c, 0x98, 0xe2, 0xb1, 0xb0, 0x09,
+	0xad, 0xce, 0xad, 0xe4, 0x57, 0x8b, 0xeb, 0x7a, 0x63, 0xb4, 0x11, 0xd6, 0x38, 0xb1, 0xca, 0x1b,
+	0x0f, 0x24, 0xd3, 0xdb, 0x2e, 0x0b, 0x8e, 0xf4, 0x50, 0x04, 0x6a, 0xc1, 0xac, 0x8f, 0x03, 0x66,
+	0x0b, 0xf7, 0x9b, 0x9e, 0xbb, 0x67, 0xb7, 0xaa, 0x48, 0xa8, 0xfd, 0x4e, 0xa6, 0xd8, 0x58, 0x5b,
+	0x4d, 0xc4, 0xe0, 0x7e, 0xc8, 0x64, 0x4b, 0xf0, 0xd0, 0x67, 0xfc, 0x24, 0x00, 0xbd, 0x0f, 0x97,
+	0xb0, 0x85, 0x7d, 0x66, 0x77, 0x89, 0x11, 0x9d, 0x51, 0xc3, 0xb2, 0xa9, 0xcc, 0xe9, 0x79, 0x91,
+	0xd3, 0x8b, 0x21, 0x4e, 0xc4, 0x8f, 0x6e, 0x2b, 0x8c, 0xc5, 0xcf, 0xa1, 0x14, 0xb7, 0x01, 0xcd,
+	0x42, 0xfe, 0x80, 0x1c, 0xa9, 0xe6, 0xcc, 0x7f, 0xf2, 0xcc, 0xef, 0x62, 0xa7, 0x43, 0xaa, 0xb9,
+	0xac, 0xe4, 0x19, 0x94, 0xf9, 0x82, 0xe4, 0x56, 0xee, 0x2d, 0xed, 0xdb, 0xe3, 0xd3, 0xe5, 0xd9,
+	0x4a, 0x34, 0x1e, 0x36, 0x4c, 0x66, 0x77, 0x6d, 0x76, 0xf4, 0x5f, 0x35, 0x1e, 0x06, 0x29, 0x75,
+	0xe6, 0xf1, 0xf0, 0xcf, 0x02, 0x2c, 0x0d, 0x60, 0xfc, 0xb2, 0xc7, 0xc3, 0x32, 0x14, 0xb1, 0xd2,
+	0x8a, 0xbb, 0x31, 0x2f, 0x0c, 0x80, 0x10, 0xb4, 0x63, 0xf1, 0xf9, 0x11, 0x21, 0x88, 0xf9, 0x31,
+	0x7e, 0xf2, 0xfc, 0x88, 0x6c, 0x14, 0xf3, 0x03, 0xc7, 0xbe, 0xd0, 0x4d, 0x98, 0xb0, 0x5d, 0xbf,
+	0xc3, 0x44, 0xe7, 0x2f, 0xae, 0xaf, 0x0c, 0x62, 0x71, 0x1f, 0x1f, 0x39, 0x1e, 0xb6, 0xa8, 0x2e,
+	0xd1, 0x33, 0x7a, 0xc7, 0xe4, 0xd9, 0x7a, 0xc7, 0x23, 0x58, 0x08, 0x01, 0x06, 0xf3, 0x0c, 0xd3,
+	0xf1, 0x28, 0x11, 0x0c, 0xbd, 0x0e, 0x13, 0xd3, 0xa4, 0xb8, 0xbe, 0x90, 0xe2, 0xb9, 0xad, 0x76,
+	0xd4, 0xcd, 0xf1, 0x5f, 0x70, 0x96, 0xe7, 0x43, 0x0e, 0xbb, 0xde, 0x16, 0xa7, 0xdf, 0x95, 0xe4,
+	0xa9, 0xbe, 0x34, 0x7d, 0x96, 0xbe, 0xb4, 0x0b, 0xe7, 0xc5, 0x67, 0x5a, 0xbb, 0xc2, 0x68, 0xda,
+	0xcd, 0x0b, 0xf2, 0x3e, 0xd5, 0xee, 0xc1, 0xdc, 0x3e, 0xc1, 0x01, 0x6b, 0x12, 0xcc, 0x22, 0x86,
+	0x30, 0x1a, 0xc3, 0xd9, 0x88, 0x32, 0xe4, 0x16, 0x1b, 0xd0, 0xc5, 0xe4, 0x80, 0x26, 0x50, 0x33,
+	0x3b, 0x41, 0xc0, 0xa7, 0xb3, 0x02, 0x19, 0x7d, 0x71, 0x2b, 0x8d, 0xe8, 0x94, 0x8b, 0x8a, 0xcf,
+	0x86, 0x64, 0xf3, 0x30, 0x11, 0xc5, 0x0f, 0xe3, 0xe6, 0x58, 0x84, 0x61, 0xdb, 0xa1, 0xd5, 0xf2,
+	0x88, 0x29, 0xd5, 0xb3, 0x67, 0x5b, 0x52, 0xa6, 0x17, 0xa4, 0xca, 0x99, 0x17, 0xa4, 0xff, 0x8b,
+	0x95, 0x69, 0xd4, 0xa9, 0xc4, 0xa0, 0x2b, 0xf4, 0x6a, 0xef, 0xa3, 0xf0, 0x00, 0xdd, 0x84, 0xc9,
+	0x7d, 0x82, 0x2d, 0x12, 0xa8, 0x21, 0x56, 0x1b, 0x24, 0xf2, 0x03, 0x81, 0xa5, 0x2b, 0xec, 0xcc,
+	0x79, 0x32, 0xf7, 0x32, 0xe6, 0x09, 0x1a, 0x36, 0x4f, 0xea, 0x7f, 0x18, 0x87, 0xf3, 0x1b, 0x96,
+	0x15, 0x9f, 0x98, 0xa7, 0xe8, 0xf0, 0x77, 0xa0, 0xf0, 0x35, 0xba, 0x5d, 0x8f, 0x16, 0x6d, 0xa9,
+	0xf6, 0x2a, 0xd7, 0x9e, 0xfc, 0x29, 0xd6, 0x9e, 0x02, 0x0b, 0x7f, 0xf2, 0x56, 0x19, 0x75, 0x8f,
+	0x68, 0xe1, 0x85, 0x10, 0xb4, 0x63, 0xf5, 0xb7, 0x17, 0x55, 0xc9, 0xaa, 0xde, 0x26, 0x4e, 0xdd,
+	0x5e, 0xc4, 0x0a, 0x1d, 0x56, 0x5d, 0xd6, 0xb4, 0x99, 0xcc, 0x9c, 0x36, 0xe8, 0x7d, 0x98, 0x54,
+	0x08, 0xbc, 0xa5, 0x55, 0xd6, 0x57, 0x33, 0x93, 0x42, 0x5c, 0x25, 0x43, 0x5b, 0x25, 0xa5, 0xae,
+	0xe8, 0xd0, 0x37, 0x61, 0x42, 0xdc, 0x4a, 0x55, 0xd7, 0xc9, 0x66, 0x20, 0x30, 0x38, 0x83, 0x87,
+	0xfb, 0x38, 0xb0, 0xb6, 0xf8, 0x97, 0x2e, 0xc9, 0xd0, 0x02, 0x4c, 0x37, 0x3b, 0xb6, 0x63, 0x71,
+	0x37, 0x81, 0x50, 0x72, 0x4a, 0x7c, 0xef, 0x58, 0x3c, 0xea, 0x7e, 0x60, 0x7b, 0x01, 0x1f, 0x27,
+	0x7c, 0xb3, 0x90, 0x2d, 0xa4, 0x18, 0xc2, 0xee, 0x92, 0x23, 0x8e, 0xb2, 0x87, 0xed, 0xc0, 0x25,
+	0x94, 0x0a, 0x94, 0x92, 0x4c, 0x8c, 0x10, 0x76, 0x97, 0x1c, 0xd5, 0x7f, 0xab, 0xc1, 0x85, 0x54,
+	0x5a, 0xa9, 0x51, 0x9a, 0x55, 0x1d, 0xda, 0xcb, 0xa8, 0x8e, 0xdc, 0xd0, 0xea, 0xf8, 0xab, 0xac,
+	0x8e, 0xf8, 0x5a, 0xf0, 0x32, 0xaa, 0xa3, 0x01, 0xf3, 0x32, 0xf0, 0x46, 0x42, 0xa4, 0xdc, 0x05,
+	0xe6, 0xe4, 0xd1, 0x47, 0x31, 0xc1, 0xc9, 0x6a, 0x1a, 0x7f, 0x21, 0xd5, 0x34, 0x71, 0xba, 0x6a,
+	0x9a, 0x7c, 0xf1, 0xd5, 0x34, 0x35, 0xac, 0x9a, 0xa6, 0x5f, 0x52, 0x35, 0xf5, 0x97, 0x0c, 0x0c,
+	0x2f, 0x99, 0xe2, 0xc0, 0x92, 0x49, 0xe6, 0xda, 0xff, 0x5e, 0xc9, 0xfc, 0x34, 0x07, 0xaf, 0x88,
+	0x3b, 0x45, 0x98, 0xd1, 0xa7, 0x28, 0x98, 0x64, 0xde, 0xe6, 0xce, 0x96, 0xb7, 0x8f, 0xa0, 0x2c,
+	0x2e, 0x39, 0x7d, 0x37, 0x8b, 0x37, 0x87, 0xde, 0x2c, 0xb2, 0xb4, 0xd6, 0x4b, 0x82, 0xd7, 0x19,
+	0xae, 0x14, 0xbf, 0xd6, 0xe0, 0x5c, 0x1f, 0x47, 0x15, 0xcc, 0x2d, 0x28, 0x85, 0x0a, 0xd2, 0x8e,
+	0xc3, 0xaa, 0xda, 0x88, 0x9b, 0x51, 0x51, 0xa9, 0xc2, 0x89, 0xd0, 0x5d, 0xa8, 0x84, 0x4c, 0xbe,
+	0x4f, 0x4c, 0x46, 0xac, 0x6c, 0x77, 0x45, 0xd7, 0x3d, 0x79, 0xcd, 0x53, 0xb8, 0x7a, 0xf9, 0x71,
+	0xfc, 0xb3, 0xfe, 0xf3, 0x1c, 0xac, 0x48, 0xf5, 0x2c, 0x81, 0xc7, 0xfd, 0xba, 0xe5, 0xb5, 0x7d,
+	0x87, 0x70, 0xe4, 0xff, 0x70, 0xfc, 0x2e, 0xc0, 0x94, 0x60, 0x12, 0x35, 0xb8, 0x49, 0xfe, 0xb9,
+	0x63, 0x21, 0x17, 0xe6, 0xcc, 0x50, 0xa9, 0x28, 0xb8, 0xb2, 0xb9, 0x6d, 0x0c, 0x0d, 0xee, 0x30,
+	0xf3, 0xf4, 0x59, 0xb3, 0x0f, 0x52, 0xbf, 0x02, 0x97, 0x4f, 0xa0, 0x92, 0xc1, 0xac, 0xff, 0x5d,
+	0x83, 0x4b, 0x5b, 0xd8, 0x35, 0x89, 0xf3, 0x71, 0x87, 0x51, 0x86, 0x5d, 0xcb, 0x76, 0x5b, 0xf7,
+	0x63, 0xb7, 0xd0, 0x11, 0xdc, 0x76, 0x0f, 0x66, 0x7a, 0x6e, 0x93, 0x2b, 0x6e, 0x4e, 0xb4, 0xb2,
+	0x3e, 0xdf, 0x25, 0x7a, 0x98, 0x70, 0x96, 0x58, 0x71, 0xcb, 0x2c, 0xfe, 0xf9, 0x62, 0x56, 0xa9,
+	0xc4, 0xd5, 0x7d, 0x3c, 0x79, 0x75, 0xaf, 0x2f, 0xc3, 0xd2, 0x00, 0x93, 0x95, 0x53, 0x7e, 0xaf,
+	0x41, 0x75, 0x9b, 0x50, 0x33, 0xb0, 0x9b, 0xe4, 0x2c, 0x0f, 0x07, 0x9f, 0x41, 0xc9, 0x22, 0xd4,
+	0x8c, 0x82, 0x9c, 0xeb, 0x7f, 0x7a, 0x1b, 0x10, 0xe4, 0x41, 0x32, 0xf5, 0x22, 0x67, 0x17, 0x2a,
+	0x70, 0x03, 0x5e, 0xc1, 0xad, 0x56, 0x40, 0x5a, 0x98, 0xc5, 0x9b, 0x9c, 0x70, 0xd5, 0xb4, 0x3e,
+	0x1f, 0x9d, 0xf5, 0x9a, 0x5b, 0xfd, 0x1f, 0x79, 0x58, 0xc8, 0x60, 0xae, 0x0a, 0xfa, 0x3d, 0x98,
+	0x92, 0xbe, 0xa1, 0x55, 0x4d, 0x3c, 0x56, 0x5d, 0x3b, 0xc1, 0xdd, 0xf7, 0xa5, 0x17, 0xf9, 0x83,
+	0x60, 0x48, 0x85, 0x3e, 0x81, 0xb9, 0x58, 0x02, 0x50, 0x86, 0x59, 0x87, 0x2a, 0xa3, 0x5f, 0x1f,
+	0x25, 0x72, 0x0f, 0x05, 0x85, 0x3e, 0xc3, 0x92, 0x00, 0xe4, 0xc2, 0xb9, 0xf8, 0xd4, 0x31, 0xd4,
+	0xfb, 0x2a, 0x37, 0x95, 0xab, 0x79, 0x6b, 0xd4, 0x37, 0xb5, 0x6f, 0xf5, 0xc6, 0xd4, 0xa6, 0x64,
+	0xa1, 0xcf, 0xef, 0xa5, 0x60, 0x14, 0xed, 0x43, 0x6f, 0xa0, 0x08, 0x33, 0xa8, 0xaa, 0xcf, 0xf7,
+	0x46, 0x95, 0x94, 0x9e, 0x54, 0xdc, 0x14, 0xaa, 0x57, 0xfc, 0xc4, 0x37, 0x6a, 0x42, 0x39, 0x7c,
+	0x46, 0x96, 0x72, 0x26, 0xfa, 0x9f, 0x8f, 0x46, 0x94, 0xa3, 0x74, 0x97, 0x52, 0x4a, 0xcd, 0xd8,
+	0x57, 0xfd, 0x33, 0x40, 0x69, 0xc3, 0x53, 0x93, 0x5c, 0x4b, 0x4d, 0x72, 0x74, 0x05, 0xca, 0x89,
+	0x37, 0x6e, 0x11, 0xca, 0xbc, 0x5e, 0x8a, 0x3f, 0x6f, 0xd7, 0x7f, 0xa6, 0xc1, 0x85, 0x01, 0xd6,
+	0xf2, 0xd5, 0x8b, 0x87, 0x92, 0x1a, 0xd8, 0xb2, 0x88, 0xac, 0x90, 0xbc, 0x2e, 0xaa, 0x9a, 0x6e,
+	0x70, 0x08, 0x9f, 0x43, 0x12, 0xc1, 0xb2, 0xa9, 0xcf, 0xad, 0x53, 0xfd, 0x3f, 0x2f, 0x73, 0x80,
+	0x6e, 0x47, 0x60, 0x74, 0x1d, 0x66, 0x42, 0x65, 0xac, 0x00, 0xdb, 0x2e, 0xb1, 0x54, 0xa2, 0x57,
+	0x14, 0x78, 0x5b, 0x42, 0xeb, 0x3f, 0xce, 0xc1, 0xb9, 0x4c, 0xb7, 0xa0, 0x5b, 0xb0, 0x80, 0x7d,
+	0x3f, 0xf0, 0x9e, 0xd8, 0x6d, 0x5e, 0x32, 0x49, 0xdb, 0xa4, 0x72, 0x17, 0x62, 0x08, 0x9b, 0x31,
+	0x33, 0xd1, 0xa7, 0x70, 0x21, 0x8b, 0x16, 0xb7, 0xc2, 0xf9, 0x30, 0x74, 0x45, 0x3c, 0x97, 0x66,
+	0xbd, 0xd1, 0x22, 0xe8, 0x2a, 0x54, 0x22, 0x1f, 0x19, 0x01, 0x66, 0xb2, 0xd5, 0x69, 0x7a, 0x29,
+	0x74, 0x93, 0x8e, 0x19, 0xe1, 0x9b, 0x73, 0xd2, 0x51, 0x12, 0x75, 0x5c, 0xa0, 0xce, 0x25, 0x7c,
+	0xc5, 0xf1, 0xeb, 0x3f, 0xd1, 0xa0, 0x76, 0xcf, 0xa6, 0x2c, 0x1d, 0x19, 0x1a, 0xb6, 0x8f, 0x4b,
+	0x50, 0xe8, 0x3d, 0x1d, 0xc8, 0xe8, 0xf7, 0x00, 0x2f, 0x64, 0x04, 0xd6, 0x7f, 0x99, 0x83, 0xe5,
+	0x81, 0x5a, 0xa8, 0xa6, 0xf3, 0x03, 0xa8, 0xf5, 0x9e, 0xfd, 0x7a, 0xcd, 0x23, 0xd6, 0xcf, 0x64,
+	0x2f, 0x7a, 0x73, 0x14, 0xe1, 0x11, 0xff, 0x0f, 0x09, 0xc3, 0x16, 0x66, 0x58, 0xbf, 0x88, 0xfb,
+	0x9f, 0x42, 0x7b, 0x3a, 0x70, 0xd9, 0xc9, 0x3f, 0x90, 0xa4, 0x64, 0xe7, 0xbe, 0x96, 0xec, 0xc3,
+	0xfe, 0xf7, 0xfb, 0x58, 0x2b, 0xfe, 0xa3, 0x06, 0xf5, 0xef, 0xf8, 0x16, 0x66, 0x84, 0x2f, 0x56,
+	0x24, 0xd8, 0x94, 0xf7, 0xd6, 0x8f, 0x03, 0x8b, 0x04, 0xb6, 0xdb, 0x3a, 0xc5, 0x94, 0x59, 0x4a,
+	0x85, 0xaa, 0x10, 0x1f, 0x81, 0xf1, 0x3b, 0x72, 0x3e, 0x79, 0x47, 0x5e, 0x83, 0xf9, 0xe8, 0xef,
+	0x6c, 0x7c, 0x6d, 0xc0, 0xcc, 0x6e, 0x3a, 0xe1, 0x26, 0x88, 0xc2, 0xa3, 0xad, 0xe8, 0x04, 0x5d,
+	0x83, 0x4a, 0x93, 0x98, 0x5e, 0x9b, 0x18, 0x16, 0xd9, 0xc3, 0x7c, 0xe9, 0x9b, 0x10, 0x35, 0x58,
+	0x96, 0xd0, 0x6d, 0x09, 0xac, 0x5f, 0x83, 0x2b, 0x27, 0x9a, 0xa6, 0xc6, 0xab, 0x09, 0xcb, 0x77,
+	0x08, 0xfb, 0xf7, 0x9a, 0x5f, 0xff, 0x21, 0xac, 0x0c, 0x16, 0xa2, 0x72, 0xf0, 0x7b, 0x30, 0xd3,
+	0x25, 0x01, 0xb5, 0x3d, 0xd7, 0x76, 0x5b, 0x06, 0x8f, 0x9d, 0x5a, 0x66, 0xd7, 0x47, 0xb9, 0x95,
+	0x7c, 0x12, 0x91, 0x6e, 0xf3, 0xa8, 0x57, 0xba, 0x89, 0xef, 0xcd, 0xe0, 0xe9, 0xb3, 0xda, 0xd8,
+	0x97, 0xcf, 0x6a, 0x63, 0x5f, 0x3d, 0xab, 0x69, 0x3f, 0x3a, 0xae, 0x69, 0xbf, 0x3a, 0xae, 0x69,
+	0xbf, 0x3b, 0xae, 0x69, 0x4f, 0x8f, 0x6b, 0xda, 0x9f, 0x8f, 0x6b, 0xda, 0x5f, 0x8e, 0x6b, 0x63,
+	0x5f, 0x1d, 0xd7, 0xb4, 0x2f, 0x9e, 0xd7, 0xc6, 0x9e, 0x3e, 0xaf, 0x8d, 0x7d, 0xf9, 0xbc, 0x36,
+	0xf6, 0xe8, 0x9d, 0x96, 0xd7, 0x93, 0x6d, 0x7b, 0x27, 0xff, 0xbb, 0xc4, 0x37, 0xfa, 0x40, 0xcd,
+	0x49, 0xd1, 0x84, 0xfe, 0xff, 0x5f, 0x03, 0x00, 0x18, 0x1c, 0x52, 0x21, 0x6f, 0x21, 0x00, 0x00,
+}
+
+func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollWorkflowTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollWorkflowTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -1588,6 +2177,12 @@ func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
+	if this.AdaptivePartitionsDisabled != that1.AdaptivePartitionsDisabled {
+		return false
+	}
 	return true
 }
 func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
@@ -1720,6 +2315,12 @@ func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.Header.Equal(that1.Header) {
 		return false
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
+	if this.AdaptivePartitionsDisabled != that1.AdaptivePartitionsDisabled {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
@@ -1771,6 +2372,15 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -1792,6 +2402,12 @@ func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
+	if this.AdaptivePartitionsDisabled != that1.AdaptivePartitionsDisabled {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
@@ -1846,6 +2462,12 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
@@ -1867,6 +2489,12 @@ func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
+	if this.AdaptivePartitionsDisabled != that1.AdaptivePartitionsDisabled {
+		return false
+	}
 	return true
 }
 func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
@@ -2062,6 +2690,9 @@ func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
 	if !this.DescRequest.Equal(that1.DescRequest) {
 		return false
 	}
+	if this.AggregatePartitions != that1.AggregatePartitions {
+		return false
+	}
 	return true
 }
 func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
@@ -2094,6 +2725,116 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if len(this.FairnessKeyBacklogs) != len(that1.FairnessKeyBacklogs) {
+		return false
+	}
+	for i := range this.FairnessKeyBacklogs {
+		if !this.FairnessKeyBacklogs[i].Equal(that1.FairnessKeyBacklogs[i]) {
+			return false
+		}
+	}
+	if !this.PartitionStats.Equal(that1.PartitionStats) {
+		return false
+	}
+	if !this.BacklogStats.Equal(that1.BacklogStats) {
+		return false
+	}
+	return true
+}
+func (this *FairnessKeyBacklog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FairnessKeyBacklog)
+	if !ok {
+		that2, ok := that.(FairnessKeyBacklog)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.BacklogCount != that1.BacklogCount {
+		return false
+	}
+	return true
+}
+func (this *TaskQueuePartitionStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueuePartitionStats)
+	if !ok {
+		that2, ok := that.(TaskQueuePartitionStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TasksAdded != that1.TasksAdded {
+		return false
+	}
+	if this.TasksDispatched != that1.TasksDispatched {
+		return false
+	}
+	if this.BacklogDrained != that1.BacklogDrained {
+		return false
+	}
+	return true
+}
+func (this *TaskQueueBacklogStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueueBacklogStats)
+	if !ok {
+		that2, ok := that.(TaskQueueBacklogStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ApproximateBacklogCount != that1.ApproximateBacklogCount {
+		return false
+	}
+	if this.ApproximateBacklogAge != nil && that1.ApproximateBacklogAge != nil {
+		if *this.ApproximateBacklogAge != *that1.ApproximateBacklogAge {
+			return false
+		}
+	} else if this.ApproximateBacklogAge != nil {
+		return false
+	} else if that1.ApproximateBacklogAge != nil {
+		return false
+	}
+	if this.TasksAddRate != that1.TasksAddRate {
+		return false
+	}
+	if this.TasksDispatchRate != that1.TasksDispatchRate {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2160,7 +2901,115 @@ func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PollWorkflowTaskQueueRequest) GoString() string {
+func (this *UpdateWorkerBuildIdOrderingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkerBuildIdOrderingRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdOrderingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.PreviousCompatible != that1.PreviousCompatible {
+		return false
+	}
+	if this.BecomeDefault != that1.BecomeDefault {
+		return false
+	}
+	return true
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkerBuildIdOrderingResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdOrderingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *GetWorkerBuildIdOrderingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetWorkerBuildIdOrderingRequest)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdOrderingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	return true
+}
+func (this *GetWorkerBuildIdOrderingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetWorkerBuildIdOrderingResponse)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdOrderingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
+}
+func (this *PollWorkflowTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
@@ -2179,7 +3028,7 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 20)
+	s := make([]string, 0, 22)
 	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2219,6 +3068,10 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "AdaptivePartitionsDisabled: "+fmt.Sprintf("%#v", this.AdaptivePartitionsDisabled)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2241,7 +3094,7 @@ func (this *PollActivityTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 20)
+	s := make([]string, 0, 22)
 	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2271,6 +3124,10 @@ func (this *PollActivityTaskQueueResponse) GoString() string {
 	if this.Header != nil {
 		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
 	}
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "AdaptivePartitionsDisabled: "+fmt.Sprintf("%#v", this.AdaptivePartitionsDisabled)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2278,7 +3135,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 15)
 	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2294,6 +3151,9 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2301,8 +3161,12 @@ func (this *AddWorkflowTaskResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
+	s := make([]string, 0, 6)
 	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "AdaptivePartitionsDisabled: "+fmt.Sprintf("%#v", this.AdaptivePartitionsDisabled)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2310,7 +3174,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2327,6 +3191,8 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2334,8 +3200,12 @@ func (this *AddActivityTaskResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
+	s := make([]string, 0, 6)
 	s = append(s, "&matchingservice.AddActivityTaskResponse{")
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "AdaptivePartitionsDisabled: "+fmt.Sprintf("%#v", this.AdaptivePartitionsDisabled)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2425,12 +3295,13 @@ func (this *DescribeTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.DescRequest != nil {
 		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
 	}
+	s = append(s, "AggregatePartitions: "+fmt.Sprintf("%#v", this.AggregatePartitions)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2438,7 +3309,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -2446,6 +3317,51 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.FairnessKeyBacklogs != nil {
+		s = append(s, "FairnessKeyBacklogs: "+fmt.Sprintf("%#v", this.FairnessKeyBacklogs)+",\n")
+	}
+	if this.PartitionStats != nil {
+		s = append(s, "PartitionStats: "+fmt.Sprintf("%#v", this.PartitionStats)+",\n")
+	}
+	if this.BacklogStats != nil {
+		s = append(s, "BacklogStats: "+fmt.Sprintf("%#v", this.BacklogStats)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FairnessKeyBacklog) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.FairnessKeyBacklog{")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "BacklogCount: "+fmt.Sprintf("%#v", this.BacklogCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueuePartitionStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.TaskQueuePartitionStats{")
+	s = append(s, "TasksAdded: "+fmt.Sprintf("%#v", this.TasksAdded)+",\n")
+	s = append(s, "TasksDispatched: "+fmt.Sprintf("%#v", this.TasksDispatched)+",\n")
+	s = append(s, "BacklogDrained: "+fmt.Sprintf("%#v", this.BacklogDrained)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueueBacklogStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.TaskQueueBacklogStats{")
+	s = append(s, "ApproximateBacklogCount: "+fmt.Sprintf("%#v", this.ApproximateBacklogCount)+",\n")
+	s = append(s, "ApproximateBacklogAge: "+fmt.Sprintf("%#v", this.ApproximateBacklogAge)+",\n")
+	s = append(s, "TasksAddRate: "+fmt.Sprintf("%#v", this.TasksAddRate)+",\n")
+	s = append(s, "TasksDispatchRate: "+fmt.Sprintf("%#v", this.TasksDispatchRate)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2477,6 +3393,52 @@ func (this *ListTaskQueuePartitionsResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpdateWorkerBuildIdOrderingRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdOrderingRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "PreviousCompatible: "+fmt.Sprintf("%#v", this.PreviousCompatible)+",\n")
+	s = append(s, "BecomeDefault: "+fmt.Sprintf("%#v", this.BecomeDefault)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdOrderingResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdOrderingRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetWorkerBuildIdOrderingRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdOrderingResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetWorkerBuildIdOrderingResponse{")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2561,6 +3523,32 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.AdaptivePartitionsDisabled {
+		i--
+		if m.AdaptivePartitionsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -2590,24 +3578,24 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		}
 	}
 	if m.StartedTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
 	if m.ScheduledTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -2799,6 +3787,32 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.AdaptivePartitionsDisabled {
+		i--
+		if m.AdaptivePartitionsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.Header != nil {
 		{
 			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
@@ -2845,12 +3859,12 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		dAtA[i] = 0x6a
 	}
 	if m.CurrentAttemptScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err14 != nil {
-			return 0, err14
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n14
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n14))
+		i -= n16
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -2860,52 +3874,52 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		dAtA[i] = 0x58
 	}
 	if m.HeartbeatTimeout != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err15 != nil {
-			return 0, err15
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n15
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n15))
+		i -= n17
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x52
 	}
 	if m.StartToCloseTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err16 != nil {
-			return 0, err16
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n16
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+		i -= n18
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err17 != nil {
-			return 0, err17
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n17
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n17))
+		i -= n19
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err18 != nil {
-			return 0, err18
+		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n18
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
+		i -= n20
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ScheduledTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err19 != nil {
-			return 0, err19
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n19
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n19))
+		i -= n21
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2982,6 +3996,25 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -3007,12 +4040,12 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x32
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err24 != nil {
-			return 0, err24
+		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n24
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n24))
+		i -= n26
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -3075,20 +4108,42 @@ func (m *AddWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	if m.AdaptivePartitionsDisabled {
+		i--
+		if m.AdaptivePartitionsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -3098,6 +4153,18 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -3123,12 +4190,12 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x3a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err28 != nil {
-			return 0, err28
+		n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n28
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n28))
+		i -= n31
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -3198,6 +4265,28 @@ func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AdaptivePartitionsDisabled {
+		i--
+		if m.AdaptivePartitionsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -3490,6 +4579,16 @@ func (m *DescribeTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.AggregatePartitions {
+		i--
+		if m.AggregatePartitions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DescRequest != nil {
 		{
 			size, err := m.DescRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -3532,6 +4631,44 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.BacklogStats != nil {
+		{
+			size, err := m.BacklogStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PartitionStats != nil {
+		{
+			size, err := m.PartitionStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FairnessKeyBacklogs) > 0 {
+		for iNdEx := len(m.FairnessKeyBacklogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FairnessKeyBacklogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3561,6 +4698,134 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *FairnessKeyBacklog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FairnessKeyBacklog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FairnessKeyBacklog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BacklogCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueuePartitionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueuePartitionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueuePartitionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BacklogDrained {
+		i--
+		if m.BacklogDrained {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TasksDispatched != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TasksDispatched))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TasksAdded != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TasksAdded))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueueBacklogStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueueBacklogStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueueBacklogStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TasksDispatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TasksDispatchRate))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.TasksAddRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TasksAddRate))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.ApproximateBacklogAge != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ApproximateBacklogAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ApproximateBacklogAge):])
+		if err46 != nil {
+			return 0, err46
+		}
+		i -= n46
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n46))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ApproximateBacklogCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ApproximateBacklogCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListTaskQueuePartitionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3654,54 +4919,210 @@ func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateWorkerBuildIdOrderingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.BecomeDefault {
+		i--
+		if m.BecomeDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.PreviousCompatible) > 0 {
+		i -= len(m.PreviousCompatible)
+		copy(dAtA[i:], m.PreviousCompatible)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PreviousCompatible)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *UpdateWorkerBuildIdOrderingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	if m.WorkflowType != nil {
@@ -3763,6 +5184,13 @@ func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AdaptivePartitionsDisabled {
+		n += 3
+	}
 	return n
 }
 
@@ -3860,6 +5288,13 @@ func (m *PollActivityTaskQueueResponse) Size() (n int) {
 		l = m.Header.Size()
 		n += 2 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AdaptivePartitionsDisabled {
+		n += 3
+	}
 	return n
 }
 
@@ -3899,6 +5334,17 @@ func (m *AddWorkflowTaskRequest) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PriorityKey != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3908,6 +5354,13 @@ func (m *AddWorkflowTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AdaptivePartitionsDisabled {
+		n += 2
+	}
 	return n
 }
 
@@ -3951,6 +5404,13 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PriorityKey != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -3960,6 +5420,13 @@ func (m *AddActivityTaskResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.AdaptivePartitionsDisabled {
+		n += 2
+	}
 	return n
 }
 
@@ -4086,6 +5553,9 @@ func (m *DescribeTaskQueueRequest) Size() (n int) {
 		l = m.DescRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.AggregatePartitions {
+		n += 2
+	}
 	return n
 }
 
@@ -4105,6 +5575,76 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.FairnessKeyBacklogs) > 0 {
+		for _, e := range m.FairnessKeyBacklogs {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.PartitionStats != nil {
+		l = m.PartitionStats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BacklogStats != nil {
+		l = m.BacklogStats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *FairnessKeyBacklog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BacklogCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BacklogCount))
+	}
+	return n
+}
+
+func (m *TaskQueuePartitionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TasksAdded != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TasksAdded))
+	}
+	if m.TasksDispatched != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TasksDispatched))
+	}
+	if m.BacklogDrained {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaskQueueBacklogStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApproximateBacklogCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ApproximateBacklogCount))
+	}
+	if m.ApproximateBacklogAge != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ApproximateBacklogAge)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TasksAddRate != 0 {
+		n += 9
+	}
+	if m.TasksDispatchRate != 0 {
+		n += 9
+	}
 	return n
 }
 
@@ -4146,6 +5686,73 @@ func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpdateWorkerBuildIdOrderingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PreviousCompatible)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BecomeDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4196,6 +5803,8 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`PartitionConfig:` + strings.Replace(fmt.Sprintf("%v", this.PartitionConfig), "TaskQueuePartitionConfig", "v15.TaskQueuePartitionConfig", 1) + `,`,
+		`AdaptivePartitionsDisabled:` + fmt.Sprintf("%v", this.AdaptivePartitionsDisabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4234,6 +5843,8 @@ func (this *PollActivityTaskQueueResponse) String() string {
 		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
 		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
 		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
+		`PartitionConfig:` + strings.Replace(fmt.Sprintf("%v", this.PartitionConfig), "TaskQueuePartitionConfig", "v15.TaskQueuePartitionConfig", 1) + `,`,
+		`AdaptivePartitionsDisabled:` + fmt.Sprintf("%v", this.AdaptivePartitionsDisabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4250,7 +5861,10 @@ func (this *AddWorkflowTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "ShardClock", "v16.ShardClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "ShardClock", "v17.ShardClock", 1) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4260,6 +5874,8 @@ func (this *AddWorkflowTaskResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
+		`PartitionConfig:` + strings.Replace(fmt.Sprintf("%v", this.PartitionConfig), "TaskQueuePartitionConfig", "v15.TaskQueuePartitionConfig", 1) + `,`,
+		`AdaptivePartitionsDisabled:` + fmt.Sprintf("%v", this.AdaptivePartitionsDisabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4277,7 +5893,9 @@ func (this *AddActivityTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "ShardClock", "v16.ShardClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "ShardClock", "v17.ShardClock", 1) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4287,6 +5905,8 @@ func (this *AddActivityTaskResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&AddActivityTaskResponse{`,
+		`PartitionConfig:` + strings.Replace(fmt.Sprintf("%v", this.PartitionConfig), "TaskQueuePartitionConfig", "v15.TaskQueuePartitionConfig", 1) + `,`,
+		`AdaptivePartitionsDisabled:` + fmt.Sprintf("%v", this.AdaptivePartitionsDisabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4366,6 +5986,7 @@ func (this *DescribeTaskQueueRequest) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
+		`AggregatePartitions:` + fmt.Sprintf("%v", this.AggregatePartitions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4379,25 +6000,69 @@ func (this *DescribeTaskQueueResponse) String() string {
 		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
 	}
 	repeatedStringForPollers += "}"
+	repeatedStringForFairnessKeyBacklogs := "[]*FairnessKeyBacklog{"
+	for _, f := range this.FairnessKeyBacklogs {
+		repeatedStringForFairnessKeyBacklogs += strings.Replace(f.String(), "FairnessKeyBacklog", "FairnessKeyBacklog", 1) + ","
+	}
+	repeatedStringForFairnessKeyBacklogs += "}"
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`FairnessKeyBacklogs:` + repeatedStringForFairnessKeyBacklogs + `,`,
+		`PartitionStats:` + strings.Replace(this.PartitionStats.String(), "TaskQueuePartitionStats", "TaskQueuePartitionStats", 1) + `,`,
+		`BacklogStats:` + strings.Replace(this.BacklogStats.String(), "TaskQueueBacklogStats", "TaskQueueBacklogStats", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ListTaskQueuePartitionsRequest) String() string {
+func (this *FairnessKeyBacklog) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+	s := strings.Join([]string{`&FairnessKeyBacklog{`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`BacklogCount:` + fmt.Sprintf("%v", this.BacklogCount) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ListTaskQueuePartitionsResponse) String() string {
+func (this *TaskQueuePartitionStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueuePartitionStats{`,
+		`TasksAdded:` + fmt.Sprintf("%v", this.TasksAdded) + `,`,
+		`TasksDispatched:` + fmt.Sprintf("%v", this.TasksDispatched) + `,`,
+		`BacklogDrained:` + fmt.Sprintf("%v", this.BacklogDrained) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueueBacklogStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueueBacklogStats{`,
+		`ApproximateBacklogCount:` + fmt.Sprintf("%v", this.ApproximateBacklogCount) + `,`,
+		`ApproximateBacklogAge:` + strings.Replace(fmt.Sprintf("%v", this.ApproximateBacklogAge), "Duration", "types.Duration", 1) + `,`,
+		`TasksAddRate:` + fmt.Sprintf("%v", this.TasksAddRate) + `,`,
+		`TasksDispatchRate:` + fmt.Sprintf("%v", this.TasksDispatchRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -4418,6 +6083,50 @@ func (this *ListTaskQueuePartitionsResponse) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateWorkerBuildIdOrderingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdOrderingRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`PreviousCompatible:` + fmt.Sprintf("%v", this.PreviousCompatible) + `,`,
+		`BecomeDefault:` + fmt.Sprintf("%v", this.BecomeDefault) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdOrderingResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdOrderingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdOrderingRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdOrderingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdOrderingResponse{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v15.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5204,6 +6913,62 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v15.TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePartitionsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptivePartitionsDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -5991,6 +7756,62 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v15.TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePartitionsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptivePartitionsDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6249,7 +8070,7 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v15.TaskSource(b&0x7F) << shift
+				m.Source |= v16.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6284,12 +8105,95 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v16.ShardClock{}
+				m.Clock = &v17.ShardClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6343,16 +8247,72 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v15.TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePartitionsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptivePartitionsDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if (iNdEx + skippy) > l {
@@ -6633,7 +8593,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v15.TaskSource(b&0x7F) << shift
+				m.Source |= v16.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6668,12 +8628,63 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v16.ShardClock{}
+				m.Clock = &v17.ShardClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6727,6 +8738,62 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v15.TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptivePartitionsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptivePartitionsDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7382,7 +9449,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v17.TaskQueueType(b&0x7F) << shift
+				m.TaskQueueType |= v18.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7629,6 +9696,26 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePartitions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AggregatePartitions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7752,64 +9839,45 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKeyBacklogs", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FairnessKeyBacklogs = append(m.FairnessKeyBacklogs, &FairnessKeyBacklog{})
+			if err := m.FairnessKeyBacklogs[len(m.FairnessKeyBacklogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7819,27 +9887,31 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.PartitionStats == nil {
+				m.PartitionStats = &TaskQueuePartitionStats{}
+			}
+			if err := m.PartitionStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7866,10 +9938,10 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			if m.BacklogStats == nil {
+				m.BacklogStats = &TaskQueueBacklogStats{}
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BacklogStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7879,10 +9951,7 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if (iNdEx + skippy) > l {
@@ -7897,7 +9966,7 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *FairnessKeyBacklog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7920,17 +9989,17 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FairnessKeyBacklog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FairnessKeyBacklog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7940,12 +10009,506 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCount", wireType)
+			}
+			m.BacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueuePartitionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueuePartitionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueuePartitionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksAdded", wireType)
+			}
+			m.TasksAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TasksAdded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksDispatched", wireType)
+			}
+			m.TasksDispatched = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TasksDispatched |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogDrained", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BacklogDrained = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueueBacklogStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueBacklogStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueBacklogStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateBacklogCount", wireType)
+			}
+			m.ApproximateBacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateBacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateBacklogAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApproximateBacklogAge == nil {
+				m.ApproximateBacklogAge = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ApproximateBacklogAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksAddRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TasksAddRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksDispatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TasksDispatchRate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			postIndex := iNdEx + msglen
@@ -7955,14 +10518,426 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCompatible", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousCompatible = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BecomeDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BecomeDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdOrderingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdOrderingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7989,8 +10964,10 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VersioningData == nil {
+				m.VersioningData = &v15.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54,36 +54,39 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6e, 0x1a, 0x31,
-	0x1c, 0x80, 0xcf, 0x4b, 0x07, 0x57, 0x15, 0xea, 0x49, 0x55, 0x55, 0x06, 0x0f, 0x1d, 0x3a, 0xde,
-	0x89, 0xb6, 0x5b, 0xa1, 0x2d, 0x85, 0xfe, 0x93, 0x5a, 0x15, 0xda, 0x4a, 0x95, 0xba, 0x54, 0xe6,
-	0xce, 0xa5, 0x16, 0xc7, 0xf9, 0x6a, 0xfb, 0x2e, 0x62, 0xcb, 0x13, 0x44, 0x19, 0x32, 0xe5, 0x01,
-	0xa2, 0x0c, 0x99, 0xf2, 0x14, 0x19, 0x32, 0x30, 0x32, 0x86, 0x63, 0xc9, 0xc8, 0x23, 0x44, 0x70,
-	0xf8, 0x80, 0x03, 0x22, 0x73, 0x64, 0x03, 0xe3, 0xef, 0xf3, 0x67, 0x89, 0x9f, 0x0c, 0x5f, 0x4a,
-	0xd2, 0x0d, 0x18, 0xc7, 0x9e, 0x2d, 0x08, 0x8f, 0x08, 0xb7, 0x71, 0x40, 0xed, 0x2e, 0x96, 0xce,
-	0x3f, 0xea, 0xb7, 0x27, 0x4b, 0xd4, 0x21, 0x76, 0x54, 0xb2, 0x67, 0x1f, 0xad, 0x80, 0x33, 0xc9,
-	0xcc, 0x67, 0x8a, 0xb2, 0x12, 0xca, 0xc2, 0x01, 0xb5, 0x32, 0x94, 0x15, 0x95, 0x8a, 0x15, 0x4d,
-	0x3b, 0x27, 0xff, 0x43, 0x22, 0xe4, 0x1f, 0x4e, 0x44, 0xc0, 0x7c, 0x31, 0x3b, 0xe6, 0xf9, 0xe5,
-	0x7d, 0x58, 0xf8, 0x3a, 0xdb, 0xfd, 0x23, 0xd9, 0x6d, 0x9e, 0x00, 0xf8, 0xa8, 0xc1, 0x3c, 0xef,
-	0x17, 0xe3, 0x9d, 0xbf, 0x1e, 0xdb, 0xfb, 0x89, 0x45, 0xa7, 0x19, 0x92, 0x90, 0x98, 0x75, 0x4b,
-	0xaf, 0xca, 0x5a, 0x8b, 0x7f, 0x4f, 0x12, 0x8a, 0xef, 0x77, 0xb4, 0x24, 0x17, 0x78, 0x6a, 0xa4,
-	0xa1, 0x55, 0x47, 0xd2, 0x88, 0xca, 0x5e, 0xce, 0xd0, 0x15, 0x3c, 0x57, 0xe8, 0x1a, 0x4b, 0x1a,
-	0x7a, 0x04, 0x60, 0xa1, 0xea, 0xba, 0x8b, 0x77, 0x31, 0x5f, 0xeb, 0xca, 0x33, 0xa0, 0x8a, 0x7b,
-	0x93, 0x9b, 0xcf, 0x66, 0x2d, 0x96, 0x6f, 0x95, 0xb5, 0x08, 0xe6, 0xc9, 0x5a, 0xe6, 0xd3, 0xac,
-	0x03, 0x00, 0x1f, 0x34, 0x43, 0xc2, 0x7b, 0x2a, 0xdb, 0x2c, 0xeb, 0x4a, 0x97, 0x30, 0x95, 0x54,
-	0xc9, 0x49, 0xa7, 0x41, 0xe7, 0x00, 0x3e, 0x49, 0xbe, 0xba, 0xd3, 0x2d, 0x93, 0xde, 0x1a, 0xeb,
-	0x06, 0x1e, 0x91, 0xc4, 0x35, 0x3f, 0xe9, 0xea, 0x37, 0x2a, 0x54, 0xe8, 0xe7, 0x3b, 0x30, 0x2d,
-	0x0d, 0x47, 0x0d, 0xfb, 0x0e, 0xf1, 0xbe, 0x85, 0x52, 0x48, 0xec, 0xbb, 0xd4, 0x6f, 0x4f, 0xfe,
-	0xa8, 0xfa, 0xc3, 0xb1, 0x16, 0xdf, 0x7a, 0x38, 0x36, 0x58, 0xd2, 0xd0, 0x63, 0x00, 0x1f, 0xd6,
-	0x89, 0x70, 0x38, 0x6d, 0x91, 0xf9, 0x04, 0xbf, 0xd5, 0xd5, 0xaf, 0xa0, 0x2a, 0xb0, 0xba, 0x83,
-	0x21, 0x8d, 0x3b, 0x03, 0xf0, 0xf1, 0x17, 0x2a, 0x64, 0xfa, 0x5b, 0x03, 0x73, 0x49, 0x25, 0x65,
-	0xbe, 0x30, 0x3f, 0xe8, 0x1e, 0xb0, 0x41, 0xa0, 0x42, 0x3f, 0xee, 0xec, 0x51, 0xb9, 0xef, 0x78,
-	0x7f, 0x88, 0x8c, 0xc1, 0x10, 0x19, 0xe3, 0x21, 0x02, 0xfb, 0x31, 0x02, 0xa7, 0x31, 0x02, 0x17,
-	0x31, 0x02, 0xfd, 0x18, 0x81, 0xab, 0x18, 0x81, 0xeb, 0x18, 0x19, 0xe3, 0x18, 0x81, 0xc3, 0x11,
-	0x32, 0xfa, 0x23, 0x64, 0x0c, 0x46, 0xc8, 0xf8, 0x5d, 0x6e, 0xb3, 0x79, 0x02, 0x65, 0xb7, 0xbf,
-	0x24, 0xaf, 0x32, 0x4b, 0xad, 0x7b, 0xd3, 0x97, 0xe4, 0xc5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x04, 0x9e, 0xce, 0x0a, 0xe8, 0x06, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3d, 0x6f, 0xd4, 0x30,
+	0x18, 0xc7, 0xe3, 0x85, 0xc1, 0x12, 0x54, 0x58, 0x42, 0x40, 0x91, 0x3c, 0x30, 0x30, 0x26, 0x2a,
+	0xb0, 0xd1, 0x02, 0xd7, 0x16, 0xda, 0xf2, 0xa2, 0xb6, 0xbc, 0x08, 0x89, 0x05, 0xb9, 0xf1, 0xc3,
+	0x61, 0x35, 0x17, 0x07, 0xdb, 0x39, 0xd4, 0x8d, 0x4f, 0x80, 0x18, 0x98, 0xf8, 0x00, 0x88, 0x01,
+	0x09, 0x89, 0x09, 0xbe, 0x01, 0xe3, 0x8d, 0x1d, 0xb9, 0xdc, 0xc2, 0xd8, 0x8f, 0x50, 0x5d, 0x73,
+	0x76, 0xef, 0xae, 0x77, 0x95, 0x9b, 0xdc, 0x96, 0x38, 0xcf, 0xff, 0xf7, 0xfc, 0x1c, 0xf9, 0x91,
+	0xf1, 0x6d, 0x03, 0xad, 0x4c, 0x2a, 0x96, 0x44, 0x1a, 0x54, 0x1b, 0x54, 0xc4, 0x32, 0x11, 0xb5,
+	0x98, 0x89, 0xdf, 0x89, 0xb4, 0xd9, 0x5f, 0x12, 0x31, 0x44, 0xed, 0x85, 0x68, 0xf0, 0x18, 0x66,
+	0x4a, 0x1a, 0x49, 0x6e, 0xd8, 0x54, 0x58, 0xa6, 0x42, 0x96, 0x89, 0x70, 0x2c, 0x15, 0xb6, 0x17,
+	0xe6, 0x97, 0x3c, 0xe9, 0x0a, 0xde, 0xe7, 0xa0, 0xcd, 0x1b, 0x05, 0x3a, 0x93, 0xa9, 0x1e, 0xb4,
+	0xb9, 0xf9, 0xe7, 0x02, 0x9e, 0x7b, 0x3a, 0xa8, 0x7e, 0x5e, 0x56, 0x93, 0x6f, 0x08, 0x5f, 0xda,
+	0x92, 0x49, 0xf2, 0x4a, 0xaa, 0xdd, 0xb7, 0x89, 0xfc, 0xf0, 0x82, 0xe9, 0xdd, 0xed, 0x1c, 0x72,
+	0x20, 0xab, 0xa1, 0x9f, 0x55, 0x38, 0x31, 0xfe, 0xac, 0x54, 0x98, 0x7f, 0x50, 0x93, 0x52, 0x6e,
+	0xe0, 0x7a, 0xe0, 0x44, 0x1b, 0xb1, 0x11, 0x6d, 0x61, 0xf6, 0x2a, 0x8a, 0x9e, 0x88, 0x57, 0x12,
+	0x9d, 0x40, 0x71, 0xa2, 0x5f, 0x10, 0x9e, 0x6b, 0x70, 0x3e, 0xbc, 0x17, 0x72, 0xd7, 0x17, 0x3e,
+	0x16, 0xb4, 0x72, 0xf7, 0x2a, 0xe7, 0xc7, 0xb5, 0x86, 0xcd, 0xcf, 0xa4, 0x35, 0x1c, 0xac, 0xa2,
+	0x35, 0x9a, 0x77, 0x5a, 0x9f, 0x10, 0x3e, 0xbf, 0x9d, 0x83, 0xda, 0xb3, 0xda, 0x64, 0xd1, 0x17,
+	0x3a, 0x12, 0xb3, 0x4a, 0x4b, 0x15, 0xd3, 0x4e, 0xe8, 0x17, 0xc2, 0x57, 0xcb, 0x57, 0x7e, 0x54,
+	0xd2, 0xf7, 0x5d, 0x91, 0xad, 0x2c, 0x01, 0x03, 0x9c, 0xac, 0xfb, 0xe2, 0xa7, 0x22, 0xac, 0xe8,
+	0xc6, 0x0c, 0x48, 0x23, 0xc3, 0xb1, 0xc2, 0xd2, 0x18, 0x92, 0xcd, 0xdc, 0x68, 0xc3, 0x52, 0x2e,
+	0xd2, 0x66, 0xff, 0xa0, 0xfa, 0x0f, 0xc7, 0xc4, 0xf8, 0x99, 0x87, 0x63, 0x0a, 0xc5, 0x89, 0x7e,
+	0x45, 0xf8, 0xe2, 0x2a, 0xe8, 0x58, 0x89, 0x1d, 0x38, 0x9e, 0xe0, 0xfb, 0xbe, 0xf8, 0x13, 0x51,
+	0x2b, 0xd8, 0xa8, 0x41, 0x70, 0x72, 0x3f, 0x10, 0xbe, 0xfc, 0x44, 0x68, 0xe3, 0xbe, 0x6d, 0x31,
+	0x65, 0x84, 0x11, 0x32, 0xd5, 0xe4, 0xa1, 0x6f, 0x83, 0x29, 0x00, 0x2b, 0xba, 0x56, 0x9b, 0xe3,
+	0x74, 0x7f, 0x23, 0x7c, 0xed, 0x65, 0xc6, 0x99, 0x81, 0xfe, 0x31, 0x06, 0xb5, 0x9c, 0x8b, 0x84,
+	0x6f, 0xf0, 0x4d, 0xc5, 0x41, 0x89, 0xb4, 0x49, 0x1e, 0xf9, 0xb6, 0x3a, 0x05, 0x62, 0xb5, 0x1f,
+	0xcf, 0x84, 0xe5, 0xd4, 0x7f, 0x22, 0x7c, 0x65, 0x0d, 0xcc, 0x64, 0x6f, 0xef, 0x5f, 0x34, 0x8d,
+	0x60, 0xa5, 0xd7, 0xeb, 0x83, 0xac, 0xf1, 0xb2, 0xea, 0x74, 0x69, 0xb0, 0xdf, 0xa5, 0xc1, 0x41,
+	0x97, 0xa2, 0x8f, 0x05, 0x45, 0xdf, 0x0b, 0x8a, 0xfe, 0x16, 0x14, 0x75, 0x0a, 0x8a, 0xfe, 0x15,
+	0x14, 0xfd, 0x2f, 0x68, 0x70, 0x50, 0x50, 0xf4, 0xb9, 0x47, 0x83, 0x4e, 0x8f, 0x06, 0xfb, 0x3d,
+	0x1a, 0xbc, 0x5e, 0x6c, 0xca, 0x63, 0x07, 0x21, 0x4f, 0xbf, 0xb6, 0xef, 0x8c, 0x2d, 0xed, 0x9c,
+	0x3b, 0xba, 0xb6, 0x6f, 0x1d, 0x0e, 0x00, 0x03, 0xa6, 0x39, 0xf1, 0x55, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -127,6 +130,11 @@ type MatchingServiceClient interface {
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a task queue.
+	// It must be called on the root partition of the workflow task queue.
+	UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the worker build id version sets of a task queue.
+	GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -218,6 +226,24 @@ func (c *matchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	out := new(UpdateWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error) {
+	out := new(GetWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 type MatchingServiceServer interface {
 	// PollWorkflowTaskQueue is called by frontend to process WorkflowTask from a specific task queue.  A
@@ -249,6 +275,11 @@ type MatchingServiceServer interface {
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a task queue.
+	// It must be called on the root partition of the workflow task queue.
+	UpdateWorkerBuildIdOrdering(context.Context, *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the worker build id version sets of a task queue.
+	GetWorkerBuildIdOrdering(context.Context, *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error)
 }
 
 // UnimplementedMatchingServiceServer can be embedded to have forward compatible implementations.
@@ -282,6 +313,12 @@ func (*UnimplementedMatchingServiceServer) DescribeTaskQueue(ctx context.Context
 func (*UnimplementedMatchingServiceServer) ListTaskQueuePartitions(ctx context.Context, req *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePartitions not implemented")
 }
+func (*UnimplementedMatchingServiceServer) UpdateWorkerBuildIdOrdering(ctx context.Context, req *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetWorkerBuildIdOrdering(ctx context.Context, req *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdOrdering not implemented")
+}
 
 func RegisterMatchingServiceServer(s *grpc.Server, srv MatchingServiceServer) {
 	s.RegisterService(&_MatchingService_serviceDesc, srv)
@@ -449,6 +486,42 @@ func _MatchingService_ListTaskQueuePartitions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdOrdering(ctx, req.(*UpdateWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdOrdering(ctx, req.(*GetWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MatchingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.matchingservice.v1.MatchingService",
 	HandlerType: (*MatchingServiceServer)(nil),
@@ -489,6 +562,14 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTaskQueuePartitions",
 			Handler:    _MatchingService_ListTaskQueuePartitions_Handler,
 		},
+		{
+			MethodName: "UpdateWorkerBuildIdOrdering",
+			Handler:    _MatchingService_UpdateWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdOrdering",
+			Handler:    _MatchingService_GetWorkerBuildIdOrdering_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
@@ -294,6 +294,12 @@ type WorkflowExecutionInfo struct {
 	CloseTransferTaskId int64 `protobuf:"varint,64,opt,name=close_transfer_task_id,json=closeTransferTaskId,proto3" json:"close_transfer_task_id,omitempty"`
 	// Used to check if visibility close task is processed before deleting the workflow execution.
 	CloseVisibilityTaskId int64 `protobuf:"varint,65,opt,name=close_visibility_task_id,json=closeVisibilityTaskId,proto3" json:"close_visibility_task_id,omitempty"`
+	// Priority of the tasks of the workflow, set by the start request or inherited from the previous run or the parent.
+	PriorityKey int32 `protobuf:"varint,66,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Fairness key of the tasks of the workflow, set by the start request or inherited from the previous run or the parent.
+	FairnessKey string `protobuf:"bytes,67,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Build id of the worker which completed the last workflow task of the workflow.
+	WorkerBuildId string `protobuf:"bytes,68,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -713,6 +719,27 @@ func (m *WorkflowExecutionInfo) GetCloseVisibilityTaskId() int64 {
 	return 0
 }
 
+func (m *WorkflowExecutionInfo) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -1385,6 +1412,10 @@ type ActivityInfo struct {
 	ScheduleId              int64         `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	LastHeartbeatDetails    *v11.Payloads `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime *time.Time    `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	// Priority of the activity task, inherited from the workflow.
+	PriorityKey int32 `protobuf:"varint,33,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Fairness key of the activity task, inherited from the workflow.
+	FairnessKey string `protobuf:"bytes,34,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
@@ -1629,6 +1660,20 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version    int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -2108,222 +2153,225 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x3b, 0x77, 0xdc, 0xc6,
-	0x77, 0x17, 0x44, 0x90, 0xc4, 0xde, 0x25, 0x97, 0x20, 0xf8, 0x02, 0x29, 0x69, 0x49, 0xed, 0x5f,
-	0xb2, 0x29, 0x5b, 0x5e, 0x8a, 0x94, 0x6c, 0xf9, 0x15, 0x3b, 0x24, 0xf5, 0xf0, 0x6e, 0x6c, 0x59,
-	0x06, 0x69, 0xcb, 0xc7, 0x39, 0x3e, 0x7b, 0x40, 0xec, 0x90, 0x44, 0x88, 0x05, 0x56, 0x00, 0x96,
-	0xd4, 0xfa, 0xa4, 0x70, 0x91, 0x93, 0x22, 0x4e, 0xe1, 0x22, 0x45, 0x9a, 0xf4, 0xf9, 0x00, 0x49,
-	0x91, 0x3a, 0x29, 0x52, 0xba, 0x74, 0xe7, 0x58, 0x4a, 0x91, 0x2e, 0xfe, 0x08, 0x39, 0x73, 0x67,
-	0x06, 0x18, 0x60, 0x21, 0x6a, 0xa9, 0x58, 0x85, 0xbb, 0xdd, 0xb9, 0x0f, 0xdc, 0xb9, 0x73, 0xe7,
-	0x3e, 0x7e, 0x00, 0xdc, 0x8c, 0x49, 0xa7, 0x1b, 0x84, 0xb6, 0xb7, 0x16, 0x91, 0xf0, 0x98, 0x84,
-	0x6b, 0x76, 0xd7, 0x5d, 0xeb, 0x92, 0x30, 0x72, 0xa3, 0x98, 0xf8, 0x0e, 0x59, 0x3b, 0x5e, 0x5f,
-	0x23, 0x4f, 0x88, 0xd3, 0x8b, 0xdd, 0xc0, 0x8f, 0xea, 0xdd, 0x30, 0x88, 0x03, 0xa3, 0x26, 0x84,
-	0xea, 0x4c, 0xa8, 0x6e, 0x77, 0xdd, 0xba, 0x24, 0x54, 0x3f, 0x5e, 0x5f, 0xaa, 0x1e, 0x04, 0xc1,
-	0x81, 0x47, 0xd6, 0x50, 0x62, 0xaf, 0xb7, 0xbf, 0xd6, 0xee, 0x85, 0x36, 0x55, 0xc2, 0x74, 0x2c,
-	0x2d, 0xe7, 0xe9, 0xb1, 0xdb, 0x21, 0x51, 0x6c, 0x77, 0xba, 0x9c, 0xe1, 0x72, 0x9b, 0x74, 0x89,
-	0xdf, 0x26, 0xbe, 0xe3, 0x92, 0x68, 0xed, 0x20, 0x38, 0x08, 0x70, 0x1d, 0x7f, 0x71, 0x96, 0x2b,
-	0x89, 0xf1, 0xd4, 0x6a, 0x27, 0xe8, 0x74, 0x02, 0x9f, 0x1a, 0xdc, 0x21, 0x51, 0x64, 0x1f, 0x90,
-	0x42, 0x2e, 0xe2, 0xf7, 0x3a, 0x11, 0x65, 0x3a, 0x09, 0xc2, 0xa3, 0x7d, 0x2f, 0x38, 0xe1, 0x5c,
-	0x57, 0x33, 0x5c, 0xfb, 0xb6, 0xeb, 0xf5, 0x42, 0x32, 0xa8, 0xec, 0xb5, 0x0c, 0x9b, 0xd0, 0x31,
-	0xc8, 0xf7, 0x46, 0x91, 0x5f, 0x1d, 0x2f, 0x70, 0x8e, 0x06, 0x79, 0xaf, 0x15, 0xf1, 0x26, 0x76,
-	0xb2, 0x6d, 0x71, 0xd6, 0x37, 0x4f, 0x65, 0xcd, 0x6d, 0xe9, 0xf5, 0x53, 0x99, 0x63, 0x3b, 0x3a,
-	0xe2, 0x8c, 0xd7, 0x8b, 0x18, 0x0f, 0xdd, 0x28, 0x0e, 0xc2, 0xfe, 0x80, 0xb9, 0xb5, 0x5f, 0x26,
-	0xa0, 0xb4, 0x73, 0x68, 0x87, 0xed, 0x86, 0xbf, 0x1f, 0x18, 0x8b, 0xa0, 0x45, 0xf4, 0x4f, 0xcb,
-	0x6d, 0x9b, 0xca, 0x8a, 0xb2, 0x3a, 0x6a, 0x8d, 0xe3, 0xff, 0x46, 0x9b, 0x92, 0x42, 0xdb, 0x3f,
-	0x20, 0x94, 0x74, 0x7e, 0x45, 0x59, 0x1d, 0xb1, 0xc6, 0xf1, 0x7f, 0xa3, 0x6d, 0xcc, 0xc2, 0x68,
-	0x70, 0xe2, 0x93, 0xd0, 0x1c, 0x59, 0x51, 0x56, 0x4b, 0x16, 0xfb, 0x63, 0xbc, 0x03, 0x73, 0x21,
-	0xe9, 0x7a, 0xae, 0x83, 0x81, 0xd2, 0xb2, 0x9d, 0xa3, 0x96, 0x47, 0x8e, 0x89, 0x67, 0xaa, 0x54,
-	0x7a, 0xeb, 0xbc, 0xa9, 0x58, 0x33, 0x12, 0xc3, 0xa6, 0x73, 0xf4, 0x29, 0x25, 0x1b, 0x37, 0xc0,
-	0x88, 0x43, 0xdb, 0x8f, 0xf6, 0x49, 0x28, 0x09, 0x8d, 0x26, 0x42, 0xba, 0xa0, 0x26, 0x12, 0xd7,
-	0xc1, 0x88, 0xe2, 0xc0, 0x23, 0x7e, 0x2b, 0x72, 0x7d, 0x87, 0xb4, 0x42, 0xe2, 0x93, 0x13, 0x73,
-	0x0c, 0xed, 0xd7, 0x19, 0x65, 0x87, 0x12, 0x2c, 0xba, 0x6e, 0x6c, 0x42, 0xb9, 0xd7, 0x6d, 0xdb,
-	0x31, 0x69, 0xd1, 0x20, 0x35, 0xc7, 0x57, 0x94, 0xd5, 0xf2, 0xc6, 0x52, 0x9d, 0x45, 0x70, 0x5d,
-	0x44, 0x70, 0x7d, 0x57, 0x44, 0xf0, 0x96, 0xfa, 0xe3, 0x2f, 0xcb, 0x8a, 0x05, 0x4c, 0x88, 0x2e,
-	0x1b, 0x3b, 0x30, 0x4b, 0x65, 0x25, 0xfb, 0x98, 0x2e, 0xed, 0x85, 0xba, 0xc6, 0xa8, 0x2e, 0x53,
-	0xb1, 0xa6, 0x51, 0x5e, 0xec, 0x00, 0x95, 0xde, 0x81, 0xaa, 0x6f, 0x77, 0x48, 0xd4, 0xb5, 0x1d,
-	0xd2, 0xf2, 0x83, 0xd8, 0xdd, 0x17, 0xae, 0x3b, 0xa6, 0x97, 0x31, 0xf0, 0xcd, 0x12, 0xba, 0xfd,
-	0x62, 0xc2, 0xf5, 0x40, 0x62, 0xfa, 0x8a, 0xf1, 0x18, 0x3f, 0x28, 0xb0, 0xe4, 0x78, 0xbd, 0x28,
-	0x26, 0x61, 0xab, 0xc0, 0x8d, 0xb0, 0x32, 0xb2, 0x5a, 0xde, 0x68, 0xd6, 0x5f, 0x7c, 0xe7, 0xeb,
-	0x49, 0x54, 0xd4, 0xb7, 0x99, 0xbe, 0xdd, 0x9c, 0xdf, 0xef, 0xfa, 0x71, 0xd8, 0xc7, 0x23, 0x59,
-	0x70, 0x8a, 0x39, 0x8c, 0xbf, 0x55, 0x60, 0x21, 0xb1, 0x26, 0xeb, 0x31, 0xb3, 0x8c, 0xa6, 0xdc,
-	0x7f, 0x39, 0x53, 0x64, 0xef, 0x31, 0x3b, 0x84, 0x67, 0x67, 0x9d, 0x02, 0x16, 0xe3, 0xef, 0x14,
-	0x58, 0x14, 0x86, 0xc8, 0x51, 0xc9, 0x4c, 0x99, 0xf8, 0x7f, 0x78, 0xc5, 0x4a, 0xb5, 0x3d, 0xc7,
-	0x2b, 0x79, 0x0e, 0xe3, 0x6f, 0x14, 0x58, 0x94, 0x8d, 0x68, 0x7b, 0x8f, 0x25, 0xbf, 0x4c, 0xa2,
-	0x31, 0x8d, 0xb3, 0x19, 0x23, 0x3d, 0xe3, 0x8e, 0xf7, 0x38, 0xe3, 0x19, 0x6b, 0x3e, 0x2c, 0x24,
-	0x1a, 0xb7, 0x60, 0xf6, 0xd8, 0x8d, 0xdc, 0x3d, 0xd7, 0x73, 0xe3, 0xbe, 0x64, 0x40, 0x25, 0xb9,
-	0x6a, 0x46, 0x4a, 0x4f, 0xa4, 0x8e, 0x40, 0x7f, 0xdc, 0x23, 0x3d, 0x92, 0x0a, 0x44, 0xa6, 0x8e,
-	0x26, 0x6f, 0x9e, 0xcd, 0xe4, 0x2f, 0xa8, 0x16, 0xa1, 0x36, 0x62, 0xa6, 0x56, 0x1e, 0x67, 0x16,
-	0x97, 0x9a, 0x70, 0xf1, 0xb4, 0xe0, 0x33, 0x74, 0x18, 0x39, 0x22, 0x7d, 0x4c, 0x55, 0x25, 0x8b,
-	0xfe, 0xa4, 0xb9, 0xe8, 0xd8, 0xf6, 0x7a, 0x84, 0xe7, 0x28, 0xf6, 0xe7, 0xfd, 0xf3, 0xef, 0x2a,
-	0x4b, 0x0e, 0x2c, 0x3e, 0x37, 0x7a, 0x0a, 0x14, 0xdd, 0x90, 0x15, 0x9d, 0x7a, 0xa9, 0xe5, 0x87,
-	0xa4, 0x06, 0x17, 0xc6, 0xc5, 0x99, 0x0c, 0x6e, 0xc0, 0x85, 0x53, 0x8e, 0xf5, 0x4c, 0xaa, 0x62,
-	0x98, 0x29, 0x70, 0xb7, 0xac, 0x62, 0x94, 0xa9, 0xb8, 0x9f, 0xdd, 0xf5, 0xfa, 0x30, 0x47, 0x9a,
-	0xd1, 0x2c, 0x3d, 0xb5, 0xa9, 0x6a, 0x53, 0xba, 0x5e, 0xfb, 0x87, 0x2a, 0xcc, 0x3d, 0xe2, 0xb5,
-	0xec, 0xae, 0x68, 0x3e, 0xb0, 0xda, 0x5c, 0x86, 0x89, 0x34, 0xe3, 0xf1, 0x8a, 0x53, 0xb2, 0xca,
-	0xc9, 0x5a, 0xa3, 0x6d, 0x2c, 0x43, 0x59, 0xd4, 0x41, 0x51, 0x78, 0x4a, 0x16, 0x88, 0xa5, 0x46,
-	0xdb, 0xa8, 0xc3, 0x4c, 0xd7, 0x0e, 0x89, 0x1f, 0xb7, 0x32, 0xaa, 0x58, 0x25, 0x9a, 0x66, 0xa4,
-	0x07, 0x92, 0xc2, 0xeb, 0x60, 0x70, 0x7e, 0x59, 0xaf, 0x8a, 0xec, 0x3a, 0xa3, 0x3c, 0x4a, 0xb5,
-	0xd7, 0x60, 0x92, 0x73, 0x87, 0x3d, 0x9f, 0x32, 0x8e, 0x32, 0x13, 0xd9, 0xa2, 0xd5, 0xf3, 0x33,
-	0x16, 0xb8, 0xbe, 0x1b, 0xbb, 0x76, 0x4c, 0xb0, 0x7c, 0x8e, 0xe1, 0x19, 0x70, 0x0b, 0x1a, 0x82,
-	0xd2, 0x68, 0x1b, 0xef, 0xc1, 0xa2, 0x13, 0x74, 0xba, 0x1e, 0xc1, 0xbb, 0x4f, 0x8e, 0xa9, 0xe4,
-	0x9e, 0x1d, 0x3b, 0x87, 0x54, 0x6a, 0x1c, 0xa5, 0xe6, 0x53, 0x86, 0xbb, 0x94, 0xbe, 0x45, 0xc9,
-	0x8d, 0xb6, 0x71, 0x09, 0x80, 0x16, 0xfa, 0x16, 0xde, 0x12, 0x2c, 0x07, 0x25, 0xab, 0x44, 0x57,
-	0xf0, 0x08, 0xe8, 0xde, 0x92, 0x4d, 0xc5, 0xfd, 0x2e, 0x41, 0x97, 0x98, 0xc0, 0xf6, 0x26, 0x28,
-	0xbb, 0xfd, 0x2e, 0xa1, 0x0e, 0x31, 0xbe, 0x85, 0xa5, 0x84, 0x3b, 0x69, 0x0a, 0x31, 0x4b, 0x07,
-	0xbd, 0xd8, 0x2c, 0xe3, 0xf9, 0x2f, 0x0e, 0x44, 0xfd, 0x1d, 0xde, 0xf8, 0x6d, 0xa9, 0xff, 0x48,
-	0xab, 0xa2, 0x79, 0x92, 0x3f, 0xd9, 0x5d, 0xa6, 0xc0, 0xf8, 0x02, 0x66, 0x13, 0xf5, 0xd4, 0x79,
-	0x42, 0xf1, 0xc4, 0x70, 0x8a, 0x93, 0x9d, 0x58, 0xbd, 0x44, 0xe5, 0x1e, 0x5c, 0x6a, 0x93, 0x7d,
-	0xbb, 0xe7, 0x49, 0x87, 0x87, 0xfe, 0x10, 0xba, 0x27, 0x87, 0xd3, 0xbd, 0xc4, 0xb5, 0x88, 0x83,
-	0xde, 0xb5, 0xa3, 0x23, 0xf1, 0x8c, 0x37, 0xc1, 0xf0, 0xec, 0x28, 0xe6, 0xe7, 0x82, 0xda, 0xdd,
-	0xb6, 0x39, 0x8d, 0xc7, 0x32, 0x45, 0x29, 0x78, 0x20, 0x54, 0xa2, 0xd1, 0x36, 0xde, 0x82, 0x19,
-	0x64, 0xde, 0x77, 0xc3, 0x44, 0xc4, 0x6d, 0x9b, 0x06, 0x72, 0xeb, 0x94, 0x74, 0x8f, 0x52, 0x50,
-	0xa4, 0xd1, 0x36, 0x3e, 0x84, 0x0b, 0xc8, 0x9e, 0x35, 0x3e, 0x8a, 0xed, 0x10, 0xc5, 0x66, 0x50,
-	0x6c, 0x81, 0xb2, 0xc8, 0x96, 0xed, 0x50, 0x7a, 0xa3, 0x6d, 0x7c, 0x0c, 0xc0, 0x58, 0xb1, 0xd5,
-	0x98, 0x1d, 0xb2, 0x6d, 0x29, 0xa1, 0x0c, 0x36, 0x18, 0x4d, 0x40, 0x93, 0x5a, 0x72, 0xf7, 0x33,
-	0x37, 0xa4, 0x9a, 0x0a, 0x95, 0xfc, 0x32, 0xed, 0x80, 0x36, 0x60, 0x2e, 0xbb, 0x0b, 0xd1, 0xa3,
-	0xcc, 0xe3, 0x26, 0x66, 0x4e, 0xa4, 0x0d, 0x88, 0xd6, 0xe4, 0x3d, 0x58, 0xcc, 0xed, 0xdc, 0x39,
-	0x24, 0xed, 0x9e, 0x87, 0x17, 0x76, 0x81, 0x05, 0xbe, 0x2c, 0xb7, 0xc3, 0xc9, 0x8d, 0xb6, 0x71,
-	0x1b, 0xcc, 0x02, 0xa7, 0xb1, 0x8b, 0x66, 0xa2, 0xe4, 0xdc, 0x49, 0xde, 0x65, 0x78, 0xd9, 0x76,
-	0xf2, 0x76, 0x8a, 0x50, 0x59, 0x1c, 0x2e, 0x54, 0x32, 0x1b, 0x11, 0x31, 0x32, 0xb0, 0x79, 0x3b,
-	0xa6, 0x69, 0x32, 0x36, 0x97, 0x30, 0x91, 0x66, 0x64, 0x36, 0x19, 0x29, 0x73, 0xdb, 0x32, 0x3b,
-	0xc0, 0x63, 0xb8, 0x30, 0xe4, 0x31, 0x2c, 0x14, 0xec, 0x12, 0xcf, 0xc3, 0x86, 0x8b, 0xc5, 0xbe,
-	0xe5, 0x0f, 0xb8, 0x38, 0xe4, 0x03, 0x16, 0x8b, 0x0e, 0x80, 0x3d, 0xe2, 0x1a, 0xe8, 0x8e, 0xed,
-	0x3b, 0xc4, 0x6b, 0x85, 0xe4, 0x71, 0x8f, 0x44, 0x31, 0x69, 0x9b, 0x97, 0x56, 0x94, 0x55, 0xcd,
-	0x9a, 0x62, 0xeb, 0x96, 0x58, 0x36, 0x42, 0xb8, 0x9a, 0xb5, 0x26, 0x08, 0xdd, 0x03, 0xd7, 0xb7,
-	0xbd, 0xbc, 0x59, 0xd5, 0x21, 0xcd, 0xba, 0x2c, 0x9b, 0xf5, 0x39, 0x57, 0x96, 0x35, 0x6f, 0x20,
-	0x44, 0xb8, 0x95, 0x34, 0x44, 0x96, 0x31, 0x05, 0x66, 0x42, 0x84, 0x1b, 0xdb, 0x68, 0x1b, 0x6f,
-	0xc0, 0x74, 0x76, 0x5f, 0x54, 0x62, 0x05, 0x25, 0xb2, 0x1b, 0x63, 0xbc, 0x51, 0xec, 0x3a, 0x47,
-	0xfd, 0x96, 0x94, 0x87, 0x2f, 0x33, 0x5e, 0x46, 0xd8, 0x4d, 0xb2, 0xf1, 0x01, 0xac, 0x70, 0xde,
-	0x24, 0xce, 0xe3, 0xa0, 0x95, 0x5e, 0x61, 0x1a, 0x85, 0xb5, 0xe1, 0xa2, 0xf0, 0x22, 0x53, 0x24,
-	0x36, 0xbc, 0x1b, 0xec, 0x88, 0x4b, 0x4d, 0xc3, 0xd1, 0x84, 0x71, 0x11, 0x80, 0x7f, 0x62, 0x33,
-	0x1b, 0xff, 0x6b, 0x7c, 0x09, 0xf3, 0x21, 0x89, 0xc3, 0x3e, 0xaf, 0x4c, 0x5e, 0xcb, 0xf5, 0x63,
-	0x12, 0x1e, 0xdb, 0x9e, 0x79, 0x65, 0xb8, 0x07, 0xcf, 0xa2, 0x38, 0xab, 0x5e, 0x5e, 0x83, 0x0b,
-	0xa7, 0x6a, 0x3b, 0xf6, 0x13, 0xb7, 0xd3, 0xeb, 0xa4, 0x6a, 0xaf, 0x9e, 0x45, 0xed, 0x67, 0x4c,
-	0x3a, 0x51, 0x7b, 0x2b, 0xaf, 0x96, 0x6f, 0x23, 0x32, 0x5f, 0xc3, 0x6d, 0x65, 0xa4, 0xf8, 0xbd,
-	0x8a, 0x8c, 0xf7, 0x69, 0x2f, 0x4d, 0xa5, 0xf6, 0x6c, 0xe7, 0x28, 0xd8, 0xdf, 0x6f, 0x39, 0x01,
-	0xd9, 0xdf, 0x77, 0x1d, 0x97, 0xf8, 0xb1, 0xf9, 0xfa, 0x8a, 0xb2, 0xaa, 0x58, 0x0b, 0xc8, 0xb0,
-	0xc5, 0xe8, 0xdb, 0x29, 0xd9, 0xe8, 0x40, 0xad, 0xa0, 0x04, 0x92, 0x27, 0x5d, 0x97, 0x99, 0xcb,
-	0x82, 0x74, 0x75, 0xc8, 0x20, 0x5d, 0x1e, 0xa8, 0x85, 0x77, 0x13, 0x4d, 0x7c, 0xc2, 0x5b, 0x66,
-	0xa6, 0xfa, 0x81, 0xdf, 0xc2, 0x5f, 0xf6, 0x9e, 0x47, 0x5a, 0x24, 0x0c, 0x83, 0x10, 0x0b, 0x76,
-	0x64, 0x5e, 0x5b, 0x19, 0x59, 0x2d, 0x59, 0x17, 0x90, 0xf8, 0x20, 0xf0, 0x2d, 0xc1, 0x74, 0x97,
-	0xf2, 0xd0, 0xd2, 0x1d, 0x19, 0xab, 0xa0, 0x1f, 0xda, 0x11, 0x93, 0x6f, 0x75, 0x03, 0xcf, 0x75,
-	0xfa, 0xe6, 0x1b, 0x78, 0x0f, 0x2b, 0x87, 0x76, 0x84, 0x12, 0x0f, 0x71, 0xd5, 0xf8, 0x13, 0x4c,
-	0x3a, 0x61, 0xe0, 0x27, 0xf1, 0x67, 0xbe, 0x89, 0x91, 0x3a, 0x41, 0x17, 0x45, 0x2c, 0xd1, 0x26,
-	0x2c, 0x72, 0x0f, 0xe8, 0xdd, 0x74, 0x82, 0x9e, 0x1f, 0x9b, 0x75, 0x4c, 0xa7, 0x65, 0xb6, 0xb6,
-	0x4d, 0x97, 0x8c, 0x2f, 0x60, 0xda, 0xee, 0xc5, 0x41, 0x2b, 0x24, 0x11, 0x89, 0x5b, 0xdd, 0xc0,
-	0xf5, 0xe3, 0xc8, 0xbc, 0x89, 0x5e, 0xb9, 0x9a, 0x36, 0x88, 0xb4, 0x33, 0x4c, 0x30, 0x8b, 0xe3,
-	0xf5, 0xba, 0x45, 0xb9, 0x1f, 0x22, 0xb3, 0x35, 0x45, 0xe5, 0xa5, 0x05, 0xe3, 0xaf, 0x61, 0x3a,
-	0x22, 0x76, 0xe8, 0x1c, 0xd2, 0x43, 0x0e, 0xdd, 0xbd, 0x5e, 0x4c, 0x22, 0xf3, 0x16, 0x8e, 0x11,
-	0x9f, 0x0f, 0xd3, 0x73, 0x16, 0x36, 0x94, 0xf5, 0x1d, 0x54, 0xb9, 0x99, 0x68, 0x64, 0x43, 0x85,
-	0x1e, 0xe5, 0x96, 0x8d, 0x47, 0xa0, 0x76, 0x48, 0x27, 0x30, 0xdf, 0xc6, 0x07, 0x6e, 0xbf, 0xfc,
-	0x03, 0x3f, 0x23, 0x9d, 0x80, 0x3d, 0x04, 0x15, 0x1a, 0xdf, 0xc2, 0x34, 0x2f, 0x84, 0x2d, 0x86,
-	0xb8, 0xb8, 0x24, 0x32, 0xdf, 0x41, 0x4f, 0xdd, 0x28, 0x7c, 0x0a, 0xc7, 0x65, 0xe8, 0x13, 0x78,
-	0x99, 0xfc, 0x44, 0xc8, 0x59, 0xfa, 0x71, 0x6e, 0xc5, 0xb8, 0x09, 0xf3, 0xbc, 0xd5, 0x48, 0x82,
-	0x95, 0xf7, 0xa5, 0xb7, 0xf1, 0x64, 0x67, 0x90, 0x9a, 0x98, 0xc8, 0xfa, 0xd3, 0xbf, 0x84, 0xa9,
-	0x94, 0x3d, 0x8a, 0xed, 0x38, 0x32, 0xdf, 0x45, 0x8b, 0x36, 0x86, 0xd9, 0x77, 0xa2, 0x6c, 0x87,
-	0x4a, 0x5a, 0x15, 0x92, 0xf9, 0x9f, 0xa9, 0x3b, 0xd4, 0x94, 0xfc, 0xdd, 0x79, 0xef, 0xac, 0x75,
-	0xc7, 0xea, 0xe5, 0x6f, 0xcd, 0x2d, 0x58, 0x18, 0x68, 0xb2, 0xe2, 0x27, 0xb8, 0xeb, 0xf7, 0x59,
-	0xb3, 0x91, 0x6d, 0xb4, 0x76, 0x9f, 0xd0, 0x5d, 0xdf, 0x82, 0x79, 0xba, 0x57, 0xc2, 0x40, 0x10,
-	0x17, 0x2d, 0x62, 0x01, 0xfe, 0x01, 0x0a, 0xcd, 0x22, 0x75, 0x37, 0x21, 0xb2, 0x48, 0xbf, 0x0f,
-	0x95, 0x6c, 0x2b, 0x6c, 0x7e, 0x38, 0xe4, 0x06, 0x26, 0x89, 0xdc, 0x00, 0x1b, 0x6b, 0x30, 0xeb,
-	0x93, 0x93, 0xc1, 0x73, 0xfa, 0x33, 0x36, 0x97, 0xf8, 0xe4, 0x24, 0x77, 0x4a, 0x7f, 0x01, 0x13,
-	0x7c, 0x8a, 0x40, 0x5c, 0xd1, 0xfc, 0x08, 0x9f, 0xbb, 0x5a, 0x78, 0x44, 0xc8, 0x91, 0x0c, 0xd3,
-	0xdb, 0xf4, 0x9f, 0x18, 0x49, 0xf0, 0x8f, 0xf1, 0x2e, 0x98, 0x03, 0x23, 0x89, 0x68, 0xd0, 0x3e,
-	0x66, 0x8d, 0x56, 0x6e, 0x2e, 0x11, 0x3d, 0xda, 0x4d, 0x98, 0x77, 0xbc, 0x20, 0x22, 0x29, 0x76,
-	0x24, 0x5a, 0xe0, 0x3f, 0x67, 0xbe, 0x46, 0xaa, 0x18, 0xc6, 0x79, 0x1b, 0x7c, 0x1b, 0x4c, 0x26,
-	0x24, 0xc1, 0x09, 0x42, 0x6c, 0x93, 0x75, 0x67, 0x48, 0xff, 0x2a, 0x21, 0x33, 0xc1, 0xa5, 0x36,
-	0xcc, 0x15, 0x5e, 0xd9, 0x82, 0xd9, 0xf6, 0xed, 0xec, 0x60, 0xba, 0x9c, 0xcd, 0x3b, 0x1c, 0x56,
-	0x3d, 0x5e, 0xaf, 0x3f, 0xb4, 0xfb, 0x5e, 0x60, 0xb7, 0xe5, 0xe1, 0xf7, 0x6b, 0x28, 0x25, 0xf7,
-	0xf4, 0x77, 0xd5, 0xdc, 0x54, 0x35, 0x4d, 0x2f, 0x35, 0x55, 0xad, 0xa2, 0x4f, 0xb1, 0x61, 0xb7,
-	0xa9, 0x6a, 0xba, 0x3e, 0xdd, 0x54, 0xb5, 0xeb, 0xfa, 0x5b, 0x4d, 0x55, 0x7b, 0x4b, 0xaf, 0x37,
-	0x55, 0x6d, 0x4d, 0xbf, 0xd1, 0x54, 0xb5, 0x1b, 0xfa, 0x7a, 0x53, 0xd5, 0xd6, 0xf5, 0x8d, 0xa6,
-	0xaa, 0x6d, 0xe8, 0x37, 0x6b, 0x37, 0xa1, 0x92, 0xbd, 0x5b, 0x34, 0x13, 0xf3, 0x74, 0xd0, 0x8a,
-	0xdc, 0xef, 0x08, 0xda, 0x38, 0x62, 0x95, 0xf9, 0xda, 0x8e, 0xfb, 0x1d, 0xa9, 0xfd, 0xaf, 0x02,
-	0xf3, 0x03, 0x99, 0x88, 0x4a, 0x13, 0x6c, 0x63, 0x42, 0x42, 0x23, 0x5e, 0x6a, 0x63, 0x14, 0xde,
-	0xc6, 0x20, 0x21, 0x6d, 0x63, 0xe6, 0x60, 0x8c, 0xc7, 0x23, 0x1b, 0xa8, 0x47, 0x43, 0x8c, 0xc1,
-	0x26, 0x8c, 0xe2, 0xad, 0xc0, 0xe9, 0xb9, 0xb2, 0x71, 0xab, 0x30, 0xf8, 0x10, 0x72, 0x2e, 0xcc,
-	0x88, 0x68, 0x87, 0xc5, 0x54, 0x18, 0xf7, 0x60, 0x8c, 0xfe, 0xe8, 0x45, 0x38, 0x5b, 0x57, 0x36,
-	0xea, 0x59, 0xb7, 0x9e, 0xae, 0xa5, 0x17, 0x59, 0x5c, 0xba, 0xf6, 0x4c, 0x05, 0x3d, 0x13, 0x6e,
-	0xbf, 0x17, 0x70, 0x90, 0xfa, 0x60, 0x44, 0xf6, 0xc1, 0x36, 0x94, 0xd8, 0x9c, 0xd0, 0xef, 0x12,
-	0x6e, 0xfa, 0x6b, 0xa7, 0xfb, 0x01, 0x27, 0x83, 0x7e, 0x97, 0x58, 0x5a, 0xcc, 0x7f, 0x19, 0x75,
-	0x98, 0x89, 0xed, 0xf0, 0x80, 0xe4, 0x40, 0x09, 0x06, 0x1e, 0x4c, 0x33, 0x52, 0x0e, 0x94, 0xe0,
-	0xfc, 0xb2, 0xcd, 0x63, 0x6c, 0x70, 0x67, 0x94, 0x2c, 0x28, 0xc1, 0xb9, 0xf9, 0x06, 0xc6, 0xd9,
-	0xf6, 0xd9, 0x22, 0x4b, 0x27, 0x59, 0xa4, 0x40, 0xcb, 0x23, 0x05, 0x1f, 0xc0, 0x12, 0x57, 0xe1,
-	0x1c, 0xba, 0x5e, 0x3b, 0x7d, 0x6c, 0xe0, 0x7b, 0x7d, 0x04, 0x16, 0x34, 0x6b, 0x81, 0x71, 0x6c,
-	0x53, 0x06, 0xf1, 0xf4, 0xcf, 0x7d, 0xaf, 0x4f, 0x5d, 0x2b, 0x4f, 0x6e, 0x80, 0x61, 0x0a, 0x51,
-	0x3a, 0xad, 0x99, 0x30, 0x2e, 0xb2, 0x4d, 0x99, 0xbd, 0x29, 0xe0, 0x7f, 0x8d, 0x05, 0x18, 0x17,
-	0x89, 0x61, 0x02, 0x29, 0x63, 0x31, 0x4b, 0x21, 0x0d, 0x98, 0x92, 0x93, 0x07, 0xcd, 0xbc, 0x93,
-	0xc3, 0x8e, 0xa6, 0xa9, 0x20, 0xa6, 0xde, 0xeb, 0x60, 0xb4, 0x89, 0x47, 0x62, 0xd2, 0xb2, 0xf7,
-	0x63, 0x12, 0xb6, 0x30, 0xf5, 0x98, 0x53, 0xb8, 0x27, 0x9d, 0x51, 0x36, 0x29, 0x61, 0x9b, 0xae,
-	0xb3, 0xcb, 0x5b, 0xfb, 0x7b, 0x15, 0x66, 0x24, 0xac, 0xed, 0x0f, 0x13, 0x68, 0x92, 0xa7, 0x47,
-	0xb3, 0x9e, 0xbe, 0x02, 0x95, 0x1c, 0x2a, 0xc1, 0x00, 0xa9, 0x89, 0x7d, 0x19, 0x91, 0xa8, 0xc1,
-	0xa4, 0x4f, 0x9e, 0x48, 0x4c, 0x0c, 0x7f, 0x2a, 0xd3, 0x45, 0xc1, 0x43, 0x1b, 0xc4, 0x64, 0x6a,
-	0x73, 0xdb, 0x18, 0x4c, 0xb4, 0x41, 0x14, 0x6b, 0x8c, 0x65, 0x2f, 0xb4, 0x7d, 0xe7, 0xb0, 0x15,
-	0x07, 0x47, 0x84, 0x9d, 0xfa, 0x84, 0x55, 0x66, 0x6b, 0xbb, 0x74, 0x49, 0x14, 0x44, 0xea, 0x89,
-	0x0c, 0xeb, 0x24, 0xb2, 0xd2, 0x82, 0x68, 0xf5, 0xfc, 0x2d, 0x49, 0x40, 0x0a, 0x95, 0xa9, 0x17,
-	0x85, 0x8a, 0xfe, 0x72, 0xa1, 0xd2, 0x54, 0xb5, 0x92, 0x0e, 0x4d, 0x55, 0x03, 0xbd, 0xdc, 0x54,
-	0xb5, 0x09, 0x7d, 0x92, 0x87, 0xc3, 0xbf, 0x8c, 0x80, 0x91, 0x2b, 0x56, 0x7f, 0xec, 0x68, 0x90,
-	0x9c, 0x39, 0xf6, 0x22, 0x67, 0x8e, 0xbf, 0xe4, 0xbd, 0xfb, 0x18, 0x80, 0xb7, 0x0e, 0xc3, 0xbd,
-	0x0a, 0xe3, 0xf8, 0x14, 0x6b, 0x28, 0xb8, 0x02, 0x09, 0xe0, 0x2a, 0x9d, 0x19, 0xe0, 0xaa, 0xfd,
-	0x87, 0x0a, 0x93, 0x88, 0xed, 0xff, 0x61, 0x4e, 0xec, 0x2e, 0x4c, 0x70, 0x28, 0x80, 0xe9, 0x19,
-	0x45, 0x3d, 0xb5, 0xe7, 0xd4, 0x4a, 0x3e, 0xf0, 0xa3, 0x8e, 0x72, 0x9c, 0xfe, 0x31, 0x88, 0x04,
-	0x48, 0x89, 0x31, 0x18, 0xf5, 0x8d, 0xa1, 0xbe, 0xf5, 0xe1, 0x0a, 0x39, 0x1f, 0x90, 0x51, 0x7d,
-	0x82, 0x61, 0x49, 0x8b, 0x72, 0x7c, 0x8d, 0x67, 0xe3, 0xeb, 0x1a, 0xe8, 0x49, 0x49, 0x10, 0x58,
-	0x84, 0x86, 0x43, 0xfb, 0x94, 0x58, 0x17, 0x40, 0xd8, 0x22, 0x68, 0x49, 0xb6, 0x61, 0x2f, 0x34,
-	0xc7, 0x09, 0xcf, 0x34, 0x52, 0x94, 0xc2, 0x8b, 0xa2, 0xb4, 0xfc, 0x92, 0x51, 0x9a, 0x4f, 0x55,
-	0x13, 0x03, 0xa9, 0xaa, 0xf6, 0x43, 0x05, 0x26, 0x36, 0x9d, 0xd8, 0x3d, 0x76, 0xe3, 0x3e, 0x46,
-	0x91, 0xb4, 0x6f, 0x25, 0xbb, 0xef, 0xdb, 0x60, 0xa6, 0xb9, 0x31, 0x07, 0xe5, 0xb3, 0x97, 0x30,
-	0x73, 0x09, 0x3d, 0x83, 0xe4, 0xdf, 0x87, 0x4a, 0x0e, 0x0a, 0x53, 0x87, 0x1d, 0x34, 0xa2, 0x0c,
-	0xec, 0x75, 0x89, 0x5f, 0x1a, 0x96, 0x9b, 0xd9, 0xb5, 0x2f, 0x45, 0x09, 0xfe, 0xb9, 0x0d, 0x13,
-	0x19, 0xa0, 0x71, 0xd8, 0xcb, 0x5d, 0x8e, 0x24, 0x70, 0x71, 0x19, 0xca, 0x36, 0xf7, 0x87, 0x28,
-	0x00, 0x25, 0x0b, 0xc4, 0x12, 0xeb, 0x36, 0xa4, 0xa6, 0x93, 0xbf, 0x97, 0x08, 0x93, 0x76, 0xf3,
-	0x1b, 0x58, 0x7c, 0x3e, 0x04, 0x06, 0xc3, 0x41, 0x46, 0xf3, 0x51, 0x31, 0xf8, 0x95, 0xd3, 0x9d,
-	0x66, 0xa0, 0x33, 0xbc, 0xc4, 0x90, 0x74, 0x6f, 0x8b, 0x6c, 0x44, 0x75, 0xef, 0xe2, 0x0c, 0x49,
-	0x6d, 0xcd, 0x2b, 0x1e, 0xf2, 0x25, 0xc6, 0x0c, 0xcb, 0x4d, 0x59, 0xad, 0x9f, 0xc2, 0xf4, 0x21,
-	0xb1, 0xc3, 0x78, 0x8f, 0xd8, 0xf1, 0x59, 0xdf, 0x5c, 0xe8, 0x89, 0xa4, 0xd0, 0x56, 0x84, 0xca,
-	0x56, 0x8a, 0x51, 0xd9, 0x42, 0xa0, 0x93, 0xd5, 0xd6, 0x22, 0xa0, 0x93, 0xbd, 0xaf, 0x17, 0x58,
-	0x35, 0xed, 0xe4, 0x75, 0x76, 0xa3, 0x63, 0x91, 0x62, 0x59, 0xab, 0x2e, 0xe3, 0x8f, 0xd3, 0x59,
-	0xfc, 0x31, 0xdb, 0x85, 0x1a, 0xf9, 0x2e, 0x94, 0x66, 0x8d, 0x24, 0x76, 0x89, 0x1f, 0xbb, 0x71,
-	0x1f, 0x5f, 0x82, 0x20, 0x98, 0xca, 0x23, 0x98, 0x2d, 0x17, 0x82, 0x5e, 0xb3, 0x85, 0xa0, 0xd7,
-	0xf3, 0x31, 0xcf, 0xb9, 0x57, 0x83, 0x79, 0xce, 0xbf, 0x1a, 0xcc, 0x73, 0xe1, 0x14, 0xcc, 0x73,
-	0x17, 0xe6, 0x98, 0x54, 0x1e, 0x6e, 0x31, 0x87, 0xbc, 0xde, 0x33, 0x28, 0x9e, 0x03, 0x5a, 0x4e,
-	0x45, 0x52, 0x17, 0x4f, 0x47, 0x52, 0x87, 0x80, 0x36, 0x97, 0x5e, 0x0c, 0x6d, 0x3e, 0x00, 0x83,
-	0x69, 0x61, 0x80, 0x0f, 0xfb, 0x6c, 0x8b, 0xbf, 0x1c, 0x59, 0xc9, 0x16, 0x45, 0x4e, 0xa4, 0xf5,
-	0xeb, 0x1e, 0xfb, 0x69, 0xe9, 0x28, 0xfb, 0xa9, 0x1d, 0xc5, 0x7c, 0x85, 0x8e, 0x39, 0x92, 0x3e,
-	0x5a, 0xd2, 0x48, 0x98, 0x86, 0xda, 0x45, 0x0c, 0xb5, 0x85, 0x44, 0xea, 0x11, 0xd2, 0x93, 0x90,
-	0xcb, 0xf7, 0x0e, 0x97, 0x0a, 0x7b, 0x07, 0x79, 0x12, 0xaa, 0x0e, 0x4c, 0x42, 0x5f, 0xc1, 0x3c,
-	0x3e, 0x3a, 0xbd, 0xf0, 0x6d, 0x12, 0xdb, 0xae, 0x17, 0xe1, 0x2b, 0x89, 0x81, 0x4d, 0x0d, 0x80,
-	0x0d, 0x91, 0x35, 0x4b, 0xe5, 0x3f, 0x11, 0xe2, 0x77, 0x98, 0xb4, 0xf1, 0x2d, 0x2c, 0xe5, 0xf4,
-	0xca, 0x2f, 0xf5, 0x56, 0x86, 0x7d, 0x9b, 0x94, 0xd1, 0x9d, 0xbe, 0xdd, 0x6b, 0xaa, 0xda, 0x88,
-	0xae, 0x36, 0x55, 0x6d, 0x4c, 0x1f, 0xaf, 0xfd, 0xbb, 0x02, 0x25, 0x6c, 0xaa, 0x5e, 0x50, 0x0a,
-	0xb3, 0x85, 0xe8, 0x7c, 0xbe, 0x10, 0x6d, 0x42, 0x19, 0x83, 0x95, 0x97, 0xef, 0x91, 0x61, 0xbf,
-	0xba, 0x62, 0x42, 0xa2, 0x0c, 0xc9, 0xd9, 0x48, 0x65, 0xde, 0x8e, 0xd3, 0x44, 0xb4, 0x08, 0x1a,
-	0x4b, 0x5a, 0xc9, 0xac, 0x3d, 0x8e, 0xff, 0x1b, 0xed, 0xda, 0x3f, 0xa9, 0x60, 0xe0, 0x24, 0x9b,
-	0xfd, 0x02, 0xe1, 0xd4, 0xca, 0x9e, 0x62, 0x67, 0xc5, 0x95, 0x3d, 0xa1, 0xe7, 0xdf, 0xd1, 0x4b,
-	0x7e, 0x18, 0xc9, 0xfb, 0xa1, 0x0e, 0x33, 0x82, 0x2c, 0xb7, 0x9d, 0x1c, 0x1a, 0xe0, 0x24, 0x69,
-	0xd8, 0xbf, 0x02, 0x15, 0xc1, 0xcf, 0xbb, 0x50, 0x06, 0x0b, 0x88, 0xb2, 0xce, 0xc6, 0xfd, 0x42,
-	0xf0, 0x47, 0x2b, 0x06, 0x7f, 0x2e, 0x42, 0x29, 0x89, 0x61, 0x51, 0xab, 0x93, 0x85, 0x33, 0x7e,
-	0x43, 0xf0, 0x75, 0xf2, 0xed, 0x03, 0xab, 0x8f, 0x3c, 0x33, 0x97, 0xb1, 0xed, 0x5c, 0x7d, 0x4e,
-	0x1b, 0xfb, 0x50, 0x20, 0x95, 0x11, 0x61, 0x39, 0x5b, 0x7c, 0x25, 0x21, 0x2d, 0xd1, 0xdb, 0x97,
-	0xf9, 0x9c, 0x82, 0xc1, 0x05, 0x65, 0x57, 0xfa, 0x90, 0xe2, 0x23, 0x18, 0x65, 0x58, 0xe9, 0xe4,
-	0x19, 0xb1, 0x52, 0x26, 0xd6, 0x54, 0x35, 0x55, 0x1f, 0x6d, 0xaa, 0xda, 0xb8, 0xae, 0xd5, 0xfe,
-	0x55, 0x81, 0x69, 0xee, 0xa2, 0x6d, 0x2c, 0x85, 0xaf, 0x2a, 0x3c, 0x0a, 0x8b, 0xf0, 0x48, 0xf1,
-	0xdb, 0xc6, 0xbc, 0x0f, 0xd4, 0x01, 0x1f, 0xd4, 0xfe, 0x4d, 0x01, 0xd8, 0xc1, 0x57, 0x35, 0xaf,
-	0x30, 0x9e, 0x07, 0x2c, 0x95, 0x7a, 0xbb, 0xbc, 0x8d, 0xe3, 0x03, 0x36, 0x26, 0x7e, 0x1e, 0xd5,
-	0xc7, 0x58, 0x4e, 0x61, 0xb8, 0x69, 0xed, 0x7b, 0x05, 0xb4, 0xed, 0x43, 0xe2, 0x1c, 0x45, 0xbd,
-	0x4e, 0xde, 0xf2, 0xd1, 0xd4, 0xf2, 0x3b, 0x30, 0xb6, 0xef, 0xd9, 0xc7, 0x41, 0x88, 0x76, 0x56,
-	0x36, 0xae, 0x9f, 0x3e, 0xcd, 0x08, 0x8d, 0xf7, 0x50, 0xc6, 0xe2, 0xb2, 0xe9, 0xb7, 0x51, 0x23,
-	0xd8, 0xf0, 0xb3, 0x3f, 0xb5, 0xff, 0x56, 0x60, 0x32, 0xf3, 0xf9, 0x92, 0x71, 0x01, 0x4a, 0xe9,
-	0x97, 0x70, 0xcc, 0x87, 0x9a, 0x2d, 0x88, 0x21, 0x4c, 0x8b, 0x8f, 0x08, 0x53, 0xa6, 0xf3, 0xf8,
-	0x12, 0xe9, 0xde, 0x99, 0xbf, 0x94, 0x12, 0x1f, 0x10, 0x66, 0x3f, 0xd6, 0x9b, 0x72, 0xb2, 0xab,
-	0x4b, 0x5b, 0x30, 0x5b, 0xc4, 0x78, 0x96, 0xcf, 0xbf, 0xb6, 0xfe, 0xea, 0xa7, 0x5f, 0xab, 0xe7,
-	0x7e, 0xfe, 0xb5, 0x7a, 0xee, 0xb7, 0x5f, 0xab, 0xca, 0xf7, 0x4f, 0xab, 0xca, 0x3f, 0x3f, 0xad,
-	0x2a, 0xff, 0xf9, 0xb4, 0xaa, 0xfc, 0xf4, 0xb4, 0xaa, 0xfc, 0xd7, 0xd3, 0xaa, 0xf2, 0x3f, 0x4f,
-	0xab, 0xe7, 0x7e, 0x7b, 0x5a, 0x55, 0x7e, 0x7c, 0x56, 0x3d, 0xf7, 0xd3, 0xb3, 0xea, 0xb9, 0x9f,
-	0x9f, 0x55, 0xcf, 0x7d, 0x73, 0xeb, 0x20, 0x48, 0x37, 0xe5, 0x06, 0xcf, 0xff, 0xa6, 0xfc, 0x03,
-	0xe9, 0xef, 0xde, 0x18, 0xe6, 0xf2, 0x9b, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x27, 0x90,
-	0xe1, 0x8c, 0x2e, 0x00, 0x00,
+	// 3485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x73, 0xdc, 0xc6,
+	0xf1, 0x17, 0x44, 0x90, 0xc4, 0xf6, 0x92, 0x4b, 0x10, 0x7c, 0x81, 0x94, 0xb4, 0xa4, 0xd6, 0x92,
+	0x4c, 0xd9, 0xf2, 0x52, 0xa4, 0x64, 0xcb, 0xaf, 0xbf, 0xfd, 0x27, 0xa9, 0x87, 0x77, 0x6d, 0xcb,
+	0x32, 0x48, 0x5b, 0x2e, 0xa7, 0x5c, 0x5b, 0x20, 0x76, 0x96, 0x44, 0x88, 0x05, 0x56, 0x00, 0x96,
+	0xd4, 0xba, 0x72, 0x70, 0xa5, 0x52, 0x39, 0x24, 0x39, 0xf8, 0x98, 0x4b, 0xee, 0xf9, 0x00, 0xc9,
+	0x21, 0xe7, 0xe4, 0x90, 0xa3, 0x73, 0xf3, 0xcd, 0xb1, 0x94, 0x43, 0x6e, 0xf1, 0x47, 0x48, 0x4d,
+	0xcf, 0x0c, 0x5e, 0x0b, 0x92, 0x4b, 0xc5, 0x3a, 0xf8, 0x06, 0x4c, 0x3f, 0xd0, 0xd3, 0xd3, 0xd3,
+	0xdd, 0xf3, 0xc3, 0xc0, 0x8d, 0x90, 0xb4, 0x3b, 0x9e, 0x6f, 0x3a, 0x2b, 0x01, 0xf1, 0x0f, 0x88,
+	0xbf, 0x62, 0x76, 0xec, 0x95, 0x0e, 0xf1, 0x03, 0x3b, 0x08, 0x89, 0x6b, 0x91, 0x95, 0x83, 0xd5,
+	0x15, 0xf2, 0x98, 0x58, 0xdd, 0xd0, 0xf6, 0xdc, 0xa0, 0xda, 0xf1, 0xbd, 0xd0, 0xd3, 0x2a, 0x42,
+	0xa8, 0xca, 0x84, 0xaa, 0x66, 0xc7, 0xae, 0x26, 0x84, 0xaa, 0x07, 0xab, 0x0b, 0xe5, 0x5d, 0xcf,
+	0xdb, 0x75, 0xc8, 0x0a, 0x4a, 0xec, 0x74, 0x5b, 0x2b, 0xcd, 0xae, 0x6f, 0x52, 0x25, 0x4c, 0xc7,
+	0xc2, 0x62, 0x96, 0x1e, 0xda, 0x6d, 0x12, 0x84, 0x66, 0xbb, 0xc3, 0x19, 0x2e, 0x36, 0x49, 0x87,
+	0xb8, 0x4d, 0xe2, 0x5a, 0x36, 0x09, 0x56, 0x76, 0xbd, 0x5d, 0x0f, 0xc7, 0xf1, 0x89, 0xb3, 0x5c,
+	0x8a, 0x8c, 0xa7, 0x56, 0x5b, 0x5e, 0xbb, 0xed, 0xb9, 0xd4, 0xe0, 0x36, 0x09, 0x02, 0x73, 0x97,
+	0xe4, 0x72, 0x11, 0xb7, 0xdb, 0x0e, 0x28, 0xd3, 0xa1, 0xe7, 0xef, 0xb7, 0x1c, 0xef, 0x90, 0x73,
+	0x5d, 0x4e, 0x71, 0xb5, 0x4c, 0xdb, 0xe9, 0xfa, 0xa4, 0x5f, 0xd9, 0x95, 0x14, 0x9b, 0xd0, 0xd1,
+	0xcf, 0xf7, 0x52, 0x9e, 0x5f, 0x2d, 0xc7, 0xb3, 0xf6, 0xfb, 0x79, 0xaf, 0xe6, 0xf1, 0x46, 0x76,
+	0xb2, 0x69, 0x71, 0xd6, 0x97, 0x8f, 0x65, 0xcd, 0x4c, 0xe9, 0xc5, 0x63, 0x99, 0x43, 0x33, 0xd8,
+	0xe7, 0x8c, 0xd7, 0xf2, 0x18, 0xf7, 0xec, 0x20, 0xf4, 0xfc, 0x5e, 0x9f, 0xb9, 0x95, 0xef, 0xc6,
+	0xa0, 0xb0, 0xb5, 0x67, 0xfa, 0xcd, 0x9a, 0xdb, 0xf2, 0xb4, 0x79, 0x50, 0x02, 0xfa, 0xd2, 0xb0,
+	0x9b, 0xba, 0xb4, 0x24, 0x2d, 0x0f, 0x1b, 0xa3, 0xf8, 0x5e, 0x6b, 0x52, 0x92, 0x6f, 0xba, 0xbb,
+	0x84, 0x92, 0xce, 0x2e, 0x49, 0xcb, 0x43, 0xc6, 0x28, 0xbe, 0xd7, 0x9a, 0xda, 0x34, 0x0c, 0x7b,
+	0x87, 0x2e, 0xf1, 0xf5, 0xa1, 0x25, 0x69, 0xb9, 0x60, 0xb0, 0x17, 0xed, 0x35, 0x98, 0xf1, 0x49,
+	0xc7, 0xb1, 0x2d, 0x0c, 0x94, 0x86, 0x69, 0xed, 0x37, 0x1c, 0x72, 0x40, 0x1c, 0x5d, 0xa6, 0xd2,
+	0x1b, 0x67, 0x75, 0xc9, 0x98, 0x4a, 0x30, 0xac, 0x5b, 0xfb, 0x1f, 0x50, 0xb2, 0x76, 0x1d, 0xb4,
+	0xd0, 0x37, 0xdd, 0xa0, 0x45, 0xfc, 0x84, 0xd0, 0x70, 0x24, 0xa4, 0x0a, 0x6a, 0x24, 0x71, 0x0d,
+	0xb4, 0x20, 0xf4, 0x1c, 0xe2, 0x36, 0x02, 0xdb, 0xb5, 0x48, 0xc3, 0x27, 0x2e, 0x39, 0xd4, 0x47,
+	0xd0, 0x7e, 0x95, 0x51, 0xb6, 0x28, 0xc1, 0xa0, 0xe3, 0xda, 0x3a, 0x14, 0xbb, 0x9d, 0xa6, 0x19,
+	0x92, 0x06, 0x0d, 0x52, 0x7d, 0x74, 0x49, 0x5a, 0x2e, 0xae, 0x2d, 0x54, 0x59, 0x04, 0x57, 0x45,
+	0x04, 0x57, 0xb7, 0x45, 0x04, 0x6f, 0xc8, 0x5f, 0x7f, 0xb7, 0x28, 0x19, 0xc0, 0x84, 0xe8, 0xb0,
+	0xb6, 0x05, 0xd3, 0x54, 0x36, 0x61, 0x1f, 0xd3, 0xa5, 0x9c, 0xa8, 0x6b, 0x84, 0xea, 0xd2, 0x25,
+	0x63, 0x12, 0xe5, 0xc5, 0x0c, 0x50, 0xe9, 0x6d, 0x28, 0xbb, 0x66, 0x9b, 0x04, 0x1d, 0xd3, 0x22,
+	0x0d, 0xd7, 0x0b, 0xed, 0x96, 0x70, 0xdd, 0x01, 0xdd, 0x8c, 0x9e, 0xab, 0x17, 0xd0, 0xed, 0xe7,
+	0x23, 0xae, 0xfb, 0x09, 0xa6, 0x4f, 0x19, 0x8f, 0xf6, 0x5b, 0x09, 0x16, 0x2c, 0xa7, 0x1b, 0x84,
+	0xc4, 0x6f, 0xe4, 0xb8, 0x11, 0x96, 0x86, 0x96, 0x8b, 0x6b, 0xf5, 0xea, 0xc9, 0x7b, 0xbe, 0x1a,
+	0x45, 0x45, 0x75, 0x93, 0xe9, 0xdb, 0xce, 0xf8, 0xfd, 0x8e, 0x1b, 0xfa, 0x3d, 0x5c, 0x92, 0x39,
+	0x2b, 0x9f, 0x43, 0xfb, 0xb5, 0x04, 0x73, 0x91, 0x35, 0x69, 0x8f, 0xe9, 0x45, 0x34, 0xe5, 0xde,
+	0xb3, 0x99, 0x62, 0xb7, 0xb3, 0x76, 0x08, 0xcf, 0x4e, 0x5b, 0x39, 0x2c, 0xda, 0x6f, 0x24, 0x98,
+	0x17, 0x86, 0x24, 0xa3, 0x92, 0x99, 0x32, 0xf6, 0x3f, 0x78, 0xc5, 0x88, 0xb5, 0x1d, 0xe1, 0x95,
+	0x2c, 0x87, 0xf6, 0x2b, 0x09, 0xe6, 0x93, 0x46, 0x34, 0x9d, 0x47, 0x09, 0xbf, 0x8c, 0xa3, 0x31,
+	0xb5, 0xd3, 0x19, 0x93, 0xf8, 0xc6, 0x6d, 0xe7, 0x51, 0xca, 0x33, 0xc6, 0xac, 0x9f, 0x4b, 0xd4,
+	0x6e, 0xc2, 0xf4, 0x81, 0x1d, 0xd8, 0x3b, 0xb6, 0x63, 0x87, 0xbd, 0x84, 0x01, 0xa5, 0x68, 0xab,
+	0x69, 0x31, 0x3d, 0x92, 0xda, 0x07, 0xf5, 0x51, 0x97, 0x74, 0x49, 0x2c, 0x10, 0xe8, 0x2a, 0x9a,
+	0xbc, 0x7e, 0x3a, 0x93, 0x3f, 0xa6, 0x5a, 0x84, 0xda, 0x80, 0x99, 0x5a, 0x7a, 0x94, 0x1a, 0x5c,
+	0xa8, 0xc3, 0xf9, 0xe3, 0x82, 0x4f, 0x53, 0x61, 0x68, 0x9f, 0xf4, 0x30, 0x55, 0x15, 0x0c, 0xfa,
+	0x48, 0x73, 0xd1, 0x81, 0xe9, 0x74, 0x09, 0xcf, 0x51, 0xec, 0xe5, 0xcd, 0xb3, 0xaf, 0x4b, 0x0b,
+	0x16, 0xcc, 0x1f, 0x19, 0x3d, 0x39, 0x8a, 0xae, 0x27, 0x15, 0x1d, 0xbb, 0xa9, 0x93, 0x1f, 0x89,
+	0x0d, 0xce, 0x8d, 0x8b, 0x53, 0x19, 0x5c, 0x83, 0x73, 0xc7, 0x2c, 0xeb, 0xa9, 0x54, 0x85, 0x30,
+	0x95, 0xe3, 0xee, 0xa4, 0x8a, 0x61, 0xa6, 0xe2, 0x5e, 0x7a, 0xd6, 0xab, 0x83, 0x2c, 0x69, 0x4a,
+	0x73, 0xe2, 0xab, 0x75, 0x59, 0x99, 0x50, 0xd5, 0xca, 0x2f, 0x17, 0x61, 0xe6, 0x21, 0xaf, 0x65,
+	0x77, 0x44, 0xf3, 0x81, 0xd5, 0xe6, 0x22, 0x8c, 0xc5, 0x19, 0x8f, 0x57, 0x9c, 0x82, 0x51, 0x8c,
+	0xc6, 0x6a, 0x4d, 0x6d, 0x11, 0x8a, 0xa2, 0x0e, 0x8a, 0xc2, 0x53, 0x30, 0x40, 0x0c, 0xd5, 0x9a,
+	0x5a, 0x15, 0xa6, 0x3a, 0xa6, 0x4f, 0xdc, 0xb0, 0x91, 0x52, 0xc5, 0x2a, 0xd1, 0x24, 0x23, 0xdd,
+	0x4f, 0x28, 0xbc, 0x06, 0x1a, 0xe7, 0x4f, 0xea, 0x95, 0x91, 0x5d, 0x65, 0x94, 0x87, 0xb1, 0xf6,
+	0x0a, 0x8c, 0x73, 0x6e, 0xbf, 0xeb, 0x52, 0xc6, 0x61, 0x66, 0x22, 0x1b, 0x34, 0xba, 0x6e, 0xca,
+	0x02, 0xdb, 0xb5, 0x43, 0xdb, 0x0c, 0x09, 0x96, 0xcf, 0x11, 0x5c, 0x03, 0x6e, 0x41, 0x4d, 0x50,
+	0x6a, 0x4d, 0xed, 0x0d, 0x98, 0xb7, 0xbc, 0x76, 0xc7, 0x21, 0xb8, 0xf7, 0xc9, 0x01, 0x95, 0xdc,
+	0x31, 0x43, 0x6b, 0x8f, 0x4a, 0x8d, 0xa2, 0xd4, 0x6c, 0xcc, 0x70, 0x87, 0xd2, 0x37, 0x28, 0xb9,
+	0xd6, 0xd4, 0x2e, 0x00, 0xd0, 0x42, 0xdf, 0xc0, 0x5d, 0x82, 0xe5, 0xa0, 0x60, 0x14, 0xe8, 0x08,
+	0x2e, 0x01, 0x9d, 0x5b, 0x34, 0xa9, 0xb0, 0xd7, 0x21, 0xe8, 0x12, 0x1d, 0xd8, 0xdc, 0x04, 0x65,
+	0xbb, 0xd7, 0x21, 0xd4, 0x21, 0xda, 0x17, 0xb0, 0x10, 0x71, 0x47, 0x4d, 0x21, 0x66, 0x69, 0xaf,
+	0x1b, 0xea, 0x45, 0x5c, 0xff, 0xf9, 0xbe, 0xa8, 0xbf, 0xcd, 0x1b, 0xbf, 0x0d, 0xf9, 0xf7, 0xb4,
+	0x2a, 0xea, 0x87, 0xd9, 0x95, 0xdd, 0x66, 0x0a, 0xb4, 0x8f, 0x61, 0x3a, 0x52, 0xef, 0x77, 0x63,
+	0xc5, 0x63, 0x83, 0x29, 0x8e, 0x66, 0x62, 0x74, 0x23, 0x95, 0x3b, 0x70, 0xa1, 0x49, 0x5a, 0x66,
+	0xd7, 0x49, 0x2c, 0x1e, 0xfa, 0x43, 0xe8, 0x1e, 0x1f, 0x4c, 0xf7, 0x02, 0xd7, 0x22, 0x16, 0x7a,
+	0xdb, 0x0c, 0xf6, 0xc5, 0x37, 0x5e, 0x06, 0xcd, 0x31, 0x83, 0x90, 0xaf, 0x0b, 0x6a, 0xb7, 0x9b,
+	0xfa, 0x24, 0x2e, 0xcb, 0x04, 0xa5, 0xe0, 0x82, 0x50, 0x89, 0x5a, 0x53, 0x7b, 0x05, 0xa6, 0x90,
+	0xb9, 0x65, 0xfb, 0x91, 0x88, 0xdd, 0xd4, 0x35, 0xe4, 0x56, 0x29, 0xe9, 0xae, 0xed, 0x73, 0x91,
+	0x5a, 0x53, 0x7b, 0x1b, 0xce, 0x21, 0x7b, 0xda, 0xf8, 0x20, 0x34, 0x7d, 0x14, 0x9b, 0x42, 0xb1,
+	0x39, 0xca, 0x92, 0xb4, 0x6c, 0x8b, 0xd2, 0x6b, 0x4d, 0xed, 0x5d, 0x00, 0xc6, 0x8a, 0xad, 0xc6,
+	0xf4, 0x80, 0x6d, 0x4b, 0x01, 0x65, 0xe8, 0xa8, 0x56, 0x07, 0x34, 0xa9, 0x91, 0xec, 0x7e, 0x66,
+	0x06, 0x54, 0x53, 0xa2, 0x92, 0x9f, 0xc4, 0x1d, 0xd0, 0x1a, 0xcc, 0xa4, 0x67, 0x21, 0x7a, 0x94,
+	0x59, 0x9c, 0xc4, 0xd4, 0x61, 0x62, 0x02, 0xa2, 0x35, 0x79, 0x03, 0xe6, 0x33, 0x33, 0xb7, 0xf6,
+	0x48, 0xb3, 0xeb, 0xe0, 0x86, 0x9d, 0x63, 0x81, 0x9f, 0x94, 0xdb, 0xe2, 0xe4, 0x5a, 0x53, 0xbb,
+	0x05, 0x7a, 0x8e, 0xd3, 0xd8, 0x46, 0xd3, 0x51, 0x72, 0xe6, 0x30, 0xeb, 0x32, 0xdc, 0x6c, 0x5b,
+	0x59, 0x3b, 0x45, 0xa8, 0xcc, 0x0f, 0x16, 0x2a, 0xa9, 0x89, 0x88, 0x18, 0xe9, 0x9b, 0xbc, 0x19,
+	0xd2, 0x34, 0x19, 0xea, 0x0b, 0x98, 0x48, 0x53, 0x32, 0xeb, 0x8c, 0x94, 0xda, 0x6d, 0xa9, 0x19,
+	0xe0, 0x32, 0x9c, 0x1b, 0x70, 0x19, 0xe6, 0x72, 0x66, 0x89, 0xeb, 0x61, 0xc2, 0xf9, 0x7c, 0xdf,
+	0xf2, 0x0f, 0x9c, 0x1f, 0xf0, 0x03, 0xf3, 0x79, 0x0b, 0xc0, 0x3e, 0x71, 0x15, 0x54, 0xcb, 0x74,
+	0x2d, 0xe2, 0x34, 0x7c, 0xf2, 0xa8, 0x4b, 0x82, 0x90, 0x34, 0xf5, 0x0b, 0x4b, 0xd2, 0xb2, 0x62,
+	0x4c, 0xb0, 0x71, 0x43, 0x0c, 0x6b, 0x3e, 0x5c, 0x4e, 0x5b, 0xe3, 0xf9, 0xf6, 0xae, 0xed, 0x9a,
+	0x4e, 0xd6, 0xac, 0xf2, 0x80, 0x66, 0x5d, 0x4c, 0x9a, 0xf5, 0x11, 0x57, 0x96, 0x36, 0xaf, 0x2f,
+	0x44, 0xb8, 0x95, 0x34, 0x44, 0x16, 0x31, 0x05, 0xa6, 0x42, 0x84, 0x1b, 0x5b, 0x6b, 0x6a, 0x2f,
+	0xc1, 0x64, 0x7a, 0x5e, 0x54, 0x62, 0x09, 0x25, 0xd2, 0x13, 0x63, 0xbc, 0x41, 0x68, 0x5b, 0xfb,
+	0xbd, 0x46, 0x22, 0x0f, 0x5f, 0x64, 0xbc, 0x8c, 0xb0, 0x1d, 0x65, 0xe3, 0x5d, 0x58, 0xe2, 0xbc,
+	0x51, 0x9c, 0x87, 0x5e, 0x23, 0xde, 0xc2, 0x34, 0x0a, 0x2b, 0x83, 0x45, 0xe1, 0x79, 0xa6, 0x48,
+	0x4c, 0x78, 0xdb, 0xdb, 0x12, 0x9b, 0x9a, 0x86, 0xa3, 0x0e, 0xa3, 0x22, 0x00, 0x5f, 0x60, 0x67,
+	0x36, 0xfe, 0xaa, 0x7d, 0x02, 0xb3, 0x3e, 0x09, 0xfd, 0x1e, 0xaf, 0x4c, 0x4e, 0xc3, 0x76, 0x43,
+	0xe2, 0x1f, 0x98, 0x8e, 0x7e, 0x69, 0xb0, 0x0f, 0x4f, 0xa3, 0x38, 0xab, 0x5e, 0x4e, 0x8d, 0x0b,
+	0xc7, 0x6a, 0xdb, 0xe6, 0x63, 0xbb, 0xdd, 0x6d, 0xc7, 0x6a, 0x2f, 0x9f, 0x46, 0xed, 0x87, 0x4c,
+	0x3a, 0x52, 0x7b, 0x33, 0xab, 0x96, 0x4f, 0x23, 0xd0, 0xaf, 0xe0, 0xb4, 0x52, 0x52, 0x7c, 0x5f,
+	0x05, 0xda, 0x9b, 0x30, 0xcf, 0xa4, 0x76, 0x4c, 0x6b, 0xdf, 0x6b, 0xb5, 0x1a, 0x96, 0x47, 0x5a,
+	0x2d, 0xdb, 0xb2, 0x89, 0x1b, 0xea, 0x2f, 0x2e, 0x49, 0xcb, 0x92, 0x31, 0x87, 0x0c, 0x1b, 0x8c,
+	0xbe, 0x19, 0x93, 0xb5, 0x36, 0x54, 0x72, 0x4a, 0x20, 0x79, 0xdc, 0xb1, 0x99, 0xb9, 0x2c, 0x48,
+	0x97, 0x07, 0x0c, 0xd2, 0xc5, 0xbe, 0x5a, 0x78, 0x27, 0xd2, 0xc4, 0x4f, 0x78, 0x8b, 0xcc, 0x54,
+	0xd7, 0x73, 0x1b, 0xf8, 0x64, 0xee, 0x38, 0xa4, 0x41, 0x7c, 0xdf, 0xf3, 0xb1, 0x60, 0x07, 0xfa,
+	0xd5, 0xa5, 0xa1, 0xe5, 0x82, 0x71, 0x0e, 0x89, 0xf7, 0x3d, 0xd7, 0x10, 0x4c, 0x77, 0x28, 0x0f,
+	0x2d, 0xdd, 0x81, 0xb6, 0x0c, 0xea, 0x9e, 0x19, 0x30, 0xf9, 0x46, 0xc7, 0x73, 0x6c, 0xab, 0xa7,
+	0xbf, 0x84, 0xfb, 0xb0, 0xb4, 0x67, 0x06, 0x28, 0xf1, 0x00, 0x47, 0xb5, 0x17, 0x60, 0xdc, 0xf2,
+	0x3d, 0x37, 0x8a, 0x3f, 0xfd, 0x65, 0x8c, 0xd4, 0x31, 0x3a, 0x28, 0x62, 0x89, 0x36, 0x61, 0x81,
+	0xbd, 0x4b, 0xf7, 0xa6, 0xe5, 0x75, 0xdd, 0x50, 0xaf, 0x62, 0x3a, 0x2d, 0xb2, 0xb1, 0x4d, 0x3a,
+	0xa4, 0x7d, 0x0c, 0x93, 0x66, 0x37, 0xf4, 0x1a, 0x3e, 0x09, 0x48, 0xd8, 0xe8, 0x78, 0xb6, 0x1b,
+	0x06, 0xfa, 0x0d, 0xf4, 0xca, 0xe5, 0xb8, 0x41, 0xa4, 0x9d, 0x61, 0x84, 0x59, 0x1c, 0xac, 0x56,
+	0x0d, 0xca, 0xfd, 0x00, 0x99, 0x8d, 0x09, 0x2a, 0x9f, 0x18, 0xd0, 0x7e, 0x01, 0x93, 0x01, 0x31,
+	0x7d, 0x6b, 0x8f, 0x2e, 0xb2, 0x6f, 0xef, 0x74, 0x43, 0x12, 0xe8, 0x37, 0xf1, 0x18, 0xf1, 0xd1,
+	0x20, 0x3d, 0x67, 0x6e, 0x43, 0x59, 0xdd, 0x42, 0x95, 0xeb, 0x91, 0x46, 0x76, 0xa8, 0x50, 0x83,
+	0xcc, 0xb0, 0xf6, 0x10, 0xe4, 0x36, 0x69, 0x7b, 0xfa, 0xab, 0xf8, 0xc1, 0xcd, 0x67, 0xff, 0xe0,
+	0x87, 0xa4, 0xed, 0xb1, 0x8f, 0xa0, 0x42, 0xed, 0x0b, 0x98, 0xe4, 0x85, 0xb0, 0xc1, 0x10, 0x17,
+	0x9b, 0x04, 0xfa, 0x6b, 0xe8, 0xa9, 0xeb, 0xb9, 0x5f, 0x61, 0x5c, 0x3d, 0xfa, 0x05, 0x5e, 0x26,
+	0xdf, 0x13, 0x72, 0x86, 0x7a, 0x90, 0x19, 0xd1, 0x6e, 0xc0, 0x2c, 0x6f, 0x35, 0xa2, 0x60, 0xe5,
+	0x7d, 0xe9, 0x2d, 0x5c, 0xd9, 0x29, 0xa4, 0x46, 0x26, 0xb2, 0xfe, 0xf4, 0x67, 0x30, 0x11, 0xb3,
+	0x07, 0xa1, 0x19, 0x06, 0xfa, 0xeb, 0x68, 0xd1, 0xda, 0x20, 0xf3, 0x8e, 0x94, 0x6d, 0x51, 0x49,
+	0xa3, 0x44, 0x52, 0xef, 0xa9, 0xba, 0xe3, 0x77, 0xfb, 0xf7, 0xce, 0x1b, 0xa7, 0xad, 0x3b, 0x46,
+	0x37, 0xbb, 0x6b, 0x6e, 0xc2, 0x5c, 0x5f, 0x93, 0x15, 0x3e, 0xc6, 0x59, 0xbf, 0xc9, 0x9a, 0x8d,
+	0x74, 0xa3, 0xb5, 0xfd, 0x98, 0xce, 0xfa, 0x26, 0xcc, 0xd2, 0xb9, 0x12, 0x06, 0x82, 0xd8, 0x68,
+	0x11, 0x0b, 0xf0, 0xb7, 0x50, 0x68, 0x1a, 0xa9, 0xdb, 0x11, 0x91, 0x45, 0xfa, 0x3d, 0x28, 0xa5,
+	0x5b, 0x61, 0xfd, 0xed, 0x01, 0x27, 0x30, 0x4e, 0x92, 0x0d, 0xb0, 0xb6, 0x02, 0xd3, 0x2e, 0x39,
+	0xec, 0x5f, 0xa7, 0xff, 0x63, 0xe7, 0x12, 0x97, 0x1c, 0x66, 0x56, 0xe9, 0x7d, 0x18, 0xe3, 0xa7,
+	0x08, 0xc4, 0x15, 0xf5, 0x77, 0xf0, 0xbb, 0xcb, 0xb9, 0x4b, 0x84, 0x1c, 0xd1, 0x61, 0x7a, 0x93,
+	0xbe, 0x89, 0x23, 0x09, 0xbe, 0x68, 0xaf, 0x83, 0xde, 0x77, 0x24, 0x11, 0x0d, 0xda, 0xbb, 0xac,
+	0xd1, 0xca, 0x9c, 0x4b, 0x44, 0x8f, 0x76, 0x03, 0x66, 0x2d, 0xc7, 0x0b, 0x48, 0x8c, 0x1d, 0x89,
+	0x16, 0xf8, 0xff, 0x99, 0xaf, 0x91, 0x2a, 0x0e, 0xe3, 0xbc, 0x0d, 0xbe, 0x05, 0x3a, 0x13, 0x4a,
+	0xc0, 0x09, 0x42, 0x6c, 0x9d, 0x75, 0x67, 0x48, 0xff, 0x34, 0x22, 0x73, 0xc1, 0x8b, 0x30, 0xd6,
+	0xf1, 0x6d, 0xcf, 0xa7, 0x02, 0xf4, 0x20, 0xba, 0x81, 0x79, 0xbe, 0x28, 0xc6, 0xde, 0x27, 0x3d,
+	0xca, 0xd2, 0x32, 0x6d, 0xdf, 0x25, 0x41, 0x80, 0x2c, 0x9b, 0xec, 0x00, 0x26, 0xc6, 0x28, 0xcb,
+	0x15, 0x98, 0xa0, 0xd1, 0x43, 0xfc, 0xc6, 0x4e, 0xd7, 0x76, 0xb0, 0x27, 0xbc, 0x8d, 0x5c, 0xe3,
+	0x6c, 0x78, 0x83, 0x8e, 0xd6, 0x9a, 0x0b, 0x4d, 0x98, 0xc9, 0x4d, 0x10, 0x39, 0x27, 0xe9, 0x57,
+	0xd3, 0xc7, 0xe0, 0xc5, 0x74, 0x96, 0xe3, 0x20, 0xee, 0xc1, 0x6a, 0xf5, 0x81, 0xd9, 0x73, 0x3c,
+	0xb3, 0x99, 0x3c, 0x6a, 0x7f, 0x06, 0x85, 0x28, 0x2b, 0xfc, 0xa8, 0x9a, 0xeb, 0xb2, 0xa2, 0xa8,
+	0x85, 0xba, 0xac, 0x94, 0xd4, 0x09, 0x76, 0xb4, 0xae, 0xcb, 0x8a, 0xaa, 0x4e, 0xd6, 0x65, 0xe5,
+	0x9a, 0xfa, 0x4a, 0x5d, 0x56, 0x5e, 0x51, 0xab, 0x75, 0x59, 0x59, 0x51, 0xaf, 0xd7, 0x65, 0xe5,
+	0xba, 0xba, 0x5a, 0x97, 0x95, 0x55, 0x75, 0xad, 0x2e, 0x2b, 0x6b, 0xea, 0x8d, 0xca, 0x0d, 0x28,
+	0xa5, 0x77, 0x32, 0x75, 0x2c, 0x4f, 0x3e, 0x8d, 0xc0, 0xfe, 0x92, 0xa0, 0x8d, 0x43, 0x46, 0x91,
+	0x8f, 0x6d, 0xd9, 0x5f, 0x92, 0xca, 0x7f, 0x24, 0x98, 0xed, 0xcb, 0x7b, 0x54, 0x9a, 0x60, 0xd3,
+	0xe4, 0x13, 0xba, 0xbf, 0x12, 0x4d, 0x93, 0xc4, 0x9b, 0x26, 0x24, 0xc4, 0x4d, 0xd3, 0x0c, 0x8c,
+	0xf0, 0xe8, 0x67, 0xc7, 0xf7, 0x61, 0x1f, 0x23, 0xbe, 0x0e, 0xc3, 0xb8, 0x07, 0xf1, 0xac, 0x5e,
+	0x5a, 0xbb, 0x99, 0x1b, 0xea, 0x08, 0x70, 0xe7, 0xe6, 0x5f, 0xb4, 0xc3, 0x60, 0x2a, 0xb4, 0xbb,
+	0x30, 0x42, 0x1f, 0xba, 0x01, 0x9e, 0xe4, 0x4b, 0x6b, 0xd5, 0xb4, 0x5b, 0x8f, 0xd7, 0xd2, 0x0d,
+	0x0c, 0x2e, 0x5d, 0x79, 0x2a, 0x83, 0x9a, 0x0a, 0xee, 0x1f, 0x0b, 0xa6, 0x88, 0x7d, 0x30, 0x94,
+	0xf4, 0xc1, 0x26, 0x14, 0xd8, 0xa9, 0xa4, 0xd7, 0x21, 0xdc, 0xf4, 0x2b, 0xc7, 0xfb, 0x01, 0xcf,
+	0x21, 0xbd, 0x0e, 0x31, 0x94, 0x90, 0x3f, 0x51, 0x00, 0x22, 0x34, 0xfd, 0x5d, 0x92, 0x81, 0x40,
+	0x18, 0x54, 0x31, 0xc9, 0x48, 0x19, 0x08, 0x84, 0xf3, 0x27, 0x6d, 0x1e, 0x61, 0x30, 0x01, 0xa3,
+	0xa4, 0x21, 0x10, 0xce, 0xcd, 0x27, 0x30, 0xca, 0xa6, 0xcf, 0x06, 0x59, 0xf2, 0x4a, 0xe3, 0x12,
+	0x4a, 0x16, 0x97, 0x78, 0x0b, 0x16, 0xb8, 0x0a, 0x6b, 0x8f, 0x6e, 0xd0, 0xe8, 0xb3, 0x9e, 0xeb,
+	0xf4, 0x10, 0xc6, 0x50, 0x8c, 0x39, 0xc6, 0xb1, 0x49, 0x19, 0xc4, 0xd7, 0x3f, 0x72, 0x9d, 0x1e,
+	0x75, 0x6d, 0xf2, 0x9c, 0x08, 0x18, 0xa6, 0x10, 0xc4, 0x67, 0x43, 0x1d, 0x46, 0x45, 0x6e, 0x2b,
+	0x22, 0x51, 0xbc, 0x6a, 0x73, 0x30, 0x2a, 0xd2, 0xd0, 0x18, 0x52, 0x46, 0x42, 0x96, 0x77, 0x6a,
+	0x30, 0x91, 0x4c, 0x55, 0x34, 0xcf, 0x8f, 0x0f, 0x7a, 0x10, 0x8e, 0x05, 0x29, 0x89, 0x3a, 0xb3,
+	0x49, 0x1c, 0x12, 0x92, 0x86, 0xd9, 0x0a, 0x89, 0xdf, 0xc0, 0x44, 0xa7, 0x4f, 0xe0, 0x9c, 0x54,
+	0x46, 0x59, 0xa7, 0x84, 0x4d, 0x3a, 0xce, 0x36, 0x6f, 0xe5, 0x77, 0x32, 0x4c, 0x25, 0x90, 0xbd,
+	0x9f, 0x4c, 0xa0, 0x25, 0x3c, 0x3d, 0x9c, 0xf6, 0xf4, 0x25, 0x28, 0x65, 0x30, 0x10, 0x06, 0x7f,
+	0x8d, 0xb5, 0x92, 0xf8, 0x47, 0x05, 0xc6, 0x5d, 0xf2, 0x38, 0xc1, 0xc4, 0xd0, 0xae, 0x22, 0x1d,
+	0x14, 0x3c, 0xb4, 0x1d, 0x8d, 0xce, 0x88, 0x76, 0x53, 0x57, 0x78, 0x3b, 0x2a, 0xc6, 0x18, 0xcb,
+	0x8e, 0x6f, 0xba, 0xd6, 0x5e, 0x23, 0xf4, 0xf6, 0x09, 0x5b, 0xf5, 0x31, 0xa3, 0xc8, 0xc6, 0xb6,
+	0xe9, 0x90, 0x28, 0xbf, 0xd4, 0x13, 0x29, 0xd6, 0x71, 0x64, 0xa5, 0xe5, 0xd7, 0xe8, 0xba, 0x1b,
+	0x09, 0x81, 0x44, 0xa8, 0x4c, 0x9c, 0x14, 0x2a, 0xea, 0xb3, 0x85, 0x4a, 0x5d, 0x56, 0x0a, 0x2a,
+	0xd4, 0x65, 0x05, 0xd4, 0x62, 0x5d, 0x56, 0xc6, 0xd4, 0x71, 0x1e, 0x0e, 0x7f, 0x1a, 0x02, 0x2d,
+	0x53, 0x1a, 0x7f, 0xda, 0xd1, 0x90, 0x70, 0xe6, 0xc8, 0x49, 0xce, 0x1c, 0x7d, 0xc6, 0x7d, 0xf7,
+	0x2e, 0x00, 0x6f, 0x54, 0x06, 0xfb, 0xf1, 0xc6, 0xd1, 0x30, 0xd6, 0xbe, 0x70, 0x05, 0x09, 0x38,
+	0xad, 0x70, 0x6a, 0x38, 0xad, 0xf2, 0x37, 0x19, 0xc6, 0xe9, 0xc3, 0x4f, 0xa7, 0x50, 0xdc, 0x81,
+	0x31, 0x0e, 0x3c, 0x30, 0x3d, 0xc3, 0xa8, 0xa7, 0x72, 0x44, 0xad, 0xe4, 0xf0, 0x02, 0xea, 0x28,
+	0x86, 0xf1, 0x8b, 0x46, 0x12, 0xf0, 0x97, 0x38, 0x74, 0xa3, 0xbe, 0x11, 0xd4, 0xb7, 0x3a, 0x58,
+	0x21, 0xe7, 0xc7, 0x71, 0x54, 0x3f, 0x75, 0xd8, 0x3f, 0x98, 0x8c, 0xaf, 0xd1, 0x74, 0x7c, 0x5d,
+	0x05, 0x35, 0x2a, 0x09, 0x02, 0xf9, 0x50, 0xb0, 0x75, 0x9c, 0x10, 0xe3, 0x02, 0x76, 0x9b, 0x07,
+	0x25, 0xca, 0x36, 0xec, 0xf7, 0xe9, 0x28, 0xe1, 0x99, 0x26, 0x11, 0xa5, 0x70, 0x52, 0x94, 0x16,
+	0x9f, 0x31, 0x4a, 0xb3, 0xa9, 0x6a, 0xac, 0x2f, 0x55, 0x55, 0xfe, 0x51, 0x82, 0xb1, 0x75, 0x2b,
+	0xb4, 0x0f, 0xec, 0xb0, 0x87, 0x51, 0x94, 0x98, 0xb7, 0x94, 0x9e, 0xf7, 0x2d, 0xd0, 0xe3, 0xdc,
+	0x98, 0xf9, 0x71, 0xc0, 0x7e, 0xf9, 0xcc, 0x44, 0xf4, 0xd4, 0x7f, 0x83, 0x7b, 0x50, 0xca, 0x00,
+	0x6f, 0xf2, 0xa0, 0xc7, 0x9a, 0x20, 0x05, 0xb2, 0x5d, 0xe0, 0x9b, 0x86, 0xe5, 0x66, 0xb6, 0xed,
+	0x0b, 0x41, 0x84, 0xb6, 0x6e, 0xc2, 0x58, 0x0a, 0xd6, 0x1c, 0x74, 0x73, 0x17, 0x83, 0x04, 0x94,
+	0xb9, 0x08, 0x45, 0x93, 0xfb, 0x43, 0x14, 0x80, 0x82, 0x01, 0x62, 0x88, 0x75, 0x1b, 0x89, 0xa6,
+	0x93, 0xff, 0x05, 0xf1, 0xa3, 0x76, 0xf3, 0x73, 0x98, 0x3f, 0x1a, 0x70, 0x83, 0xc1, 0x00, 0xaa,
+	0xd9, 0x20, 0x1f, 0x6a, 0xcb, 0xe8, 0x8e, 0x33, 0xd0, 0x29, 0x7e, 0x99, 0x24, 0x74, 0x6f, 0x8a,
+	0x6c, 0x44, 0x75, 0x6f, 0xc3, 0x2c, 0xb7, 0x35, 0xab, 0x78, 0xc0, 0x5f, 0x26, 0x53, 0x2c, 0x37,
+	0xa5, 0xb5, 0x7e, 0x00, 0x93, 0x7b, 0xc4, 0xf4, 0xc3, 0x1d, 0x62, 0x86, 0xa7, 0xfd, 0x4f, 0xa2,
+	0x46, 0x92, 0x42, 0x5b, 0x1e, 0x06, 0x5c, 0xca, 0xc7, 0x80, 0x73, 0x61, 0x55, 0x56, 0x5b, 0xf3,
+	0x60, 0x55, 0x76, 0x3b, 0x40, 0x20, 0xe3, 0xb4, 0x93, 0x57, 0xd9, 0x8e, 0x0e, 0x45, 0x8a, 0x65,
+	0xad, 0x7a, 0x12, 0xed, 0x9c, 0x4c, 0xa3, 0x9d, 0xe9, 0x2e, 0x54, 0xcb, 0x76, 0xa1, 0x34, 0x6b,
+	0x44, 0xb1, 0x4b, 0xdc, 0xd0, 0x0e, 0x7b, 0xfa, 0x94, 0x80, 0x6e, 0x79, 0x04, 0xb3, 0xe1, 0x5c,
+	0x88, 0x6d, 0x3a, 0x17, 0x62, 0x3b, 0x1a, 0x61, 0x9d, 0x79, 0x3e, 0x08, 0xeb, 0xec, 0xf3, 0x41,
+	0x58, 0xe7, 0x8e, 0x41, 0x58, 0xb7, 0x61, 0x86, 0x49, 0x65, 0xc1, 0x1d, 0x7d, 0xc0, 0xed, 0x3d,
+	0x85, 0xe2, 0x19, 0x58, 0xe7, 0x58, 0xdc, 0x76, 0xfe, 0x78, 0xdc, 0x76, 0x00, 0x20, 0x75, 0xe1,
+	0x64, 0x20, 0xf5, 0x3e, 0x68, 0x4c, 0x0b, 0x83, 0x97, 0xd8, 0x25, 0x31, 0xfe, 0x2b, 0x66, 0x29,
+	0x5d, 0x14, 0x39, 0x91, 0xd6, 0xaf, 0xbb, 0xec, 0xd1, 0x50, 0x51, 0xf6, 0x03, 0x0a, 0x3d, 0xb1,
+	0x11, 0x7a, 0xcc, 0x49, 0xe8, 0xe3, 0x90, 0x44, 0x14, 0x6a, 0xe7, 0x31, 0xd4, 0xe6, 0x22, 0xa9,
+	0x87, 0x48, 0x8f, 0x42, 0x2e, 0xdb, 0x3b, 0x5c, 0xc8, 0xed, 0x1d, 0x92, 0x27, 0xa1, 0x72, 0xdf,
+	0x49, 0xe8, 0x53, 0x98, 0xc5, 0x4f, 0xc7, 0x1b, 0xbe, 0x49, 0x42, 0xd3, 0x76, 0x02, 0x7d, 0x31,
+	0x6f, 0x52, 0x7d, 0x60, 0x43, 0x60, 0x4c, 0x53, 0xf9, 0xf7, 0x84, 0xf8, 0x6d, 0x26, 0x4d, 0xff,
+	0x5d, 0x65, 0xf4, 0x26, 0x7f, 0x21, 0x2e, 0x0d, 0xfa, 0xef, 0x2a, 0xa5, 0x3b, 0xf1, 0x2f, 0x31,
+	0x8b, 0x02, 0x5d, 0x3c, 0x19, 0x05, 0xaa, 0xf4, 0xa1, 0x40, 0x75, 0x59, 0x19, 0x52, 0xe5, 0xba,
+	0xac, 0x8c, 0xa8, 0xa3, 0x95, 0xbf, 0x4a, 0x50, 0xa0, 0xaa, 0xfd, 0x13, 0x0a, 0x6a, 0xba, 0x9c,
+	0x9d, 0xcd, 0x96, 0xb3, 0x75, 0x28, 0x62, 0xc8, 0xf3, 0x26, 0x60, 0x68, 0xc0, 0x89, 0x02, 0x13,
+	0x12, 0xc5, 0x2c, 0x99, 0xd3, 0x64, 0xb6, 0x66, 0x61, 0x9c, 0xce, 0xe6, 0x41, 0x61, 0xa9, 0x2f,
+	0x3a, 0xb1, 0x8f, 0xe2, 0x7b, 0xad, 0x59, 0xf9, 0x83, 0x0c, 0x1a, 0x9e, 0x87, 0xd3, 0xb7, 0x26,
+	0x8e, 0xed, 0x0f, 0x62, 0xbc, 0x2f, 0xbf, 0x3f, 0x88, 0xe8, 0xd9, 0x7b, 0x05, 0x09, 0x3f, 0x0c,
+	0x65, 0xfd, 0x50, 0x85, 0x29, 0x41, 0x4e, 0x36, 0xaf, 0x1c, 0x60, 0xe0, 0xa4, 0x04, 0x64, 0x70,
+	0x09, 0x4a, 0x82, 0x9f, 0xf7, 0xb2, 0x0c, 0x5c, 0x10, 0xcd, 0x01, 0x03, 0x0d, 0x72, 0x21, 0x24,
+	0x25, 0x1f, 0x42, 0x3a, 0x0f, 0x85, 0x68, 0x27, 0x88, 0x8a, 0x1f, 0x0d, 0x9c, 0xf2, 0xde, 0xc3,
+	0x67, 0xd1, 0x7d, 0x0d, 0x56, 0x65, 0x79, 0x7e, 0x2f, 0x62, 0xf3, 0xba, 0x7c, 0x44, 0x33, 0xfc,
+	0x40, 0xa0, 0xab, 0x01, 0x61, 0x99, 0x5f, 0xdc, 0xec, 0x48, 0x0c, 0xd1, 0x28, 0x4d, 0x5d, 0x01,
+	0x61, 0xa0, 0x43, 0xd1, 0x4e, 0x5c, 0xfe, 0x78, 0x07, 0x86, 0x19, 0xbe, 0x3b, 0x7e, 0x4a, 0x7c,
+	0x97, 0x89, 0xd5, 0x65, 0x45, 0x56, 0x87, 0xeb, 0xb2, 0x32, 0xaa, 0x2a, 0x95, 0x3f, 0x4b, 0x30,
+	0xc9, 0x5d, 0xb4, 0x89, 0x05, 0xf5, 0x79, 0x85, 0x47, 0x6e, 0x29, 0x1f, 0xca, 0xff, 0x43, 0x9a,
+	0xf5, 0x81, 0xdc, 0xe7, 0x83, 0xca, 0x5f, 0x24, 0x80, 0x2d, 0xfc, 0xbd, 0xf4, 0x1c, 0xe3, 0xb9,
+	0xcf, 0xd2, 0x82, 0x7f, 0xa4, 0x8d, 0xa3, 0x7d, 0x36, 0x46, 0x7e, 0x1e, 0x56, 0x47, 0x58, 0x4e,
+	0x61, 0xe8, 0x6b, 0xe5, 0x2b, 0x09, 0x94, 0xcd, 0x3d, 0x62, 0xed, 0x07, 0xdd, 0x76, 0xd6, 0xf2,
+	0xe1, 0xd8, 0xf2, 0xdb, 0x30, 0xd2, 0x72, 0xcc, 0x03, 0xcf, 0x47, 0x3b, 0x4b, 0x6b, 0xd7, 0x8e,
+	0x3f, 0x13, 0x09, 0x8d, 0x77, 0x51, 0xc6, 0xe0, 0xb2, 0xf1, 0x7d, 0xae, 0x21, 0x3c, 0x36, 0xb0,
+	0x97, 0xca, 0xbf, 0x24, 0x18, 0x4f, 0x5d, 0xb9, 0xd2, 0xce, 0x41, 0x21, 0xbe, 0xbd, 0xc7, 0x7c,
+	0xa8, 0x98, 0x82, 0xe8, 0xc3, 0xa4, 0xb8, 0xf8, 0x18, 0x33, 0x9d, 0xc5, 0x1f, 0x5f, 0x77, 0x4f,
+	0x7d, 0xbb, 0x4b, 0x5c, 0x7a, 0x4c, 0x5f, 0x30, 0x9c, 0xb0, 0xd2, 0xa3, 0x0b, 0x1b, 0x30, 0x9d,
+	0xc7, 0x78, 0x9a, 0x2b, 0x6b, 0x1b, 0x3f, 0xff, 0xe6, 0xfb, 0xf2, 0x99, 0x6f, 0xbf, 0x2f, 0x9f,
+	0xf9, 0xe1, 0xfb, 0xb2, 0xf4, 0xd5, 0x93, 0xb2, 0xf4, 0xc7, 0x27, 0x65, 0xe9, 0xef, 0x4f, 0xca,
+	0xd2, 0x37, 0x4f, 0xca, 0xd2, 0x3f, 0x9f, 0x94, 0xa5, 0x7f, 0x3f, 0x29, 0x9f, 0xf9, 0xe1, 0x49,
+	0x59, 0xfa, 0xfa, 0x69, 0xf9, 0xcc, 0x37, 0x4f, 0xcb, 0x67, 0xbe, 0x7d, 0x5a, 0x3e, 0xf3, 0xf9,
+	0xcd, 0x5d, 0x2f, 0x9e, 0x94, 0xed, 0x1d, 0x7d, 0x0f, 0xfe, 0xad, 0xc4, 0xeb, 0xce, 0x08, 0xe6,
+	0xf2, 0x1b, 0xff, 0x1d, 0x00, 0xe7, 0xbd, 0x75, 0x3d, 0x40, 0x2f, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2698,6 +2746,15 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.CloseVisibilityTaskId != that1.CloseVisibilityTaskId {
 		return false
 	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3172,6 +3229,12 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3484,7 +3547,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 59)
+	s := make([]string, 0, 62)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3573,6 +3636,9 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "ParentInitiatedVersion: "+fmt.Sprintf("%#v", this.ParentInitiatedVersion)+",\n")
 	s = append(s, "CloseTransferTaskId: "+fmt.Sprintf("%#v", this.CloseTransferTaskId)+",\n")
 	s = append(s, "CloseVisibilityTaskId: "+fmt.Sprintf("%#v", this.CloseVisibilityTaskId)+",\n")
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3686,7 +3752,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 34)
+	s := make([]string, 0, 36)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3722,6 +3788,8 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4036,6 +4104,31 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
 	if m.CloseVisibilityTaskId != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.CloseVisibilityTaskId))
 		i--
@@ -5088,6 +5181,22 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
 		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
 		if err32 != nil {
@@ -6020,6 +6129,17 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if m.CloseVisibilityTaskId != 0 {
 		n += 2 + sovExecutions(uint64(m.CloseVisibilityTaskId))
 	}
+	if m.PriorityKey != 0 {
+		n += 2 + sovExecutions(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6377,6 +6497,13 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.PriorityKey != 0 {
+		n += 2 + sovExecutions(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6697,6 +6824,9 @@ func (this *WorkflowExecutionInfo) String() string {
 		`ParentInitiatedVersion:` + fmt.Sprintf("%v", this.ParentInitiatedVersion) + `,`,
 		`CloseTransferTaskId:` + fmt.Sprintf("%v", this.CloseTransferTaskId) + `,`,
 		`CloseVisibilityTaskId:` + fmt.Sprintf("%v", this.CloseVisibilityTaskId) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6842,6 +6972,8 @@ func (this *ActivityInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9612,6 +9744,89 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -12213,6 +12428,57 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
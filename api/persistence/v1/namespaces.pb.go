@@ -240,6 +240,8 @@ type NamespaceConfig struct {
 	HistoryArchivalUri      string           `protobuf:"bytes,5,opt,name=history_archival_uri,json=historyArchivalUri,proto3" json:"history_archival_uri,omitempty"`
 	VisibilityArchivalState v1.ArchivalState `protobuf:"varint,6,opt,name=visibility_archival_state,json=visibilityArchivalState,proto3,enum=temporal.api.enums.v1.ArchivalState" json:"visibility_archival_state,omitempty"`
 	VisibilityArchivalUri   string           `protobuf:"bytes,7,opt,name=visibility_archival_uri,json=visibilityArchivalUri,proto3" json:"visibility_archival_uri,omitempty"`
+	// Map from field name to alias.
+	CustomSearchAttributeAliases map[string]string `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NamespaceConfig) Reset()      { *m = NamespaceConfig{} }
@@ -323,6 +325,13 @@ func (m *NamespaceConfig) GetVisibilityArchivalUri() string {
 	return ""
 }
 
+func (m *NamespaceConfig) GetCustomSearchAttributeAliases() map[string]string {
+	if m != nil {
+		return m.CustomSearchAttributeAliases
+	}
+	return nil
+}
+
 type NamespaceReplicationConfig struct {
 	ActiveClusterName string              `protobuf:"bytes,1,opt,name=active_cluster_name,json=activeClusterName,proto3" json:"active_cluster_name,omitempty"`
 	Clusters          []string            `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
@@ -387,6 +396,7 @@ func init() {
 	proto.RegisterType((*NamespaceInfo)(nil), "temporal.server.api.persistence.v1.NamespaceInfo")
 	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceInfo.DataEntry")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistence.v1.NamespaceConfig")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.NamespaceConfig.CustomSearchAttributeAliasesEntry")
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistence.v1.NamespaceReplicationConfig")
 }
 
@@ -395,59 +405,63 @@ func init() {
 }
 
 var fileDescriptor_0486d93c2107d6bc = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x73, 0xe3, 0x34,
-	0x1c, 0x8d, 0x93, 0x34, 0x8b, 0x15, 0x36, 0xdd, 0x8a, 0xc2, 0x66, 0xc3, 0xe0, 0x0d, 0x19, 0x4a,
-	0xc3, 0xc5, 0x26, 0x2d, 0x03, 0x0c, 0x9d, 0x65, 0x86, 0x6c, 0x7b, 0xd8, 0x81, 0x59, 0x66, 0x0c,
-	0xcb, 0xa1, 0x17, 0xa3, 0xd8, 0x4a, 0x2a, 0xea, 0x48, 0x1e, 0x49, 0x31, 0xd3, 0x1b, 0x1f, 0xa1,
-	0x47, 0xbe, 0x01, 0x9c, 0xf9, 0x14, 0x1c, 0x7b, 0xec, 0x0d, 0x9a, 0x5e, 0x38, 0x70, 0xe8, 0x91,
-	0x23, 0x63, 0x49, 0xb6, 0x93, 0x86, 0x0c, 0x93, 0x9b, 0xf5, 0xd3, 0x7b, 0x4f, 0xbf, 0x3f, 0x4f,
-	0x32, 0x38, 0x94, 0x78, 0x9a, 0x30, 0x8e, 0x62, 0x4f, 0x60, 0x9e, 0x62, 0xee, 0xa1, 0x84, 0x78,
-	0x09, 0xe6, 0x82, 0x08, 0x89, 0x69, 0x88, 0xbd, 0x74, 0xe0, 0x51, 0x34, 0xc5, 0x22, 0x41, 0x21,
-	0x16, 0x6e, 0xc2, 0x99, 0x64, 0xb0, 0x97, 0x93, 0x5c, 0x4d, 0x72, 0x51, 0x42, 0xdc, 0x05, 0x92,
-	0x9b, 0x0e, 0x3a, 0xce, 0x84, 0xb1, 0x49, 0x8c, 0x3d, 0xc5, 0x18, 0xcd, 0xc6, 0x5e, 0x34, 0xe3,
-	0x48, 0x12, 0x46, 0xb5, 0x46, 0xe7, 0xe9, 0xfd, 0x7d, 0x49, 0xa6, 0x58, 0x48, 0x34, 0x4d, 0x0c,
-	0xe0, 0xdd, 0x08, 0x27, 0x98, 0x46, 0x98, 0x86, 0x04, 0x0b, 0x6f, 0xc2, 0x26, 0x4c, 0xc5, 0xd5,
-	0x97, 0x81, 0xec, 0x15, 0xc9, 0x67, 0x59, 0x63, 0x3a, 0x9b, 0x8a, 0xa5, 0x7c, 0x0d, 0x6c, 0x7f,
-	0x09, 0x56, 0xec, 0x66, 0xd0, 0x29, 0x16, 0x02, 0x4d, 0x0c, 0xb0, 0xf7, 0x4f, 0x0d, 0x6c, 0xbf,
-	0xcc, 0xb7, 0x8f, 0xb1, 0x44, 0x24, 0x86, 0x27, 0xa0, 0x4e, 0xe8, 0x98, 0xb5, 0xad, 0xae, 0xd5,
-	0x6f, 0x1e, 0x0c, 0xdc, 0xff, 0x2f, 0xdd, 0x2d, 0x24, 0x5e, 0xd0, 0x31, 0xf3, 0x15, 0x1d, 0x7e,
-	0x09, 0x1a, 0x21, 0xa3, 0x63, 0x32, 0x69, 0x57, 0x95, 0xd0, 0xe1, 0x46, 0x42, 0xcf, 0x15, 0xd5,
-	0x37, 0x12, 0x70, 0x0a, 0x20, 0xc7, 0x49, 0x4c, 0x42, 0xd5, 0xd0, 0xc0, 0x08, 0xd7, 0x94, 0xf0,
-	0xe7, 0x1b, 0x09, 0xfb, 0xa5, 0x8c, 0x39, 0x63, 0x87, 0xdf, 0x0f, 0xc1, 0x3d, 0xd0, 0xd2, 0x47,
-	0x04, 0x69, 0x26, 0xc3, 0x68, 0xbb, 0xde, 0xb5, 0xfa, 0x35, 0xff, 0xa1, 0x8e, 0x7e, 0xa7, 0x83,
-	0x70, 0x08, 0xde, 0x19, 0x23, 0x12, 0xb3, 0x14, 0xf3, 0x80, 0x32, 0x49, 0xc6, 0x79, 0x7e, 0x39,
-	0x6b, 0x4b, 0xb1, 0xde, 0xce, 0x41, 0x2f, 0x17, 0x30, 0xb9, 0xc6, 0x07, 0xe0, 0x51, 0xa1, 0x91,
-	0xd3, 0x1a, 0x8a, 0xb6, 0x9d, 0xc7, 0x73, 0xe8, 0x57, 0x60, 0xa7, 0x80, 0x62, 0x1a, 0x05, 0x99,
-	0x7f, 0xda, 0x0f, 0x54, 0x0f, 0x3a, 0xae, 0x36, 0x97, 0x9b, 0x9b, 0xcb, 0xfd, 0x36, 0x37, 0xd7,
-	0xb0, 0x7e, 0xf9, 0xc7, 0x53, 0xab, 0x54, 0x3b, 0xa1, 0x51, 0xb6, 0xd7, 0xfb, 0xad, 0x0a, 0x1e,
-	0x2e, 0xcd, 0x0d, 0xb6, 0x40, 0x95, 0x44, 0x6a, 0xec, 0xb6, 0x5f, 0x25, 0x11, 0x3c, 0x02, 0x5b,
-	0x42, 0x22, 0x89, 0xd5, 0x00, 0x5b, 0x07, 0x7b, 0x65, 0x9f, 0xb3, 0x06, 0x2b, 0xf3, 0x2d, 0xb5,
-	0xf6, 0x9b, 0x0c, 0xec, 0x6b, 0x0e, 0x84, 0xa0, 0x9e, 0xf9, 0x4e, 0xcd, 0xc8, 0xf6, 0xd5, 0x37,
-	0xec, 0x82, 0x66, 0x84, 0x45, 0xc8, 0x49, 0x22, 0xf3, 0x9e, 0xda, 0xfe, 0x62, 0x08, 0xee, 0x82,
-	0x2d, 0xf6, 0x23, 0xc5, 0x5c, 0x75, 0xce, 0xf6, 0xf5, 0x02, 0x7e, 0x0d, 0xea, 0x11, 0x92, 0xa8,
-	0xdd, 0xe8, 0xd6, 0xfa, 0xcd, 0x83, 0xa3, 0x8d, 0x1d, 0xe9, 0x1e, 0x23, 0x89, 0x4e, 0xa8, 0xe4,
-	0x17, 0xbe, 0x12, 0xea, 0x7c, 0x02, 0xec, 0x22, 0x04, 0x1f, 0x81, 0xda, 0x39, 0xbe, 0x30, 0x75,
-	0x67, 0x9f, 0x59, 0x16, 0x29, 0x8a, 0x67, 0xba, 0x70, 0xdb, 0xd7, 0x8b, 0xcf, 0xaa, 0x9f, 0x5a,
-	0xbd, 0xbf, 0x17, 0xef, 0x8b, 0x31, 0xcb, 0x33, 0x60, 0x73, 0x2c, 0x31, 0x55, 0x35, 0xe9, 0x4b,
-	0xf3, 0x64, 0x65, 0x1c, 0xc7, 0xe6, 0x2d, 0x18, 0xd6, 0x7f, 0xce, 0xa6, 0x51, 0x32, 0xe0, 0x3e,
-	0xd8, 0x46, 0x3c, 0x3c, 0x23, 0x29, 0x8a, 0x83, 0xd1, 0x2c, 0x3c, 0xc7, 0xd2, 0x1c, 0xdb, 0xca,
-	0xc3, 0x43, 0x15, 0x85, 0x2f, 0xc0, 0xeb, 0x23, 0x14, 0x05, 0x23, 0x42, 0x11, 0x27, 0x58, 0x18,
-	0xf7, 0xbf, 0xbf, 0x3c, 0x95, 0xf2, 0x25, 0x48, 0x07, 0xee, 0x10, 0x45, 0x43, 0x83, 0xf6, 0x9b,
-	0xa3, 0x72, 0x01, 0x4f, 0xc1, 0x5b, 0x67, 0x44, 0x48, 0xc6, 0x2f, 0x82, 0xe2, 0x6c, 0x3d, 0xea,
-	0xba, 0x1a, 0xf5, 0x7b, 0x6b, 0x46, 0xfd, 0x85, 0x01, 0xeb, 0x49, 0xef, 0x1a, 0x8d, 0xa5, 0x28,
-	0xfc, 0x10, 0xec, 0xae, 0x68, 0xcf, 0x38, 0x31, 0x13, 0x85, 0xf7, 0x38, 0xaf, 0x38, 0x81, 0xdf,
-	0x83, 0x27, 0x29, 0x11, 0x64, 0x44, 0x62, 0x22, 0x57, 0x12, 0x6a, 0x6c, 0x90, 0xd0, 0xe3, 0x52,
-	0x66, 0x39, 0xa7, 0x8f, 0xc1, 0xe3, 0xff, 0x3a, 0x21, 0x4b, 0xeb, 0x81, 0x4a, 0xeb, 0xcd, 0x55,
-	0xe6, 0x2b, 0x4e, 0x7a, 0xbf, 0x58, 0xa0, 0xb3, 0xfe, 0xe5, 0x80, 0x2e, 0x78, 0x03, 0x85, 0x92,
-	0xa4, 0x38, 0x08, 0xe3, 0x99, 0x90, 0xd9, 0x2b, 0x90, 0x59, 0x5e, 0x3b, 0x69, 0x47, 0x6f, 0x3d,
-	0xd7, 0x3b, 0x99, 0x0a, 0xec, 0x80, 0xd7, 0x0c, 0x50, 0xb4, 0xab, 0xdd, 0x5a, 0xdf, 0xf6, 0x8b,
-	0x35, 0x7c, 0x96, 0x5f, 0xb6, 0x9a, 0x2a, 0x78, 0x7f, 0x4d, 0xc1, 0x0b, 0x49, 0x2c, 0x5e, 0xb7,
-	0xe1, 0x0f, 0x57, 0x37, 0x4e, 0xe5, 0xfa, 0xc6, 0xa9, 0xdc, 0xdd, 0x38, 0xd6, 0x4f, 0x73, 0xc7,
-	0xfa, 0x75, 0xee, 0x58, 0xbf, 0xcf, 0x1d, 0xeb, 0x6a, 0xee, 0x58, 0x7f, 0xce, 0x1d, 0xeb, 0xaf,
-	0xb9, 0x53, 0xb9, 0x9b, 0x3b, 0xd6, 0xe5, 0xad, 0x53, 0xb9, 0xba, 0x75, 0x2a, 0xd7, 0xb7, 0x4e,
-	0xe5, 0xf4, 0xa3, 0x09, 0x2b, 0xcf, 0x21, 0x6c, 0xfd, 0x1f, 0xf1, 0x68, 0x61, 0x39, 0x6a, 0x28,
-	0x57, 0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x41, 0x1e, 0xc1, 0x4a, 0x07, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xbf, 0x73, 0x1b, 0x45,
+	0x14, 0xd6, 0x49, 0xb2, 0x12, 0xad, 0x89, 0x1d, 0x2f, 0x86, 0x28, 0x02, 0xce, 0x8a, 0x07, 0x63,
+	0xd3, 0x9c, 0xb0, 0xcd, 0x00, 0x83, 0x27, 0xcc, 0x58, 0xb6, 0x8b, 0x0c, 0x4c, 0x32, 0x73, 0x21,
+	0x14, 0x69, 0x8e, 0xd5, 0xdd, 0x93, 0xb2, 0xe4, 0xb4, 0x7b, 0xb3, 0xbb, 0x77, 0x8c, 0x3b, 0xfe,
+	0x84, 0x94, 0x14, 0xf4, 0x50, 0xf3, 0x57, 0x50, 0xba, 0x4c, 0xc1, 0x0c, 0x58, 0x6e, 0x28, 0x53,
+	0x52, 0x32, 0xfb, 0xe3, 0xf4, 0xc3, 0xc6, 0x64, 0x44, 0x77, 0xfb, 0xf6, 0xfb, 0xbe, 0x7d, 0xfb,
+	0xbe, 0xb7, 0x6f, 0x0e, 0xed, 0x2b, 0x18, 0x65, 0x5c, 0x90, 0xb4, 0x2b, 0x41, 0x14, 0x20, 0xba,
+	0x24, 0xa3, 0xdd, 0x0c, 0x84, 0xa4, 0x52, 0x01, 0x8b, 0xa1, 0x5b, 0xec, 0x76, 0x19, 0x19, 0x81,
+	0xcc, 0x48, 0x0c, 0x32, 0xc8, 0x04, 0x57, 0x1c, 0x6f, 0x96, 0xa4, 0xc0, 0x92, 0x02, 0x92, 0xd1,
+	0x60, 0x86, 0x14, 0x14, 0xbb, 0x6d, 0x7f, 0xc8, 0xf9, 0x30, 0x85, 0xae, 0x61, 0xf4, 0xf3, 0x41,
+	0x37, 0xc9, 0x05, 0x51, 0x94, 0x33, 0xab, 0xd1, 0xde, 0xb8, 0xbc, 0xaf, 0xe8, 0x08, 0xa4, 0x22,
+	0xa3, 0xcc, 0x01, 0xee, 0x25, 0x90, 0x01, 0x4b, 0x80, 0xc5, 0x14, 0x64, 0x77, 0xc8, 0x87, 0xdc,
+	0xc4, 0xcd, 0x97, 0x83, 0x6c, 0x4d, 0x92, 0xd7, 0x59, 0x03, 0xcb, 0x47, 0x72, 0x2e, 0x5f, 0x07,
+	0xdb, 0x9e, 0x83, 0x4d, 0x76, 0x35, 0x74, 0x04, 0x52, 0x92, 0xa1, 0x03, 0x6e, 0xfe, 0x5d, 0x43,
+	0xab, 0x0f, 0xcb, 0xed, 0x63, 0x50, 0x84, 0xa6, 0xf8, 0x04, 0xd5, 0x29, 0x1b, 0xf0, 0x96, 0xd7,
+	0xf1, 0x76, 0x96, 0xf7, 0x76, 0x83, 0xd7, 0x5f, 0x3d, 0x98, 0x48, 0x3c, 0x60, 0x03, 0x1e, 0x1a,
+	0x3a, 0xfe, 0x12, 0x35, 0x62, 0xce, 0x06, 0x74, 0xd8, 0xaa, 0x1a, 0xa1, 0xfd, 0x85, 0x84, 0x8e,
+	0x0c, 0x35, 0x74, 0x12, 0x78, 0x84, 0xb0, 0x80, 0x2c, 0xa5, 0xb1, 0x29, 0x68, 0xe4, 0x84, 0x6b,
+	0x46, 0xf8, 0x8b, 0x85, 0x84, 0xc3, 0xa9, 0x8c, 0x3b, 0x63, 0x4d, 0x5c, 0x0e, 0xe1, 0x2d, 0xb4,
+	0x62, 0x8f, 0x88, 0x0a, 0x2d, 0xc3, 0x59, 0xab, 0xde, 0xf1, 0x76, 0x6a, 0xe1, 0x2d, 0x1b, 0xfd,
+	0xc6, 0x06, 0x71, 0x0f, 0xbd, 0x37, 0x20, 0x34, 0xe5, 0x05, 0x88, 0x88, 0x71, 0x45, 0x07, 0x65,
+	0x7e, 0x25, 0x6b, 0xc9, 0xb0, 0xde, 0x29, 0x41, 0x0f, 0x67, 0x30, 0xa5, 0xc6, 0x87, 0xe8, 0xf6,
+	0x44, 0xa3, 0xa4, 0x35, 0x0c, 0x6d, 0xb5, 0x8c, 0x97, 0xd0, 0xaf, 0xd0, 0xda, 0x04, 0x0a, 0x2c,
+	0x89, 0x74, 0xff, 0xb4, 0x6e, 0x98, 0x1a, 0xb4, 0x03, 0xdb, 0x5c, 0x41, 0xd9, 0x5c, 0xc1, 0xd7,
+	0x65, 0x73, 0xf5, 0xea, 0x2f, 0xfe, 0xd8, 0xf0, 0xa6, 0x6a, 0x27, 0x2c, 0xd1, 0x7b, 0x9b, 0xbf,
+	0x56, 0xd1, 0xad, 0x39, 0xdf, 0xf0, 0x0a, 0xaa, 0xd2, 0xc4, 0xd8, 0xde, 0x0c, 0xab, 0x34, 0xc1,
+	0x07, 0x68, 0x49, 0x2a, 0xa2, 0xc0, 0x18, 0xb8, 0xb2, 0xb7, 0x35, 0xad, 0xb3, 0x2e, 0xb0, 0x69,
+	0xbe, 0xb9, 0xd2, 0x3e, 0xd6, 0xe0, 0xd0, 0x72, 0x30, 0x46, 0x75, 0xdd, 0x77, 0xc6, 0xa3, 0x66,
+	0x68, 0xbe, 0x71, 0x07, 0x2d, 0x27, 0x20, 0x63, 0x41, 0x33, 0x55, 0xd6, 0xb4, 0x19, 0xce, 0x86,
+	0xf0, 0x3a, 0x5a, 0xe2, 0xdf, 0x33, 0x10, 0xa6, 0x72, 0xcd, 0xd0, 0x2e, 0xf0, 0x23, 0x54, 0x4f,
+	0x88, 0x22, 0xad, 0x46, 0xa7, 0xb6, 0xb3, 0xbc, 0x77, 0xb0, 0x70, 0x47, 0x06, 0xc7, 0x44, 0x91,
+	0x13, 0xa6, 0xc4, 0x69, 0x68, 0x84, 0xda, 0x9f, 0xa2, 0xe6, 0x24, 0x84, 0x6f, 0xa3, 0xda, 0x73,
+	0x38, 0x75, 0xf7, 0xd6, 0x9f, 0x3a, 0x8b, 0x82, 0xa4, 0xb9, 0xbd, 0x78, 0x33, 0xb4, 0x8b, 0xcf,
+	0xab, 0x9f, 0x79, 0x9b, 0xbf, 0x2f, 0xcd, 0xbc, 0x17, 0xd7, 0x2c, 0xf7, 0x51, 0x53, 0x80, 0x02,
+	0x66, 0xee, 0x64, 0x1f, 0xcd, 0xdd, 0x2b, 0x76, 0x1c, 0xbb, 0x59, 0xd0, 0xab, 0xff, 0xa8, 0xdd,
+	0x98, 0x32, 0xf0, 0x36, 0x5a, 0x25, 0x22, 0x7e, 0x46, 0x0b, 0x92, 0x46, 0xfd, 0x3c, 0x7e, 0x0e,
+	0xca, 0x1d, 0xbb, 0x52, 0x86, 0x7b, 0x26, 0x8a, 0x1f, 0xa0, 0x37, 0xfa, 0x24, 0x89, 0xfa, 0x94,
+	0x11, 0x41, 0x41, 0xba, 0xee, 0xff, 0x60, 0xde, 0x95, 0xe9, 0x24, 0x28, 0x76, 0x83, 0x1e, 0x49,
+	0x7a, 0x0e, 0x1d, 0x2e, 0xf7, 0xa7, 0x0b, 0xfc, 0x14, 0xbd, 0xfd, 0x8c, 0x4a, 0xc5, 0xc5, 0x69,
+	0x34, 0x39, 0xdb, 0x5a, 0x5d, 0x37, 0x56, 0xbf, 0x7f, 0x8d, 0xd5, 0x87, 0x0e, 0x6c, 0x9d, 0x5e,
+	0x77, 0x1a, 0x73, 0x51, 0xfc, 0x11, 0x5a, 0xbf, 0xa2, 0x9d, 0x0b, 0xea, 0x1c, 0xc5, 0x97, 0x38,
+	0x4f, 0x04, 0xc5, 0xdf, 0xa2, 0xbb, 0x05, 0x95, 0xb4, 0x4f, 0x53, 0xaa, 0xae, 0x24, 0xd4, 0x58,
+	0x20, 0xa1, 0x3b, 0x53, 0x99, 0xf9, 0x9c, 0x3e, 0x41, 0x77, 0xfe, 0xed, 0x04, 0x9d, 0xd6, 0x0d,
+	0x93, 0xd6, 0x5b, 0x57, 0x99, 0x3a, 0xb3, 0x9f, 0x3c, 0xb4, 0x11, 0xe7, 0x52, 0xf1, 0x51, 0x24,
+	0x41, 0xd3, 0x22, 0xa2, 0x94, 0xa0, 0xfd, 0x5c, 0x41, 0x44, 0x52, 0x4a, 0x24, 0xc8, 0xd6, 0x4d,
+	0xd3, 0x94, 0x4f, 0xfe, 0xc7, 0x74, 0x0b, 0x8e, 0x8c, 0xf4, 0x63, 0xa3, 0x7c, 0x58, 0x0a, 0x1f,
+	0x5a, 0x5d, 0xdb, 0xae, 0xef, 0xc6, 0xff, 0x01, 0x69, 0x3f, 0x42, 0xf7, 0x5e, 0x2b, 0xb1, 0x50,
+	0x7b, 0xff, 0xec, 0xa1, 0xf6, 0xf5, 0x93, 0x12, 0x07, 0xe8, 0x4d, 0x12, 0x2b, 0x5a, 0x40, 0x14,
+	0xa7, 0xb9, 0x54, 0x7a, 0xea, 0xe9, 0x27, 0x6e, 0xa5, 0xd7, 0xec, 0xd6, 0x91, 0xdd, 0xd1, 0x2a,
+	0xb8, 0x8d, 0x6e, 0x3a, 0xa0, 0x6c, 0x55, 0x3b, 0xb5, 0x9d, 0x66, 0x38, 0x59, 0xe3, 0xfb, 0xe5,
+	0x70, 0xa9, 0x19, 0x83, 0xb7, 0xaf, 0x31, 0x78, 0x26, 0x89, 0xd9, 0xf1, 0xd2, 0xfb, 0xee, 0xec,
+	0xdc, 0xaf, 0xbc, 0x3c, 0xf7, 0x2b, 0xaf, 0xce, 0x7d, 0xef, 0x87, 0xb1, 0xef, 0xfd, 0x32, 0xf6,
+	0xbd, 0xdf, 0xc6, 0xbe, 0x77, 0x36, 0xf6, 0xbd, 0x3f, 0xc7, 0xbe, 0xf7, 0xd7, 0xd8, 0xaf, 0xbc,
+	0x1a, 0xfb, 0xde, 0x8b, 0x0b, 0xbf, 0x72, 0x76, 0xe1, 0x57, 0x5e, 0x5e, 0xf8, 0x95, 0xa7, 0x1f,
+	0x0f, 0xf9, 0xf4, 0x1c, 0xca, 0xaf, 0xff, 0x03, 0x38, 0x98, 0x59, 0xf6, 0x1b, 0xe6, 0x15, 0xef,
+	0xff, 0x33, 0x00, 0xe4, 0xcf, 0x16, 0xf4, 0x3a, 0x08, 0x00, 0x00,
 }
 
 func (this *NamespaceDetail) Equal(that interface{}) bool {
@@ -586,6 +600,14 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	if this.VisibilityArchivalUri != that1.VisibilityArchivalUri {
 		return false
 	}
+	if len(this.CustomSearchAttributeAliases) != len(that1.CustomSearchAttributeAliases) {
+		return false
+	}
+	for i := range this.CustomSearchAttributeAliases {
+		if this.CustomSearchAttributeAliases[i] != that1.CustomSearchAttributeAliases[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *NamespaceReplicationConfig) Equal(that interface{}) bool {
@@ -676,7 +698,7 @@ func (this *NamespaceConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.NamespaceConfig{")
 	s = append(s, "Retention: "+fmt.Sprintf("%#v", this.Retention)+",\n")
 	s = append(s, "ArchivalBucket: "+fmt.Sprintf("%#v", this.ArchivalBucket)+",\n")
@@ -687,6 +709,19 @@ func (this *NamespaceConfig) GoString() string {
 	s = append(s, "HistoryArchivalUri: "+fmt.Sprintf("%#v", this.HistoryArchivalUri)+",\n")
 	s = append(s, "VisibilityArchivalState: "+fmt.Sprintf("%#v", this.VisibilityArchivalState)+",\n")
 	s = append(s, "VisibilityArchivalUri: "+fmt.Sprintf("%#v", this.VisibilityArchivalUri)+",\n")
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
+	if this.CustomSearchAttributeAliases != nil {
+		s = append(s, "CustomSearchAttributeAliases: "+mapStringForCustomSearchAttributeAliases+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -889,6 +924,25 @@ func (m *NamespaceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k := range m.CustomSearchAttributeAliases {
+			v := m.CustomSearchAttributeAliases[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNamespaces(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNamespaces(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.VisibilityArchivalUri) > 0 {
 		i -= len(m.VisibilityArchivalUri)
 		copy(dAtA[i:], m.VisibilityArchivalUri)
@@ -1102,6 +1156,14 @@ func (m *NamespaceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNamespaces(uint64(l))
 	}
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k, v := range m.CustomSearchAttributeAliases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNamespaces(uint64(len(k))) + 1 + len(v) + sovNamespaces(uint64(len(v)))
+			n += mapEntrySize + 1 + sovNamespaces(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1178,6 +1240,16 @@ func (this *NamespaceConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
 	s := strings.Join([]string{`&NamespaceConfig{`,
 		`Retention:` + strings.Replace(fmt.Sprintf("%v", this.Retention), "Duration", "types.Duration", 1) + `,`,
 		`ArchivalBucket:` + fmt.Sprintf("%v", this.ArchivalBucket) + `,`,
@@ -1186,6 +1258,7 @@ func (this *NamespaceConfig) String() string {
 		`HistoryArchivalUri:` + fmt.Sprintf("%v", this.HistoryArchivalUri) + `,`,
 		`VisibilityArchivalState:` + fmt.Sprintf("%v", this.VisibilityArchivalState) + `,`,
 		`VisibilityArchivalUri:` + fmt.Sprintf("%v", this.VisibilityArchivalUri) + `,`,
+		`CustomSearchAttributeAliases:` + mapStringForCustomSearchAttributeAliases + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2026,6 +2099,133 @@ func (m *NamespaceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.VisibilityArchivalUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributeAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespaces
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespaces
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributeAliases == nil {
+				m.CustomSearchAttributeAliases = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNamespaces
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNamespaces
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNamespaces(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNamespaces
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributeAliases[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespaces(dAtA[iNdEx:])
@@ -38,6 +38,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/enums/v1"
@@ -116,6 +117,12 @@ type TaskInfo struct {
 	CreateTime  *time.Time     `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime  *time.Time     `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	Clock       *v1.ShardClock `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Build id of the worker that last completed a workflow task of this workflow.
+	BuildId string `protobuf:"bytes,8,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Priority of the task from 1 (highest) to 5 (lowest), 0 means the default priority.
+	PriorityKey int32 `protobuf:"varint,9,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	// Tasks are dispatched round robin across fairness keys within a priority level.
+	FairnessKey string `protobuf:"bytes,10,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -199,6 +206,27 @@ func (m *TaskInfo) GetClock() *v1.ShardClock {
 	return nil
 }
 
+func (m *TaskInfo) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetPriorityKey() int32 {
+	if m != nil {
+		return m.PriorityKey
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -208,6 +236,14 @@ type TaskQueueInfo struct {
 	AckLevel       int64             `protobuf:"varint,5,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	ExpiryTime     *time.Time        `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	LastUpdateTime *time.Time        `protobuf:"bytes,7,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	VersioningData *VersioningData   `protobuf:"bytes,8,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+	// Only set on the root partition of task queues managed by the adaptive partition controller.
+	PartitionConfig *TaskQueuePartitionConfig `protobuf:"bytes,9,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	// Approximate number of tasks persisted in the task queue which are not completed yet.
+	ApproximateBacklogCount int64 `protobuf:"varint,10,opt,name=approximate_backlog_count,json=approximateBacklogCount,proto3" json:"approximate_backlog_count,omitempty"`
+
+	// Approximate number of tasks which are not completed yet per fairness key, only keys with tasks are kept.
+	FairnessKeyBacklogCounts map[string]int64 `protobuf:"bytes,11,rep,name=fairness_key_backlog_counts,json=fairnessKeyBacklogCounts,proto3" json:"fairness_key_backlog_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
@@ -291,10 +327,186 @@ func (m *TaskQueueInfo) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *TaskQueueInfo) GetVersioningData() *VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+func (m *TaskQueueInfo) GetPartitionConfig() *TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+func (m *TaskQueueInfo) GetApproximateBacklogCount() int64 {
+	if m != nil {
+		return m.ApproximateBacklogCount
+	}
+	return 0
+}
+
+func (m *TaskQueueInfo) GetFairnessKeyBacklogCounts() map[string]int64 {
+	if m != nil {
+		return m.FairnessKeyBacklogCounts
+	}
+	return nil
+}
+
+// Worker build id ordering of a task queue.
+type VersioningData struct {
+	// Version sets ordered from oldest to newest. The last set is the default one.
+	VersionSets []*CompatibleVersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+}
+
+func (m *VersioningData) Reset()      { *m = VersioningData{} }
+func (*VersioningData) ProtoMessage() {}
+func (*VersioningData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{3}
+}
+func (m *VersioningData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersioningData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersioningData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersioningData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersioningData.Merge(m, src)
+}
+func (m *VersioningData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersioningData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersioningData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersioningData proto.InternalMessageInfo
+
+func (m *VersioningData) GetVersionSets() []*CompatibleVersionSet {
+	if m != nil {
+		return m.VersionSets
+	}
+	return nil
+}
+
+// Build ids that are compatible with each other, ordered from oldest to newest.
+type CompatibleVersionSet struct {
+	BuildIds []string `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+}
+
+func (m *CompatibleVersionSet) Reset()      { *m = CompatibleVersionSet{} }
+func (*CompatibleVersionSet) ProtoMessage() {}
+func (*CompatibleVersionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{4}
+}
+func (m *CompatibleVersionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompatibleVersionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompatibleVersionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompatibleVersionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompatibleVersionSet.Merge(m, src)
+}
+func (m *CompatibleVersionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompatibleVersionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompatibleVersionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompatibleVersionSet proto.InternalMessageInfo
+
+func (m *CompatibleVersionSet) GetBuildIds() []string {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
+// Partition counts of a task queue set by the adaptive partition controller of its root partition.
+type TaskQueuePartitionConfig struct {
+	// Number of partitions new tasks are added to.
+	WritePartitionCount int32 `protobuf:"varint,1,opt,name=write_partition_count,json=writePartitionCount,proto3" json:"write_partition_count,omitempty"`
+	// Number of partitions which are polled, never less than the write partition count.
+	// Partitions past the write partition count are retiring and only drain their backlog.
+	ReadPartitionCount int32 `protobuf:"varint,2,opt,name=read_partition_count,json=readPartitionCount,proto3" json:"read_partition_count,omitempty"`
+}
+
+func (m *TaskQueuePartitionConfig) Reset()      { *m = TaskQueuePartitionConfig{} }
+func (*TaskQueuePartitionConfig) ProtoMessage() {}
+func (*TaskQueuePartitionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{5}
+}
+func (m *TaskQueuePartitionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueuePartitionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueuePartitionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueuePartitionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueuePartitionConfig.Merge(m, src)
+}
+func (m *TaskQueuePartitionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueuePartitionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueuePartitionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueuePartitionConfig proto.InternalMessageInfo
+
+func (m *TaskQueuePartitionConfig) GetWritePartitionCount() int32 {
+	if m != nil {
+		return m.WritePartitionCount
+	}
+	return 0
+}
+
+func (m *TaskQueuePartitionConfig) GetReadPartitionCount() int32 {
+	if m != nil {
+		return m.ReadPartitionCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AllocatedTaskInfo)(nil), "temporal.server.api.persistence.v1.AllocatedTaskInfo")
 	proto.RegisterType((*TaskInfo)(nil), "temporal.server.api.persistence.v1.TaskInfo")
 	proto.RegisterType((*TaskQueueInfo)(nil), "temporal.server.api.persistence.v1.TaskQueueInfo")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.TaskQueueInfo.FairnessKeyBacklogCountsEntry")
+	proto.RegisterType((*VersioningData)(nil), "temporal.server.api.persistence.v1.VersioningData")
+	proto.RegisterType((*CompatibleVersionSet)(nil), "temporal.server.api.persistence.v1.CompatibleVersionSet")
+	proto.RegisterType((*TaskQueuePartitionConfig)(nil), "temporal.server.api.persistence.v1.TaskQueuePartitionConfig")
 }
 
 func init() {
@@ -302,44 +514,64 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xb5, 0x9b, 0xff, 0x17, 0xa8, 0xc0, 0x12, 0x22, 0x0a, 0xd2, 0xa5, 0x8d, 0x10, 0x8a, 0x10,
-	0xb2, 0xd5, 0xc2, 0x80, 0x84, 0x84, 0x48, 0x99, 0x02, 0x2c, 0x98, 0xb0, 0xb0, 0x44, 0x57, 0xdf,
-	0x2f, 0xae, 0xf1, 0x9f, 0x3b, 0x7c, 0xe7, 0x94, 0x6c, 0x7c, 0x84, 0x7e, 0x0c, 0x3e, 0x0a, 0x03,
-	0x43, 0xc6, 0x6e, 0x10, 0x67, 0x61, 0xa3, 0x1f, 0x01, 0xdd, 0x39, 0x4e, 0x3b, 0x14, 0x91, 0x81,
-	0xed, 0x7e, 0xbf, 0x7b, 0xef, 0xdd, 0xf3, 0x7b, 0x51, 0x90, 0x2d, 0x21, 0xe6, 0x2c, 0x25, 0x91,
-	0x23, 0x20, 0x9d, 0x41, 0xea, 0x10, 0x1e, 0x38, 0x1c, 0x52, 0x11, 0x08, 0x09, 0x89, 0x07, 0xce,
-	0xec, 0xc0, 0x91, 0x44, 0x84, 0xc2, 0xe6, 0x29, 0x93, 0xcc, 0xea, 0x97, 0x78, 0xbb, 0xc0, 0xdb,
-	0x84, 0x07, 0xf6, 0x15, 0xbc, 0x3d, 0x3b, 0xe8, 0xf6, 0x7c, 0xc6, 0xfc, 0x08, 0x1c, 0xcd, 0x38,
-	0xce, 0xa6, 0x8e, 0x0c, 0x62, 0x10, 0x92, 0xc4, 0xbc, 0x10, 0xe9, 0xee, 0x53, 0xe0, 0x90, 0x50,
-	0x48, 0xbc, 0x00, 0x84, 0xe3, 0x33, 0x9f, 0xe9, 0xbd, 0x3e, 0xad, 0x21, 0x0f, 0x36, 0xbe, 0x94,
-	0x21, 0x48, 0xb2, 0x58, 0x94, 0x56, 0x26, 0x9f, 0x32, 0xc8, 0x60, 0x8d, 0x7b, 0x78, 0x9d, 0x7f,
-	0x2f, 0x62, 0x5e, 0xa8, 0xe0, 0x31, 0x08, 0x41, 0xfc, 0x35, 0xb6, 0x9f, 0xa0, 0xdb, 0xc3, 0x28,
-	0x62, 0x1e, 0x91, 0x40, 0xc7, 0x44, 0x84, 0xa3, 0x64, 0xca, 0xac, 0x17, 0xa8, 0x4a, 0x89, 0x24,
-	0x1d, 0x73, 0xcf, 0x1c, 0xb4, 0x0f, 0x1f, 0xd9, 0xff, 0xfe, 0x3e, 0xbb, 0xe4, 0xba, 0x9a, 0x69,
-	0xdd, 0x45, 0x0d, 0x6d, 0x2b, 0xa0, 0x9d, 0x9d, 0x3d, 0x73, 0x50, 0x71, 0xeb, 0x6a, 0x1c, 0xd1,
-	0xfe, 0xf7, 0x1d, 0xd4, 0xdc, 0xbc, 0xb3, 0x8f, 0x6e, 0x24, 0x24, 0x06, 0xc1, 0x89, 0x07, 0x0a,
-	0xaa, 0xde, 0x6b, 0xb9, 0xed, 0xcd, 0x6e, 0x44, 0xad, 0x1e, 0x6a, 0x9f, 0xb2, 0x34, 0x9c, 0x46,
-	0xec, 0xb4, 0x14, 0x6b, 0xb9, 0xa8, 0x5c, 0x8d, 0xa8, 0x75, 0x07, 0xd5, 0xd3, 0x2c, 0x51, 0x77,
-	0x15, 0x7d, 0x57, 0x4b, 0xb3, 0xa4, 0xe0, 0x09, 0xef, 0x04, 0x68, 0x16, 0x69, 0xe5, 0xaa, 0x36,
-	0x81, 0xca, 0xd5, 0x88, 0x5a, 0x43, 0xd4, 0xf6, 0x52, 0x20, 0x12, 0x26, 0xaa, 0x89, 0x4e, 0x4d,
-	0x7f, 0x6a, 0xd7, 0x2e, 0x6a, 0xb2, 0xcb, 0x9a, 0xec, 0x71, 0x59, 0xd3, 0x51, 0xf5, 0xec, 0x47,
-	0xcf, 0x74, 0x51, 0x41, 0x52, 0x6b, 0x25, 0x01, 0x9f, 0x79, 0x90, 0xce, 0x0b, 0x89, 0xfa, 0xb6,
-	0x12, 0x05, 0x49, 0x4b, 0x3c, 0x47, 0x35, 0x5d, 0x4c, 0xa7, 0xa1, 0xc9, 0x83, 0x6b, 0xa3, 0xd6,
-	0x08, 0x15, 0xf2, 0xbb, 0x13, 0x92, 0xd2, 0x97, 0x6a, 0x72, 0x0b, 0x5a, 0xff, 0xf7, 0x0e, 0xba,
-	0xa9, 0xe2, 0x7c, 0xab, 0xea, 0xdf, 0x36, 0x53, 0x0b, 0x55, 0xd5, 0xb8, 0x0e, 0x53, 0x9f, 0xad,
-	0x21, 0x6a, 0xe9, 0xc2, 0xe4, 0x9c, 0x83, 0x4e, 0x72, 0xf7, 0xf0, 0xfe, 0xa5, 0x19, 0xe5, 0x42,
-	0xff, 0xde, 0xca, 0xaa, 0xf5, 0x7b, 0xe3, 0x39, 0x07, 0xb7, 0xa9, 0x68, 0xea, 0x64, 0x3d, 0x45,
-	0xd5, 0x30, 0x48, 0x8a, 0xac, 0xb7, 0x60, 0xbf, 0x0e, 0x12, 0xea, 0x6a, 0x86, 0x75, 0x0f, 0xb5,
-	0x88, 0x17, 0x4e, 0x22, 0x98, 0x41, 0xa4, 0x9b, 0xa8, 0xb8, 0x4d, 0xe2, 0x85, 0x6f, 0xd4, 0xfc,
-	0x3f, 0x52, 0x7e, 0x85, 0x6e, 0x45, 0x44, 0xc8, 0x49, 0xc6, 0xe9, 0xa6, 0xf0, 0xc6, 0x96, 0x3a,
-	0xbb, 0x8a, 0xf9, 0x5e, 0x13, 0xd5, 0xd5, 0xd1, 0xc7, 0xc5, 0x12, 0x1b, 0xe7, 0x4b, 0x6c, 0x5c,
-	0x2c, 0xb1, 0xf9, 0x25, 0xc7, 0xe6, 0xd7, 0x1c, 0x9b, 0xdf, 0x72, 0x6c, 0x2e, 0x72, 0x6c, 0xfe,
-	0xcc, 0xb1, 0xf9, 0x2b, 0xc7, 0xc6, 0x45, 0x8e, 0xcd, 0xb3, 0x15, 0x36, 0x16, 0x2b, 0x6c, 0x9c,
-	0xaf, 0xb0, 0xf1, 0xe1, 0x89, 0xcf, 0x2e, 0xf3, 0x08, 0xd8, 0xdf, 0xff, 0x58, 0x9e, 0x5d, 0x19,
-	0x8f, 0xeb, 0xda, 0xd5, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x22, 0xf7, 0x48, 0x91,
-	0x04, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0x23, 0xc5,
+	0x13, 0xf6, 0xc4, 0x7f, 0x62, 0xb7, 0xf7, 0x97, 0xcd, 0xaf, 0xc9, 0x6a, 0xbd, 0x59, 0x31, 0xc9,
+	0x5a, 0x08, 0x59, 0x08, 0x8d, 0x59, 0x2f, 0x87, 0x68, 0x41, 0x88, 0x24, 0x80, 0x64, 0x82, 0x04,
+	0xcc, 0x06, 0x0e, 0xec, 0x61, 0xd4, 0x9e, 0x29, 0xcf, 0x36, 0x1e, 0x4f, 0x37, 0xdd, 0x3d, 0xce,
+	0xfa, 0xb6, 0x4f, 0x80, 0xf6, 0x31, 0xb8, 0xf0, 0x1e, 0xdc, 0xc8, 0x71, 0x6f, 0x10, 0xe7, 0xc2,
+	0x71, 0x1f, 0x01, 0x75, 0x8d, 0xc7, 0xb1, 0x45, 0x02, 0x46, 0xe2, 0xd6, 0x55, 0x5d, 0xdf, 0xd7,
+	0x55, 0xf5, 0x55, 0xcd, 0x10, 0xcf, 0xc0, 0x58, 0x0a, 0xc5, 0x92, 0xae, 0x06, 0x35, 0x01, 0xd5,
+	0x65, 0x92, 0x77, 0x25, 0x28, 0xcd, 0xb5, 0x81, 0x34, 0x84, 0xee, 0xe4, 0x61, 0xd7, 0x30, 0x3d,
+	0xd2, 0x9e, 0x54, 0xc2, 0x08, 0xda, 0x2e, 0xe2, 0xbd, 0x3c, 0xde, 0x63, 0x92, 0x7b, 0x4b, 0xf1,
+	0xde, 0xe4, 0xe1, 0xee, 0x5e, 0x2c, 0x44, 0x9c, 0x40, 0x17, 0x11, 0x83, 0x6c, 0xd8, 0x35, 0x7c,
+	0x0c, 0xda, 0xb0, 0xb1, 0xcc, 0x49, 0x76, 0x1f, 0x44, 0x20, 0x21, 0x8d, 0x20, 0x0d, 0x39, 0xe8,
+	0x6e, 0x2c, 0x62, 0x81, 0x7e, 0x3c, 0xcd, 0x43, 0xde, 0x5e, 0xe4, 0x65, 0x13, 0x82, 0x34, 0x1b,
+	0xeb, 0x22, 0x95, 0xe0, 0x87, 0x0c, 0x32, 0x98, 0xc7, 0xbd, 0x73, 0x5d, 0xfe, 0x61, 0x22, 0xc2,
+	0x91, 0x0d, 0x1f, 0x83, 0xd6, 0x2c, 0x9e, 0xc7, 0xb6, 0x53, 0xf2, 0xff, 0xc3, 0x24, 0x11, 0x21,
+	0x33, 0x10, 0x9d, 0x32, 0x3d, 0xea, 0xa7, 0x43, 0x41, 0x3f, 0x26, 0x95, 0x88, 0x19, 0xd6, 0x72,
+	0xf6, 0x9d, 0x4e, 0xb3, 0xf7, 0xae, 0xf7, 0xcf, 0xf5, 0x79, 0x05, 0xd6, 0x47, 0x24, 0xbd, 0x4b,
+	0x36, 0x31, 0x2d, 0x1e, 0xb5, 0x36, 0xf6, 0x9d, 0x4e, 0xd9, 0xaf, 0x59, 0xb3, 0x1f, 0xb5, 0x7f,
+	0x2e, 0x93, 0xfa, 0xe2, 0x9d, 0x07, 0xe4, 0x56, 0xca, 0xc6, 0xa0, 0x25, 0x0b, 0xc1, 0x86, 0xda,
+	0xf7, 0x1a, 0x7e, 0x73, 0xe1, 0xeb, 0x47, 0x74, 0x8f, 0x34, 0xcf, 0x84, 0x1a, 0x0d, 0x13, 0x71,
+	0x56, 0x90, 0x35, 0x7c, 0x52, 0xb8, 0xfa, 0x11, 0xbd, 0x43, 0x6a, 0x2a, 0x4b, 0xed, 0x5d, 0x19,
+	0xef, 0xaa, 0x2a, 0x4b, 0x73, 0x9c, 0x0e, 0x9f, 0x41, 0x94, 0x25, 0xc8, 0x5c, 0xc1, 0x24, 0x48,
+	0xe1, 0xea, 0x47, 0xf4, 0x90, 0x34, 0x43, 0x05, 0xcc, 0x40, 0x60, 0x95, 0x68, 0x55, 0xb1, 0xd4,
+	0x5d, 0x2f, 0x97, 0xc9, 0x2b, 0x64, 0xf2, 0x4e, 0x0b, 0x99, 0x8e, 0x2a, 0x2f, 0x7f, 0xdb, 0x73,
+	0x7c, 0x92, 0x83, 0xac, 0xdb, 0x52, 0xc0, 0x73, 0xc9, 0xd5, 0x34, 0xa7, 0xa8, 0xad, 0x4b, 0x91,
+	0x83, 0x90, 0xe2, 0x23, 0x52, 0x45, 0x61, 0x5a, 0x9b, 0x08, 0xee, 0x5c, 0xdb, 0x6a, 0x8c, 0xb0,
+	0x4d, 0x7e, 0xf2, 0x8c, 0xa9, 0xe8, 0xd8, 0x5a, 0x7e, 0x0e, 0xa3, 0xf7, 0x48, 0x7d, 0x90, 0xf1,
+	0x24, 0xb2, 0x35, 0xd6, 0xb1, 0xfe, 0x4d, 0xb4, 0xfb, 0x91, 0x6d, 0xae, 0x54, 0x5c, 0x28, 0x6e,
+	0xa6, 0xc1, 0x08, 0xa6, 0xad, 0xc6, 0xbe, 0xd3, 0xa9, 0xfa, 0xcd, 0xc2, 0x77, 0x02, 0x53, 0x1b,
+	0x32, 0x64, 0x5c, 0xa5, 0xa0, 0x35, 0x86, 0x90, 0xbc, 0xff, 0x85, 0xef, 0x04, 0xa6, 0xed, 0x5f,
+	0x6b, 0xe4, 0x7f, 0x56, 0xaf, 0xaf, 0xed, 0x7c, 0xad, 0x2b, 0x1a, 0x25, 0x15, 0x6b, 0xce, 0xd5,
+	0xc2, 0x33, 0x3d, 0x24, 0x0d, 0x9c, 0x08, 0x33, 0x95, 0x80, 0x52, 0x6d, 0xf5, 0xde, 0xba, 0xaa,
+	0xd6, 0x96, 0x89, 0x03, 0x5d, 0xcc, 0x12, 0xbe, 0x77, 0x3a, 0x95, 0xe0, 0xd7, 0x2d, 0xcc, 0x9e,
+	0xe8, 0x01, 0xa9, 0x8c, 0x78, 0x9a, 0x8b, 0xb9, 0x06, 0xfa, 0x84, 0xa7, 0x91, 0x8f, 0x08, 0x7a,
+	0x9f, 0x34, 0x58, 0x38, 0x0a, 0x12, 0x98, 0x40, 0x82, 0x52, 0x97, 0xfd, 0x3a, 0x0b, 0x47, 0x5f,
+	0x58, 0xfb, 0xbf, 0x90, 0xf1, 0x73, 0xb2, 0x9d, 0x30, 0x6d, 0x82, 0x4c, 0x46, 0x8b, 0x89, 0xda,
+	0x5c, 0x93, 0x67, 0xcb, 0x22, 0xbf, 0x41, 0x20, 0x72, 0x3d, 0x25, 0xb7, 0x27, 0x76, 0xb7, 0x44,
+	0xca, 0xd3, 0x38, 0xc0, 0x3d, 0xac, 0x23, 0x55, 0x6f, 0x9d, 0x3d, 0xfc, 0x76, 0x01, 0xfd, 0x84,
+	0x19, 0xe6, 0x6f, 0x4d, 0x56, 0x6c, 0x1a, 0x93, 0x6d, 0xc9, 0x94, 0xe1, 0x86, 0x8b, 0x34, 0x08,
+	0x45, 0x3a, 0xe4, 0x31, 0x0e, 0x46, 0xb3, 0xf7, 0xe1, 0xba, 0x5b, 0x8e, 0xbd, 0xfd, 0xaa, 0x20,
+	0x39, 0x46, 0x0e, 0xff, 0xb6, 0x5c, 0x75, 0xd0, 0xc7, 0xe4, 0x1e, 0x93, 0x52, 0x89, 0xe7, 0x7c,
+	0x6c, 0x3b, 0x32, 0x60, 0xe1, 0x28, 0x11, 0x71, 0x10, 0x8a, 0x2c, 0x35, 0x38, 0x67, 0x65, 0xff,
+	0xee, 0x52, 0xc0, 0x51, 0x7e, 0x7f, 0x6c, 0xaf, 0xe9, 0x8f, 0x0e, 0xb9, 0xbf, 0x3c, 0x97, 0xab,
+	0x68, 0xdd, 0x6a, 0xee, 0x97, 0x3b, 0xcd, 0xde, 0x97, 0xff, 0x2a, 0x61, 0x3b, 0xba, 0xde, 0x67,
+	0x57, 0x83, 0xbd, 0xfc, 0xa0, 0xfe, 0x34, 0x35, 0x6a, 0xea, 0xb7, 0x86, 0x37, 0x5c, 0xef, 0x9e,
+	0x90, 0x37, 0xff, 0x16, 0x4a, 0xb7, 0x49, 0xd9, 0xee, 0x4f, 0xbe, 0x0a, 0xf6, 0x48, 0x77, 0x48,
+	0x75, 0xc2, 0x92, 0x0c, 0xe6, 0x9f, 0xbf, 0xdc, 0x78, 0xbc, 0x71, 0xe0, 0xb4, 0xc7, 0x64, 0x6b,
+	0x55, 0x24, 0xfa, 0x94, 0xdc, 0x9a, 0xcb, 0x14, 0x68, 0x30, 0xba, 0xe5, 0x60, 0x7d, 0x07, 0xeb,
+	0xd4, 0x77, 0x2c, 0xc6, 0x92, 0x19, 0x3e, 0x48, 0x60, 0xce, 0xf9, 0x04, 0x8c, 0xdf, 0x9c, 0x2c,
+	0xce, 0xba, 0xfd, 0x88, 0xec, 0x5c, 0x17, 0x64, 0x57, 0xa2, 0xf8, 0x72, 0xe4, 0x2f, 0x36, 0xfc,
+	0xfa, 0xfc, 0xd3, 0xa1, 0xdb, 0x2f, 0x1c, 0xd2, 0xba, 0x49, 0x6b, 0xda, 0x23, 0x77, 0xce, 0x14,
+	0x37, 0x10, 0x2c, 0x4f, 0x92, 0x95, 0xd5, 0xc1, 0x2f, 0xcc, 0x1b, 0x78, 0xb9, 0x04, 0xb2, 0x92,
+	0xbe, 0x47, 0x76, 0x14, 0xb0, 0xe8, 0x2f, 0x90, 0x0d, 0x84, 0x50, 0x7b, 0xb7, 0x8a, 0x38, 0xfa,
+	0xfe, 0xfc, 0xc2, 0x2d, 0xbd, 0xba, 0x70, 0x4b, 0xaf, 0x2f, 0x5c, 0xe7, 0xc5, 0xcc, 0x75, 0x7e,
+	0x9a, 0xb9, 0xce, 0x2f, 0x33, 0xd7, 0x39, 0x9f, 0xb9, 0xce, 0xef, 0x33, 0xd7, 0xf9, 0x63, 0xe6,
+	0x96, 0x5e, 0xcf, 0x5c, 0xe7, 0xe5, 0xa5, 0x5b, 0x3a, 0xbf, 0x74, 0x4b, 0xaf, 0x2e, 0xdd, 0xd2,
+	0x77, 0xef, 0xc7, 0xe2, 0xaa, 0x6d, 0x5c, 0xdc, 0xfc, 0x03, 0xff, 0x60, 0xc9, 0x1c, 0xd4, 0x70,
+	0x39, 0x1f, 0xfd, 0x39, 0x00, 0x4a, 0xef, 0xef, 0x62, 0xf9, 0x07, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -417,6 +649,15 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.PriorityKey != that1.PriorityKey {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -467,6 +708,108 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
 		return false
 	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
+	if this.ApproximateBacklogCount != that1.ApproximateBacklogCount {
+		return false
+	}
+	if len(this.FairnessKeyBacklogCounts) != len(that1.FairnessKeyBacklogCounts) {
+		return false
+	}
+	for i := range this.FairnessKeyBacklogCounts {
+		if this.FairnessKeyBacklogCounts[i] != that1.FairnessKeyBacklogCounts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *VersioningData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersioningData)
+	if !ok {
+		that2, ok := that.(VersioningData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VersionSets) != len(that1.VersionSets) {
+		return false
+	}
+	for i := range this.VersionSets {
+		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompatibleVersionSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompatibleVersionSet)
+	if !ok {
+		that2, ok := that.(CompatibleVersionSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BuildIds) != len(that1.BuildIds) {
+		return false
+	}
+	for i := range this.BuildIds {
+		if this.BuildIds[i] != that1.BuildIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TaskQueuePartitionConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueuePartitionConfig)
+	if !ok {
+		that2, ok := that.(TaskQueuePartitionConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WritePartitionCount != that1.WritePartitionCount {
+		return false
+	}
+	if this.ReadPartitionCount != that1.ReadPartitionCount {
+		return false
+	}
 	return true
 }
 func (this *AllocatedTaskInfo) GoString() string {
@@ -486,7 +829,7 @@ func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 14)
 	s = append(s, "&persistence.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -497,6 +840,9 @@ func (this *TaskInfo) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "PriorityKey: "+fmt.Sprintf("%#v", this.PriorityKey)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -504,7 +850,7 @@ func (this *TaskQueueInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 15)
 	s = append(s, "&persistence.TaskQueueInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
@@ -513,6 +859,59 @@ func (this *TaskQueueInfo) GoString() string {
 	s = append(s, "AckLevel: "+fmt.Sprintf("%#v", this.AckLevel)+",\n")
 	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
 	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "ApproximateBacklogCount: "+fmt.Sprintf("%#v", this.ApproximateBacklogCount)+",\n")
+	keysForFairnessKeyBacklogCounts := make([]string, 0, len(this.FairnessKeyBacklogCounts))
+	for k, _ := range this.FairnessKeyBacklogCounts {
+		keysForFairnessKeyBacklogCounts = append(keysForFairnessKeyBacklogCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFairnessKeyBacklogCounts)
+	mapStringForFairnessKeyBacklogCounts := "map[string]int64{"
+	for _, k := range keysForFairnessKeyBacklogCounts {
+		mapStringForFairnessKeyBacklogCounts += fmt.Sprintf("%#v: %#v,", k, this.FairnessKeyBacklogCounts[k])
+	}
+	mapStringForFairnessKeyBacklogCounts += "}"
+	if this.FairnessKeyBacklogCounts != nil {
+		s = append(s, "FairnessKeyBacklogCounts: "+mapStringForFairnessKeyBacklogCounts+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersioningData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.VersioningData{")
+	if this.VersionSets != nil {
+		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompatibleVersionSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.CompatibleVersionSet{")
+	s = append(s, "BuildIds: "+fmt.Sprintf("%#v", this.BuildIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueuePartitionConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.TaskQueuePartitionConfig{")
+	s = append(s, "WritePartitionCount: "+fmt.Sprintf("%#v", this.WritePartitionCount)+",\n")
+	s = append(s, "ReadPartitionCount: "+fmt.Sprintf("%#v", this.ReadPartitionCount)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -584,6 +983,25 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PriorityKey != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.PriorityKey))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -665,30 +1083,76 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.LastUpdateTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err5 != nil {
-			return 0, err5
+	if len(m.FairnessKeyBacklogCounts) > 0 {
+		for k := range m.FairnessKeyBacklogCounts {
+			v := m.FairnessKeyBacklogCounts[k]
+			baseI := i
+			i = encodeVarintTasks(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTasks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTasks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
 		}
-		i -= n5
-		i = encodeVarintTasks(dAtA, i, uint64(n5))
+	}
+	if m.ApproximateBacklogCount != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.ApproximateBacklogCount))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x50
 	}
-	if m.ExpiryTime != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err6 != nil {
-			return 0, err6
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
 		}
-		i -= n6
-		i = encodeVarintTasks(dAtA, i, uint64(n6))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if m.AckLevel != 0 {
-		i = encodeVarintTasks(dAtA, i, uint64(m.AckLevel))
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x42
+	}
+	if m.LastUpdateTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTasks(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTasks(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AckLevel != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.AckLevel))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.Kind != 0 {
 		i = encodeVarintTasks(dAtA, i, uint64(m.Kind))
@@ -717,6 +1181,108 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersioningData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersioningData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompatibleVersionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompatibleVersionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompatibleVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIds[iNdEx])
+			copy(dAtA[i:], m.BuildIds[iNdEx])
+			i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskQueuePartitionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueuePartitionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueuePartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadPartitionCount != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.ReadPartitionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WritePartitionCount != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.WritePartitionCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTasks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTasks(v)
 	base := offset
@@ -777,6 +1343,17 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.PriorityKey != 0 {
+		n += 1 + sovTasks(uint64(m.PriorityKey))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
 	return n
 }
 
@@ -811,6 +1388,70 @@ func (m *TaskQueueInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.ApproximateBacklogCount != 0 {
+		n += 1 + sovTasks(uint64(m.ApproximateBacklogCount))
+	}
+	if len(m.FairnessKeyBacklogCounts) > 0 {
+		for k, v := range m.FairnessKeyBacklogCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTasks(uint64(len(k))) + 1 + sovTasks(uint64(v))
+			n += mapEntrySize + 1 + sovTasks(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *VersioningData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for _, e := range m.VersionSets {
+			l = e.Size()
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompatibleVersionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for _, s := range m.BuildIds {
+			l = len(s)
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskQueuePartitionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WritePartitionCount != 0 {
+		n += 1 + sovTasks(uint64(m.WritePartitionCount))
+	}
+	if m.ReadPartitionCount != 0 {
+		n += 1 + sovTasks(uint64(m.ReadPartitionCount))
+	}
 	return n
 }
 
@@ -843,6 +1484,9 @@ func (this *TaskInfo) String() string {
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "ShardClock", "v1.ShardClock", 1) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`PriorityKey:` + fmt.Sprintf("%v", this.PriorityKey) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -851,6 +1495,16 @@ func (this *TaskQueueInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForFairnessKeyBacklogCounts := make([]string, 0, len(this.FairnessKeyBacklogCounts))
+	for k, _ := range this.FairnessKeyBacklogCounts {
+		keysForFairnessKeyBacklogCounts = append(keysForFairnessKeyBacklogCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFairnessKeyBacklogCounts)
+	mapStringForFairnessKeyBacklogCounts := "map[string]int64{"
+	for _, k := range keysForFairnessKeyBacklogCounts {
+		mapStringForFairnessKeyBacklogCounts += fmt.Sprintf("%v: %v,", k, this.FairnessKeyBacklogCounts[k])
+	}
+	mapStringForFairnessKeyBacklogCounts += "}"
 	s := strings.Join([]string{`&TaskQueueInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -859,6 +1513,46 @@ func (this *TaskQueueInfo) String() string {
 		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`VersioningData:` + strings.Replace(this.VersioningData.String(), "VersioningData", "VersioningData", 1) + `,`,
+		`PartitionConfig:` + strings.Replace(this.PartitionConfig.String(), "TaskQueuePartitionConfig", "TaskQueuePartitionConfig", 1) + `,`,
+		`ApproximateBacklogCount:` + fmt.Sprintf("%v", this.ApproximateBacklogCount) + `,`,
+		`FairnessKeyBacklogCounts:` + mapStringForFairnessKeyBacklogCounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersioningData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.VersionSets {
+		repeatedStringForVersionSets += strings.Replace(f.String(), "CompatibleVersionSet", "CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForVersionSets += "}"
+	s := strings.Join([]string{`&VersioningData{`,
+		`VersionSets:` + repeatedStringForVersionSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompatibleVersionSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompatibleVersionSet{`,
+		`BuildIds:` + fmt.Sprintf("%v", this.BuildIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskQueuePartitionConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueuePartitionConfig{`,
+		`WritePartitionCount:` + fmt.Sprintf("%v", this.WritePartitionCount) + `,`,
+		`ReadPartitionCount:` + fmt.Sprintf("%v", this.ReadPartitionCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1231,6 +1925,89 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityKey", wireType)
+			}
+			m.PriorityKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityKey |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -1477,6 +2254,464 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateBacklogCount", wireType)
+			}
+			m.ApproximateBacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateBacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKeyBacklogCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FairnessKeyBacklogCounts == nil {
+				m.FairnessKeyBacklogCounts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTasks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTasks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTasks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTasks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FairnessKeyBacklogCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersioningData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersioningData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersioningData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionSets = append(m.VersionSets, &CompatibleVersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompatibleVersionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompatibleVersionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompatibleVersionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildIds = append(m.BuildIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueuePartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueuePartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueuePartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritePartitionCount", wireType)
+			}
+			m.WritePartitionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WritePartitionCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPartitionCount", wireType)
+			}
+			m.ReadPartitionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadPartitionCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
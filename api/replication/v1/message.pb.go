@@ -38,6 +38,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v14 "go.temporal.io/api/common/v1"
@@ -520,6 +521,8 @@ type NamespaceTaskAttributes struct {
 	ReplicationConfig  *v12.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
 	ConfigVersion      int64                           `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion    int64                           `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	// Map from custom search attribute field name to namespace alias.
+	CustomSearchAttributeAliases map[string]string `protobuf:"bytes,8,rep,name=custom_search_attribute_aliases,json=customSearchAttributeAliases,proto3" json:"custom_search_attribute_aliases,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NamespaceTaskAttributes) Reset()      { *m = NamespaceTaskAttributes{} }
@@ -603,6 +606,13 @@ func (m *NamespaceTaskAttributes) GetFailoverVersion() int64 {
 	return 0
 }
 
+func (m *NamespaceTaskAttributes) GetCustomSearchAttributeAliases() map[string]string {
+	if m != nil {
+		return m.CustomSearchAttributeAliases
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	TargetClusters []string     `protobuf:"bytes,1,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
 	NamespaceId    string       `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1098,6 +1108,7 @@ func init() {
 	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.replication.v1.ReplicationTaskInfo")
 	proto.RegisterType((*NamespaceTaskAttributes)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.replication.v1.NamespaceTaskAttributes.CustomSearchAttributeAliasesEntry")
 	proto.RegisterType((*HistoryTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryTaskAttributes")
 	proto.RegisterType((*HistoryMetadataTaskAttributes)(nil), "temporal.server.api.replication.v1.HistoryMetadataTaskAttributes")
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
@@ -1110,104 +1121,109 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0xff, 0x3c, 0xff, 0xed, 0x84, 0x10, 0xc7, 0x28, 0x6e, 0x62, 0xb5, 0x34,
-	0x45, 0xc8, 0x6e, 0x92, 0x03, 0xb4, 0x45, 0x48, 0x49, 0xa1, 0xc4, 0x91, 0x5a, 0xaa, 0x6d, 0xd4,
-	0x4a, 0x5c, 0x96, 0x89, 0x77, 0x6c, 0xaf, 0x62, 0xef, 0x5a, 0x33, 0x63, 0x07, 0x73, 0x42, 0xe2,
-	0x80, 0x84, 0x40, 0xea, 0x77, 0x80, 0x03, 0x27, 0x3e, 0x47, 0x8f, 0xbd, 0x20, 0x95, 0x13, 0x34,
-	0xe5, 0xc0, 0xb1, 0x7c, 0x03, 0x34, 0xb3, 0xb3, 0xf6, 0xae, 0xd7, 0x76, 0xdd, 0xa2, 0x9e, 0xb8,
-	0x79, 0xde, 0x7b, 0xbf, 0xdf, 0x9b, 0x79, 0xf3, 0xfe, 0xcc, 0x1a, 0xae, 0x71, 0xd2, 0xed, 0x39,
-	0x14, 0x77, 0x6a, 0x8c, 0xd0, 0x01, 0xa1, 0x35, 0xdc, 0xb3, 0x6a, 0x94, 0xf4, 0x3a, 0x56, 0x03,
-	0x73, 0xcb, 0xb1, 0x6b, 0x83, 0x9d, 0x5a, 0x97, 0x30, 0x86, 0x5b, 0xa4, 0xda, 0xa3, 0x0e, 0x77,
-	0x50, 0xc5, 0x43, 0x54, 0x5d, 0x44, 0x15, 0xf7, 0xac, 0xaa, 0x0f, 0x51, 0x1d, 0xec, 0x94, 0x2e,
-	0xb6, 0x1c, 0xa7, 0xd5, 0x21, 0x35, 0x89, 0x38, 0xe9, 0x37, 0x6b, 0xdc, 0xea, 0x12, 0xc6, 0x71,
-	0xb7, 0xe7, 0x92, 0x94, 0xb6, 0x4c, 0xd2, 0x23, 0xb6, 0x49, 0xec, 0x86, 0x45, 0x58, 0xad, 0xe5,
-	0xb4, 0x1c, 0x29, 0x97, 0xbf, 0x94, 0x49, 0x75, 0xda, 0xce, 0x88, 0xdd, 0xef, 0x32, 0xb1, 0x27,
-	0xbf, 0x43, 0xd7, 0xfe, 0xca, 0x5c, 0x7b, 0x8e, 0xd9, 0xa9, 0x32, 0x7c, 0x7f, 0x9a, 0x61, 0xdb,
-	0x62, 0xdc, 0xa1, 0xc3, 0xd0, 0x71, 0x4b, 0x97, 0x46, 0xd6, 0xc2, 0xac, 0xe1, 0x74, 0xbb, 0x53,
-	0x82, 0xe2, 0x73, 0x2e, 0xac, 0x6c, 0xdc, 0x25, 0xac, 0x87, 0x1b, 0x24, 0x6c, 0x78, 0x35, 0x60,
-	0x38, 0x2f, 0xd0, 0xa5, 0xcb, 0x01, 0xd3, 0x99, 0x1b, 0x0c, 0x9a, 0x35, 0xb1, 0xd5, 0xe9, 0xd3,
-	0xb0, 0xe3, 0xca, 0x3f, 0x09, 0xc8, 0xeb, 0x63, 0x77, 0xc7, 0x98, 0x9d, 0xa2, 0xbb, 0x90, 0x12,
-	0x71, 0x31, 0xf8, 0xb0, 0x47, 0x8a, 0xda, 0xa6, 0xb6, 0x9d, 0xdb, 0xdd, 0xa9, 0x4e, 0xbb, 0x5e,
-	0x19, 0xc6, 0xea, 0x60, 0xa7, 0x3a, 0xc1, 0x70, 0x3c, 0xec, 0x11, 0x3d, 0xc9, 0xd5, 0x2f, 0x74,
-	0x09, 0x72, 0xcc, 0xe9, 0xd3, 0x06, 0x31, 0x24, 0xad, 0x65, 0x16, 0x23, 0x9b, 0xda, 0x76, 0x54,
-	0xcf, 0xb8, 0x52, 0x81, 0xa8, 0x9b, 0x68, 0x08, 0xeb, 0xa3, 0x00, 0xb9, 0x86, 0x98, 0x73, 0x6a,
-	0x9d, 0xf4, 0x39, 0x61, 0xc5, 0xe8, 0xa6, 0xb6, 0x9d, 0xde, 0xbd, 0x59, 0x7d, 0x79, 0x92, 0x55,
-	0xef, 0x7a, 0x24, 0x82, 0x77, 0x7f, 0x44, 0x71, 0xb8, 0xa4, 0xaf, 0xd9, 0xd3, 0x55, 0x88, 0xc1,
-	0x9a, 0x8a, 0x63, 0xc8, 0x71, 0x4c, 0x3a, 0xbe, 0xbe, 0x88, 0xe3, 0x43, 0x97, 0x22, 0xe4, 0x76,
-	0xb5, 0x3d, 0x4d, 0x81, 0x7e, 0xd4, 0x60, 0x8b, 0x0d, 0xed, 0x86, 0xc1, 0xda, 0x98, 0x9a, 0x06,
-	0xe3, 0x98, 0xf7, 0x59, 0xc8, 0xff, 0xb2, 0xf4, 0xbf, 0xbf, 0x88, 0xff, 0xfb, 0x43, 0xbb, 0x71,
-	0x5f, 0x70, 0xdd, 0x97, 0x54, 0xa1, 0x7d, 0x6c, 0xb0, 0x79, 0x06, 0xe8, 0x5b, 0x0d, 0xa4, 0x85,
-	0x81, 0x1b, 0xdc, 0x1a, 0x58, 0x3c, 0x1c, 0x8b, 0xb8, 0xdc, 0xcb, 0xc7, 0x8b, 0xee, 0x65, 0x5f,
-	0xf1, 0x84, 0x36, 0x52, 0x62, 0x33, 0xb5, 0xe8, 0x07, 0x0d, 0x36, 0xbd, 0xbb, 0xe8, 0x12, 0x8e,
-	0x4d, 0xcc, 0x71, 0x68, 0x23, 0x89, 0xc5, 0x83, 0xa2, 0x2e, 0xe5, 0x8e, 0xa2, 0x0a, 0x07, 0xa5,
-	0x3d, 0xcf, 0x00, 0x7d, 0x0d, 0xa5, 0x40, 0x66, 0x0c, 0x76, 0xfd, 0xfb, 0x48, 0x2e, 0x9e, 0x95,
-	0xbe, 0xe4, 0x78, 0xb0, 0x1b, 0xcc, 0xca, 0xf6, 0x74, 0x15, 0xaa, 0x43, 0x7e, 0x60, 0x31, 0xeb,
-	0xc4, 0xea, 0xc8, 0xcb, 0xb0, 0xba, 0xa4, 0x98, 0x92, 0x0e, 0x4b, 0x55, 0xb7, 0x8f, 0x56, 0xbd,
-	0x3e, 0x5a, 0x3d, 0xf6, 0xfa, 0xe8, 0x41, 0xec, 0xd1, 0x1f, 0x17, 0x35, 0x3d, 0x37, 0x06, 0x0a,
-	0xd5, 0x41, 0x06, 0x60, 0xbc, 0xed, 0xca, 0xf7, 0x11, 0x28, 0xf8, 0x2b, 0xd6, 0x39, 0x25, 0x36,
-	0x5a, 0x87, 0xa4, 0x9b, 0x88, 0x96, 0x29, 0x6b, 0x7e, 0x59, 0x4f, 0xc8, 0x75, 0xdd, 0x44, 0xd7,
-	0x61, 0xbd, 0x83, 0x19, 0x37, 0x28, 0xe1, 0xd4, 0x22, 0x03, 0x62, 0x1a, 0xaa, 0x87, 0x8c, 0x4b,
-	0xf9, 0x6d, 0x61, 0xa0, 0x7b, 0xfa, 0x3b, 0xae, 0xda, 0x07, 0xed, 0x51, 0xa7, 0x41, 0x18, 0x0b,
-	0x42, 0xa3, 0x63, 0xe8, 0x3d, 0x4f, 0x3f, 0x86, 0x12, 0x28, 0x4f, 0x40, 0x27, 0xa3, 0x11, 0x5b,
-	0x30, 0x1a, 0xef, 0x04, 0x3c, 0x3c, 0x08, 0x84, 0xa6, 0x72, 0x0c, 0xf9, 0x89, 0xc2, 0x41, 0xfb,
-	0x90, 0xf6, 0xaa, 0x51, 0xb8, 0xd1, 0x16, 0x74, 0x03, 0x2e, 0x48, 0xb2, 0xfe, 0x1a, 0x81, 0x15,
-	0x5f, 0x88, 0xd5, 0xa9, 0x18, 0xfa, 0x12, 0x2e, 0xf8, 0x12, 0x43, 0xe6, 0x14, 0x2b, 0x6a, 0x9b,
-	0xd1, 0xed, 0xf4, 0xee, 0xde, 0x22, 0x69, 0x34, 0xd1, 0x68, 0xf5, 0x02, 0x0d, 0x0a, 0xd8, 0x7f,
-	0xb9, 0xac, 0x75, 0x48, 0xb6, 0x31, 0x33, 0xba, 0x0e, 0x25, 0xf2, 0x6e, 0x92, 0x7a, 0xa2, 0x8d,
-	0xd9, 0x1d, 0x87, 0x12, 0x64, 0xc0, 0x85, 0x50, 0xaf, 0x52, 0xf1, 0xdf, 0x7b, 0x8d, 0xde, 0xa4,
-	0xe7, 0x27, 0x7a, 0x51, 0xe5, 0xb7, 0x60, 0xc0, 0xe4, 0x4c, 0xb0, 0x9b, 0x0e, 0xda, 0x82, 0xcc,
-	0x78, 0x2a, 0xa8, 0xd4, 0x4c, 0xe9, 0xe9, 0x91, 0xac, 0x6e, 0xa2, 0x8b, 0x90, 0x3e, 0x73, 0xe8,
-	0x69, 0xb3, 0xe3, 0x9c, 0x79, 0x67, 0x4c, 0xe9, 0xe0, 0x89, 0xea, 0x26, 0x5a, 0x85, 0x38, 0xed,
-	0xdb, 0x5e, 0xc6, 0xa5, 0xf4, 0x65, 0xda, 0xb7, 0xeb, 0x26, 0xba, 0xe5, 0x1f, 0x73, 0x31, 0x39,
-	0xe6, 0xde, 0x9d, 0x3f, 0xe6, 0xa6, 0xcc, 0xb6, 0x35, 0x48, 0x78, 0x43, 0x6d, 0x59, 0x06, 0x37,
-	0xce, 0xdd, 0x71, 0x56, 0x84, 0xc4, 0x80, 0x50, 0x66, 0x39, 0xb6, 0xec, 0x9b, 0x51, 0xdd, 0x5b,
-	0x8a, 0x71, 0xd8, 0xb4, 0x28, 0xe3, 0x06, 0x19, 0x10, 0x9b, 0x0b, 0x64, 0xc2, 0x1d, 0x87, 0x52,
-	0xfa, 0xa9, 0x10, 0xd6, 0x4d, 0x54, 0x81, 0xac, 0x4d, 0xbe, 0xf2, 0x19, 0x25, 0xa5, 0x51, 0x5a,
-	0x08, 0x3d, 0x9b, 0x2d, 0xc8, 0xb0, 0x46, 0x9b, 0x98, 0xfd, 0x0e, 0x91, 0x75, 0x9b, 0x72, 0x4d,
-	0x46, 0xb2, 0xba, 0x59, 0x79, 0x1c, 0x85, 0xb5, 0x19, 0x13, 0x11, 0x61, 0x58, 0x19, 0xc7, 0xd6,
-	0xe9, 0x11, 0x2a, 0x43, 0xaf, 0x26, 0xfe, 0xb5, 0xf9, 0xa1, 0x18, 0x71, 0x7e, 0xee, 0xe1, 0x74,
-	0x64, 0x87, 0x64, 0x28, 0x07, 0x91, 0xd1, 0x95, 0x44, 0x2c, 0x13, 0x7d, 0x04, 0x31, 0xcb, 0x6e,
-	0x3a, 0x6a, 0x9e, 0x6f, 0x8f, 0x7d, 0x08, 0xf2, 0x11, 0x3e, 0xe0, 0x40, 0xa4, 0x81, 0x2e, 0x51,
-	0xe8, 0x00, 0xe2, 0x0d, 0xc7, 0x6e, 0x5a, 0x2d, 0x95, 0x7a, 0xef, 0x2d, 0x82, 0xbf, 0x25, 0x11,
-	0xba, 0x42, 0xa2, 0x26, 0x20, 0x7f, 0x05, 0x2a, 0x3e, 0x77, 0xcc, 0x7e, 0x10, 0xe4, 0x9b, 0xf5,
-	0xb0, 0xf0, 0xe5, 0xa9, 0x22, 0xf7, 0x17, 0xb5, 0x2b, 0x42, 0x97, 0x21, 0xe7, 0x72, 0x1b, 0xc1,
-	0x34, 0xc8, 0xba, 0xd2, 0x07, 0x2a, 0x19, 0xae, 0x42, 0x41, 0xbc, 0xcd, 0x9c, 0x01, 0xa1, 0x23,
-	0x43, 0x37, 0x1d, 0xf2, 0x9e, 0x5c, 0x99, 0x56, 0x7e, 0x8a, 0xc2, 0xea, 0xd4, 0x37, 0x06, 0xba,
-	0x02, 0x79, 0x8e, 0x69, 0x8b, 0x70, 0xa3, 0xd1, 0xe9, 0x33, 0x4e, 0xa8, 0xdb, 0x53, 0x52, 0x7a,
-	0xce, 0x15, 0xdf, 0x52, 0xd2, 0x50, 0x35, 0x45, 0x5e, 0x5a, 0x4d, 0xd1, 0x39, 0xd5, 0x14, 0xf3,
-	0x57, 0x53, 0x38, 0xab, 0x97, 0x17, 0xc9, 0xea, 0x78, 0x38, 0xab, 0x7d, 0x95, 0x93, 0x08, 0x56,
-	0xce, 0x0d, 0x48, 0xa8, 0x61, 0xa9, 0x26, 0xe1, 0x66, 0xf0, 0xc2, 0x94, 0xd2, 0x37, 0x6f, 0x75,
-	0x0f, 0x80, 0x0e, 0x21, 0x6f, 0x93, 0x33, 0x43, 0x6c, 0xdd, 0xe3, 0x80, 0x05, 0x39, 0xb2, 0x36,
-	0x39, 0xd3, 0xfb, 0xb6, 0x5a, 0x1e, 0xc5, 0x92, 0xc9, 0x42, 0xea, 0x28, 0x96, 0x4c, 0x17, 0x32,
-	0x47, 0xb1, 0x64, 0xa6, 0x90, 0x3d, 0x8a, 0x25, 0xb3, 0x85, 0xdc, 0x51, 0x2c, 0x99, 0x2b, 0xe4,
-	0x2b, 0xdf, 0x45, 0x60, 0x63, 0xee, 0xa3, 0xe3, 0xff, 0x72, 0x5b, 0x95, 0x9f, 0x35, 0xd8, 0x98,
-	0xfb, 0x26, 0x15, 0x35, 0xa2, 0x3e, 0x0c, 0x54, 0x24, 0x54, 0x7b, 0xcf, 0xba, 0x52, 0x15, 0x88,
-	0xc0, 0xd3, 0x24, 0x12, 0x7c, 0x9a, 0x4c, 0x8c, 0xea, 0xe8, 0x6b, 0x8c, 0xea, 0xdf, 0x97, 0xa1,
-	0x34, 0xfb, 0xb9, 0xfa, 0x26, 0x07, 0x90, 0x2f, 0x74, 0xb1, 0x60, 0xa2, 0x4f, 0x36, 0xf6, 0xe5,
-	0x50, 0x63, 0x47, 0x9f, 0x41, 0x6e, 0x6c, 0x22, 0x0f, 0x1f, 0x5f, 0xf0, 0xf0, 0xd9, 0x11, 0x4e,
-	0x68, 0xd0, 0x06, 0x88, 0x68, 0x50, 0xee, 0x7a, 0x72, 0xef, 0x30, 0xa5, 0x24, 0x72, 0x4a, 0x66,
-	0x3c, 0xb5, 0xf4, 0x92, 0x5c, 0xd0, 0x4b, 0x5a, 0xa1, 0xa4, 0x8f, 0x7b, 0xb0, 0x22, 0x1f, 0x25,
-	0x6d, 0x82, 0x29, 0x3f, 0x21, 0x98, 0xbf, 0xda, 0x73, 0xf6, 0x82, 0x00, 0x1f, 0x7a, 0x58, 0xc9,
-	0x78, 0x03, 0x12, 0x26, 0xe1, 0xd8, 0xea, 0xb0, 0xe9, 0x65, 0xec, 0x7e, 0x91, 0x8b, 0x2a, 0xbe,
-	0x87, 0x87, 0x1d, 0x07, 0x9b, 0x4c, 0xf7, 0x00, 0x22, 0xee, 0x98, 0x0b, 0x6b, 0x5e, 0x4c, 0xbb,
-	0xe9, 0xa4, 0x96, 0xe2, 0xb0, 0x72, 0x9f, 0xea, 0x73, 0xb9, 0x98, 0x99, 0x46, 0xad, 0x94, 0x82,
-	0xfb, 0xb6, 0xfb, 0x53, 0x4f, 0x0b, 0x94, 0x5a, 0xa0, 0x6b, 0xf0, 0x96, 0x24, 0x11, 0x09, 0x40,
-	0xa8, 0x61, 0x99, 0xc4, 0xe6, 0x16, 0x1f, 0x16, 0xb3, 0xf2, 0xee, 0x91, 0xd0, 0x3d, 0x94, 0xaa,
-	0xba, 0xd2, 0xa0, 0x87, 0x90, 0x57, 0x37, 0x3f, 0xea, 0x4d, 0x39, 0xe9, 0xb9, 0x3a, 0x75, 0x08,
-	0xfb, 0x5a, 0x94, 0x9a, 0x0d, 0x5e, 0xa7, 0xca, 0x0d, 0x02, 0xeb, 0xca, 0x5f, 0x11, 0x58, 0x9b,
-	0xf1, 0xe5, 0xf1, 0x26, 0xbb, 0x4b, 0x13, 0x56, 0x27, 0xce, 0x63, 0x58, 0x9c, 0x74, 0xc5, 0xd7,
-	0xac, 0x78, 0xe9, 0xee, 0xbe, 0xda, 0xa9, 0xea, 0x9c, 0x74, 0xf5, 0x95, 0x41, 0x48, 0xc6, 0xd0,
-	0x87, 0x10, 0x97, 0xad, 0xc9, 0xfb, 0x34, 0x9d, 0x99, 0x03, 0x9f, 0x60, 0x8e, 0x0f, 0x3a, 0xce,
-	0x89, 0xae, 0xec, 0xd1, 0x6d, 0xc8, 0x79, 0xd3, 0x40, 0x31, 0x24, 0x16, 0x64, 0xc8, 0xb8, 0xc3,
-	0x40, 0xb6, 0x3f, 0x76, 0x14, 0x4b, 0x6a, 0x85, 0xc8, 0x81, 0xf5, 0xe4, 0x59, 0x79, 0xe9, 0xe9,
-	0xb3, 0xf2, 0xd2, 0x8b, 0x67, 0x65, 0xed, 0x9b, 0xf3, 0xb2, 0xf6, 0xcb, 0x79, 0x59, 0x7b, 0x7c,
-	0x5e, 0xd6, 0x9e, 0x9c, 0x97, 0xb5, 0x3f, 0xcf, 0xcb, 0xda, 0xdf, 0xe7, 0xe5, 0xa5, 0x17, 0xe7,
-	0x65, 0xed, 0xd1, 0xf3, 0xf2, 0xd2, 0x93, 0xe7, 0xe5, 0xa5, 0xa7, 0xcf, 0xcb, 0x4b, 0x5f, 0xec,
-	0xb5, 0x9c, 0xb1, 0x37, 0xcb, 0x99, 0xfd, 0x4f, 0xdb, 0x4d, 0x4a, 0x7a, 0x6a, 0x75, 0x12, 0x97,
-	0x45, 0xb2, 0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0xdf, 0x1d, 0x36, 0xa1, 0x13, 0x00,
-	0x00,
+	// 1620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0xf5, 0xd6, 0xe8, 0xe9, 0x71, 0x7c, 0x2d, 0xeb, 0x5e, 0xcb, 0xb6, 0x90, 0xdc, 0x38,
+	0x17, 0x17, 0x54, 0x6c, 0x2f, 0x6e, 0x1e, 0x17, 0x05, 0x6c, 0x37, 0xa9, 0x65, 0x20, 0x0f, 0xd0,
+	0x46, 0x02, 0x14, 0x28, 0xd8, 0x31, 0x39, 0x92, 0x08, 0x4b, 0xa4, 0x30, 0x33, 0x92, 0xab, 0xae,
+	0x0a, 0x74, 0x51, 0xa0, 0x68, 0x81, 0xfc, 0x87, 0x66, 0xd1, 0x55, 0x7f, 0x43, 0x97, 0x5d, 0x66,
+	0x53, 0x20, 0x5d, 0xb5, 0x71, 0xba, 0xe8, 0x32, 0xfd, 0x07, 0xc5, 0x0c, 0x87, 0x12, 0x29, 0x4a,
+	0x8a, 0x92, 0x20, 0xab, 0xee, 0x34, 0x67, 0xbe, 0xf3, 0x9d, 0x99, 0x33, 0xe7, 0x45, 0x81, 0xeb,
+	0x0c, 0x77, 0xba, 0x0e, 0x41, 0xed, 0x1a, 0xc5, 0xa4, 0x8f, 0x49, 0x0d, 0x75, 0xad, 0x1a, 0xc1,
+	0xdd, 0xb6, 0x65, 0x20, 0x66, 0x39, 0x76, 0xad, 0xbf, 0x5d, 0xeb, 0x60, 0x4a, 0x51, 0x13, 0xab,
+	0x5d, 0xe2, 0x30, 0x07, 0x56, 0x3d, 0x0d, 0xd5, 0xd5, 0x50, 0x51, 0xd7, 0x52, 0x7d, 0x1a, 0x6a,
+	0x7f, 0xbb, 0xbc, 0xde, 0x74, 0x9c, 0x66, 0x1b, 0xd7, 0x84, 0xc6, 0x69, 0xaf, 0x51, 0x63, 0x56,
+	0x07, 0x53, 0x86, 0x3a, 0x5d, 0x97, 0xa4, 0xbc, 0x69, 0xe2, 0x2e, 0xb6, 0x4d, 0x6c, 0x1b, 0x16,
+	0xa6, 0xb5, 0xa6, 0xd3, 0x74, 0x84, 0x5c, 0xfc, 0x92, 0x10, 0x75, 0xd2, 0xc9, 0xb0, 0xdd, 0xeb,
+	0x50, 0x7e, 0x26, 0xbf, 0x41, 0x17, 0x7f, 0x75, 0x26, 0x9e, 0x21, 0x7a, 0x26, 0x81, 0xff, 0x9d,
+	0x04, 0x6c, 0x59, 0x94, 0x39, 0x64, 0x10, 0xba, 0x6e, 0xf9, 0xf2, 0x10, 0xcd, 0x61, 0x86, 0xd3,
+	0xe9, 0x4c, 0x70, 0x4a, 0xf9, 0x6a, 0x00, 0x65, 0xa3, 0x0e, 0xa6, 0x5d, 0x64, 0xe0, 0x30, 0xf0,
+	0x5a, 0x00, 0x38, 0xcb, 0xd1, 0xe5, 0x2b, 0x01, 0xe8, 0xd4, 0x03, 0x06, 0x61, 0x0d, 0x64, 0xb5,
+	0x7b, 0x24, 0x6c, 0xb8, 0xfa, 0x67, 0x12, 0x14, 0xb4, 0x91, 0xb9, 0x13, 0x44, 0xcf, 0xe0, 0x7d,
+	0x90, 0xe6, 0x7e, 0xd1, 0xd9, 0xa0, 0x8b, 0x4b, 0xca, 0x86, 0xb2, 0x95, 0xdf, 0xd9, 0x56, 0x27,
+	0x3d, 0xaf, 0x70, 0xa3, 0xda, 0xdf, 0x56, 0xc7, 0x18, 0x4e, 0x06, 0x5d, 0xac, 0xa5, 0x98, 0xfc,
+	0x05, 0x2f, 0x83, 0x3c, 0x75, 0x7a, 0xc4, 0xc0, 0xba, 0xa0, 0xb5, 0xcc, 0x52, 0x64, 0x43, 0xd9,
+	0x8a, 0x6a, 0x59, 0x57, 0xca, 0x35, 0xea, 0x26, 0x1c, 0x80, 0xd5, 0xa1, 0x83, 0x5c, 0x20, 0x62,
+	0x8c, 0x58, 0xa7, 0x3d, 0x86, 0x69, 0x29, 0xba, 0xa1, 0x6c, 0x65, 0x76, 0x6e, 0xab, 0xaf, 0x0f,
+	0x32, 0xf5, 0xbe, 0x47, 0xc2, 0x79, 0xf7, 0x86, 0x14, 0x87, 0x0b, 0xda, 0x8a, 0x3d, 0x79, 0x0b,
+	0x52, 0xb0, 0x22, 0xfd, 0x18, 0x32, 0x1c, 0x13, 0x86, 0x6f, 0xce, 0x63, 0xf8, 0xd0, 0xa5, 0x08,
+	0x99, 0x5d, 0x6e, 0x4d, 0xda, 0x80, 0xdf, 0x2a, 0x60, 0x93, 0x0e, 0x6c, 0x43, 0xa7, 0x2d, 0x44,
+	0x4c, 0x9d, 0x32, 0xc4, 0x7a, 0x34, 0x64, 0x3f, 0x2e, 0xec, 0xef, 0xcd, 0x63, 0xff, 0x78, 0x60,
+	0x1b, 0xc7, 0x9c, 0xeb, 0x58, 0x50, 0x85, 0xce, 0xb1, 0x46, 0x67, 0x01, 0xe0, 0x97, 0x0a, 0x10,
+	0x08, 0x1d, 0x19, 0xcc, 0xea, 0x5b, 0x2c, 0xec, 0x8b, 0x84, 0x38, 0xcb, 0x07, 0xf3, 0x9e, 0x65,
+	0x4f, 0xf2, 0x84, 0x0e, 0x52, 0xa6, 0x53, 0x77, 0xe1, 0x37, 0x0a, 0xd8, 0xf0, 0xde, 0xa2, 0x83,
+	0x19, 0x32, 0x11, 0x43, 0xa1, 0x83, 0x24, 0xe7, 0x77, 0x8a, 0x7c, 0x94, 0x7b, 0x92, 0x2a, 0xec,
+	0x94, 0xd6, 0x2c, 0x00, 0xfc, 0x1c, 0x94, 0x03, 0x91, 0xd1, 0xdf, 0xf1, 0x9f, 0x23, 0x35, 0x7f,
+	0x54, 0xfa, 0x82, 0xe3, 0xd1, 0x4e, 0x30, 0x2a, 0x5b, 0x93, 0xb7, 0x60, 0x1d, 0x14, 0xfa, 0x16,
+	0xb5, 0x4e, 0xad, 0xb6, 0x78, 0x0c, 0xab, 0x83, 0x4b, 0x69, 0x61, 0xb0, 0xac, 0xba, 0x75, 0x54,
+	0xf5, 0xea, 0xa8, 0x7a, 0xe2, 0xd5, 0xd1, 0xfd, 0xd8, 0x93, 0x5f, 0xd7, 0x15, 0x2d, 0x3f, 0x52,
+	0xe4, 0x5b, 0xfb, 0x59, 0x00, 0x46, 0xc7, 0xae, 0x7e, 0x1d, 0x01, 0x45, 0x7f, 0xc6, 0x3a, 0x67,
+	0xd8, 0x86, 0xab, 0x20, 0xe5, 0x06, 0xa2, 0x65, 0x8a, 0x9c, 0x8f, 0x6b, 0x49, 0xb1, 0xae, 0x9b,
+	0xf0, 0x26, 0x58, 0x6d, 0x23, 0xca, 0x74, 0x82, 0x19, 0xb1, 0x70, 0x1f, 0x9b, 0xba, 0xac, 0x21,
+	0xa3, 0x54, 0xfe, 0x07, 0x07, 0x68, 0xde, 0xfe, 0x3d, 0x77, 0xdb, 0xa7, 0xda, 0x25, 0x8e, 0x81,
+	0x29, 0x0d, 0xaa, 0x46, 0x47, 0xaa, 0x0f, 0xbd, 0xfd, 0x91, 0x2a, 0x06, 0x95, 0x31, 0xd5, 0x71,
+	0x6f, 0xc4, 0xe6, 0xf4, 0xc6, 0x3f, 0x03, 0x16, 0x1e, 0x05, 0x5c, 0x53, 0x3d, 0x01, 0x85, 0xb1,
+	0xc4, 0x81, 0x7b, 0x20, 0xe3, 0x65, 0x23, 0x37, 0xa3, 0xcc, 0x69, 0x06, 0xb8, 0x4a, 0x82, 0xf5,
+	0x87, 0x08, 0x58, 0xf2, 0xb9, 0x58, 0xde, 0x8a, 0xc2, 0x4f, 0xc1, 0xa2, 0x2f, 0x30, 0x44, 0x4c,
+	0xd1, 0x92, 0xb2, 0x11, 0xdd, 0xca, 0xec, 0xec, 0xce, 0x13, 0x46, 0x63, 0x85, 0x56, 0x2b, 0x92,
+	0xa0, 0x80, 0xbe, 0xcb, 0x63, 0xad, 0x82, 0x54, 0x0b, 0x51, 0xbd, 0xe3, 0x10, 0x2c, 0xde, 0x26,
+	0xa5, 0x25, 0x5b, 0x88, 0xde, 0x73, 0x08, 0x86, 0x3a, 0x58, 0x0c, 0xd5, 0x2a, 0xe9, 0xff, 0xdd,
+	0xb7, 0xa8, 0x4d, 0x5a, 0x61, 0xac, 0x16, 0x55, 0x7f, 0x0e, 0x3a, 0x4c, 0xf4, 0x04, 0xbb, 0xe1,
+	0xc0, 0x4d, 0x90, 0x1d, 0x75, 0x05, 0x19, 0x9a, 0x69, 0x2d, 0x33, 0x94, 0xd5, 0x4d, 0xb8, 0x0e,
+	0x32, 0xe7, 0x0e, 0x39, 0x6b, 0xb4, 0x9d, 0x73, 0xef, 0x8e, 0x69, 0x0d, 0x78, 0xa2, 0xba, 0x09,
+	0x97, 0x41, 0x82, 0xf4, 0x6c, 0x2f, 0xe2, 0xd2, 0x5a, 0x9c, 0xf4, 0xec, 0xba, 0x09, 0x0f, 0xfc,
+	0x6d, 0x2e, 0x26, 0xda, 0xdc, 0xbf, 0x67, 0xb7, 0xb9, 0x09, 0xbd, 0x6d, 0x05, 0x24, 0xbd, 0xa6,
+	0x16, 0x17, 0xce, 0x4d, 0x30, 0xb7, 0x9d, 0x95, 0x40, 0xb2, 0x8f, 0x09, 0xb5, 0x1c, 0x5b, 0xd4,
+	0xcd, 0xa8, 0xe6, 0x2d, 0x79, 0x3b, 0x6c, 0x58, 0x84, 0x32, 0x1d, 0xf7, 0xb1, 0xcd, 0xb8, 0x66,
+	0xd2, 0x6d, 0x87, 0x42, 0x7a, 0x87, 0x0b, 0xeb, 0x26, 0xac, 0x82, 0x9c, 0x8d, 0x3f, 0xf3, 0x81,
+	0x52, 0x02, 0x94, 0xe1, 0x42, 0x0f, 0xb3, 0x09, 0xb2, 0xd4, 0x68, 0x61, 0xb3, 0xd7, 0xc6, 0x22,
+	0x6f, 0xd3, 0x2e, 0x64, 0x28, 0xab, 0x9b, 0xd5, 0x1f, 0xe3, 0x60, 0x65, 0x4a, 0x47, 0x84, 0x08,
+	0x2c, 0x8d, 0x7c, 0xeb, 0x74, 0x31, 0x11, 0xae, 0x97, 0x1d, 0xff, 0xfa, 0x6c, 0x57, 0x0c, 0x39,
+	0x1f, 0x78, 0x7a, 0x1a, 0xb4, 0x43, 0x32, 0x98, 0x07, 0x91, 0xe1, 0x93, 0x44, 0x2c, 0x13, 0xfe,
+	0x1f, 0xc4, 0x2c, 0xbb, 0xe1, 0xc8, 0x7e, 0xbe, 0x35, 0xb2, 0xc1, 0xc9, 0x87, 0xfa, 0x01, 0x03,
+	0x3c, 0x0c, 0x34, 0xa1, 0x05, 0xf7, 0x41, 0xc2, 0x70, 0xec, 0x86, 0xd5, 0x94, 0xa1, 0xf7, 0x9f,
+	0x79, 0xf4, 0x0f, 0x84, 0x86, 0x26, 0x35, 0x61, 0x03, 0x40, 0x7f, 0x06, 0x4a, 0x3e, 0xb7, 0xcd,
+	0xfe, 0x2f, 0xc8, 0x37, 0x6d, 0xb0, 0xf0, 0xc5, 0xa9, 0x24, 0x5f, 0x24, 0xe3, 0x22, 0x78, 0x05,
+	0xe4, 0x5d, 0x6e, 0x3d, 0x18, 0x06, 0x39, 0x57, 0xfa, 0x48, 0x06, 0xc3, 0x35, 0x50, 0xe4, 0xb3,
+	0x99, 0xd3, 0xc7, 0x64, 0x08, 0x74, 0xc3, 0xa1, 0xe0, 0xc9, 0x3d, 0xe8, 0x53, 0x05, 0xac, 0x1b,
+	0x3d, 0xca, 0x9c, 0x8e, 0x4e, 0x31, 0x22, 0x46, 0x6b, 0xd4, 0x8a, 0x74, 0xd4, 0xb6, 0x10, 0x15,
+	0x1d, 0x89, 0x97, 0x92, 0x4f, 0xde, 0x61, 0x4e, 0x52, 0x0f, 0x84, 0x89, 0x63, 0x61, 0x61, 0x28,
+	0xde, 0x73, 0xf9, 0xef, 0xd8, 0x8c, 0x0c, 0xb4, 0x7f, 0x19, 0x33, 0x20, 0xe5, 0x07, 0x60, 0xf3,
+	0xb5, 0x14, 0xb0, 0x08, 0xa2, 0x67, 0x78, 0x20, 0xb3, 0x99, 0xff, 0x84, 0x97, 0x40, 0xbc, 0x8f,
+	0xda, 0x3d, 0x2c, 0x83, 0xc5, 0x5d, 0xdc, 0x8a, 0xdc, 0x50, 0xaa, 0xdf, 0x45, 0xc1, 0xf2, 0xc4,
+	0xd9, 0x0a, 0x5e, 0x05, 0x05, 0x86, 0x48, 0x13, 0x33, 0xdd, 0x68, 0xf7, 0x28, 0xc3, 0xc4, 0xad,
+	0xa5, 0x69, 0x2d, 0xef, 0x8a, 0x0f, 0xa4, 0x34, 0x54, 0x45, 0x22, 0xaf, 0xad, 0x22, 0xd1, 0x19,
+	0x55, 0x24, 0xe6, 0xaf, 0x22, 0xe1, 0x6c, 0x8e, 0xcf, 0x93, 0xcd, 0x89, 0x70, 0x36, 0xfb, 0x2a,
+	0x46, 0x32, 0x58, 0x31, 0x6e, 0x81, 0xa4, 0x1c, 0x12, 0xe4, 0x04, 0xb0, 0x11, 0x0c, 0x54, 0xb9,
+	0xe9, 0x9b, 0x33, 0x34, 0x4f, 0x01, 0x1e, 0x82, 0x82, 0x8d, 0xcf, 0x75, 0x7e, 0x74, 0x8f, 0x03,
+	0xcc, 0xc9, 0x91, 0xb3, 0xf1, 0xb9, 0xd6, 0xb3, 0xe5, 0xf2, 0x28, 0x96, 0x4a, 0x15, 0xd3, 0x47,
+	0xb1, 0x54, 0xa6, 0x98, 0x3d, 0x8a, 0xa5, 0xb2, 0xc5, 0xdc, 0x51, 0x2c, 0x95, 0x2b, 0xe6, 0x8f,
+	0x62, 0xa9, 0x7c, 0xb1, 0x50, 0xfd, 0x2a, 0x02, 0xd6, 0x66, 0x0e, 0x5b, 0x7f, 0x97, 0xd7, 0xaa,
+	0x3e, 0x55, 0xc0, 0xda, 0xcc, 0x59, 0x9c, 0xd7, 0x06, 0xf9, 0x41, 0x24, 0x3d, 0x21, 0x13, 0x21,
+	0xe7, 0x4a, 0xa5, 0x23, 0x02, 0x23, 0x59, 0x24, 0x38, 0x92, 0x8d, 0x8d, 0x28, 0xd1, 0xb7, 0x18,
+	0x51, 0x7e, 0x89, 0x83, 0xf2, 0xf4, 0x31, 0xfd, 0x7d, 0x36, 0x5e, 0x9f, 0xeb, 0x62, 0xc1, 0x40,
+	0x1f, 0x6f, 0x68, 0xf1, 0x50, 0x43, 0x83, 0x1f, 0x81, 0xfc, 0x08, 0x22, 0x2e, 0x9f, 0x98, 0xf3,
+	0xf2, 0xb9, 0xa1, 0x1e, 0xdf, 0x81, 0x6b, 0x80, 0x7b, 0x83, 0x30, 0xd7, 0x92, 0xfb, 0x86, 0x69,
+	0x29, 0x11, 0xd3, 0x41, 0xd6, 0xdb, 0x16, 0x56, 0x52, 0x73, 0x5a, 0xc9, 0x48, 0x2d, 0x61, 0xe3,
+	0x21, 0x58, 0x12, 0xc3, 0x58, 0x0b, 0x23, 0xc2, 0x4e, 0x31, 0x62, 0x6f, 0x36, 0xc6, 0x2f, 0x72,
+	0xe5, 0x43, 0x4f, 0x57, 0x30, 0xde, 0x02, 0x49, 0x13, 0x33, 0x64, 0xb5, 0xe9, 0xe4, 0x34, 0x76,
+	0xff, 0x89, 0xe0, 0x59, 0xfc, 0x10, 0x0d, 0xda, 0x0e, 0x32, 0xa9, 0xe6, 0x29, 0x70, 0xbf, 0x23,
+	0xc6, 0xd1, 0xac, 0x94, 0x71, 0xc3, 0x49, 0x2e, 0xf9, 0x65, 0xc5, 0x39, 0xe5, 0xdf, 0x04, 0xa5,
+	0xec, 0x24, 0x6a, 0xb9, 0xc9, 0xb9, 0xef, 0xba, 0x3f, 0xb5, 0x0c, 0xd7, 0x92, 0x0b, 0x78, 0x1d,
+	0x5c, 0x12, 0x24, 0x3c, 0x00, 0x30, 0xd1, 0x2d, 0x13, 0xdb, 0xcc, 0x62, 0x83, 0x52, 0x4e, 0xbc,
+	0x3d, 0xe4, 0x7b, 0x8f, 0xc5, 0x56, 0x5d, 0xee, 0xc0, 0xc7, 0xa0, 0x20, 0x5f, 0x7e, 0x58, 0x9b,
+	0xf2, 0xc2, 0xb2, 0x3a, 0xb1, 0x81, 0xf9, 0x4a, 0x94, 0xec, 0x89, 0x5e, 0xa5, 0xca, 0xf7, 0x03,
+	0xeb, 0xea, 0xef, 0x11, 0xb0, 0x32, 0xe5, 0x8b, 0xeb, 0x7d, 0x56, 0x97, 0x06, 0x58, 0x1e, 0xbb,
+	0x8f, 0x6e, 0x31, 0xdc, 0xe1, 0x5f, 0xf1, 0xbc, 0x2d, 0xef, 0xbc, 0xd9, 0xad, 0xea, 0x0c, 0x77,
+	0xb4, 0xa5, 0x7e, 0x48, 0x46, 0xe1, 0x0d, 0x90, 0x10, 0xa5, 0xc9, 0xfb, 0x24, 0x9f, 0x1a, 0x03,
+	0x1f, 0x22, 0x86, 0xf6, 0xdb, 0xce, 0xa9, 0x26, 0xf1, 0xf0, 0x2e, 0xc8, 0x7b, 0xdd, 0x40, 0x32,
+	0x24, 0xe7, 0x64, 0xc8, 0xba, 0xcd, 0x40, 0x94, 0x3f, 0x7a, 0x14, 0x4b, 0x29, 0xc5, 0xc8, 0xbe,
+	0xf5, 0xec, 0x45, 0x65, 0xe1, 0xf9, 0x8b, 0xca, 0xc2, 0xab, 0x17, 0x15, 0xe5, 0x8b, 0x8b, 0x8a,
+	0xf2, 0xfd, 0x45, 0x45, 0xf9, 0xe9, 0xa2, 0xa2, 0x3c, 0xbb, 0xa8, 0x28, 0xbf, 0x5d, 0x54, 0x94,
+	0x3f, 0x2e, 0x2a, 0x0b, 0xaf, 0x2e, 0x2a, 0xca, 0x93, 0x97, 0x95, 0x85, 0x67, 0x2f, 0x2b, 0x0b,
+	0xcf, 0x5f, 0x56, 0x16, 0x3e, 0xde, 0x6d, 0x3a, 0x23, 0x6b, 0x96, 0x33, 0xfd, 0x1f, 0xc6, 0xdb,
+	0x04, 0x77, 0xe5, 0xea, 0x34, 0x21, 0x92, 0x64, 0xf7, 0xaf, 0x01, 0x00, 0x55, 0x2b, 0xf7, 0xa7,
+	0x99, 0x14, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1588,6 +1604,14 @@ func (this *NamespaceTaskAttributes) Equal(that interface{}) bool {
 	if this.FailoverVersion != that1.FailoverVersion {
 		return false
 	}
+	if len(this.CustomSearchAttributeAliases) != len(that1.CustomSearchAttributeAliases) {
+		return false
+	}
+	for i := range this.CustomSearchAttributeAliases {
+		if this.CustomSearchAttributeAliases[i] != that1.CustomSearchAttributeAliases[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1968,7 +1992,7 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&repication.NamespaceTaskAttributes{")
 	s = append(s, "NamespaceOperation: "+fmt.Sprintf("%#v", this.NamespaceOperation)+",\n")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
@@ -1983,6 +2007,19 @@ func (this *NamespaceTaskAttributes) GoString() string {
 	}
 	s = append(s, "ConfigVersion: "+fmt.Sprintf("%#v", this.ConfigVersion)+",\n")
 	s = append(s, "FailoverVersion: "+fmt.Sprintf("%#v", this.FailoverVersion)+",\n")
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%#v: %#v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
+	if this.CustomSearchAttributeAliases != nil {
+		s = append(s, "CustomSearchAttributeAliases: "+mapStringForCustomSearchAttributeAliases+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2511,6 +2548,25 @@ func (m *NamespaceTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k := range m.CustomSearchAttributeAliases {
+			v := m.CustomSearchAttributeAliases[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.FailoverVersion != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.FailoverVersion))
 		i--
@@ -3231,6 +3287,14 @@ func (m *NamespaceTaskAttributes) Size() (n int) {
 	if m.FailoverVersion != 0 {
 		n += 1 + sovMessage(uint64(m.FailoverVersion))
 	}
+	if len(m.CustomSearchAttributeAliases) > 0 {
+		for k, v := range m.CustomSearchAttributeAliases {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3572,6 +3636,16 @@ func (this *NamespaceTaskAttributes) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForCustomSearchAttributeAliases := make([]string, 0, len(this.CustomSearchAttributeAliases))
+	for k, _ := range this.CustomSearchAttributeAliases {
+		keysForCustomSearchAttributeAliases = append(keysForCustomSearchAttributeAliases, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCustomSearchAttributeAliases)
+	mapStringForCustomSearchAttributeAliases := "map[string]string{"
+	for _, k := range keysForCustomSearchAttributeAliases {
+		mapStringForCustomSearchAttributeAliases += fmt.Sprintf("%v: %v,", k, this.CustomSearchAttributeAliases[k])
+	}
+	mapStringForCustomSearchAttributeAliases += "}"
 	s := strings.Join([]string{`&NamespaceTaskAttributes{`,
 		`NamespaceOperation:` + fmt.Sprintf("%v", this.NamespaceOperation) + `,`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
@@ -3580,6 +3654,7 @@ func (this *NamespaceTaskAttributes) String() string {
 		`ReplicationConfig:` + strings.Replace(fmt.Sprintf("%v", this.ReplicationConfig), "NamespaceReplicationConfig", "v12.NamespaceReplicationConfig", 1) + `,`,
 		`ConfigVersion:` + fmt.Sprintf("%v", this.ConfigVersion) + `,`,
 		`FailoverVersion:` + fmt.Sprintf("%v", this.FailoverVersion) + `,`,
+		`CustomSearchAttributeAliases:` + mapStringForCustomSearchAttributeAliases + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4904,6 +4979,133 @@ func (m *NamespaceTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomSearchAttributeAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CustomSearchAttributeAliases == nil {
+				m.CustomSearchAttributeAliases = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CustomSearchAttributeAliases[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
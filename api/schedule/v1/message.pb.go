@@ -0,0 +1,4251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/schedule/v1/message.proto
+
+package schedule
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v11 "go.temporal.io/api/common/v1"
+	v1 "go.temporal.io/api/schedule/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule is the user-controlled part of a schedule.
+type Schedule struct {
+	Spec *v1.ScheduleSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Cron strings are added to spec when the schedule is compiled.
+	CronStrings []string             `protobuf:"bytes,2,rep,name=cron_strings,json=cronStrings,proto3" json:"cron_strings,omitempty"`
+	Action      *StartWorkflowAction `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Policies    *SchedulePolicies    `protobuf:"bytes,4,opt,name=policies,proto3" json:"policies,omitempty"`
+	State       *ScheduleState       `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *Schedule) Reset()      { *m = Schedule{} }
+func (*Schedule) ProtoMessage() {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetSpec() *v1.ScheduleSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Schedule) GetCronStrings() []string {
+	if m != nil {
+		return m.CronStrings
+	}
+	return nil
+}
+
+func (m *Schedule) GetAction() *StartWorkflowAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *Schedule) GetPolicies() *SchedulePolicies {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *Schedule) GetState() *ScheduleState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// StartWorkflowAction describes the workflow started by each scheduled action. The actual
+// workflow id is workflow_id with the nominal time appended.
+type StartWorkflowAction struct {
+	WorkflowId               string                `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowType             string                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskQueue                string                `protobuf:"bytes,3,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Input                    *v11.Payloads         `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	WorkflowExecutionTimeout *time.Duration        `protobuf:"bytes,5,opt,name=workflow_execution_timeout,json=workflowExecutionTimeout,proto3,stdduration" json:"workflow_execution_timeout,omitempty"`
+	WorkflowRunTimeout       *time.Duration        `protobuf:"bytes,6,opt,name=workflow_run_timeout,json=workflowRunTimeout,proto3,stdduration" json:"workflow_run_timeout,omitempty"`
+	WorkflowTaskTimeout      *time.Duration        `protobuf:"bytes,7,opt,name=workflow_task_timeout,json=workflowTaskTimeout,proto3,stdduration" json:"workflow_task_timeout,omitempty"`
+	RetryPolicy              *v11.RetryPolicy      `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Memo                     *v11.Memo             `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes         *v11.SearchAttributes `protobuf:"bytes,10,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	Header                   *v11.Header           `protobuf:"bytes,11,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *StartWorkflowAction) Reset()      { *m = StartWorkflowAction{} }
+func (*StartWorkflowAction) ProtoMessage() {}
+func (*StartWorkflowAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{1}
+}
+func (m *StartWorkflowAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartWorkflowAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartWorkflowAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartWorkflowAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartWorkflowAction.Merge(m, src)
+}
+func (m *StartWorkflowAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartWorkflowAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartWorkflowAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartWorkflowAction proto.InternalMessageInfo
+
+func (m *StartWorkflowAction) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *StartWorkflowAction) GetWorkflowType() string {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return ""
+}
+
+func (m *StartWorkflowAction) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *StartWorkflowAction) GetInput() *v11.Payloads {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetWorkflowExecutionTimeout() *time.Duration {
+	if m != nil {
+		return m.WorkflowExecutionTimeout
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetWorkflowRunTimeout() *time.Duration {
+	if m != nil {
+		return m.WorkflowRunTimeout
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetWorkflowTaskTimeout() *time.Duration {
+	if m != nil {
+		return m.WorkflowTaskTimeout
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetRetryPolicy() *v11.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetMemo() *v11.Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetSearchAttributes() *v11.SearchAttributes {
+	if m != nil {
+		return m.SearchAttributes
+	}
+	return nil
+}
+
+func (m *StartWorkflowAction) GetHeader() *v11.Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type SchedulePolicies struct {
+	// Policy for overlapping scheduled actions. Default is skip.
+	OverlapPolicy v12.ScheduleOverlapPolicy `protobuf:"varint,1,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.server.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	// If the scheduler was down or paused and an action is more than this late, it is skipped.
+	// Default is one minute.
+	CatchupWindow *time.Duration `protobuf:"bytes,2,opt,name=catchup_window,json=catchupWindow,proto3,stdduration" json:"catchup_window,omitempty"`
+}
+
+func (m *SchedulePolicies) Reset()      { *m = SchedulePolicies{} }
+func (*SchedulePolicies) ProtoMessage() {}
+func (*SchedulePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{2}
+}
+func (m *SchedulePolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePolicies.Merge(m, src)
+}
+func (m *SchedulePolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePolicies proto.InternalMessageInfo
+
+func (m *SchedulePolicies) GetOverlapPolicy() v12.ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return v12.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (m *SchedulePolicies) GetCatchupWindow() *time.Duration {
+	if m != nil {
+		return m.CatchupWindow
+	}
+	return nil
+}
+
+type ScheduleState struct {
+	// Informative notes, set with pause and unpause.
+	Notes  string `protobuf:"bytes,1,opt,name=notes,proto3" json:"notes,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// If limited_actions is true, only remaining_actions more scheduled actions will be taken.
+	// Manual actions don't count towards the limit.
+	LimitedActions   bool  `protobuf:"varint,3,opt,name=limited_actions,json=limitedActions,proto3" json:"limited_actions,omitempty"`
+	RemainingActions int64 `protobuf:"varint,4,opt,name=remaining_actions,json=remainingActions,proto3" json:"remaining_actions,omitempty"`
+}
+
+func (m *ScheduleState) Reset()      { *m = ScheduleState{} }
+func (*ScheduleState) ProtoMessage() {}
+func (*ScheduleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{3}
+}
+func (m *ScheduleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleState.Merge(m, src)
+}
+func (m *ScheduleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleState proto.InternalMessageInfo
+
+func (m *ScheduleState) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *ScheduleState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *ScheduleState) GetLimitedActions() bool {
+	if m != nil {
+		return m.LimitedActions
+	}
+	return false
+}
+
+func (m *ScheduleState) GetRemainingActions() int64 {
+	if m != nil {
+		return m.RemainingActions
+	}
+	return 0
+}
+
+// SchedulePatch is a set of one-off changes to a schedule.
+type SchedulePatch struct {
+	TriggerImmediately *TriggerImmediatelyRequest `protobuf:"bytes,1,opt,name=trigger_immediately,json=triggerImmediately,proto3" json:"trigger_immediately,omitempty"`
+	BackfillRequest    []*BackfillRequest         `protobuf:"bytes,2,rep,name=backfill_request,json=backfillRequest,proto3" json:"backfill_request,omitempty"`
+	// If set, pause the schedule with this string as notes.
+	Pause string `protobuf:"bytes,3,opt,name=pause,proto3" json:"pause,omitempty"`
+	// If set, unpause the schedule with this string as notes.
+	Unpause string `protobuf:"bytes,4,opt,name=unpause,proto3" json:"unpause,omitempty"`
+}
+
+func (m *SchedulePatch) Reset()      { *m = SchedulePatch{} }
+func (*SchedulePatch) ProtoMessage() {}
+func (*SchedulePatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{4}
+}
+func (m *SchedulePatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePatch.Merge(m, src)
+}
+func (m *SchedulePatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePatch proto.InternalMessageInfo
+
+func (m *SchedulePatch) GetTriggerImmediately() *TriggerImmediatelyRequest {
+	if m != nil {
+		return m.TriggerImmediately
+	}
+	return nil
+}
+
+func (m *SchedulePatch) GetBackfillRequest() []*BackfillRequest {
+	if m != nil {
+		return m.BackfillRequest
+	}
+	return nil
+}
+
+func (m *SchedulePatch) GetPause() string {
+	if m != nil {
+		return m.Pause
+	}
+	return ""
+}
+
+func (m *SchedulePatch) GetUnpause() string {
+	if m != nil {
+		return m.Unpause
+	}
+	return ""
+}
+
+type TriggerImmediatelyRequest struct {
+	OverlapPolicy v12.ScheduleOverlapPolicy `protobuf:"varint,1,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.server.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+}
+
+func (m *TriggerImmediatelyRequest) Reset()      { *m = TriggerImmediatelyRequest{} }
+func (*TriggerImmediatelyRequest) ProtoMessage() {}
+func (*TriggerImmediatelyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{5}
+}
+func (m *TriggerImmediatelyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerImmediatelyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerImmediatelyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerImmediatelyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerImmediatelyRequest.Merge(m, src)
+}
+func (m *TriggerImmediatelyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerImmediatelyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerImmediatelyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerImmediatelyRequest proto.InternalMessageInfo
+
+func (m *TriggerImmediatelyRequest) GetOverlapPolicy() v12.ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return v12.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+// BackfillRequest takes all actions that the schedule would have taken in the range
+// [start_time, end_time], ignoring the catchup window and pause.
+type BackfillRequest struct {
+	StartTime     *time.Time                `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	EndTime       *time.Time                `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	OverlapPolicy v12.ScheduleOverlapPolicy `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.server.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+}
+
+func (m *BackfillRequest) Reset()      { *m = BackfillRequest{} }
+func (*BackfillRequest) ProtoMessage() {}
+func (*BackfillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{6}
+}
+func (m *BackfillRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillRequest.Merge(m, src)
+}
+func (m *BackfillRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillRequest proto.InternalMessageInfo
+
+func (m *BackfillRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *BackfillRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *BackfillRequest) GetOverlapPolicy() v12.ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
+	}
+	return v12.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+type ScheduleActionResult struct {
+	// Scheduled time, including jitter.
+	ScheduleTime *time.Time `protobuf:"bytes,1,opt,name=schedule_time,json=scheduleTime,proto3,stdtime" json:"schedule_time,omitempty"`
+	// Time that the action was actually taken.
+	ActualTime          *time.Time             `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3,stdtime" json:"actual_time,omitempty"`
+	StartWorkflowResult *v11.WorkflowExecution `protobuf:"bytes,3,opt,name=start_workflow_result,json=startWorkflowResult,proto3" json:"start_workflow_result,omitempty"`
+}
+
+func (m *ScheduleActionResult) Reset()      { *m = ScheduleActionResult{} }
+func (*ScheduleActionResult) ProtoMessage() {}
+func (*ScheduleActionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{7}
+}
+func (m *ScheduleActionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleActionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleActionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleActionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleActionResult.Merge(m, src)
+}
+func (m *ScheduleActionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleActionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleActionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleActionResult proto.InternalMessageInfo
+
+func (m *ScheduleActionResult) GetScheduleTime() *time.Time {
+	if m != nil {
+		return m.ScheduleTime
+	}
+	return nil
+}
+
+func (m *ScheduleActionResult) GetActualTime() *time.Time {
+	if m != nil {
+		return m.ActualTime
+	}
+	return nil
+}
+
+func (m *ScheduleActionResult) GetStartWorkflowResult() *v11.WorkflowExecution {
+	if m != nil {
+		return m.StartWorkflowResult
+	}
+	return nil
+}
+
+// ScheduleInfo holds statistics and recent history, maintained by the scheduler.
+type ScheduleInfo struct {
+	ActionCount          int64                    `protobuf:"varint,1,opt,name=action_count,json=actionCount,proto3" json:"action_count,omitempty"`
+	MissedCatchupWindow  int64                    `protobuf:"varint,2,opt,name=missed_catchup_window,json=missedCatchupWindow,proto3" json:"missed_catchup_window,omitempty"`
+	OverlapSkipped       int64                    `protobuf:"varint,3,opt,name=overlap_skipped,json=overlapSkipped,proto3" json:"overlap_skipped,omitempty"`
+	RunningWorkflows     []*v11.WorkflowExecution `protobuf:"bytes,4,rep,name=running_workflows,json=runningWorkflows,proto3" json:"running_workflows,omitempty"`
+	RecentActions        []*ScheduleActionResult  `protobuf:"bytes,5,rep,name=recent_actions,json=recentActions,proto3" json:"recent_actions,omitempty"`
+	FutureActionTimes    []*time.Time             `protobuf:"bytes,6,rep,name=future_action_times,json=futureActionTimes,proto3,stdtime" json:"future_action_times,omitempty"`
+	CreateTime           *time.Time               `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
+	UpdateTime           *time.Time               `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time,omitempty"`
+	InvalidScheduleError string                   `protobuf:"bytes,9,opt,name=invalid_schedule_error,json=invalidScheduleError,proto3" json:"invalid_schedule_error,omitempty"`
+}
+
+func (m *ScheduleInfo) Reset()      { *m = ScheduleInfo{} }
+func (*ScheduleInfo) ProtoMessage() {}
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6461b6986ba20ee7, []int{8}
+}
+func (m *ScheduleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleInfo.Merge(m, src)
+}
+func (m *ScheduleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleInfo proto.InternalMessageInfo
+
+func (m *ScheduleInfo) GetActionCount() int64 {
+	if m != nil {
+		return m.ActionCount
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetMissedCatchupWindow() int64 {
+	if m != nil {
+		return m.MissedCatchupWindow
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetOverlapSkipped() int64 {
+	if m != nil {
+		return m.OverlapSkipped
+	}
+	return 0
+}
+
+func (m *ScheduleInfo) GetRunningWorkflows() []*v11.WorkflowExecution {
+	if m != nil {
+		return m.RunningWorkflows
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetRecentActions() []*ScheduleActionResult {
+	if m != nil {
+		return m.RecentActions
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetFutureActionTimes() []*time.Time {
+	if m != nil {
+		return m.FutureActionTimes
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetCreateTime() *time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetUpdateTime() *time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+func (m *ScheduleInfo) GetInvalidScheduleError() string {
+	if m != nil {
+		return m.InvalidScheduleError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Schedule)(nil), "temporal.server.api.schedule.v1.Schedule")
+	proto.RegisterType((*StartWorkflowAction)(nil), "temporal.server.api.schedule.v1.StartWorkflowAction")
+	proto.RegisterType((*SchedulePolicies)(nil), "temporal.server.api.schedule.v1.SchedulePolicies")
+	proto.RegisterType((*ScheduleState)(nil), "temporal.server.api.schedule.v1.ScheduleState")
+	proto.RegisterType((*SchedulePatch)(nil), "temporal.server.api.schedule.v1.SchedulePatch")
+	proto.RegisterType((*TriggerImmediatelyRequest)(nil), "temporal.server.api.schedule.v1.TriggerImmediatelyRequest")
+	proto.RegisterType((*BackfillRequest)(nil), "temporal.server.api.schedule.v1.BackfillRequest")
+	proto.RegisterType((*ScheduleActionResult)(nil), "temporal.server.api.schedule.v1.ScheduleActionResult")
+	proto.RegisterType((*ScheduleInfo)(nil), "temporal.server.api.schedule.v1.ScheduleInfo")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/schedule/v1/message.proto", fileDescriptor_6461b6986ba20ee7)
+}
+
+var fileDescriptor_6461b6986ba20ee7 = []byte{
+	// 1230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x4e, 0x62, 0x8f, 0x93, 0x34, 0x99, 0xa4, 0xd5, 0xd6, 0x82, 0x4d, 0xea, 0xa2,
+	0x52, 0x54, 0xb1, 0x6e, 0xd3, 0xd2, 0x43, 0x7b, 0x40, 0x4d, 0x3f, 0x44, 0x25, 0x2a, 0xd2, 0x71,
+	0xa0, 0x52, 0xa1, 0x5a, 0x4d, 0x76, 0x27, 0xce, 0xca, 0xbb, 0x3b, 0xdb, 0x99, 0xd9, 0x04, 0xdf,
+	0xb8, 0x72, 0xab, 0xc4, 0x85, 0x9f, 0xc0, 0x2f, 0xe0, 0x07, 0x70, 0xe2, 0xc0, 0xa1, 0xe2, 0xd4,
+	0x1b, 0xd4, 0xbd, 0x20, 0x71, 0xe9, 0x3f, 0x00, 0xcd, 0xd7, 0x3a, 0x71, 0x62, 0xc5, 0x95, 0xe0,
+	0xe6, 0x7d, 0xe7, 0x79, 0x9e, 0x9d, 0xf7, 0x99, 0xf7, 0x7d, 0x67, 0x0d, 0x3e, 0x16, 0x24, 0xcd,
+	0x29, 0xc3, 0x49, 0x9b, 0x13, 0xb6, 0x4f, 0x58, 0x1b, 0xe7, 0x71, 0x9b, 0x87, 0x7b, 0x24, 0x2a,
+	0x12, 0xd2, 0xde, 0xbf, 0xd6, 0x4e, 0x09, 0xe7, 0xb8, 0x4b, 0xfc, 0x9c, 0x51, 0x41, 0xe1, 0x9a,
+	0x85, 0xfb, 0x1a, 0xee, 0xe3, 0x3c, 0xf6, 0x2d, 0xdc, 0xdf, 0xbf, 0xd6, 0xf4, 0xba, 0x94, 0x76,
+	0x13, 0xd2, 0x56, 0xf0, 0x9d, 0x62, 0xb7, 0x1d, 0x15, 0x0c, 0x8b, 0x98, 0x66, 0x5a, 0xa0, 0xb9,
+	0x36, 0xba, 0x2e, 0xe2, 0x94, 0x70, 0x81, 0xd3, 0xdc, 0x00, 0x2e, 0x44, 0x24, 0x27, 0x59, 0x44,
+	0xb2, 0x30, 0x26, 0xbc, 0xdd, 0xa5, 0x5d, 0xaa, 0xe2, 0xea, 0x97, 0x81, 0x7c, 0x50, 0xee, 0x59,
+	0x6e, 0x36, 0xa4, 0x69, 0x4a, 0xb3, 0x63, 0x5b, 0x6d, 0x5e, 0x3a, 0x82, 0x1a, 0x9b, 0x52, 0xf3,
+	0xca, 0x49, 0x0e, 0x90, 0xac, 0x48, 0xb9, 0xc4, 0x96, 0xb9, 0x29, 0x70, 0xeb, 0xb7, 0x69, 0x50,
+	0xeb, 0x98, 0x10, 0xbc, 0x05, 0xaa, 0x3c, 0x27, 0xa1, 0xeb, 0xac, 0x3b, 0x97, 0x1b, 0x1b, 0x97,
+	0xfc, 0xd2, 0x9b, 0x11, 0x53, 0x7c, 0xcb, 0xe8, 0xe4, 0x24, 0x44, 0x8a, 0x03, 0x2f, 0x80, 0xf9,
+	0x90, 0xd1, 0x2c, 0xe0, 0x82, 0xc5, 0x59, 0x97, 0xbb, 0xd3, 0xeb, 0x95, 0xcb, 0x75, 0xd4, 0x90,
+	0xb1, 0x8e, 0x0e, 0xc1, 0xcf, 0xc1, 0x2c, 0x0e, 0xa5, 0x75, 0x6e, 0x45, 0xbd, 0xe0, 0x86, 0x7f,
+	0x8a, 0xf9, 0x7e, 0x47, 0x60, 0x26, 0x9e, 0x50, 0xd6, 0xdb, 0x4d, 0xe8, 0xc1, 0x1d, 0xc5, 0x45,
+	0x46, 0x03, 0x3e, 0x02, 0xb5, 0x9c, 0x26, 0xb1, 0x74, 0xd5, 0xad, 0x2a, 0xbd, 0x6b, 0xa7, 0xeb,
+	0x99, 0xdf, 0x5b, 0x86, 0x88, 0x4a, 0x09, 0x78, 0x0f, 0xcc, 0x70, 0x81, 0x05, 0x71, 0x67, 0x94,
+	0x96, 0x3f, 0xb1, 0x56, 0x47, 0xb2, 0x90, 0x26, 0xb7, 0x7e, 0x99, 0x01, 0x2b, 0x27, 0x6c, 0x1a,
+	0xae, 0x81, 0xc6, 0x81, 0x89, 0x04, 0x71, 0xa4, 0x0c, 0xae, 0x23, 0x60, 0x43, 0x0f, 0x23, 0x78,
+	0x11, 0x2c, 0x94, 0x00, 0xd1, 0xcf, 0x89, 0x3b, 0xad, 0x20, 0xf3, 0x36, 0xb8, 0xdd, 0xcf, 0x09,
+	0x7c, 0x1f, 0x00, 0x81, 0x79, 0x2f, 0x78, 0x5e, 0x90, 0x82, 0x28, 0x13, 0xeb, 0xa8, 0x2e, 0x23,
+	0x8f, 0x65, 0x00, 0xde, 0x04, 0x33, 0x71, 0x96, 0x17, 0xc2, 0xd8, 0xb1, 0x7e, 0xf4, 0xfc, 0x74,
+	0x59, 0xc9, 0x9d, 0x6f, 0xe1, 0x7e, 0x42, 0x71, 0xc4, 0x91, 0x86, 0xc3, 0x67, 0xa0, 0x59, 0xbe,
+	0x9b, 0x7c, 0x4b, 0xc2, 0x42, 0x6e, 0x39, 0x90, 0x75, 0x4c, 0x0b, 0x61, 0xfc, 0x38, 0xef, 0xeb,
+	0x3a, 0xf7, 0x6d, 0x9d, 0xfb, 0xf7, 0x4c, 0x1f, 0x6c, 0x56, 0x7f, 0xfc, 0x63, 0xcd, 0x41, 0xae,
+	0x95, 0xb8, 0x6f, 0x15, 0xb6, 0xb5, 0x00, 0x7c, 0x0c, 0x56, 0x4b, 0x79, 0x56, 0x0c, 0x85, 0x67,
+	0x27, 0x13, 0x86, 0x96, 0x8c, 0x8a, 0x52, 0xb2, 0x03, 0xce, 0x0e, 0xdd, 0x92, 0x8e, 0x58, 0xcd,
+	0xb9, 0xc9, 0x34, 0x57, 0x4a, 0x5b, 0x31, 0xef, 0x59, 0xd1, 0x07, 0x60, 0x9e, 0x11, 0xc1, 0xfa,
+	0x81, 0xaa, 0x89, 0xbe, 0x5b, 0x53, 0x5a, 0x17, 0xc7, 0xb9, 0x88, 0x24, 0x56, 0x15, 0x52, 0x1f,
+	0x35, 0xd8, 0xf0, 0x01, 0x5e, 0x05, 0xd5, 0x94, 0xa4, 0xd4, 0xad, 0x2b, 0xfe, 0x7b, 0xe3, 0xf8,
+	0x8f, 0x48, 0x4a, 0x91, 0x42, 0xc2, 0x2f, 0xc1, 0x32, 0x27, 0x98, 0x85, 0x7b, 0x01, 0x16, 0x82,
+	0xc5, 0x3b, 0x85, 0x20, 0xdc, 0x05, 0x8a, 0x7e, 0x79, 0x1c, 0xbd, 0xa3, 0x08, 0x77, 0x4a, 0x3c,
+	0x5a, 0xe2, 0x23, 0x11, 0x78, 0x13, 0xcc, 0xee, 0x11, 0x1c, 0x11, 0xe6, 0x36, 0x94, 0x96, 0x37,
+	0x4e, 0xeb, 0x33, 0x85, 0x42, 0x06, 0xdd, 0xfa, 0xd9, 0x01, 0x4b, 0xa3, 0x9d, 0x02, 0x9f, 0x82,
+	0x45, 0xba, 0x4f, 0x58, 0x82, 0x73, 0xeb, 0x8f, 0x2c, 0xe2, 0xc5, 0x8d, 0xeb, 0x27, 0x36, 0x8a,
+	0x1a, 0x37, 0x87, 0xbb, 0xe4, 0x0b, 0xcd, 0x35, 0x7e, 0x2d, 0xd0, 0xc3, 0x8f, 0xf0, 0x01, 0x58,
+	0x0c, 0xb1, 0x08, 0xf7, 0x8a, 0x3c, 0x38, 0x88, 0xb3, 0x88, 0x1e, 0xb8, 0xd3, 0x93, 0x9d, 0xe3,
+	0x82, 0xa1, 0x3d, 0x51, 0xac, 0xd6, 0x0f, 0x0e, 0x58, 0x38, 0xd2, 0x96, 0x70, 0x15, 0xcc, 0x64,
+	0x54, 0xba, 0xa9, 0x3b, 0x4e, 0x3f, 0xc0, 0x73, 0x60, 0x36, 0xc7, 0x05, 0x27, 0x91, 0x7a, 0x4f,
+	0x0d, 0x99, 0x27, 0xf8, 0x21, 0x38, 0x93, 0xc4, 0x69, 0x2c, 0x48, 0x14, 0xe8, 0x21, 0xc3, 0x55,
+	0x93, 0xd5, 0xd0, 0xa2, 0x09, 0xeb, 0x6e, 0xe6, 0xf0, 0x0a, 0x58, 0x66, 0x24, 0xc5, 0x71, 0x16,
+	0x67, 0xdd, 0x12, 0x2a, 0xbb, 0xae, 0x82, 0x96, 0xca, 0x05, 0x03, 0x6e, 0x7d, 0x3f, 0x3d, 0xdc,
+	0xd5, 0x96, 0xdc, 0x2f, 0xec, 0x81, 0x15, 0xc1, 0xe2, 0x6e, 0x97, 0xb0, 0x20, 0x4e, 0x53, 0x12,
+	0xc5, 0x58, 0x90, 0xa4, 0x6f, 0xc6, 0xee, 0xad, 0x53, 0x27, 0xcf, 0xb6, 0xe6, 0x3e, 0x1c, 0x52,
+	0x11, 0x79, 0x5e, 0x10, 0x2e, 0x10, 0x14, 0xc7, 0x96, 0xe0, 0xd7, 0x60, 0x69, 0x07, 0x87, 0xbd,
+	0xdd, 0x38, 0x49, 0x02, 0xa6, 0x71, 0x6a, 0x38, 0x37, 0x36, 0xae, 0x9e, 0xfa, 0xa6, 0x4d, 0x43,
+	0xb4, 0xfa, 0x67, 0x76, 0x8e, 0x06, 0xa4, 0xbf, 0xca, 0x3b, 0x33, 0x8c, 0xf4, 0x03, 0x74, 0xc1,
+	0x5c, 0x91, 0xe9, 0x78, 0x55, 0xc5, 0xed, 0x63, 0xeb, 0x00, 0x9c, 0x1f, 0xbb, 0xfb, 0xff, 0xb3,
+	0xc4, 0x5a, 0x7f, 0x3b, 0xe0, 0xcc, 0x48, 0x36, 0xf0, 0x53, 0x00, 0xb8, 0x9c, 0xd5, 0x6a, 0x7a,
+	0x18, 0xf7, 0x9b, 0xc7, 0x4a, 0x6e, 0xdb, 0xde, 0xe7, 0x9b, 0xd5, 0x17, 0xb2, 0xe6, 0xea, 0x8a,
+	0x23, 0xa3, 0xf0, 0x36, 0xa8, 0x91, 0x2c, 0xd2, 0xf4, 0xe9, 0x09, 0xe9, 0x73, 0x24, 0x8b, 0x14,
+	0xf9, 0x78, 0xb6, 0x95, 0xff, 0x2c, 0xdb, 0x7f, 0x1c, 0xb0, 0x6a, 0x81, 0xe6, 0xda, 0x24, 0xbc,
+	0x48, 0x04, 0xbc, 0x0f, 0x16, 0xec, 0xf9, 0xbe, 0x5b, 0xd6, 0xf3, 0x96, 0xa6, 0xf6, 0x7e, 0x07,
+	0x34, 0x70, 0x28, 0x0a, 0x9c, 0xbc, 0x5b, 0xee, 0x40, 0x93, 0x94, 0xc4, 0x33, 0x70, 0x56, 0x9b,
+	0x3f, 0xbc, 0x1b, 0xd4, 0x16, 0xcd, 0xb7, 0xc1, 0x47, 0xe3, 0x66, 0xd5, 0x93, 0xd1, 0x6b, 0x06,
+	0xad, 0xf0, 0xc3, 0x17, 0xae, 0x4e, 0xb4, 0xf5, 0x7b, 0x15, 0xcc, 0x5b, 0x07, 0x1e, 0x66, 0xbb,
+	0x54, 0x7e, 0x9f, 0xe8, 0x46, 0x0d, 0x42, 0x5a, 0x64, 0x42, 0x25, 0x5e, 0x41, 0x0d, 0x1d, 0xbb,
+	0x2b, 0x43, 0x70, 0x03, 0x9c, 0x4d, 0x63, 0xce, 0x49, 0x14, 0x9c, 0x30, 0x8d, 0x2a, 0x68, 0x45,
+	0x2f, 0xde, 0x3d, 0x3c, 0x72, 0xe4, 0xc8, 0xb0, 0xa7, 0xc8, 0x7b, 0x71, 0x9e, 0x93, 0x48, 0x25,
+	0x50, 0x41, 0xf6, 0x70, 0x3b, 0x3a, 0x0a, 0xbf, 0x02, 0xcb, 0xac, 0xc8, 0xd4, 0xc0, 0xb0, 0x19,
+	0xcb, 0x91, 0x51, 0x79, 0xb7, 0x5c, 0x97, 0x8c, 0x86, 0x5d, 0xe1, 0xf0, 0x1b, 0xb0, 0xc8, 0x48,
+	0x48, 0x32, 0x51, 0xce, 0xa1, 0x19, 0x25, 0xfa, 0xc9, 0xc4, 0x1f, 0x30, 0x87, 0x0b, 0x04, 0x2d,
+	0x68, 0x31, 0x3b, 0xe8, 0xb6, 0xc0, 0xca, 0x6e, 0x21, 0x0a, 0x46, 0x8c, 0xba, 0x3a, 0x6f, 0xee,
+	0xce, 0xae, 0x57, 0x26, 0x3a, 0xf0, 0x65, 0x4d, 0xd6, 0x6a, 0x6a, 0x51, 0x96, 0x4e, 0xc8, 0x08,
+	0x16, 0xa6, 0xfe, 0xe6, 0x26, 0x2d, 0x1d, 0x4d, 0xb2, 0xd5, 0x57, 0xe4, 0x51, 0x29, 0x51, 0x9b,
+	0x54, 0x42, 0x93, 0x94, 0xc4, 0x0d, 0x70, 0x2e, 0xce, 0xf6, 0x71, 0x12, 0x47, 0x41, 0xd9, 0x0f,
+	0x84, 0x31, 0xca, 0xd4, 0xad, 0x5d, 0x47, 0xab, 0x66, 0xd5, 0x7a, 0x74, 0x5f, 0xae, 0x6d, 0x46,
+	0x2f, 0x5f, 0x7b, 0x53, 0xaf, 0x5e, 0x7b, 0x53, 0x6f, 0x5f, 0x7b, 0xce, 0x77, 0x03, 0xcf, 0xf9,
+	0x69, 0xe0, 0x39, 0xbf, 0x0e, 0x3c, 0xe7, 0xe5, 0xc0, 0x73, 0xfe, 0x1c, 0x78, 0xce, 0x5f, 0x03,
+	0x6f, 0xea, 0xed, 0xc0, 0x73, 0x5e, 0xbc, 0xf1, 0xa6, 0x5e, 0xbe, 0xf1, 0xa6, 0x5e, 0xbd, 0xf1,
+	0xa6, 0x9e, 0xfa, 0x5d, 0x3a, 0x3c, 0x8b, 0x98, 0x8e, 0xf9, 0x5f, 0x72, 0xdb, 0xfe, 0xde, 0x99,
+	0x55, 0x19, 0x5c, 0xff, 0x77, 0x00, 0x83, 0x4d, 0xcf, 0x88, 0xca, 0x0c, 0x00, 0x00,
+}
+
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Spec.Equal(that1.Spec) {
+		return false
+	}
+	if len(this.CronStrings) != len(that1.CronStrings) {
+		return false
+	}
+	for i := range this.CronStrings {
+		if this.CronStrings[i] != that1.CronStrings[i] {
+			return false
+		}
+	}
+	if !this.Action.Equal(that1.Action) {
+		return false
+	}
+	if !this.Policies.Equal(that1.Policies) {
+		return false
+	}
+	if !this.State.Equal(that1.State) {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowAction)
+	if !ok {
+		that2, ok := that.(StartWorkflowAction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.WorkflowType != that1.WorkflowType {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if this.WorkflowExecutionTimeout != nil && that1.WorkflowExecutionTimeout != nil {
+		if *this.WorkflowExecutionTimeout != *that1.WorkflowExecutionTimeout {
+			return false
+		}
+	} else if this.WorkflowExecutionTimeout != nil {
+		return false
+	} else if that1.WorkflowExecutionTimeout != nil {
+		return false
+	}
+	if this.WorkflowRunTimeout != nil && that1.WorkflowRunTimeout != nil {
+		if *this.WorkflowRunTimeout != *that1.WorkflowRunTimeout {
+			return false
+		}
+	} else if this.WorkflowRunTimeout != nil {
+		return false
+	} else if that1.WorkflowRunTimeout != nil {
+		return false
+	}
+	if this.WorkflowTaskTimeout != nil && that1.WorkflowTaskTimeout != nil {
+		if *this.WorkflowTaskTimeout != *that1.WorkflowTaskTimeout {
+			return false
+		}
+	} else if this.WorkflowTaskTimeout != nil {
+		return false
+	} else if that1.WorkflowTaskTimeout != nil {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if !this.Memo.Equal(that1.Memo) {
+		return false
+	}
+	if !this.SearchAttributes.Equal(that1.SearchAttributes) {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	return true
+}
+func (this *SchedulePolicies) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchedulePolicies)
+	if !ok {
+		that2, ok := that.(SchedulePolicies)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OverlapPolicy != that1.OverlapPolicy {
+		return false
+	}
+	if this.CatchupWindow != nil && that1.CatchupWindow != nil {
+		if *this.CatchupWindow != *that1.CatchupWindow {
+			return false
+		}
+	} else if this.CatchupWindow != nil {
+		return false
+	} else if that1.CatchupWindow != nil {
+		return false
+	}
+	return true
+}
+func (this *ScheduleState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleState)
+	if !ok {
+		that2, ok := that.(ScheduleState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Notes != that1.Notes {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.LimitedActions != that1.LimitedActions {
+		return false
+	}
+	if this.RemainingActions != that1.RemainingActions {
+		return false
+	}
+	return true
+}
+func (this *SchedulePatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchedulePatch)
+	if !ok {
+		that2, ok := that.(SchedulePatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TriggerImmediately.Equal(that1.TriggerImmediately) {
+		return false
+	}
+	if len(this.BackfillRequest) != len(that1.BackfillRequest) {
+		return false
+	}
+	for i := range this.BackfillRequest {
+		if !this.BackfillRequest[i].Equal(that1.BackfillRequest[i]) {
+			return false
+		}
+	}
+	if this.Pause != that1.Pause {
+		return false
+	}
+	if this.Unpause != that1.Unpause {
+		return false
+	}
+	return true
+}
+func (this *TriggerImmediatelyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TriggerImmediatelyRequest)
+	if !ok {
+		that2, ok := that.(TriggerImmediatelyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OverlapPolicy != that1.OverlapPolicy {
+		return false
+	}
+	return true
+}
+func (this *BackfillRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BackfillRequest)
+	if !ok {
+		that2, ok := that.(BackfillRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
+		}
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
+	}
+	if this.OverlapPolicy != that1.OverlapPolicy {
+		return false
+	}
+	return true
+}
+func (this *ScheduleActionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleActionResult)
+	if !ok {
+		that2, ok := that.(ScheduleActionResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.ScheduleTime == nil {
+		if this.ScheduleTime != nil {
+			return false
+		}
+	} else if !this.ScheduleTime.Equal(*that1.ScheduleTime) {
+		return false
+	}
+	if that1.ActualTime == nil {
+		if this.ActualTime != nil {
+			return false
+		}
+	} else if !this.ActualTime.Equal(*that1.ActualTime) {
+		return false
+	}
+	if !this.StartWorkflowResult.Equal(that1.StartWorkflowResult) {
+		return false
+	}
+	return true
+}
+func (this *ScheduleInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduleInfo)
+	if !ok {
+		that2, ok := that.(ScheduleInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ActionCount != that1.ActionCount {
+		return false
+	}
+	if this.MissedCatchupWindow != that1.MissedCatchupWindow {
+		return false
+	}
+	if this.OverlapSkipped != that1.OverlapSkipped {
+		return false
+	}
+	if len(this.RunningWorkflows) != len(that1.RunningWorkflows) {
+		return false
+	}
+	for i := range this.RunningWorkflows {
+		if !this.RunningWorkflows[i].Equal(that1.RunningWorkflows[i]) {
+			return false
+		}
+	}
+	if len(this.RecentActions) != len(that1.RecentActions) {
+		return false
+	}
+	for i := range this.RecentActions {
+		if !this.RecentActions[i].Equal(that1.RecentActions[i]) {
+			return false
+		}
+	}
+	if len(this.FutureActionTimes) != len(that1.FutureActionTimes) {
+		return false
+	}
+	for i := range this.FutureActionTimes {
+		if !this.FutureActionTimes[i].Equal(*that1.FutureActionTimes[i]) {
+			return false
+		}
+	}
+	if that1.CreateTime == nil {
+		if this.CreateTime != nil {
+			return false
+		}
+	} else if !this.CreateTime.Equal(*that1.CreateTime) {
+		return false
+	}
+	if that1.UpdateTime == nil {
+		if this.UpdateTime != nil {
+			return false
+		}
+	} else if !this.UpdateTime.Equal(*that1.UpdateTime) {
+		return false
+	}
+	if this.InvalidScheduleError != that1.InvalidScheduleError {
+		return false
+	}
+	return true
+}
+func (this *Schedule) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&schedule.Schedule{")
+	if this.Spec != nil {
+		s = append(s, "Spec: "+fmt.Sprintf("%#v", this.Spec)+",\n")
+	}
+	s = append(s, "CronStrings: "+fmt.Sprintf("%#v", this.CronStrings)+",\n")
+	if this.Action != nil {
+		s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	}
+	if this.Policies != nil {
+		s = append(s, "Policies: "+fmt.Sprintf("%#v", this.Policies)+",\n")
+	}
+	if this.State != nil {
+		s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartWorkflowAction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&schedule.StartWorkflowAction{")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "WorkflowExecutionTimeout: "+fmt.Sprintf("%#v", this.WorkflowExecutionTimeout)+",\n")
+	s = append(s, "WorkflowRunTimeout: "+fmt.Sprintf("%#v", this.WorkflowRunTimeout)+",\n")
+	s = append(s, "WorkflowTaskTimeout: "+fmt.Sprintf("%#v", this.WorkflowTaskTimeout)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	if this.Memo != nil {
+		s = append(s, "Memo: "+fmt.Sprintf("%#v", this.Memo)+",\n")
+	}
+	if this.SearchAttributes != nil {
+		s = append(s, "SearchAttributes: "+fmt.Sprintf("%#v", this.SearchAttributes)+",\n")
+	}
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SchedulePolicies) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&schedule.SchedulePolicies{")
+	s = append(s, "OverlapPolicy: "+fmt.Sprintf("%#v", this.OverlapPolicy)+",\n")
+	s = append(s, "CatchupWindow: "+fmt.Sprintf("%#v", this.CatchupWindow)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&schedule.ScheduleState{")
+	s = append(s, "Notes: "+fmt.Sprintf("%#v", this.Notes)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "LimitedActions: "+fmt.Sprintf("%#v", this.LimitedActions)+",\n")
+	s = append(s, "RemainingActions: "+fmt.Sprintf("%#v", this.RemainingActions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SchedulePatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&schedule.SchedulePatch{")
+	if this.TriggerImmediately != nil {
+		s = append(s, "TriggerImmediately: "+fmt.Sprintf("%#v", this.TriggerImmediately)+",\n")
+	}
+	if this.BackfillRequest != nil {
+		s = append(s, "BackfillRequest: "+fmt.Sprintf("%#v", this.BackfillRequest)+",\n")
+	}
+	s = append(s, "Pause: "+fmt.Sprintf("%#v", this.Pause)+",\n")
+	s = append(s, "Unpause: "+fmt.Sprintf("%#v", this.Unpause)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TriggerImmediatelyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schedule.TriggerImmediatelyRequest{")
+	s = append(s, "OverlapPolicy: "+fmt.Sprintf("%#v", this.OverlapPolicy)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BackfillRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&schedule.BackfillRequest{")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "EndTime: "+fmt.Sprintf("%#v", this.EndTime)+",\n")
+	s = append(s, "OverlapPolicy: "+fmt.Sprintf("%#v", this.OverlapPolicy)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleActionResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&schedule.ScheduleActionResult{")
+	s = append(s, "ScheduleTime: "+fmt.Sprintf("%#v", this.ScheduleTime)+",\n")
+	s = append(s, "ActualTime: "+fmt.Sprintf("%#v", this.ActualTime)+",\n")
+	if this.StartWorkflowResult != nil {
+		s = append(s, "StartWorkflowResult: "+fmt.Sprintf("%#v", this.StartWorkflowResult)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduleInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&schedule.ScheduleInfo{")
+	s = append(s, "ActionCount: "+fmt.Sprintf("%#v", this.ActionCount)+",\n")
+	s = append(s, "MissedCatchupWindow: "+fmt.Sprintf("%#v", this.MissedCatchupWindow)+",\n")
+	s = append(s, "OverlapSkipped: "+fmt.Sprintf("%#v", this.OverlapSkipped)+",\n")
+	if this.RunningWorkflows != nil {
+		s = append(s, "RunningWorkflows: "+fmt.Sprintf("%#v", this.RunningWorkflows)+",\n")
+	}
+	if this.RecentActions != nil {
+		s = append(s, "RecentActions: "+fmt.Sprintf("%#v", this.RecentActions)+",\n")
+	}
+	s = append(s, "FutureActionTimes: "+fmt.Sprintf("%#v", this.FutureActionTimes)+",\n")
+	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
+	s = append(s, "UpdateTime: "+fmt.Sprintf("%#v", this.UpdateTime)+",\n")
+	s = append(s, "InvalidScheduleError: "+fmt.Sprintf("%#v", this.InvalidScheduleError)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Policies != nil {
+		{
+			size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CronStrings) > 0 {
+		for iNdEx := len(m.CronStrings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CronStrings[iNdEx])
+			copy(dAtA[i:], m.CronStrings[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.CronStrings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartWorkflowAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SearchAttributes != nil {
+		{
+			size, err := m.SearchAttributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WorkflowTaskTimeout != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMessage(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkflowRunTimeout != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMessage(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WorkflowExecutionTimeout != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintMessage(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowType) > 0 {
+		i -= len(m.WorkflowType)
+		copy(dAtA[i:], m.WorkflowType)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CatchupWindow != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.CatchupWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.CatchupWindow):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintMessage(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingActions != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RemainingActions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LimitedActions {
+		i--
+		if m.LimitedActions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unpause) > 0 {
+		i -= len(m.Unpause)
+		copy(dAtA[i:], m.Unpause)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Unpause)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Pause) > 0 {
+		i -= len(m.Pause)
+		copy(dAtA[i:], m.Pause)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Pause)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BackfillRequest) > 0 {
+		for iNdEx := len(m.BackfillRequest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackfillRequest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TriggerImmediately != nil {
+		{
+			size, err := m.TriggerImmediately.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerImmediatelyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerImmediatelyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerImmediatelyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndTime != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintMessage(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartTime != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintMessage(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleActionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleActionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleActionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartWorkflowResult != nil {
+		{
+			size, err := m.StartWorkflowResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActualTime != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ActualTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActualTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintMessage(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduleTime != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduleTime):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintMessage(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidScheduleError) > 0 {
+		i -= len(m.InvalidScheduleError)
+		copy(dAtA[i:], m.InvalidScheduleError)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.InvalidScheduleError)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.UpdateTime != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CreateTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintMessage(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FutureActionTimes) > 0 {
+		for iNdEx := len(m.FutureActionTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FutureActionTimes[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FutureActionTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintMessage(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RecentActions) > 0 {
+		for iNdEx := len(m.RecentActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RunningWorkflows) > 0 {
+		for iNdEx := len(m.RunningWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunningWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OverlapSkipped != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.OverlapSkipped))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedCatchupWindow != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MissedCatchupWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActionCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ActionCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.CronStrings) > 0 {
+		for _, s := range m.CronStrings {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Policies != nil {
+		l = m.Policies.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *StartWorkflowAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowType)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.WorkflowExecutionTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.WorkflowRunTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.WorkflowTaskTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.SearchAttributes != nil {
+		l = m.SearchAttributes.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovMessage(uint64(m.OverlapPolicy))
+	}
+	if m.CatchupWindow != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.CatchupWindow)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.LimitedActions {
+		n += 2
+	}
+	if m.RemainingActions != 0 {
+		n += 1 + sovMessage(uint64(m.RemainingActions))
+	}
+	return n
+}
+
+func (m *SchedulePatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggerImmediately != nil {
+		l = m.TriggerImmediately.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.BackfillRequest) > 0 {
+		for _, e := range m.BackfillRequest {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.Pause)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Unpause)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *TriggerImmediatelyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovMessage(uint64(m.OverlapPolicy))
+	}
+	return n
+}
+
+func (m *BackfillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovMessage(uint64(m.OverlapPolicy))
+	}
+	return n
+}
+
+func (m *ScheduleActionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduleTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ActualTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ActualTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartWorkflowResult != nil {
+		l = m.StartWorkflowResult.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionCount != 0 {
+		n += 1 + sovMessage(uint64(m.ActionCount))
+	}
+	if m.MissedCatchupWindow != 0 {
+		n += 1 + sovMessage(uint64(m.MissedCatchupWindow))
+	}
+	if m.OverlapSkipped != 0 {
+		n += 1 + sovMessage(uint64(m.OverlapSkipped))
+	}
+	if len(m.RunningWorkflows) > 0 {
+		for _, e := range m.RunningWorkflows {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.RecentActions) > 0 {
+		for _, e := range m.RecentActions {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.FutureActionTimes) > 0 {
+		for _, e := range m.FutureActionTimes {
+			l = github_com_gogo_protobuf_types.SizeOfStdTime(*e)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.CreateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.UpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.InvalidScheduleError)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Schedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Schedule{`,
+		`Spec:` + strings.Replace(fmt.Sprintf("%v", this.Spec), "ScheduleSpec", "v1.ScheduleSpec", 1) + `,`,
+		`CronStrings:` + fmt.Sprintf("%v", this.CronStrings) + `,`,
+		`Action:` + strings.Replace(this.Action.String(), "StartWorkflowAction", "StartWorkflowAction", 1) + `,`,
+		`Policies:` + strings.Replace(this.Policies.String(), "SchedulePolicies", "SchedulePolicies", 1) + `,`,
+		`State:` + strings.Replace(this.State.String(), "ScheduleState", "ScheduleState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartWorkflowAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowAction{`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`WorkflowType:` + fmt.Sprintf("%v", this.WorkflowType) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
+		`WorkflowExecutionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTimeout), "Duration", "types.Duration", 1) + `,`,
+		`WorkflowRunTimeout:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowRunTimeout), "Duration", "types.Duration", 1) + `,`,
+		`WorkflowTaskTimeout:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskTimeout), "Duration", "types.Duration", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "RetryPolicy", "v11.RetryPolicy", 1) + `,`,
+		`Memo:` + strings.Replace(fmt.Sprintf("%v", this.Memo), "Memo", "v11.Memo", 1) + `,`,
+		`SearchAttributes:` + strings.Replace(fmt.Sprintf("%v", this.SearchAttributes), "SearchAttributes", "v11.SearchAttributes", 1) + `,`,
+		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SchedulePolicies) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SchedulePolicies{`,
+		`OverlapPolicy:` + fmt.Sprintf("%v", this.OverlapPolicy) + `,`,
+		`CatchupWindow:` + strings.Replace(fmt.Sprintf("%v", this.CatchupWindow), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleState{`,
+		`Notes:` + fmt.Sprintf("%v", this.Notes) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`LimitedActions:` + fmt.Sprintf("%v", this.LimitedActions) + `,`,
+		`RemainingActions:` + fmt.Sprintf("%v", this.RemainingActions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SchedulePatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBackfillRequest := "[]*BackfillRequest{"
+	for _, f := range this.BackfillRequest {
+		repeatedStringForBackfillRequest += strings.Replace(f.String(), "BackfillRequest", "BackfillRequest", 1) + ","
+	}
+	repeatedStringForBackfillRequest += "}"
+	s := strings.Join([]string{`&SchedulePatch{`,
+		`TriggerImmediately:` + strings.Replace(this.TriggerImmediately.String(), "TriggerImmediatelyRequest", "TriggerImmediatelyRequest", 1) + `,`,
+		`BackfillRequest:` + repeatedStringForBackfillRequest + `,`,
+		`Pause:` + fmt.Sprintf("%v", this.Pause) + `,`,
+		`Unpause:` + fmt.Sprintf("%v", this.Unpause) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerImmediatelyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerImmediatelyRequest{`,
+		`OverlapPolicy:` + fmt.Sprintf("%v", this.OverlapPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BackfillRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BackfillRequest{`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`EndTime:` + strings.Replace(fmt.Sprintf("%v", this.EndTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`OverlapPolicy:` + fmt.Sprintf("%v", this.OverlapPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleActionResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleActionResult{`,
+		`ScheduleTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ActualTime:` + strings.Replace(fmt.Sprintf("%v", this.ActualTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartWorkflowResult:` + strings.Replace(fmt.Sprintf("%v", this.StartWorkflowResult), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRunningWorkflows := "[]*WorkflowExecution{"
+	for _, f := range this.RunningWorkflows {
+		repeatedStringForRunningWorkflows += strings.Replace(fmt.Sprintf("%v", f), "WorkflowExecution", "v11.WorkflowExecution", 1) + ","
+	}
+	repeatedStringForRunningWorkflows += "}"
+	repeatedStringForRecentActions := "[]*ScheduleActionResult{"
+	for _, f := range this.RecentActions {
+		repeatedStringForRecentActions += strings.Replace(f.String(), "ScheduleActionResult", "ScheduleActionResult", 1) + ","
+	}
+	repeatedStringForRecentActions += "}"
+	repeatedStringForFutureActionTimes := "[]*Timestamp{"
+	for _, f := range this.FutureActionTimes {
+		repeatedStringForFutureActionTimes += strings.Replace(fmt.Sprintf("%v", f), "Timestamp", "types.Timestamp", 1) + ","
+	}
+	repeatedStringForFutureActionTimes += "}"
+	s := strings.Join([]string{`&ScheduleInfo{`,
+		`ActionCount:` + fmt.Sprintf("%v", this.ActionCount) + `,`,
+		`MissedCatchupWindow:` + fmt.Sprintf("%v", this.MissedCatchupWindow) + `,`,
+		`OverlapSkipped:` + fmt.Sprintf("%v", this.OverlapSkipped) + `,`,
+		`RunningWorkflows:` + repeatedStringForRunningWorkflows + `,`,
+		`RecentActions:` + repeatedStringForRecentActions + `,`,
+		`FutureActionTimes:` + repeatedStringForFutureActionTimes + `,`,
+		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.UpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`InvalidScheduleError:` + fmt.Sprintf("%v", this.InvalidScheduleError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &v1.ScheduleSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronStrings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronStrings = append(m.CronStrings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Action == nil {
+				m.Action = &StartWorkflowAction{}
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policies == nil {
+				m.Policies = &SchedulePolicies{}
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &ScheduleState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &v11.Payloads{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTimeout == nil {
+				m.WorkflowExecutionTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowExecutionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowRunTimeout == nil {
+				m.WorkflowRunTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowRunTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTaskTimeout == nil {
+				m.WorkflowTaskTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowTaskTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v11.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &v11.Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = &v11.SearchAttributes{}
+			}
+			if err := m.SearchAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v11.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= v12.ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchupWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CatchupWindow == nil {
+				m.CatchupWindow = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.CatchupWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitedActions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LimitedActions = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingActions", wireType)
+			}
+			m.RemainingActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingActions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerImmediately", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerImmediately == nil {
+				m.TriggerImmediately = &TriggerImmediatelyRequest{}
+			}
+			if err := m.TriggerImmediately.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackfillRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackfillRequest = append(m.BackfillRequest, &BackfillRequest{})
+			if err := m.BackfillRequest[len(m.BackfillRequest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unpause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerImmediatelyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerImmediatelyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerImmediatelyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= v12.ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackfillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= v12.ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleActionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleActionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleActionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleTime == nil {
+				m.ScheduleTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualTime == nil {
+				m.ActualTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ActualTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkflowResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartWorkflowResult == nil {
+				m.StartWorkflowResult = &v11.WorkflowExecution{}
+			}
+			if err := m.StartWorkflowResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionCount", wireType)
+			}
+			m.ActionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCatchupWindow", wireType)
+			}
+			m.MissedCatchupWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCatchupWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapSkipped", wireType)
+			}
+			m.OverlapSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningWorkflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningWorkflows = append(m.RunningWorkflows, &v11.WorkflowExecution{})
+			if err := m.RunningWorkflows[len(m.RunningWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentActions = append(m.RecentActions, &ScheduleActionResult{})
+			if err := m.RecentActions[len(m.RecentActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureActionTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FutureActionTimes = append(m.FutureActionTimes, new(time.Time))
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FutureActionTimes[len(m.FutureActionTimes)-1], dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateTime == nil {
+				m.UpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidScheduleError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidScheduleError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -504,3 +504,190 @@ func (c *metricClient) GetTaskQueueTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueBacklogScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskQueueBacklogScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueBacklogScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerBuildIdOrderingResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkerBuildIdOrdering(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerBuildIdOrderingResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkerBuildIdOrdering(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkflowExecutionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCountWorkflowExecutionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCountWorkflowExecutionsScope, metrics.ClientLatency)
+	resp, err := c.client.CountWorkflowExecutions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCountWorkflowExecutionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCreateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PatchScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPatchScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.PatchSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListSchedulesScope, metrics.ClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PreviewScheduleSpec(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleSpecRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewScheduleSpecResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPreviewScheduleSpecScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPreviewScheduleSpecScope, metrics.ClientLatency)
+	resp, err := c.client.PreviewScheduleSpec(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPreviewScheduleSpecScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
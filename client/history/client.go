@@ -669,6 +669,29 @@ func (c *clientImpl) RemoveSignalMutableState(
 	return response, err
 }
 
+func (c *clientImpl) UpsertWorkflowExecutionMetadata(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionMetadataRequest,
+	opts ...grpc.CallOption) (*historyservice.UpsertWorkflowExecutionMetadataResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.UpsertWorkflowExecutionMetadataResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpsertWorkflowExecutionMetadata(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.TerminateWorkflowExecutionRequest,
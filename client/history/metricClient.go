@@ -358,6 +358,20 @@ func (c *metricClient) RemoveSignalMutableState(
 	return c.client.RemoveSignalMutableState(context, request, opts...)
 }
 
+func (c *metricClient) UpsertWorkflowExecutionMetadata(
+	context context.Context,
+	request *historyservice.UpsertWorkflowExecutionMetadataRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpsertWorkflowExecutionMetadataResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientUpsertWorkflowExecutionMetadataScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.UpsertWorkflowExecutionMetadata(context, request, opts...)
+}
+
 func (c *metricClient) TerminateWorkflowExecution(
 	context context.Context,
 	request *historyservice.TerminateWorkflowExecutionRequest,
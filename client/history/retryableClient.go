@@ -418,6 +418,22 @@ func (c *retryableClient) RemoveSignalMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) UpsertWorkflowExecutionMetadata(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionMetadataRequest,
+	opts ...grpc.CallOption) (*historyservice.UpsertWorkflowExecutionMetadataResponse, error) {
+
+	var resp *historyservice.UpsertWorkflowExecutionMetadataResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpsertWorkflowExecutionMetadata(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.TerminateWorkflowExecutionRequest,
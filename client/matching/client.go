@@ -71,9 +71,10 @@ func (c *clientImpl) AddActivityTask(
 	ctx context.Context,
 	request *matchingservice.AddActivityTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
+	taskQueue := *request.GetTaskQueue()
 	partition := c.loadBalancer.PickWritePartition(
 		namespace.ID(request.GetNamespaceId()),
-		*request.GetTaskQueue(),
+		taskQueue,
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
 		request.GetForwardedSource(),
 	)
@@ -84,16 +85,29 @@ func (c *clientImpl) AddActivityTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddActivityTask(ctx, request, opts...)
+	resp, err := client.AddActivityTask(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.loadBalancer.UpdatePartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		taskQueue,
+		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		request.GetForwardedSource(),
+		resp.GetPartitionConfig(),
+		resp.GetAdaptivePartitionsDisabled(),
+	)
+	return resp, nil
 }
 
 func (c *clientImpl) AddWorkflowTask(
 	ctx context.Context,
 	request *matchingservice.AddWorkflowTaskRequest,
 	opts ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
+	taskQueue := *request.GetTaskQueue()
 	partition := c.loadBalancer.PickWritePartition(
 		namespace.ID(request.GetNamespaceId()),
-		*request.GetTaskQueue(),
+		taskQueue,
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
 	)
@@ -104,16 +118,29 @@ func (c *clientImpl) AddWorkflowTask(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.AddWorkflowTask(ctx, request, opts...)
+	resp, err := client.AddWorkflowTask(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.loadBalancer.UpdatePartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		taskQueue,
+		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		request.GetForwardedSource(),
+		resp.GetPartitionConfig(),
+		resp.GetAdaptivePartitionsDisabled(),
+	)
+	return resp, nil
 }
 
 func (c *clientImpl) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
+	taskQueue := *request.PollRequest.GetTaskQueue()
 	partition := c.loadBalancer.PickReadPartition(
 		namespace.ID(request.GetNamespaceId()),
-		*request.PollRequest.GetTaskQueue(),
+		taskQueue,
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
 		request.GetForwardedSource(),
 	)
@@ -124,16 +151,29 @@ func (c *clientImpl) PollActivityTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollActivityTaskQueue(ctx, request, opts...)
+	resp, err := client.PollActivityTaskQueue(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.loadBalancer.UpdatePartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		taskQueue,
+		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		request.GetForwardedSource(),
+		resp.GetPartitionConfig(),
+		resp.GetAdaptivePartitionsDisabled(),
+	)
+	return resp, nil
 }
 
 func (c *clientImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollWorkflowTaskQueueRequest,
 	opts ...grpc.CallOption) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
+	taskQueue := *request.PollRequest.GetTaskQueue()
 	partition := c.loadBalancer.PickReadPartition(
 		namespace.ID(request.GetNamespaceId()),
-		*request.PollRequest.GetTaskQueue(),
+		taskQueue,
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
 	)
@@ -144,7 +184,19 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 	}
 	ctx, cancel := c.createLongPollContext(ctx)
 	defer cancel()
-	return client.PollWorkflowTaskQueue(ctx, request, opts...)
+	resp, err := client.PollWorkflowTaskQueue(ctx, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.loadBalancer.UpdatePartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		taskQueue,
+		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		request.GetForwardedSource(),
+		resp.GetPartitionConfig(),
+		resp.GetAdaptivePartitionsDisabled(),
+	)
+	return resp, nil
 }
 
 func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
@@ -204,6 +256,26 @@ func (c *clientImpl) ListTaskQueuePartitions(ctx context.Context, request *match
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkerBuildIdOrdering(ctx context.Context, request *matchingservice.UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*matchingservice.UpdateWorkerBuildIdOrderingResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIdOrdering(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkerBuildIdOrdering(ctx context.Context, request *matchingservice.GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerBuildIdOrderingResponse, error) {
+	client, err := c.getClientForTaskqueue(request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetWorkerBuildIdOrdering(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
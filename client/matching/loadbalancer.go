@@ -32,6 +32,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 )
@@ -63,17 +65,43 @@ type (
 			taskQueueType enumspb.TaskQueueType,
 			forwardedFrom string,
 		) string
+
+		// UpdatePartitionConfig records the partition counts returned by a task queue
+		// partition, they take precedence over the configured partition counts when
+		// picking partitions. A nil config keeps the recorded partition counts, they are
+		// only removed when the partition reports the adaptive partition controller disabled.
+		UpdatePartitionConfig(
+			namespaceID namespace.ID,
+			taskQueue taskqueuepb.TaskQueue,
+			taskQueueType enumspb.TaskQueueType,
+			forwardedFrom string,
+			config *persistencespb.TaskQueuePartitionConfig,
+			adaptivePartitionsDisabled bool,
+		)
 	}
 
 	defaultLoadBalancer struct {
 		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		namespaceIDToName func(id namespace.ID) (namespace.Name, error)
+		// partitionConfigs holds the partition counts set by the adaptive partition
+		// controller of the task queues, keyed by partitionConfigKey
+		partitionConfigs cache.Cache
+	}
+
+	partitionConfigKey struct {
+		namespaceID   namespace.ID
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
 	}
 )
 
 const (
 	taskQueuePartitionPrefix = "/_sys/"
+
+	// partitionConfigCacheSize is the max number of task queues whose partition counts
+	// are recorded by the load balancer
+	partitionConfigCacheSize = 10000
 )
 
 // NewLoadBalancer returns an instance of matching load balancer that
@@ -88,6 +116,7 @@ func NewLoadBalancer(
 			dynamicconfig.MatchingNumTaskqueueReadPartitions, dynamicconfig.DefaultNumTaskQueuePartitions),
 		nWritePartitions: dc.GetIntPropertyFilteredByTaskQueueInfo(
 			dynamicconfig.MatchingNumTaskqueueWritePartitions, dynamicconfig.DefaultNumTaskQueuePartitions),
+		partitionConfigs: cache.NewLRU(partitionConfigCacheSize),
 	}
 }
 
@@ -97,7 +126,8 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions,
+		(*persistencespb.TaskQueuePartitionConfig).GetWritePartitionCount)
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -106,7 +136,31 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions,
+		(*persistencespb.TaskQueuePartitionConfig).GetReadPartitionCount)
+}
+
+func (lb *defaultLoadBalancer) UpdatePartitionConfig(
+	namespaceID namespace.ID,
+	taskQueue taskqueuepb.TaskQueue,
+	taskQueueType enumspb.TaskQueueType,
+	forwardedFrom string,
+	config *persistencespb.TaskQueuePartitionConfig,
+	adaptivePartitionsDisabled bool,
+) {
+	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return
+	}
+	key := partitionConfigKey{namespaceID: namespaceID, taskQueue: taskQueue.GetName(), taskQueueType: taskQueueType}
+	switch {
+	case config != nil:
+		lb.partitionConfigs.Put(key, config)
+	case adaptivePartitionsDisabled:
+		lb.partitionConfigs.Delete(key)
+	default:
+		// the partition does not know the partition counts, e.g. it was just loaded or
+		// unloaded, keep the recorded ones
+	}
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -115,6 +169,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters,
+	configPartitions func(*persistencespb.TaskQueuePartitionConfig) int32,
 ) string {
 
 	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
@@ -132,6 +187,10 @@ func (lb *defaultLoadBalancer) pickPartition(
 	}
 
 	n := nPartitions(namespace.String(), taskQueue.GetName(), taskQueueType)
+	key := partitionConfigKey{namespaceID: namespaceID, taskQueue: taskQueue.GetName(), taskQueueType: taskQueueType}
+	if config, ok := lb.partitionConfigs.Get(key).(*persistencespb.TaskQueuePartitionConfig); ok {
+		n = int(configPartitions(config))
+	}
 	if n <= 0 {
 		return taskQueue.GetName()
 	}
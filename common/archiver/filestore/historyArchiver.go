@@ -26,7 +26,8 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format, or in the
+// compressed format selected by the URI's encoding query parameter (see archiver.GetHistoryEncoding).
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -52,7 +53,6 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
-	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -178,8 +178,12 @@ func (h *historyArchiver) Archive(
 		historyBatches = append(historyBatches, historyBlob.Body...)
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-	encodedHistoryBatches, err := encoder.EncodeHistories(historyBatches)
+	encoding, err := archiver.GetHistoryEncoding(URI)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+	encodedHistoryBatches, err := archiver.EncodeHistories(encoding, historyBatches)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
@@ -259,12 +263,10 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
-	encoder := codec.NewJSONPBEncoder()
-	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
+	historyBatches, err := archiver.DecodeHistories(encodedHistoryBatches, token.NextBatchIdx)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
-	historyBatches = historyBatches[token.NextBatchIdx:]
 
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
@@ -295,6 +297,10 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 		return archiver.ErrURISchemeMismatch
 	}
 
+	if _, err := archiver.GetHistoryEncoding(URI); err != nil {
+		return err
+	}
+
 	return validateDirPath(URI.Path())
 }
 
@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// queryFilter is a condition which is evaluated against each visibility record.
+	queryFilter interface {
+		match(record *filterRecord) bool
+	}
+
+	andFilter struct {
+		left  queryFilter
+		right queryFilter
+	}
+
+	orFilter struct {
+		left  queryFilter
+		right queryFilter
+	}
+
+	notFilter struct {
+		filter queryFilter
+	}
+
+	comparisonFilter struct {
+		field    string
+		operator string
+		// values has exactly one element, except for IN and NOT IN.
+		values []interface{}
+	}
+
+	// filterRecord is a visibility record with lazily decoded search attributes.
+	filterRecord struct {
+		record           *archiverspb.VisibilityRecord
+		saTypeMap        searchattribute.NameTypeMap
+		searchAttributes map[string][]interface{}
+	}
+)
+
+// recordFieldTypes are the filterable fields stored directly in the visibility record.
+var recordFieldTypes = map[string]enumspb.IndexedValueType{
+	WorkflowID:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	RunID:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	WorkflowType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	ExecutionStatus: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	StartTime:       enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	CloseTime:       enumspb.INDEXED_VALUE_TYPE_DATETIME,
+}
+
+func newFilterRecord(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) *filterRecord {
+	return &filterRecord{
+		record:           record,
+		saTypeMap:        saTypeMap,
+		searchAttributes: make(map[string][]interface{}),
+	}
+}
+
+func (f *andFilter) match(record *filterRecord) bool {
+	return f.left.match(record) && f.right.match(record)
+}
+
+func (f *orFilter) match(record *filterRecord) bool {
+	return f.left.match(record) || f.right.match(record)
+}
+
+func (f *notFilter) match(record *filterRecord) bool {
+	return !f.filter.match(record)
+}
+
+// match returns true if the comparison holds for any of the record values. This matters for
+// search attributes with multiple values, and a record without the field matches only != and NOT IN.
+func (f *comparisonFilter) match(record *filterRecord) bool {
+	recordValues := record.values(f.field)
+	switch f.operator {
+	case sqlparser.NotEqualStr:
+		return !anyValueMatches(recordValues, sqlparser.EqualStr, f.values)
+	case sqlparser.InStr:
+		return anyValueMatches(recordValues, sqlparser.EqualStr, f.values)
+	case sqlparser.NotInStr:
+		return !anyValueMatches(recordValues, sqlparser.EqualStr, f.values)
+	default:
+		return anyValueMatches(recordValues, f.operator, f.values)
+	}
+}
+
+func anyValueMatches(recordValues []interface{}, op string, filterValues []interface{}) bool {
+	for _, recordValue := range recordValues {
+		for _, filterValue := range filterValues {
+			cmp, ok := compareValues(recordValue, filterValue)
+			if ok && compareResultMatches(cmp, op) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func compareResultMatches(cmp int, op string) bool {
+	switch op {
+	case sqlparser.EqualStr:
+		return cmp == 0
+	case sqlparser.LessThanStr:
+		return cmp < 0
+	case sqlparser.LessEqualStr:
+		return cmp <= 0
+	case sqlparser.GreaterThanStr:
+		return cmp > 0
+	case sqlparser.GreaterEqualStr:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// compareValues returns -1, 0 or 1 if a is less than, equal to or greater than b.
+// Values which can't be ordered are only ever reported as equal (0) or not equal (1).
+// The second return value is false if the values have different types.
+func compareValues(a interface{}, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		return strings.Compare(a, b), ok
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			switch {
+			case a < b:
+				return -1, true
+			case a > b:
+				return 1, true
+			default:
+				return 0, true
+			}
+		case float64:
+			return compareFloats(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case int64:
+			return compareFloats(a, float64(b)), true
+		case float64:
+			return compareFloats(a, b), true
+		}
+	case time.Time:
+		b, ok := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1, ok
+		case a.After(b):
+			return 1, ok
+		default:
+			return 0, ok
+		}
+	case bool:
+		b, ok := b.(bool)
+		if a == b {
+			return 0, ok
+		}
+		return 1, ok
+	case enumspb.WorkflowExecutionStatus:
+		b, ok := b.(enumspb.WorkflowExecutionStatus)
+		if a == b {
+			return 0, ok
+		}
+		return 1, ok
+	}
+	return 0, false
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// values returns the values of a field of the record. Search attributes can have any
+// number of values, the execution fields always have one.
+func (r *filterRecord) values(field string) []interface{} {
+	switch field {
+	case WorkflowID:
+		return []interface{}{r.record.GetWorkflowId()}
+	case RunID:
+		return []interface{}{r.record.GetRunId()}
+	case WorkflowType:
+		return []interface{}{r.record.GetWorkflowTypeName()}
+	case ExecutionStatus:
+		return []interface{}{r.record.GetStatus()}
+	case StartTime:
+		return []interface{}{timestamp.TimeValue(r.record.GetStartTime())}
+	case CloseTime:
+		return []interface{}{timestamp.TimeValue(r.record.GetCloseTime())}
+	}
+
+	if values, ok := r.searchAttributes[field]; ok {
+		return values
+	}
+	values := r.decodeSearchAttribute(field)
+	r.searchAttributes[field] = values
+	return values
+}
+
+// decodeSearchAttribute parses the stringified search attribute stored in the record.
+// Values which can't be parsed with the current type of the search attribute are ignored.
+func (r *filterRecord) decodeSearchAttribute(field string) []interface{} {
+	valueStr, ok := r.record.GetSearchAttributes()[field]
+	if !ok {
+		return nil
+	}
+	searchAttributes, err := searchattribute.Parse(map[string]string{field: valueStr}, &r.saTypeMap)
+	if err != nil {
+		return nil
+	}
+	decoded, err := searchattribute.Decode(searchAttributes, &r.saTypeMap)
+	if err != nil {
+		return nil
+	}
+
+	value := decoded[field]
+	if value == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice {
+		values := make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = rv.Index(i).Interface()
+		}
+		return values
+	}
+	return []interface{}{value}
+}
@@ -39,12 +39,13 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
 	// QueryParser parses a limited SQL where clause into a struct
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
@@ -57,6 +58,12 @@ type (
 		workflowTypeName  *string
 		status            *enumspb.WorkflowExecutionStatus
 		emptyResult       bool
+		// filters are conditions which can't be expressed with the fields above,
+		// e.g. OR, IN, StartTime or custom search attributes. Records must match all of them.
+		filters []queryFilter
+		// sortAscending is set by ORDER BY CloseTime [ASC]. By default, records are
+		// returned by CloseTime in descending order.
+		sortAscending bool
 	}
 )
 
@@ -65,13 +72,15 @@ const (
 	WorkflowID   = "WorkflowId"
 	RunID        = "RunId"
 	WorkflowType = "WorkflowType"
+	StartTime    = "StartTime"
 	CloseTime    = "CloseTime"
 	// Field name can't be just "Status" because it is reserved keyword in MySQL parser.
 	ExecutionStatus = "ExecutionStatus"
 )
 
 const (
-	queryTemplate = "select * from dummy where %s"
+	queryTemplate        = "select * from dummy where %s"
+	orderByQueryTemplate = "select * from dummy %s"
 
 	defaultDateTimeFormat = time.RFC3339
 )
@@ -81,57 +90,96 @@ func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
+	template := queryTemplate
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(query)), "order by") {
+		template = orderByQueryTemplate
+	}
+	stmt, err := sqlparser.Parse(fmt.Sprintf(template, query))
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	selectStmt := stmt.(*sqlparser.Select)
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: time.Time{},
 		latestCloseTime:   time.Now().UTC(),
 	}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	if selectStmt.Where != nil {
+		if err := p.convertWhereExpr(selectStmt.Where.Expr, parsedQuery, saTypeMap); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.convertOrderBy(selectStmt.OrderBy, parsedQuery); err != nil {
 		return nil, err
 	}
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
 	}
 
 	switch expr.(type) {
 	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery)
+		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery, saTypeMap)
 	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
+		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery, saTypeMap)
 	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
+		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery, saTypeMap)
+	case *sqlparser.OrExpr, *sqlparser.NotExpr, *sqlparser.RangeCond:
+		return p.addFilter(expr, parsedQuery, saTypeMap)
 	default:
-		return errors.New("only comparsion and \"and\" expression is supported")
+		return fmt.Errorf("expression %s is not supported", sqlparser.String(expr))
 	}
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	return p.convertWhereExpr(parenExpr.Expr, parsedQuery, saTypeMap)
+}
+
+func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	if err := p.convertWhereExpr(andExpr.Left, parsedQuery, saTypeMap); err != nil {
+		return err
+	}
+	return p.convertWhereExpr(andExpr.Right, parsedQuery, saTypeMap)
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
+func (p *queryParser) addFilter(expr sqlparser.Expr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
+	filter, err := p.convertFilterExpr(expr, saTypeMap)
+	if err != nil {
 		return err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	parsedQuery.filters = append(parsedQuery.filters, filter)
+	return nil
+}
+
+func (p *queryParser) convertOrderBy(orderBy sqlparser.OrderBy, parsedQuery *parsedQuery) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if len(orderBy) > 1 {
+		return errors.New("only one order by field is supported")
+	}
+	colName, ok := orderBy[0].Expr.(*sqlparser.ColName)
+	if !ok || sqlparser.String(colName) != CloseTime {
+		return fmt.Errorf("only %s is supported in order by clause", CloseTime)
+	}
+	// Like in SQL, the direction defaults to ascending.
+	parsedQuery.sortAscending = orderBy[0].Direction == sqlparser.AscScr
+	return nil
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery, saTypeMap searchattribute.NameTypeMap) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
 	}
 	colNameStr := sqlparser.String(colName)
 	op := compExpr.Operator
+	if !isSimpleComparison(colNameStr, op) {
+		return p.addFilter(compExpr, parsedQuery, saTypeMap)
+	}
 	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
 	if !ok {
 		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
@@ -144,9 +192,6 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
-		}
 		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
 			parsedQuery.emptyResult = true
 			return nil
@@ -157,9 +202,6 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", RunID)
-		}
 		if parsedQuery.runID != nil && *parsedQuery.runID != val {
 			parsedQuery.emptyResult = true
 			return nil
@@ -170,24 +212,13 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if err != nil {
 			return err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowType)
-		}
 		if parsedQuery.workflowTypeName != nil && *parsedQuery.workflowTypeName != val {
 			parsedQuery.emptyResult = true
 			return nil
 		}
 		parsedQuery.workflowTypeName = convert.StringPtr(val)
 	case ExecutionStatus:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			// if failed to extract string value, it means user input close status as a number
-			val = valStr
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", ExecutionStatus)
-		}
-		status, err := convertStatusStr(val)
+		status, err := convertStatusValue(valStr)
 		if err != nil {
 			return err
 		}
@@ -202,13 +233,191 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 			return err
 		}
 		return p.convertCloseTime(timestamp, op, parsedQuery)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
 	}
 
 	return nil
 }
 
+func (p *queryParser) convertFilterExpr(expr sqlparser.Expr, saTypeMap searchattribute.NameTypeMap) (queryFilter, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := p.convertFilterExprs(e.Left, e.Right, saTypeMap)
+		if err != nil {
+			return nil, err
+		}
+		return &andFilter{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := p.convertFilterExprs(e.Left, e.Right, saTypeMap)
+		if err != nil {
+			return nil, err
+		}
+		return &orFilter{left: left, right: right}, nil
+	case *sqlparser.NotExpr:
+		filter, err := p.convertFilterExpr(e.Expr, saTypeMap)
+		if err != nil {
+			return nil, err
+		}
+		return &notFilter{filter: filter}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertFilterExpr(e.Expr, saTypeMap)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparisonFilter(e, saTypeMap)
+	case *sqlparser.RangeCond:
+		return p.convertRangeFilter(e, saTypeMap)
+	default:
+		return nil, fmt.Errorf("expression %s is not supported", sqlparser.String(expr))
+	}
+}
+
+func (p *queryParser) convertFilterExprs(left sqlparser.Expr, right sqlparser.Expr, saTypeMap searchattribute.NameTypeMap) (queryFilter, queryFilter, error) {
+	leftFilter, err := p.convertFilterExpr(left, saTypeMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightFilter, err := p.convertFilterExpr(right, saTypeMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftFilter, rightFilter, nil
+}
+
+func (p *queryParser) convertComparisonFilter(compExpr *sqlparser.ComparisonExpr, saTypeMap searchattribute.NameTypeMap) (queryFilter, error) {
+	field, fieldType, err := convertFilterField(compExpr.Left, saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+	op := compExpr.Operator
+	if !isOperatorSupported(field, fieldType, op) {
+		return nil, fmt.Errorf("operator %s is not supported for %s", op, field)
+	}
+
+	valExprs := []sqlparser.Expr{compExpr.Right}
+	if op == sqlparser.InStr || op == sqlparser.NotInStr {
+		tuple, ok := compExpr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		}
+		valExprs = tuple
+	}
+	values := make([]interface{}, 0, len(valExprs))
+	for _, valExpr := range valExprs {
+		val, err := convertFilterValue(valExpr, field, fieldType)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val)
+	}
+	return &comparisonFilter{field: field, operator: op, values: values}, nil
+}
+
+func (p *queryParser) convertRangeFilter(rangeCond *sqlparser.RangeCond, saTypeMap searchattribute.NameTypeMap) (queryFilter, error) {
+	field, fieldType, err := convertFilterField(rangeCond.Left, saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+	if !isOperatorSupported(field, fieldType, sqlparser.GreaterEqualStr) {
+		return nil, fmt.Errorf("operator %s is not supported for %s", rangeCond.Operator, field)
+	}
+	from, err := convertFilterValue(rangeCond.From, field, fieldType)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertFilterValue(rangeCond.To, field, fieldType)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter queryFilter = &andFilter{
+		left:  &comparisonFilter{field: field, operator: sqlparser.GreaterEqualStr, values: []interface{}{from}},
+		right: &comparisonFilter{field: field, operator: sqlparser.LessEqualStr, values: []interface{}{to}},
+	}
+	switch rangeCond.Operator {
+	case sqlparser.BetweenStr:
+	case sqlparser.NotBetweenStr:
+		filter = &notFilter{filter: filter}
+	default:
+		return nil, fmt.Errorf("operator %s is not supported for %s", rangeCond.Operator, field)
+	}
+	return filter, nil
+}
+
+// convertFilterField returns the name and type of a field that can be used in filters:
+// the execution fields stored in the visibility record or a custom search attribute.
+func convertFilterField(expr sqlparser.Expr, saTypeMap searchattribute.NameTypeMap) (string, enumspb.IndexedValueType, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	field := sqlparser.String(colName)
+	if fieldType, ok := recordFieldTypes[field]; ok {
+		return field, fieldType, nil
+	}
+	if fieldType, ok := saTypeMap.Custom()[field]; ok {
+		return field, fieldType, nil
+	}
+	return "", enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("unknown filter name: %s", field)
+}
+
+func isOperatorSupported(field string, fieldType enumspb.IndexedValueType, op string) bool {
+	switch op {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		return true
+	case sqlparser.InStr, sqlparser.NotInStr:
+		return fieldType != enumspb.INDEXED_VALUE_TYPE_BOOL
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		switch fieldType {
+		case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+			return true
+		}
+	}
+	return false
+}
+
+// convertFilterValue converts a literal to the Go type used for the field when matching records.
+func convertFilterValue(expr sqlparser.Expr, field string, fieldType enumspb.IndexedValueType) (interface{}, error) {
+	if boolVal, ok := expr.(sqlparser.BoolVal); ok && fieldType == enumspb.INDEXED_VALUE_TYPE_BOOL {
+		return bool(boolVal), nil
+	}
+	valExpr, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+	}
+	valStr := sqlparser.String(valExpr)
+
+	if field == ExecutionStatus {
+		return convertStatusValue(valStr)
+	}
+	switch fieldType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return extractStringValue(valStr)
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		val, err := strconv.ParseInt(valStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s for %s: %w", valStr, field, err)
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		val, err := strconv.ParseFloat(valStr, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s for %s: %w", valStr, field, err)
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if str, err := extractStringValue(valStr); err == nil {
+			valStr = str
+		}
+		val, err := strconv.ParseBool(valStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s for %s: %w", valStr, field, err)
+		}
+		return val, nil
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return convertToTime(valStr)
+	default:
+		return nil, fmt.Errorf("unsupported type %v of %s", fieldType, field)
+	}
+}
+
 func (p *queryParser) convertCloseTime(timestamp time.Time, op string, parsedQuery *parsedQuery) error {
 	switch op {
 	case "=":
@@ -248,6 +457,30 @@ func convertToTime(timeStr string) (time.Time, error) {
 	return parsedTime, nil
 }
 
+// isSimpleComparison returns true if the comparison can be stored in the fixed fields of
+// parsedQuery. CloseTime ranges are also used to skip files without reading them.
+func isSimpleComparison(colName string, op string) bool {
+	switch colName {
+	case WorkflowID, RunID, WorkflowType, ExecutionStatus:
+		return op == sqlparser.EqualStr
+	case CloseTime:
+		switch op {
+		case sqlparser.EqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+			return true
+		}
+	}
+	return false
+}
+
+func convertStatusValue(valStr string) (enumspb.WorkflowExecutionStatus, error) {
+	val, err := extractStringValue(valStr)
+	if err != nil {
+		// if failed to extract string value, it means user input close status as a number
+		val = valStr
+	}
+	return convertStatusStr(val)
+}
+
 func convertStatusStr(statusStr string) (enumspb.WorkflowExecutionStatus, error) {
 	statusStr = strings.ToLower(strings.TrimSpace(statusStr))
 	switch statusStr {
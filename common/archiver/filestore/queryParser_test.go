@@ -32,7 +32,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type queryParserSuite struct {
@@ -112,10 +115,6 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			query:     "runId = random workflowID",
 			expectErr: true,
 		},
-		{
-			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
-			expectErr: true,
-		},
 		{
 			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
 			expectErr: true,
@@ -131,7 +130,7 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -212,10 +211,6 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			query:     "status = \"Failed\"",
 			expectErr: true,
 		},
-		{
-			query:     "ExecutionStatus = \"Failed\" or ExecutionStatus = \"Failed\"",
-			expectErr: true,
-		},
 		{
 			query:     "ExecutionStatus = \"unknown\"",
 			expectErr: true,
@@ -238,7 +233,7 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -304,7 +299,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -353,7 +348,7 @@ func (s *queryParserSuite) TestParse() {
 	}
 
 	for i, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -365,3 +360,154 @@ func (s *queryParserSuite) TestParse() {
 		}
 	}
 }
+
+func (s *queryParserSuite) TestParseFilters() {
+	record := &archiverspb.VisibilityRecord{
+		WorkflowId:       "random workflowID",
+		RunId:            "random runID",
+		WorkflowTypeName: "random typeName",
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+		StartTime:        timestamp.TimePtr(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+		CloseTime:        timestamp.TimePtr(time.Date(2019, 1, 1, 11, 11, 11, 0, time.UTC)),
+		SearchAttributes: map[string]string{
+			"CustomIntField":      "10",
+			"CustomDoubleField":   "1.5",
+			"CustomBoolField":     "true",
+			"CustomKeywordField":  `["a","b"]`,
+			"CustomDatetimeField": "2019-01-01T00:00:00Z",
+		},
+	}
+
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		shouldMatch bool
+	}{
+		{
+			query:       "WorkflowId = 'random workflowID' or WorkflowId = 'another workflowID'",
+			shouldMatch: true,
+		},
+		{
+			query:       "WorkflowId = 'another workflowID' or (RunId = 'another runID' and WorkflowType = 'random typeName')",
+			shouldMatch: false,
+		},
+		{
+			query:       "WorkflowId in ('another workflowID', 'random workflowID')",
+			shouldMatch: true,
+		},
+		{
+			query:       "ExecutionStatus not in ('Failed', 'Completed')",
+			shouldMatch: false,
+		},
+		{
+			query:       "ExecutionStatus != 2",
+			shouldMatch: true,
+		},
+		{
+			query:       "StartTime >= '2019-01-01T00:00:00Z' and StartTime < '2019-01-01T00:00:01Z'",
+			shouldMatch: true,
+		},
+		{
+			query:       "StartTime between '2019-01-02T00:00:00Z' and '2019-01-03T00:00:00Z'",
+			shouldMatch: false,
+		},
+		{
+			query:       "CloseTime != '2019-01-01T11:11:11Z'",
+			shouldMatch: false,
+		},
+		{
+			query:       "CustomIntField > 9 and CustomDoubleField <= 1.5 and CustomBoolField = true",
+			shouldMatch: true,
+		},
+		{
+			query:       "CustomIntField not between 5 and 15",
+			shouldMatch: false,
+		},
+		{
+			query:       "CustomKeywordField = 'b' and CustomKeywordField != 'c'",
+			shouldMatch: true,
+		},
+		{
+			query:       "CustomKeywordField != 'a'",
+			shouldMatch: false,
+		},
+		{
+			query:       "CustomDatetimeField < '2019-01-01T00:00:01Z'",
+			shouldMatch: true,
+		},
+		{
+			query:       "not (CustomTextField = 'missing')",
+			shouldMatch: true,
+		},
+		{
+			query:     "UnknownField = 'value'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomKeywordField > 'a'",
+			expectErr: true,
+		},
+		{
+			query:     "CustomBoolField in (true, false)",
+			expectErr: true,
+		},
+		{
+			query:     "CustomIntField = 'ten'",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId = 'random workflowID' or RunId like 'random%'",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.NotEmpty(parsedQuery.filters, tc.query)
+		s.Equal(tc.shouldMatch, matchQuery(record, parsedQuery, searchattribute.TestNameTypeMap), tc.query)
+	}
+}
+
+func (s *queryParserSuite) TestParseOrderBy() {
+	testCases := []struct {
+		query         string
+		expectErr     bool
+		sortAscending bool
+	}{
+		{
+			query:         "WorkflowId = 'random workflowID' order by CloseTime",
+			sortAscending: true,
+		},
+		{
+			query:         "order by CloseTime asc",
+			sortAscending: true,
+		},
+		{
+			query:         "ORDER BY CloseTime DESC",
+			sortAscending: false,
+		},
+		{
+			query:     "WorkflowId = 'random workflowID' order by StartTime",
+			expectErr: true,
+		},
+		{
+			query:     "order by CloseTime, WorkflowId",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.sortAscending, parsedQuery.sortAscending, tc.query)
+	}
+}
@@ -44,6 +44,12 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+const (
+	// Visibility records are stored in one directory per day (in UTC) of their close time.
+	visibilityPartitionLayout   = "2006-01-02"
+	visibilityPartitionDuration = 24 * time.Hour
+)
+
 var (
 	errDirectoryExpected  = errors.New("a path to a directory was expected")
 	errFileExpected       = errors.New("a path to a file was expected")
@@ -201,6 +207,10 @@ func constructVisibilityFilename(closeTimestamp *time.Time, runID string) string
 	return fmt.Sprintf("%v_%s.visibility", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID))
 }
 
+func constructVisibilityPartition(closeTimestamp *time.Time) string {
+	return timestamp.TimeValue(closeTimestamp).UTC().Format(visibilityPartitionLayout)
+}
+
 func hash(s string) string {
 	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
 }
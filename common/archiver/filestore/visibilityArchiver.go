@@ -39,6 +39,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
@@ -69,6 +70,16 @@ type (
 		nextPageToken []byte
 		parsedQuery   *parsedQuery
 	}
+
+	// visibilityPartition holds the visibility records closed within one day.
+	visibilityPartition struct {
+		name      string
+		startTime time.Time
+		hasDir    bool
+		// legacyFiles are records stored directly in the namespace directory,
+		// which is where records were archived before partitioning was introduced.
+		legacyFiles []string
+	}
 )
 
 // NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on filestore
@@ -117,8 +128,11 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	// Records are partitioned by the day they were closed on, so that queries only
+	// need to list the partitions overlapping with the queried close time range.
 	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
-	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+	partitionPath := path.Join(dirPath, constructVisibilityPartition(request.CloseTime))
+	if err = mkdirAll(partitionPath, v.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
@@ -132,7 +146,14 @@ func (v *visibilityArchiver) Archive(
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTime, request.GetRunId())
-	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+	if err := writeFile(path.Join(partitionPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
+	// Remove the copy archived before partitioning was introduced, if any,
+	// so that the record is not returned twice.
+	if err := os.Remove(path.Join(dirPath, filename)); err != nil && !os.IsNotExist(err) {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
@@ -154,7 +175,7 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
@@ -200,36 +221,41 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	files, err := listFiles(dirPath)
+	partitions, err := listVisibilityPartitions(dirPath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
-
-	files, err = sortAndFilterFiles(files, token)
-	if err != nil {
-		return nil, serviceerror.NewInternal(err.Error())
-	}
-	if len(files) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
-	}
+	partitions = selectVisibilityPartitions(partitions, request.parsedQuery, token)
 
 	response := &archiver.QueryVisibilityResponse{}
-	for idx, file := range files {
-		encodedRecord, err := readFile(path.Join(dirPath, file))
+	for _, partition := range partitions {
+		if contextExpired(ctx) {
+			return nil, serviceerror.NewDeadlineExceeded(archiver.ErrContextTimeout.Error())
+		}
+
+		files, err := partition.listFiles(dirPath)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
-
-		record, err := decodeVisibilityRecord(encodedRecord)
+		files, err = sortAndFilterFiles(files, token, request.parsedQuery)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
 
-		if record.CloseTime.Before(request.parsedQuery.earliestCloseTime) {
-			break
-		}
+		for _, file := range files {
+			encodedRecord, err := readFile(path.Join(dirPath, file))
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
 
-		if matchQuery(record, request.parsedQuery) {
+			if !matchQuery(record, request.parsedQuery, saTypeMap) {
+				continue
+			}
 			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
 			if err != nil {
 				return nil, serviceerror.NewInternal(err.Error())
@@ -237,18 +263,16 @@ func (v *visibilityArchiver) query(
 
 			response.Executions = append(response.Executions, executionInfo)
 			if len(response.Executions) == request.pageSize {
-				if idx != len(files) {
-					newToken := &queryVisibilityToken{
-						LastCloseTime: timestamp.TimeValue(record.CloseTime),
-						LastRunID:     record.GetRunId(),
-					}
-					encodedToken, err := serializeToken(newToken)
-					if err != nil {
-						return nil, serviceerror.NewInternal(err.Error())
-					}
-					response.NextPageToken = encodedToken
+				newToken := &queryVisibilityToken{
+					LastCloseTime: timestamp.TimeValue(record.CloseTime),
+					LastRunID:     record.GetRunId(),
 				}
-				break
+				encodedToken, err := serializeToken(newToken)
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				response.NextPageToken = encodedToken
+				return response, nil
 			}
 		}
 	}
@@ -270,44 +294,154 @@ type parsedVisFilename struct {
 	hashedRunID string
 }
 
-// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
-// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
-func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
-	var parsedFilenames []*parsedVisFilename
-	for _, name := range filenames {
-		pieces := strings.FieldsFunc(name, func(r rune) bool {
-			return r == '_' || r == '.'
-		})
-		if len(pieces) != 3 {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+// listVisibilityPartitions returns the partitions of a namespace directory, including the
+// ones which only have records stored directly in the namespace directory.
+func listVisibilityPartitions(dirPath string) ([]*visibilityPartition, error) {
+	names, err := listFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions := make(map[string]*visibilityPartition)
+	getPartition := func(name string) (*visibilityPartition, error) {
+		if partition, ok := partitions[name]; ok {
+			return partition, nil
 		}
+		startTime, err := time.Parse(visibilityPartitionLayout, name)
+		if err != nil {
+			return nil, err
+		}
+		partition := &visibilityPartition{name: name, startTime: startTime}
+		partitions[name] = partition
+		return partition, nil
+	}
 
-		closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	for _, name := range names {
+		if partition, err := getPartition(name); err == nil {
+			partition.hasDir = true
+			continue
+		}
+		parsedFilename, err := parseVisibilityFilename(name)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+			return nil, err
 		}
-		parsedFilenames = append(parsedFilenames, &parsedVisFilename{
-			name:        name,
-			closeTime:   timestamp.UnixOrZeroTime(closeTime),
-			hashedRunID: pieces[1],
-		})
+		partition, err := getPartition(constructVisibilityPartition(&parsedFilename.closeTime))
+		if err != nil {
+			return nil, err
+		}
+		partition.legacyFiles = append(partition.legacyFiles, name)
 	}
 
-	sort.Slice(parsedFilenames, func(i, j int) bool {
-		if parsedFilenames[i].closeTime.Equal(parsedFilenames[j].closeTime) {
-			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
+	result := make([]*visibilityPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		result = append(result, partition)
+	}
+	return result, nil
+}
+
+// selectVisibilityPartitions returns the partitions which may have records in the close time
+// range of the query and after the nextPageToken, sorted in the order of the query.
+func selectVisibilityPartitions(partitions []*visibilityPartition, query *parsedQuery, token *queryVisibilityToken) []*visibilityPartition {
+	earliestCloseTime := query.earliestCloseTime
+	latestCloseTime := query.latestCloseTime
+	if token != nil {
+		if query.sortAscending {
+			earliestCloseTime = common.MaxTime(earliestCloseTime, token.LastCloseTime)
+		} else {
+			latestCloseTime = common.MinTime(latestCloseTime, token.LastCloseTime)
+		}
+	}
+
+	var selected []*visibilityPartition
+	for _, partition := range partitions {
+		if partition.startTime.After(latestCloseTime) || !partition.startTime.Add(visibilityPartitionDuration).After(earliestCloseTime) {
+			continue
 		}
-		return parsedFilenames[i].closeTime.After(parsedFilenames[j].closeTime)
+		selected = append(selected, partition)
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		if query.sortAscending {
+			return selected[i].startTime.Before(selected[j].startTime)
+		}
+		return selected[i].startTime.After(selected[j].startTime)
+	})
+	return selected
+}
+
+// listFiles returns the visibility record files of the partition, relative to the namespace directory.
+func (p *visibilityPartition) listFiles(dirPath string) ([]string, error) {
+	files := append([]string(nil), p.legacyFiles...)
+	if !p.hasDir {
+		return files, nil
+	}
+	names, err := listFiles(path.Join(dirPath, p.name))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		files = append(files, path.Join(p.name, name))
+	}
+	return files, nil
+}
+
+func parseVisibilityFilename(name string) (*parsedVisFilename, error) {
+	pieces := strings.FieldsFunc(path.Base(name), func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(pieces) != 3 {
+		return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+	}
+
+	closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse visibility filename %s", name)
+	}
+	return &parsedVisFilename{
+		name:        name,
+		closeTime:   timestamp.UnixOrZeroTime(closeTime),
+		hashedRunID: pieces[1],
+	}, nil
+}
+
+// sortAndFilterFiles sort visibility record file names based on close timestamp (desc, or asc if the query asks for it)
+// and use hashed runID to break ties. Files outside the close time range of the query are dropped without being read.
+// if a nextPageToken is give, it only returns filenames that come after it in the sort order
+func sortAndFilterFiles(filenames []string, token *queryVisibilityToken, query *parsedQuery) ([]string, error) {
+	var parsedFilenames []*parsedVisFilename
+	for _, name := range filenames {
+		parsedFilename, err := parseVisibilityFilename(name)
+		if err != nil {
+			return nil, err
+		}
+		if parsedFilename.closeTime.Before(query.earliestCloseTime) || parsedFilename.closeTime.After(query.latestCloseTime) {
+			continue
+		}
+		parsedFilenames = append(parsedFilenames, parsedFilename)
+	}
+
+	// before returns true if file a comes before file b in the sort order.
+	before := func(a *parsedVisFilename, closeTime time.Time, hashedRunID string) bool {
+		if a.closeTime.Equal(closeTime) {
+			if query.sortAscending {
+				return a.hashedRunID < hashedRunID
+			}
+			return a.hashedRunID > hashedRunID
+		}
+		if query.sortAscending {
+			return a.closeTime.Before(closeTime)
+		}
+		return a.closeTime.After(closeTime)
+	}
+	sort.Slice(parsedFilenames, func(i, j int) bool {
+		return before(parsedFilenames[i], parsedFilenames[j].closeTime, parsedFilenames[j].hashedRunID)
 	})
 
 	startIdx := 0
 	if token != nil {
 		LastHashedRunID := hash(token.LastRunID)
 		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
-			if parsedFilenames[i].closeTime.Equal(token.LastCloseTime) {
-				return parsedFilenames[i].hashedRunID < LastHashedRunID
-			}
-			return parsedFilenames[i].closeTime.Before(token.LastCloseTime)
+			return !before(parsedFilenames[i], token.LastCloseTime, LastHashedRunID) &&
+				!(parsedFilenames[i].closeTime.Equal(token.LastCloseTime) && parsedFilenames[i].hashedRunID == LastHashedRunID)
 		})
 	}
 
@@ -322,7 +456,7 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery, saTypeMap searchattribute.NameTypeMap) bool {
 	if record.CloseTime.Before(query.earliestCloseTime) || record.CloseTime.After(query.latestCloseTime) {
 		return false
 	}
@@ -338,6 +472,14 @@ func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
 	if query.status != nil && record.Status != *query.status {
 		return false
 	}
+	if len(query.filters) != 0 {
+		filterRecord := newFilterRecord(record, saTypeMap)
+		for _, filter := range query.filters {
+			if !filter.match(filterRecord) {
+				return false
+			}
+		}
+	}
 	return true
 }
 
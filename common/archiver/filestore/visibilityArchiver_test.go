@@ -27,8 +27,10 @@ package filestore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -194,7 +196,7 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.NoError(err)
 
 	expectedFilename := constructVisibilityFilename(closeTimestamp, testRunID)
-	filepath := path.Join(dir, testNamespaceID, expectedFilename)
+	filepath := path.Join(dir, testNamespaceID, constructVisibilityPartition(closeTimestamp), expectedFilename)
 	s.assertFileExists(filepath)
 
 	data, err := readFile(filepath)
@@ -284,10 +286,40 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			},
 			shouldMatch: true,
 		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+				filters: []queryFilter{
+					&comparisonFilter{field: StartTime, operator: ">=", values: []interface{}{time.Unix(0, 100)}},
+				},
+			},
+			record: &archiverspb.VisibilityRecord{
+				StartTime: timestamp.UnixOrZeroTimePtr(99),
+				CloseTime: timestamp.UnixOrZeroTimePtr(12345),
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 1000),
+				latestCloseTime:   time.Unix(0, 12345),
+				filters: []queryFilter{
+					&comparisonFilter{field: StartTime, operator: ">=", values: []interface{}{time.Unix(0, 100)}},
+					&comparisonFilter{field: "CustomKeywordField", operator: "in", values: []interface{}{"a", "b"}},
+				},
+			},
+			record: &archiverspb.VisibilityRecord{
+				StartTime:        timestamp.UnixOrZeroTimePtr(100),
+				CloseTime:        timestamp.UnixOrZeroTimePtr(12345),
+				SearchAttributes: map[string]string{"CustomKeywordField": "b"},
+			},
+			shouldMatch: true,
+		},
 	}
 
 	for _, tc := range testCases {
-		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
+		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query, searchattribute.TestNameTypeMap))
 	}
 }
 
@@ -295,6 +327,7 @@ func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	testCases := []struct {
 		filenames      []string
 		token          *queryVisibilityToken
+		query          *parsedQuery
 		expectedResult []string
 	}{
 		{
@@ -322,10 +355,41 @@ func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 			},
 			expectedResult: []string{"5_0.vis"},
 		},
+		{
+			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
+			query: &parsedQuery{
+				earliestCloseTime: time.Unix(0, 6),
+				latestCloseTime:   time.Unix(0, 999),
+			},
+			expectedResult: []string{"9_54321.vis", "9_12345.vis"},
+		},
+		{
+			filenames: []string{"2019-01-01/9_12345.vis", "5_0.vis", "2019-01-01/9_54321.vis", "1000_654.vis", "1000_78.vis"},
+			query: &parsedQuery{
+				latestCloseTime: time.Now().UTC(),
+				sortAscending:   true,
+			},
+			expectedResult: []string{"5_0.vis", "2019-01-01/9_12345.vis", "2019-01-01/9_54321.vis", "1000_654.vis", "1000_78.vis"},
+		},
+		{
+			filenames: []string{"9_12345.vis", "5_0.vis", "9_54321.vis", "1000_654.vis", "1000_78.vis"},
+			token: &queryVisibilityToken{
+				LastCloseTime: time.Unix(0, 10),
+			},
+			query: &parsedQuery{
+				latestCloseTime: time.Now().UTC(),
+				sortAscending:   true,
+			},
+			expectedResult: []string{"1000_654.vis", "1000_78.vis"},
+		},
 	}
 
 	for i, tc := range testCases {
-		result, err := sortAndFilterFiles(tc.filenames, tc.token)
+		query := tc.query
+		if query == nil {
+			query = &parsedQuery{latestCloseTime: time.Now().UTC()}
+		}
+		result, err := sortAndFilterFiles(tc.filenames, tc.token, query)
 		s.NoError(err, "case %d", i)
 		s.Equal(tc.expectedResult, result, "case %d", i)
 	}
@@ -354,7 +418,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -368,7 +432,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -388,7 +452,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 101),
 	}, nil)
@@ -407,7 +471,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		workflowID:        convert.StringPtr(testWorkflowID),
@@ -433,7 +497,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 1),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -474,7 +538,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		earliestCloseTime: time.Unix(0, 10),
 		latestCloseTime:   time.Unix(0, 10001),
 		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
@@ -509,6 +573,136 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_QueryLanguage() {
+	dir := testhelper.MkdirTemp(s.T(), "", "TestArchiveAndQueryLanguage")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	day := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	var records []*archiverspb.VisibilityRecord
+	for i := 0; i < 6; i++ {
+		closeTime := day.Add(time.Duration(i) * 12 * time.Hour)
+		records = append(records, &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       fmt.Sprintf("workflow-%d", i),
+			RunId:            fmt.Sprintf("run-%d", i),
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.TimePtr(closeTime.Add(-time.Duration(i) * time.Hour)),
+			CloseTime:        timestamp.TimePtr(closeTime),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength:    int64(i),
+			SearchAttributes: map[string]string{
+				"CustomIntField":     strconv.Itoa(i),
+				"CustomKeywordField": fmt.Sprintf(`["even-%v","tag"]`, i%2 == 0),
+			},
+		})
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, record := range records[1:] {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+	// records[0] is stored the way it was before partitioning.
+	data, err := encode(records[0])
+	s.NoError(err)
+	s.NoError(writeFile(path.Join(dir, testNamespaceID, constructVisibilityFilename(records[0].CloseTime, records[0].RunId)), data, testFileMode))
+
+	partitions, err := listVisibilityPartitions(path.Join(dir, testNamespaceID))
+	s.NoError(err)
+	s.Len(partitions, 3)
+
+	testCases := []struct {
+		query    string
+		expected []int
+	}{
+		{
+			query:    "WorkflowId = 'workflow-1' or WorkflowId = 'workflow-4'",
+			expected: []int{4, 1},
+		},
+		{
+			query:    "RunId in ('run-0', 'run-2', 'run-5') order by CloseTime",
+			expected: []int{0, 2, 5},
+		},
+		{
+			query:    "CloseTime >= '2022-06-02T00:00:00Z' and StartTime <= '2022-06-02T09:00:00Z'",
+			expected: []int{3, 2},
+		},
+		{
+			query:    "CustomIntField between 1 and 3 and not CustomKeywordField = 'even-true'",
+			expected: []int{3, 1},
+		},
+		{
+			query:    "CustomKeywordField = 'tag' and (CustomIntField > 3 or ExecutionStatus != 'Completed')",
+			expected: []int{5, 4},
+		},
+		{
+			query:    "order by CloseTime desc",
+			expected: []int{5, 4, 3, 2, 1, 0},
+		},
+		{
+			query:    "CloseTime < '2022-06-01T12:00:00Z' order by CloseTime asc",
+			expected: []int{0},
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    2,
+			Query:       tc.query,
+		}
+		var executions []*workflowpb.WorkflowExecutionInfo
+		for {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+			s.NoError(err, tc.query)
+			executions = append(executions, response.Executions...)
+			if response.NextPageToken == nil {
+				break
+			}
+			request.NextPageToken = response.NextPageToken
+		}
+
+		var workflowIDs []string
+		for _, execution := range executions {
+			workflowIDs = append(workflowIDs, execution.GetExecution().GetWorkflowId())
+		}
+		var expectedWorkflowIDs []string
+		for _, i := range tc.expected {
+			expectedWorkflowIDs = append(expectedWorkflowIDs, records[i].WorkflowId)
+		}
+		s.Equal(expectedWorkflowIDs, workflowIDs, tc.query)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestSelectVisibilityPartitions() {
+	var partitions []*visibilityPartition
+	for _, name := range []string{"2022-06-03", "2022-06-01", "2022-06-02", "2022-06-04"} {
+		startTime, err := time.Parse(visibilityPartitionLayout, name)
+		s.NoError(err)
+		partitions = append(partitions, &visibilityPartition{name: name, startTime: startTime, hasDir: true})
+	}
+	names := func(partitions []*visibilityPartition) []string {
+		var result []string
+		for _, partition := range partitions {
+			result = append(result, partition.name)
+		}
+		return result
+	}
+
+	query := &parsedQuery{
+		earliestCloseTime: time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC),
+		latestCloseTime:   time.Date(2022, 6, 3, 23, 0, 0, 0, time.UTC),
+	}
+	s.Equal([]string{"2022-06-03", "2022-06-02"}, names(selectVisibilityPartitions(partitions, query, nil)))
+
+	token := &queryVisibilityToken{LastCloseTime: time.Date(2022, 6, 2, 12, 0, 0, 0, time.UTC)}
+	s.Equal([]string{"2022-06-02"}, names(selectVisibilityPartitions(partitions, query, token)))
+
+	query.sortAscending = true
+	s.Equal([]string{"2022-06-02", "2022-06-03"}, names(selectVisibilityPartitions(partitions, query, token)))
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
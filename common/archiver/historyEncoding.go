@@ -0,0 +1,312 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+)
+
+// Archived histories are written either as JSON (the default) or, if the archival URI has the
+// query parameter encoding=proto-deflate, in the compressed format below. Readers detect the
+// format from the data, so the encoding of a URI can be changed at any time.
+//
+// The compressed format is:
+//
+//	magic        8 bytes, historyEncodingMagic
+//	header       uvarint length + protobuf-encoded HistoryBlobHeader (length 0 if absent)
+//	frame count  uvarint
+//	frame index  per frame: uvarint number of batches, uvarint compressed length
+//	frames       per frame: deflate stream of (uvarint length + protobuf-encoded History) per batch
+//
+// Consecutive batches are grouped into frames of about historyEncodingFrameSize uncompressed
+// bytes, so readers can use the index to skip to the frame holding a given batch.
+
+const (
+	// HistoryEncodingQueryParam is the archival URI query parameter that selects the encoding
+	// of archived histories.
+	HistoryEncodingQueryParam = "encoding"
+	// HistoryEncodingJSON encodes histories as JSON. This is the default.
+	HistoryEncodingJSON = "json"
+	// HistoryEncodingProtoDeflate encodes histories as deflate-compressed protobuf with a batch index.
+	HistoryEncodingProtoDeflate = "proto-deflate"
+
+	historyEncodingMagic     = "TMPRLHZ1"
+	historyEncodingFrameSize = 256 * 1024
+)
+
+var (
+	// ErrUnknownHistoryEncoding is the error for an unsupported history encoding in an archival URI
+	ErrUnknownHistoryEncoding = errors.New("unknown history encoding")
+
+	errCorruptedHistoryEncoding = errors.New("corrupted compressed history")
+)
+
+type (
+	historyFrame struct {
+		numBatches     uint64
+		compressedSize uint64
+	}
+)
+
+// GetHistoryEncoding returns the history encoding selected by the given URI.
+func GetHistoryEncoding(URI URI) (string, error) {
+	values := URI.Query()[HistoryEncodingQueryParam]
+	if len(values) == 0 {
+		return HistoryEncodingJSON, nil
+	}
+	switch encoding := values[len(values)-1]; encoding {
+	case "", HistoryEncodingJSON:
+		return HistoryEncodingJSON, nil
+	case HistoryEncodingProtoDeflate:
+		return encoding, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownHistoryEncoding, encoding)
+	}
+}
+
+// EncodeHistories encodes history batches with the given encoding.
+func EncodeHistories(encoding string, histories []*historypb.History) ([]byte, error) {
+	switch encoding {
+	case HistoryEncodingJSON:
+		return codec.NewJSONPBEncoder().EncodeHistories(histories)
+	case HistoryEncodingProtoDeflate:
+		return encodeCompressed(nil, histories)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownHistoryEncoding, encoding)
+	}
+}
+
+// DecodeHistories decodes history batches written by EncodeHistories in any encoding, skipping the
+// first firstBatch batches. With the compressed encoding, frames before firstBatch aren't
+// decompressed.
+func DecodeHistories(data []byte, firstBatch int) ([]*historypb.History, error) {
+	if !isCompressedEncoding(data) {
+		histories, err := codec.NewJSONPBEncoder().DecodeHistories(data)
+		if err != nil {
+			return nil, err
+		}
+		if firstBatch > len(histories) {
+			firstBatch = len(histories)
+		}
+		return histories[firstBatch:], nil
+	}
+	_, histories, err := decodeCompressed(data, firstBatch)
+	return histories, err
+}
+
+// EncodeHistoryBlob encodes a history blob with the given encoding.
+func EncodeHistoryBlob(encoding string, blob *archiverspb.HistoryBlob) ([]byte, error) {
+	switch encoding {
+	case HistoryEncodingJSON:
+		return codec.NewJSONPBEncoder().Encode(blob)
+	case HistoryEncodingProtoDeflate:
+		return encodeCompressed(blob.Header, blob.Body)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownHistoryEncoding, encoding)
+	}
+}
+
+// DecodeHistoryBlob decodes a history blob written by EncodeHistoryBlob in any encoding.
+func DecodeHistoryBlob(data []byte) (*archiverspb.HistoryBlob, error) {
+	if !isCompressedEncoding(data) {
+		blob := &archiverspb.HistoryBlob{}
+		if err := codec.NewJSONPBEncoder().Decode(data, blob); err != nil {
+			return nil, err
+		}
+		return blob, nil
+	}
+	header, histories, err := decodeCompressed(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &archiverspb.HistoryBlob{Header: header, Body: histories}, nil
+}
+
+func isCompressedEncoding(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(historyEncodingMagic))
+}
+
+func encodeCompressed(header *archiverspb.HistoryBlobHeader, histories []*historypb.History) ([]byte, error) {
+	var headerBytes []byte
+	if header != nil {
+		var err error
+		if headerBytes, err = header.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+
+	var frames []historyFrame
+	var body, frame bytes.Buffer
+	var numBatches uint64
+	flushFrame := func() error {
+		if numBatches == 0 {
+			return nil
+		}
+		start := body.Len()
+		w, err := flate.NewWriter(&body, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(frame.Bytes()); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		frames = append(frames, historyFrame{numBatches: numBatches, compressedSize: uint64(body.Len() - start)})
+		frame.Reset()
+		numBatches = 0
+		return nil
+	}
+
+	for _, history := range histories {
+		batch, err := history.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		frame.Write(appendUvarint(nil, uint64(len(batch))))
+		frame.Write(batch)
+		numBatches++
+		if frame.Len() >= historyEncodingFrameSize {
+			if err := flushFrame(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flushFrame(); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(historyEncodingMagic)+len(headerBytes)+len(frames)*8+body.Len()+16)
+	out = append(out, historyEncodingMagic...)
+	out = appendUvarint(out, uint64(len(headerBytes)))
+	out = append(out, headerBytes...)
+	out = appendUvarint(out, uint64(len(frames)))
+	for _, f := range frames {
+		out = appendUvarint(out, f.numBatches)
+		out = appendUvarint(out, f.compressedSize)
+	}
+	return append(out, body.Bytes()...), nil
+}
+
+func decodeCompressed(data []byte, firstBatch int) (*archiverspb.HistoryBlobHeader, []*historypb.History, error) {
+	r := bytes.NewReader(data[len(historyEncodingMagic):])
+
+	var header *archiverspb.HistoryBlobHeader
+	headerBytes, err := readUvarintBytes(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(headerBytes) > 0 {
+		header = &archiverspb.HistoryBlobHeader{}
+		if err := header.Unmarshal(headerBytes); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	numFrames, err := binary.ReadUvarint(r)
+	// each frame index entry takes at least two bytes, so a larger count can only come
+	// from a corrupted blob and must not be used to size the allocation below
+	if err != nil || numFrames > uint64(r.Len())/2 {
+		return nil, nil, errCorruptedHistoryEncoding
+	}
+	frames := make([]historyFrame, 0, numFrames)
+	for i := uint64(0); i < numFrames; i++ {
+		var f historyFrame
+		if f.numBatches, err = binary.ReadUvarint(r); err != nil {
+			return nil, nil, errCorruptedHistoryEncoding
+		}
+		if f.compressedSize, err = binary.ReadUvarint(r); err != nil {
+			return nil, nil, errCorruptedHistoryEncoding
+		}
+		frames = append(frames, f)
+	}
+
+	var histories []*historypb.History
+	skip := uint64(firstBatch)
+	for _, f := range frames {
+		if f.compressedSize > uint64(r.Len()) {
+			return nil, nil, errCorruptedHistoryEncoding
+		}
+		if skip >= f.numBatches {
+			// use the index to skip frames without decompressing them
+			skip -= f.numBatches
+			if _, err := r.Seek(int64(f.compressedSize), io.SeekCurrent); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		frame, err := io.ReadAll(flate.NewReader(io.LimitReader(r, int64(f.compressedSize))))
+		if err != nil {
+			return nil, nil, err
+		}
+		fr := bytes.NewReader(frame)
+		for i := uint64(0); i < f.numBatches; i++ {
+			batch, err := readUvarintBytes(fr)
+			if err != nil {
+				return nil, nil, err
+			}
+			if i < skip {
+				continue
+			}
+			history := &historypb.History{}
+			if err := history.Unmarshal(batch); err != nil {
+				return nil, nil, err
+			}
+			histories = append(histories, history)
+		}
+		skip = 0
+	}
+	return header, histories, nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func readUvarintBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(r.Len()) {
+		return nil, errCorruptedHistoryEncoding
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+)
+
+type historyEncodingSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestHistoryEncodingSuite(t *testing.T) {
+	suite.Run(t, new(historyEncodingSuite))
+}
+
+func (s *historyEncodingSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyEncodingSuite) TestGetHistoryEncoding() {
+	for uri, expected := range map[string]string{
+		"file:///tmp/archival":                            HistoryEncodingJSON,
+		"file:///tmp/archival?encoding=json":              HistoryEncodingJSON,
+		"s3://bucket/path?encoding=proto-deflate":         HistoryEncodingProtoDeflate,
+		"s3://bucket/path?foo=bar&encoding=proto-deflate": HistoryEncodingProtoDeflate,
+	} {
+		URI, err := NewURI(uri)
+		s.NoError(err)
+		encoding, err := GetHistoryEncoding(URI)
+		s.NoError(err)
+		s.Equal(expected, encoding, uri)
+	}
+
+	URI, err := NewURI("s3://bucket/path?encoding=zip")
+	s.NoError(err)
+	_, err = GetHistoryEncoding(URI)
+	s.ErrorIs(err, ErrUnknownHistoryEncoding)
+}
+
+func (s *historyEncodingSuite) TestHistoriesRoundTrip() {
+	// enough batches to span several frames
+	histories := s.histories(2000, 50)
+
+	for _, encoding := range []string{HistoryEncodingJSON, HistoryEncodingProtoDeflate} {
+		data, err := EncodeHistories(encoding, histories)
+		s.NoError(err)
+
+		decoded, err := DecodeHistories(data, 0)
+		s.NoError(err)
+		s.Equal(histories, decoded, encoding)
+
+		decoded, err = DecodeHistories(data, 1234)
+		s.NoError(err)
+		s.Equal(histories[1234:], decoded, encoding)
+
+		decoded, err = DecodeHistories(data, len(histories))
+		s.NoError(err)
+		s.Empty(decoded)
+	}
+
+	jsonData, err := EncodeHistories(HistoryEncodingJSON, histories)
+	s.NoError(err)
+	compressedData, err := EncodeHistories(HistoryEncodingProtoDeflate, histories)
+	s.NoError(err)
+	s.Less(len(compressedData), len(jsonData)/4)
+}
+
+func (s *historyEncodingSuite) TestHistoryBlobRoundTrip() {
+	blob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			Namespace:    "test-namespace",
+			WorkflowId:   "test-workflow-id",
+			IsLast:       true,
+			FirstEventId: 1,
+			LastEventId:  10,
+		},
+		Body: s.histories(3, 3),
+	}
+	for _, encoding := range []string{HistoryEncodingJSON, HistoryEncodingProtoDeflate} {
+		data, err := EncodeHistoryBlob(encoding, blob)
+		s.NoError(err)
+		decoded, err := DecodeHistoryBlob(data)
+		s.NoError(err)
+		s.Equal(blob, decoded, encoding)
+	}
+
+	// blobs written before the encoding was configurable
+	data, err := codec.NewJSONPBEncoder().Encode(blob)
+	s.NoError(err)
+	decoded, err := DecodeHistoryBlob(data)
+	s.NoError(err)
+	s.Equal(blob, decoded)
+}
+
+func (s *historyEncodingSuite) TestDecodeCorrupted() {
+	data, err := EncodeHistories(HistoryEncodingProtoDeflate, s.histories(10, 10))
+	s.NoError(err)
+	_, err = DecodeHistories(data[:len(data)/2], 0)
+	s.Error(err)
+
+	// empty header followed by a frame count far larger than the blob
+	data = append([]byte(historyEncodingMagic), 0)
+	data = appendUvarint(data, math.MaxUint64)
+	_, err = DecodeHistories(data, 0)
+	s.Equal(errCorruptedHistoryEncoding, err)
+}
+
+func (s *historyEncodingSuite) histories(numBatches, eventsPerBatch int) []*historypb.History {
+	histories := make([]*historypb.History, numBatches)
+	eventID := int64(1)
+	for i := range histories {
+		events := make([]*historypb.HistoryEvent, eventsPerBatch)
+		for j := range events {
+			events[j] = &historypb.HistoryEvent{
+				EventId:   eventID,
+				Version:   1,
+				EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			}
+			eventID++
+		}
+		histories[i] = &historypb.History{Events: events}
+	}
+	return histories
+}
@@ -30,11 +30,7 @@ import (
 	"errors"
 	"sync"
 
-	"go.temporal.io/server/common/archiver/gcloud"
-
 	"go.temporal.io/server/common/archiver"
-	"go.temporal.io/server/common/archiver/filestore"
-	"go.temporal.io/server/common/archiver/s3store"
 	"go.temporal.io/server/common/config"
 )
 
@@ -49,6 +45,8 @@ var (
 	ErrArchiverConfigNotFound = errors.New("unable to find archiver config for the given scheme")
 	// ErrBootstrapContainerAlreadyRegistered is the error for registering multiple containers for the same serviceName
 	ErrBootstrapContainerAlreadyRegistered = errors.New("bootstrap container has already been registered")
+	// ErrSchemeAlreadyRegistered is the error for registering a custom archiver for a scheme that already has one
+	ErrSchemeAlreadyRegistered = errors.New("archiver for the given scheme has already been registered")
 )
 
 type (
@@ -67,8 +65,8 @@ type (
 	archiverProvider struct {
 		sync.RWMutex
 
-		historyArchiverConfigs    *config.HistoryArchiverProvider
-		visibilityArchiverConfigs *config.VisibilityArchiverProvider
+		historyFactories    map[string]historyArchiverFactory
+		visibilityFactories map[string]visibilityArchiverFactory
 
 		// Key for the container is just serviceName
 		historyContainers    map[string]*archiver.HistoryBootstrapContainer
@@ -80,18 +78,20 @@ type (
 	}
 )
 
-// NewArchiverProvider returns a new Archiver provider
+// NewArchiverProvider returns a new Archiver provider. Archivers for custom URI schemes are created
+// by the factories in customArchivers, which may be nil.
 func NewArchiverProvider(
 	historyArchiverConfigs *config.HistoryArchiverProvider,
 	visibilityArchiverConfigs *config.VisibilityArchiverProvider,
+	customArchivers *CustomArchivers,
 ) ArchiverProvider {
 	return &archiverProvider{
-		historyArchiverConfigs:    historyArchiverConfigs,
-		visibilityArchiverConfigs: visibilityArchiverConfigs,
-		historyContainers:         make(map[string]*archiver.HistoryBootstrapContainer),
-		visibilityContainers:      make(map[string]*archiver.VisibilityBootstrapContainer),
-		historyArchivers:          make(map[string]archiver.HistoryArchiver),
-		visibilityArchivers:       make(map[string]archiver.VisibilityArchiver),
+		historyFactories:     historyArchiverFactories(historyArchiverConfigs, customArchivers),
+		visibilityFactories:  visibilityArchiverFactories(visibilityArchiverConfigs, customArchivers),
+		historyContainers:    make(map[string]*archiver.HistoryBootstrapContainer),
+		visibilityContainers: make(map[string]*archiver.VisibilityBootstrapContainer),
+		historyArchivers:     make(map[string]archiver.HistoryArchiver),
+		visibilityArchivers:  make(map[string]archiver.VisibilityArchiver),
 	}
 }
 
@@ -138,29 +138,11 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 		return nil, ErrBootstrapContainerNotFound
 	}
 
-	switch scheme {
-	case filestore.URIScheme:
-		if p.historyArchiverConfigs.Filestore == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		historyArchiver, err = filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
-
-	case gcloud.URIScheme:
-		if p.historyArchiverConfigs.Gstorage == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-
-		historyArchiver, err = gcloud.NewHistoryArchiver(container, p.historyArchiverConfigs.Gstorage)
-
-	case s3store.URIScheme:
-		if p.historyArchiverConfigs.S3store == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
-	default:
+	factory, ok := p.historyFactories[scheme]
+	if !ok {
 		return nil, ErrUnknownScheme
 	}
-
+	historyArchiver, err = factory(container)
 	if err != nil {
 		return nil, err
 	}
@@ -188,29 +170,11 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		return nil, ErrBootstrapContainerNotFound
 	}
 
-	var visibilityArchiver archiver.VisibilityArchiver
-	var err error
-
-	switch scheme {
-	case filestore.URIScheme:
-		if p.visibilityArchiverConfigs.Filestore == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
-	case s3store.URIScheme:
-		if p.visibilityArchiverConfigs.S3store == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = s3store.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store)
-	case gcloud.URIScheme:
-		if p.visibilityArchiverConfigs.Gstorage == nil {
-			return nil, ErrArchiverConfigNotFound
-		}
-		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
-
-	default:
+	factory, ok := p.visibilityFactories[scheme]
+	if !ok {
 		return nil, ErrUnknownScheme
 	}
+	visibilityArchiver, err := factory(container)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/gcloud"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// HistoryArchiverFactory creates a history archiver for a custom URI scheme. cfg is the YAML block
+	// configured for the scheme under archival.history.provider; decode it with cfg.Decode.
+	HistoryArchiverFactory func(container *archiver.HistoryBootstrapContainer, cfg *yaml.Node) (archiver.HistoryArchiver, error)
+
+	// VisibilityArchiverFactory creates a visibility archiver for a custom URI scheme. cfg is the YAML
+	// block configured for the scheme under archival.visibility.provider; decode it with cfg.Decode.
+	VisibilityArchiverFactory func(container *archiver.VisibilityBootstrapContainer, cfg *yaml.Node) (archiver.VisibilityArchiver, error)
+
+	// CustomArchivers holds archiver factories for URI schemes other than the built-in
+	// filestore, gstorage and s3 schemes, keyed by scheme.
+	CustomArchivers struct {
+		history    map[string]HistoryArchiverFactory
+		visibility map[string]VisibilityArchiverFactory
+	}
+
+	historyArchiverFactory    func(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error)
+	visibilityArchiverFactory func(container *archiver.VisibilityBootstrapContainer) (archiver.VisibilityArchiver, error)
+)
+
+// NewCustomArchivers returns an empty set of custom archiver factories.
+func NewCustomArchivers() *CustomArchivers {
+	return &CustomArchivers{
+		history:    make(map[string]HistoryArchiverFactory),
+		visibility: make(map[string]VisibilityArchiverFactory),
+	}
+}
+
+// RegisterHistoryArchiver registers the factory for history archivers with the given URI scheme.
+func (c *CustomArchivers) RegisterHistoryArchiver(scheme string, factory HistoryArchiverFactory) error {
+	if err := validateCustomScheme(scheme); err != nil {
+		return err
+	}
+	if _, ok := c.history[scheme]; ok {
+		return fmt.Errorf("%w: %q", ErrSchemeAlreadyRegistered, scheme)
+	}
+	c.history[scheme] = factory
+	return nil
+}
+
+// RegisterVisibilityArchiver registers the factory for visibility archivers with the given URI scheme.
+func (c *CustomArchivers) RegisterVisibilityArchiver(scheme string, factory VisibilityArchiverFactory) error {
+	if err := validateCustomScheme(scheme); err != nil {
+		return err
+	}
+	if _, ok := c.visibility[scheme]; ok {
+		return fmt.Errorf("%w: %q", ErrSchemeAlreadyRegistered, scheme)
+	}
+	c.visibility[scheme] = factory
+	return nil
+}
+
+func validateCustomScheme(scheme string) error {
+	switch scheme {
+	case "":
+		return fmt.Errorf("%w: scheme is empty", ErrUnknownScheme)
+	case filestore.URIScheme, gcloud.URIScheme, s3store.URIScheme:
+		return fmt.Errorf("%w: %q is a built-in scheme", ErrSchemeAlreadyRegistered, scheme)
+	}
+	return nil
+}
+
+func historyArchiverFactories(
+	cfg *config.HistoryArchiverProvider,
+	customArchivers *CustomArchivers,
+) map[string]historyArchiverFactory {
+	if cfg == nil {
+		cfg = &config.HistoryArchiverProvider{}
+	}
+	factories := map[string]historyArchiverFactory{
+		filestore.URIScheme: func(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
+			if cfg.Filestore == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return filestore.NewHistoryArchiver(container, cfg.Filestore)
+		},
+		gcloud.URIScheme: func(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
+			if cfg.Gstorage == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return gcloud.NewHistoryArchiver(container, cfg.Gstorage)
+		},
+		s3store.URIScheme: func(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
+			if cfg.S3store == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return s3store.NewHistoryArchiver(container, cfg.S3store)
+		},
+	}
+	if customArchivers == nil {
+		return factories
+	}
+	for scheme, factory := range customArchivers.history {
+		scheme, factory := scheme, factory
+		factories[scheme] = func(container *archiver.HistoryBootstrapContainer) (archiver.HistoryArchiver, error) {
+			node, ok := cfg.Custom[scheme]
+			if !ok {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return factory(container, &node)
+		}
+	}
+	return factories
+}
+
+func visibilityArchiverFactories(
+	cfg *config.VisibilityArchiverProvider,
+	customArchivers *CustomArchivers,
+) map[string]visibilityArchiverFactory {
+	if cfg == nil {
+		cfg = &config.VisibilityArchiverProvider{}
+	}
+	factories := map[string]visibilityArchiverFactory{
+		filestore.URIScheme: func(container *archiver.VisibilityBootstrapContainer) (archiver.VisibilityArchiver, error) {
+			if cfg.Filestore == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return filestore.NewVisibilityArchiver(container, cfg.Filestore)
+		},
+		gcloud.URIScheme: func(container *archiver.VisibilityBootstrapContainer) (archiver.VisibilityArchiver, error) {
+			if cfg.Gstorage == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return gcloud.NewVisibilityArchiver(container, cfg.Gstorage)
+		},
+		s3store.URIScheme: func(container *archiver.VisibilityBootstrapContainer) (archiver.VisibilityArchiver, error) {
+			if cfg.S3store == nil {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return s3store.NewVisibilityArchiver(container, cfg.S3store)
+		},
+	}
+	if customArchivers == nil {
+		return factories
+	}
+	for scheme, factory := range customArchivers.visibility {
+		scheme, factory := scheme, factory
+		factories[scheme] = func(container *archiver.VisibilityBootstrapContainer) (archiver.VisibilityArchiver, error) {
+			node, ok := cfg.Custom[scheme]
+			if !ok {
+				return nil, ErrArchiverConfigNotFound
+			}
+			return factory(container, &node)
+		}
+	}
+	return factories
+}
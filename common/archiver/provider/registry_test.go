@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/config"
+)
+
+type blobstoreConfig struct {
+	Bucket string `yaml:"bucket"`
+}
+
+func TestCustomArchivers_RegisterBuiltinScheme(t *testing.T) {
+	customArchivers := NewCustomArchivers()
+	err := customArchivers.RegisterHistoryArchiver(filestore.URIScheme, nil)
+	require.True(t, errors.Is(err, ErrSchemeAlreadyRegistered))
+	err = customArchivers.RegisterVisibilityArchiver("", nil)
+	require.True(t, errors.Is(err, ErrUnknownScheme))
+}
+
+func TestArchiverProvider_CustomScheme(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var cfg struct {
+		History config.HistoryArchiverProvider `yaml:"history"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(`
+history:
+  filestore:
+    fileMode: "0666"
+  blobstore:
+    bucket: archive
+`), &cfg))
+	require.NotNil(t, cfg.History.Filestore)
+
+	historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+	customArchivers := NewCustomArchivers()
+	require.NoError(t, customArchivers.RegisterHistoryArchiver("blobstore",
+		func(_ *archiver.HistoryBootstrapContainer, node *yaml.Node) (archiver.HistoryArchiver, error) {
+			var blobCfg blobstoreConfig
+			if err := node.Decode(&blobCfg); err != nil {
+				return nil, err
+			}
+			require.Equal(t, "archive", blobCfg.Bucket)
+			return historyArchiver, nil
+		}))
+
+	p := NewArchiverProvider(&cfg.History, nil, customArchivers)
+	require.NoError(t, p.RegisterBootstrapContainer("frontend", &archiver.HistoryBootstrapContainer{}, &archiver.VisibilityBootstrapContainer{}))
+
+	a, err := p.GetHistoryArchiver("blobstore", "frontend")
+	require.NoError(t, err)
+	require.Equal(t, historyArchiver, a)
+
+	_, err = p.GetHistoryArchiver("unknown", "frontend")
+	require.Equal(t, ErrUnknownScheme, err)
+
+	// custom scheme registered for history only
+	_, err = p.GetVisibilityArchiver("blobstore", "frontend")
+	require.Equal(t, ErrUnknownScheme, err)
+}
@@ -28,6 +28,8 @@ package s3store
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"strconv"
@@ -46,7 +48,6 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
-	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -61,12 +62,20 @@ const (
 	errWriteKey             = "failed to write history to s3"
 	defaultBlobstoreTimeout = time.Minute
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
+
+	defaultMultipartUploadThreshold = 16 * 1024 * 1024 // 16MiB
+	defaultMultipartUploadPartSize  = 8 * 1024 * 1024  // 8MiB
+	minMultipartUploadPartSize      = 5 * 1024 * 1024  // 5MiB, the minimum size of all but the last part allowed by s3
+	// with multipart uploads enabled, history is read into blobs of up to this many times the
+	// multipart upload threshold, as blobs of targetHistoryBlobSize would never exceed the threshold
+	multipartHistoryBlobSizeFactor = 4
 )
 
 var (
 	errNoBucketSpecified = errors.New("no bucket specified")
 	errBucketNotExists   = errors.New("requested bucket does not exist")
 	errEmptyAwsRegion    = errors.New("empty aws region")
+	errPartSizeTooSmall  = errors.New("multipart upload part size must be at least 5MiB")
 )
 
 type (
@@ -76,6 +85,12 @@ type (
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 		config          *config.S3Archiver
+		// blobs larger than multipartUploadThreshold are uploaded in parts of multipartUploadPartSize,
+		// multipart uploads are disabled if multipartUploadThreshold is negative
+		multipartUploadThreshold int64
+		multipartUploadPartSize  int64
+		// historyBlobSize is the target size of the blobs history is read into
+		historyBlobSize int
 	}
 
 	getHistoryToken struct {
@@ -88,6 +103,23 @@ type (
 		IteratorState []byte
 		uploadedSize  int64
 		historySize   int64
+		// MultipartUpload is set while a blob is uploaded in parts. IteratorState and BatchIdx
+		// are then the state before the blob, so that the same blob is read again on resume.
+		MultipartUpload *multipartUploadProgress
+	}
+
+	multipartUploadProgress struct {
+		Key      string
+		UploadID string
+		Parts    []*uploadedPart
+	}
+
+	uploadedPart struct {
+		PartNumber int64
+		ETag       string
+		// MD5 is the base64 encoded md5 checksum of the part, used to check whether
+		// the part needs to be uploaded again on resume
+		MD5 string
 	}
 )
 
@@ -107,6 +139,21 @@ func newHistoryArchiver(
 	if len(config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
+	multipartUploadThreshold := config.MultipartUploadThreshold
+	if multipartUploadThreshold == 0 {
+		multipartUploadThreshold = defaultMultipartUploadThreshold
+	}
+	multipartUploadPartSize := config.MultipartUploadPartSize
+	if multipartUploadPartSize == 0 {
+		multipartUploadPartSize = defaultMultipartUploadPartSize
+	}
+	if multipartUploadPartSize < minMultipartUploadPartSize {
+		return nil, errPartSizeTooSmall
+	}
+	historyBlobSize := targetHistoryBlobSize
+	if multipartUploadThreshold > 0 && multipartHistoryBlobSizeFactor*multipartUploadThreshold > targetHistoryBlobSize {
+		historyBlobSize = int(multipartHistoryBlobSizeFactor * multipartUploadThreshold)
+	}
 	s3Config := &aws.Config{
 		Endpoint:         config.Endpoint,
 		Region:           aws.String(config.Region),
@@ -118,11 +165,16 @@ func newHistoryArchiver(
 	}
 
 	return &historyArchiver{
-		container:       container,
-		s3cli:           s3.New(sess),
-		historyIterator: historyIterator,
+		container:                container,
+		s3cli:                    s3.New(sess),
+		historyIterator:          historyIterator,
+		config:                   config,
+		multipartUploadThreshold: multipartUploadThreshold,
+		multipartUploadPartSize:  multipartUploadPartSize,
+		historyBlobSize:          historyBlobSize,
 	}, nil
 }
+
 func (h *historyArchiver) Archive(
 	ctx context.Context,
 	URI archiver.URI,
@@ -158,12 +210,26 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	encoding, err := archiver.GetHistoryEncoding(URI)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
 	var progress uploadProgress
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, h.historyBlobSize, featureCatalog, &progress)
+	} else if !loadUploadProgress(ctx, featureCatalog, &progress) {
+		progress = uploadProgress{}
 	}
 	for historyIterator.HasNext() {
+		// the iterator state before reading the blob is needed to resume multipart uploads of the blob
+		var iteratorState []byte
+		if featureCatalog.ProgressManager != nil {
+			// ignore errors, recording progress is a best effort operation
+			iteratorState, _ = historyIterator.GetState()
+		}
 		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
 		if err != nil {
 			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
@@ -189,8 +255,7 @@ func (h *historyArchiver) Archive(
 			return archiver.ErrHistoryMutated
 		}
 
-		encoder := codec.NewJSONPBEncoder()
-		encodedHistoryBlob, err := encoder.Encode(historyBlob)
+		encodedHistoryBlob, err := archiver.EncodeHistoryBlob(encoding, historyBlob)
 		if err != nil {
 			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
@@ -209,8 +274,10 @@ func (h *historyArchiver) Archive(
 		blobSize := int64(binary.Size(encodedHistoryBlob))
 		if exists {
 			scope.IncCounter(metrics.HistoryArchiverBlobExistsCount)
+			// a multipart upload of this blob may have completed without its progress being recorded
+			progress.MultipartUpload = nil
 		} else {
-			if err := upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
+			if err := h.uploadHistoryBlob(ctx, URI, key, encodedHistoryBlob, featureCatalog, iteratorState, &progress); err != nil {
 				if isRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 				} else {
@@ -233,23 +300,22 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
-	if featureCatalog.ProgressManager != nil {
-		if featureCatalog.ProgressManager.HasProgress(ctx) {
-			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
-			if err == nil {
-				historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, progress.IteratorState)
-				if err == nil {
-					return historyIterator
-				}
-			}
-			progress.IteratorState = nil
-			progress.BatchIdx = 0
-			progress.historySize = 0
-			progress.uploadedSize = 0
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, historyBlobSize int, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+	if loadUploadProgress(ctx, featureCatalog, progress) {
+		historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, historyBlobSize, progress.IteratorState)
+		if err == nil {
+			return historyIterator
 		}
 	}
-	return archiver.NewHistoryIterator(request, executionManager, targetHistoryBlobSize)
+	*progress = uploadProgress{}
+	return archiver.NewHistoryIterator(request, executionManager, historyBlobSize)
+}
+
+func loadUploadProgress(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) bool {
+	if featureCatalog.ProgressManager == nil || !featureCatalog.ProgressManager.HasProgress(ctx) {
+		return false
+	}
+	return featureCatalog.ProgressManager.LoadProgress(ctx, progress) == nil
 }
 
 func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
@@ -267,6 +333,93 @@ func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.Arch
 	}
 }
 
+// uploadHistoryBlob uploads blobs larger than the multipart upload threshold in parts. The progress
+// is recorded after each part, so that a retried archival only uploads the remaining parts.
+// iteratorState is the state of the history iterator before the blob was read.
+func (h *historyArchiver) uploadHistoryBlob(
+	ctx context.Context,
+	URI archiver.URI,
+	key string,
+	data []byte,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	iteratorState []byte,
+	progress *uploadProgress,
+) error {
+	if h.multipartUploadThreshold < 0 || int64(len(data)) <= h.multipartUploadThreshold {
+		return upload(ctx, h.s3cli, URI, key, data)
+	}
+
+	if progress.MultipartUpload != nil && progress.MultipartUpload.Key != key {
+		// should not happen as the key is derived from the recorded progress, but don't leak the upload
+		abortMultipartUpload(ctx, h.s3cli, URI, progress.MultipartUpload.Key, progress.MultipartUpload.UploadID)
+		progress.MultipartUpload = nil
+	}
+	if progress.MultipartUpload == nil {
+		uploadID, err := createMultipartUpload(ctx, h.s3cli, URI, key)
+		if err != nil {
+			return err
+		}
+		progress.MultipartUpload = &multipartUploadProgress{
+			Key:      key,
+			UploadID: uploadID,
+		}
+	}
+	multipartUpload := progress.MultipartUpload
+	recordProgress := func() {
+		// recording progress is a best effort operation, ignore errors and continue
+		if featureCatalog.ProgressManager != nil && iteratorState != nil {
+			progress.IteratorState = iteratorState
+			_ = featureCatalog.ProgressManager.RecordProgress(ctx, progress)
+		}
+	}
+	resetUpload := func(err error) error {
+		if isNoSuchUploadError(err) {
+			// the upload was aborted or has expired, start over next time
+			progress.MultipartUpload = nil
+			recordProgress()
+		}
+		return err
+	}
+
+	uploadedParts := make(map[int64]*uploadedPart, len(multipartUpload.Parts))
+	for _, part := range multipartUpload.Parts {
+		uploadedParts[part.PartNumber] = part
+	}
+	parts := make([]*uploadedPart, 0, (int64(len(data))+h.multipartUploadPartSize-1)/h.multipartUploadPartSize)
+	for offset := int64(0); offset < int64(len(data)); offset += h.multipartUploadPartSize {
+		end := offset + h.multipartUploadPartSize
+		if end > int64(len(data)) {
+			end = int64(len(data))
+		}
+		partNumber := int64(len(parts) + 1)
+		partData := data[offset:end]
+		sum := md5.Sum(partData)
+		partMD5 := base64.StdEncoding.EncodeToString(sum[:])
+
+		if part, ok := uploadedParts[partNumber]; ok && part.MD5 == partMD5 {
+			parts = append(parts, part)
+			continue
+		}
+		etag, err := uploadPart(ctx, h.s3cli, URI, key, multipartUpload.UploadID, partNumber, partData, partMD5)
+		if err != nil {
+			return resetUpload(err)
+		}
+		parts = append(parts, &uploadedPart{
+			PartNumber: partNumber,
+			ETag:       etag,
+			MD5:        partMD5,
+		})
+		multipartUpload.Parts = parts
+		recordProgress()
+	}
+
+	if err := completeMultipartUpload(ctx, h.s3cli, URI, key, multipartUpload.UploadID, parts); err != nil {
+		return resetUpload(err)
+	}
+	progress.MultipartUpload = nil
+	return nil
+}
+
 func (h *historyArchiver) Get(
 	ctx context.Context,
 	URI archiver.URI,
@@ -300,7 +453,6 @@ func (h *historyArchiver) Get(
 			CloseFailoverVersion: *highestVersion,
 		}
 	}
-	encoder := codec.NewJSONPBEncoder()
 	response := &archiver.GetHistoryResponse{}
 	numOfEvents := 0
 	isTruncated := false
@@ -324,8 +476,7 @@ func (h *historyArchiver) Get(
 			}
 		}
 
-		historyBlob := archiverspb.HistoryBlob{}
-		err = encoder.Decode(encodedRecord, &historyBlob)
+		historyBlob, err := archiver.DecodeHistoryBlob(encodedRecord)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -357,6 +508,9 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if err != nil {
 		return err
 	}
+	if _, err := archiver.GetHistoryEncoding(URI); err != nil {
+		return err
+	}
 	return bucketExists(context.TODO(), h.s3cli, URI)
 }
 
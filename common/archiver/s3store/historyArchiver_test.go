@@ -27,9 +27,11 @@ package s3store
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,7 +40,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -52,7 +56,9 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/archiver/s3store/s3test"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -505,6 +511,183 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	s.assertKeyExists(expectedkey)
 }
 
+func (s *historyArchiverSuite) TestArchive_MultipartUpload() {
+	server, historyArchiver := s.newFakeServerHistoryArchiver()
+	defer server.Close()
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	historyArchiver.historyIterator = historyIterator
+	var uploadedParts int
+	server.SetFailRequest(func(r *http.Request) bool {
+		if r.URL.Query().Has("partNumber") {
+			uploadedParts++
+		}
+		return false
+	})
+
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, archiveRequest)
+	s.NoError(err)
+	s.Greater(uploadedParts, 2)
+	s.Zero(server.MultipartUploads())
+	s.Equal(2, server.CompletedMultipartUploads())
+
+	for i, blob := range s.historyBatchesV100 {
+		data, err := archiver.EncodeHistoryBlob(archiver.HistoryEncodingJSON, blob)
+		s.NoError(err)
+		key := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, i)
+		object, ok := server.Object(testBucket, key)
+		s.True(ok)
+		s.Equal(data, object)
+	}
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestArchive_ResumeMultipartUpload() {
+	server, historyArchiver := s.newFakeServerHistoryArchiver()
+	defer server.Close()
+	progressManager := &testProgressManager{}
+	progressOption := func(catalog *archiver.ArchiveFeatureCatalog) {
+		catalog.ProgressManager = progressManager
+	}
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	historyBlob := s.historyBatchesV100[1]
+	data, err := archiver.EncodeHistoryBlob(archiver.HistoryEncodingJSON, historyBlob)
+	s.NoError(err)
+	totalParts := int((int64(len(data)) + historyArchiver.multipartUploadPartSize - 1) / historyArchiver.multipartUploadPartSize)
+	s.Greater(totalParts, 2)
+
+	// fail the upload of the third part
+	var uploadedParts int
+	server.SetFailRequest(func(r *http.Request) bool {
+		if !r.URL.Query().Has("partNumber") {
+			return false
+		}
+		uploadedParts++
+		return uploadedParts == 3
+	})
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().GetState().Return([]byte("before-blob"), nil),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+	)
+	historyArchiver.historyIterator = historyIterator
+	err = historyArchiver.Archive(context.Background(), s.testArchivalURI, archiveRequest, progressOption)
+	s.Error(err)
+
+	var progress uploadProgress
+	s.NoError(progressManager.LoadProgress(context.Background(), &progress))
+	s.Equal(0, progress.BatchIdx)
+	s.Equal([]byte("before-blob"), progress.IteratorState)
+	s.NotNil(progress.MultipartUpload)
+	s.Len(progress.MultipartUpload.Parts, 2)
+	s.Equal(1, server.MultipartUploads())
+
+	// resume from the recorded progress, only the remaining parts are uploaded
+	uploadedParts = 0
+	server.SetFailRequest(func(r *http.Request) bool {
+		if r.URL.Query().Has("partNumber") {
+			uploadedParts++
+		}
+		return false
+	})
+	historyIterator = archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().GetState().Return([]byte("before-blob"), nil),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().GetState().Return([]byte("after-blob"), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	historyArchiver.historyIterator = historyIterator
+	err = historyArchiver.Archive(context.Background(), s.testArchivalURI, archiveRequest, progressOption)
+	s.NoError(err)
+	s.Equal(totalParts-2, uploadedParts)
+	s.Zero(server.MultipartUploads())
+	s.Equal(1, server.CompletedMultipartUploads())
+
+	key := constructHistoryKey("", testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	object, ok := server.Object(testBucket, key)
+	s.True(ok)
+	s.Equal(data, object)
+
+	progress = uploadProgress{}
+	s.NoError(progressManager.LoadProgress(context.Background(), &progress))
+	s.Equal(1, progress.BatchIdx)
+	s.Equal([]byte("after-blob"), progress.IteratorState)
+	s.Nil(progress.MultipartUpload)
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_MultipartUploadConfig() {
+	testCases := []struct {
+		threshold               int64
+		expectedThreshold       int64
+		expectedHistoryBlobSize int
+	}{
+		{
+			threshold:               0,
+			expectedThreshold:       defaultMultipartUploadThreshold,
+			expectedHistoryBlobSize: multipartHistoryBlobSizeFactor * defaultMultipartUploadThreshold,
+		},
+		{
+			threshold:               1,
+			expectedThreshold:       1,
+			expectedHistoryBlobSize: targetHistoryBlobSize,
+		},
+		{
+			threshold:               -1,
+			expectedThreshold:       -1,
+			expectedHistoryBlobSize: targetHistoryBlobSize,
+		},
+	}
+	for _, tc := range testCases {
+		historyArchiver, err := newHistoryArchiver(s.container, &config.S3Archiver{
+			Region:                   "us-east-1",
+			MultipartUploadThreshold: tc.threshold,
+		}, nil)
+		s.NoError(err)
+		s.Equal(tc.expectedThreshold, historyArchiver.multipartUploadThreshold)
+		s.Equal(tc.expectedHistoryBlobSize, historyArchiver.historyBlobSize)
+	}
+
+	_, err := newHistoryArchiver(s.container, &config.S3Archiver{
+		Region:                  "us-east-1",
+		MultipartUploadPartSize: minMultipartUploadPartSize - 1,
+	}, nil)
+	s.Equal(errPartSizeTooSmall, err)
+}
+
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.GetHistoryRequest{
@@ -686,6 +869,47 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	return archiver
 }
 
+// newFakeServerHistoryArchiver returns a history archiver backed by a fake s3 server, which uploads
+// all but tiny blobs in multiple parts.
+func (s *historyArchiverSuite) newFakeServerHistoryArchiver() (*s3test.Server, *historyArchiver) {
+	server := s3test.NewServer()
+	server.CreateBucket(testBucket)
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(server.URL()),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	s.Require().NoError(err)
+	return server, &historyArchiver{
+		container:                s.container,
+		s3cli:                    s3.New(sess),
+		multipartUploadThreshold: 64,
+		multipartUploadPartSize:  32,
+	}
+}
+
+type testProgressManager struct {
+	progress []byte
+}
+
+func (m *testProgressManager) RecordProgress(_ context.Context, progress interface{}) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	m.progress = data
+	return nil
+}
+
+func (m *testProgressManager) LoadProgress(_ context.Context, valuePtr interface{}) error {
+	return json.Unmarshal(m.progress, valuePtr)
+}
+
+func (m *testProgressManager) HasProgress(_ context.Context) bool {
+	return len(m.progress) > 0
+}
+
 func (s *historyArchiverSuite) setupHistoryDirectory() {
 	now := time.Date(2020, 8, 22, 1, 2, 3, 4, time.UTC)
 
@@ -0,0 +1,501 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package s3test provides an in-process fake of the parts of the S3 REST API used by
+// the s3store archivers, so that archival can be tested end-to-end without AWS.
+package s3test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Server is an in-memory S3 server. Only path-style requests are supported, so
+	// clients must set S3ForcePathStyle. Requests are not authenticated.
+	Server struct {
+		server *httptest.Server
+
+		sync.Mutex
+		buckets      map[string]map[string]*object
+		uploads      map[string]*multipartUpload
+		nextUploadID int
+		completed    int
+		failRequest  func(*http.Request) bool
+	}
+
+	object struct {
+		data         []byte
+		etag         string
+		lastModified time.Time
+	}
+
+	multipartUpload struct {
+		bucket string
+		key    string
+		parts  map[int64]*object
+	}
+
+	errorResponse struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string   `xml:"Code"`
+		Message   string   `xml:"Message"`
+		Resource  string   `xml:"Resource"`
+		RequestID string   `xml:"RequestId"`
+	}
+
+	listBucketResult struct {
+		XMLName               xml.Name       `xml:"ListBucketResult"`
+		Name                  string         `xml:"Name"`
+		Prefix                string         `xml:"Prefix"`
+		Delimiter             string         `xml:"Delimiter,omitempty"`
+		MaxKeys               int            `xml:"MaxKeys"`
+		KeyCount              int            `xml:"KeyCount"`
+		IsTruncated           bool           `xml:"IsTruncated"`
+		ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+		NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+		StartAfter            string         `xml:"StartAfter,omitempty"`
+		Contents              []listContent  `xml:"Contents"`
+		CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+	}
+
+	listContent struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int    `xml:"Size"`
+		StorageClass string `xml:"StorageClass"`
+	}
+
+	commonPrefix struct {
+		Prefix string `xml:"Prefix"`
+	}
+
+	initiateMultipartUploadResult struct {
+		XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+		Bucket   string   `xml:"Bucket"`
+		Key      string   `xml:"Key"`
+		UploadID string   `xml:"UploadId"`
+	}
+
+	completeMultipartUploadRequest struct {
+		Parts []struct {
+			PartNumber int64  `xml:"PartNumber"`
+			ETag       string `xml:"ETag"`
+		} `xml:"Part"`
+	}
+
+	completeMultipartUploadResult struct {
+		XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		Bucket  string   `xml:"Bucket"`
+		Key     string   `xml:"Key"`
+		ETag    string   `xml:"ETag"`
+	}
+)
+
+// NewServer starts a new server without any buckets. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		buckets: make(map[string]map[string]*object),
+		uploads: make(map[string]*multipartUpload),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// URL returns the endpoint of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// CreateBucket creates an empty bucket, if it doesn't exist yet.
+func (s *Server) CreateBucket(bucket string) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.buckets[bucket]; !ok {
+		s.buckets[bucket] = make(map[string]*object)
+	}
+}
+
+// Object returns the content of an object.
+func (s *Server) Object(bucket string, key string) ([]byte, bool) {
+	s.Lock()
+	defer s.Unlock()
+	obj, ok := s.buckets[bucket][key]
+	if !ok {
+		return nil, false
+	}
+	return obj.data, true
+}
+
+// Keys returns the sorted keys of all objects in a bucket.
+func (s *Server) Keys(bucket string) []string {
+	s.Lock()
+	defer s.Unlock()
+	keys := make([]string, 0, len(s.buckets[bucket]))
+	for key := range s.buckets[bucket] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// MultipartUploads returns the number of multipart uploads which are neither completed nor aborted.
+func (s *Server) MultipartUploads() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.uploads)
+}
+
+// CompletedMultipartUploads returns the number of multipart uploads which were completed.
+func (s *Server) CompletedMultipartUploads() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.completed
+}
+
+// SetFailRequest sets a function which decides whether a request fails. Failed requests
+// get a non-retryable error response without being processed. Pass nil to stop failing requests.
+func (s *Server) SetFailRequest(failRequest func(*http.Request) bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.failRequest = failRequest
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.failRequest != nil && s.failRequest(r) {
+		writeError(w, r, http.StatusBadRequest, "InjectedFailure", "request failed by test")
+		return
+	}
+
+	bucket, key := splitPath(r.URL.Path)
+	if bucket == "" {
+		writeError(w, r, http.StatusBadRequest, "InvalidRequest", "only path-style requests for a bucket are supported")
+		return
+	}
+	if r.Method == http.MethodPut && key == "" {
+		if _, ok := s.buckets[bucket]; !ok {
+			s.buckets[bucket] = make(map[string]*object)
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "NoSuchBucket", "the specified bucket does not exist")
+		return
+	}
+
+	query := r.URL.Query()
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case key == "" && r.Method == http.MethodGet:
+		s.listObjects(w, r, bucket, objects)
+	case key == "":
+		writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "unsupported bucket operation")
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.createMultipartUpload(w, bucket, key)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		s.uploadPart(w, r, bucket, key)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		s.completeMultipartUpload(w, r, bucket, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		s.abortMultipartUpload(w, r, bucket, key)
+	case r.Method == http.MethodPut:
+		data, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		obj := newObject(data)
+		objects[key] = obj
+		w.Header().Set("ETag", obj.etag)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		obj, ok := objects[key]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "NoSuchKey", "the specified key does not exist")
+			return
+		}
+		w.Header().Set("ETag", obj.etag)
+		w.Header().Set("Last-Modified", obj.lastModified.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "unsupported object operation")
+	}
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, bucket string, objects map[string]*object) {
+	query := r.URL.Query()
+	if query.Get("list-type") != "2" {
+		writeError(w, r, http.StatusBadRequest, "InvalidRequest", "only ListObjectsV2 is supported")
+		return
+	}
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	maxKeys := 1000
+	if v := query.Get("max-keys"); v != "" {
+		var err error
+		if maxKeys, err = strconv.Atoi(v); err != nil || maxKeys < 0 {
+			writeError(w, r, http.StatusBadRequest, "InvalidArgument", "invalid max-keys")
+			return
+		}
+	}
+	// The continuation token is the last key or common prefix returned in the previous page.
+	startAfter := query.Get("start-after")
+	if token := query.Get("continuation-token"); token != "" {
+		decoded, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "InvalidArgument", "invalid continuation token")
+			return
+		}
+		startAfter = string(decoded)
+	}
+
+	var keys []string
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := &listBucketResult{
+		Name:              bucket,
+		Prefix:            prefix,
+		Delimiter:         delimiter,
+		MaxKeys:           maxKeys,
+		ContinuationToken: query.Get("continuation-token"),
+		StartAfter:        query.Get("start-after"),
+	}
+	last := ""
+	for _, key := range keys {
+		entry := key
+		isPrefix := false
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				entry = key[:len(prefix)+i+len(delimiter)]
+				isPrefix = true
+			}
+		}
+		if entry <= startAfter || entry == last {
+			continue
+		}
+		if result.KeyCount == maxKeys {
+			result.IsTruncated = true
+			result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(last))
+			break
+		}
+		last = entry
+		result.KeyCount++
+		if isPrefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: entry})
+			continue
+		}
+		obj := objects[key]
+		result.Contents = append(result.Contents, listContent{
+			Key:          key,
+			LastModified: obj.lastModified.Format(time.RFC3339),
+			ETag:         obj.etag,
+			Size:         len(obj.data),
+			StorageClass: "STANDARD",
+		})
+	}
+	writeXML(w, result)
+}
+
+func (s *Server) createMultipartUpload(w http.ResponseWriter, bucket string, key string) {
+	s.nextUploadID++
+	uploadID := strconv.Itoa(s.nextUploadID)
+	s.uploads[uploadID] = &multipartUpload{
+		bucket: bucket,
+		key:    key,
+		parts:  make(map[int64]*object),
+	}
+	writeXML(w, &initiateMultipartUploadResult{Bucket: bucket, Key: key, UploadID: uploadID})
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	upload, ok := s.getUpload(w, r, bucket, key)
+	if !ok {
+		return
+	}
+	partNumber, err := strconv.ParseInt(r.URL.Query().Get("partNumber"), 10, 64)
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		writeError(w, r, http.StatusBadRequest, "InvalidArgument", "part number must be an integer between 1 and 10000")
+		return
+	}
+	data, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	part := newObject(data)
+	upload.parts[partNumber] = part
+	w.Header().Set("ETag", part.etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	upload, ok := s.getUpload(w, r, bucket, key)
+	if !ok {
+		return
+	}
+	data, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+	var request completeMultipartUploadRequest
+	if err := xml.Unmarshal(data, &request); err != nil || len(request.Parts) == 0 {
+		writeError(w, r, http.StatusBadRequest, "MalformedXML", "invalid complete multipart upload request")
+		return
+	}
+
+	var content bytes.Buffer
+	lastPartNumber := int64(0)
+	for _, requestPart := range request.Parts {
+		part, ok := upload.parts[requestPart.PartNumber]
+		if !ok || part.etag != requestPart.ETag {
+			writeError(w, r, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("part %d was not uploaded or has a different ETag", requestPart.PartNumber))
+			return
+		}
+		if requestPart.PartNumber <= lastPartNumber {
+			writeError(w, r, http.StatusBadRequest, "InvalidPartOrder", "parts must be in ascending order")
+			return
+		}
+		lastPartNumber = requestPart.PartNumber
+		content.Write(part.data)
+	}
+
+	obj := newObject(content.Bytes())
+	s.buckets[bucket][key] = obj
+	delete(s.uploads, r.URL.Query().Get("uploadId"))
+	s.completed++
+	writeXML(w, &completeMultipartUploadResult{Bucket: bucket, Key: key, ETag: obj.etag})
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	if _, ok := s.getUpload(w, r, bucket, key); !ok {
+		return
+	}
+	delete(s.uploads, r.URL.Query().Get("uploadId"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getUpload(w http.ResponseWriter, r *http.Request, bucket string, key string) (*multipartUpload, bool) {
+	upload, ok := s.uploads[r.URL.Query().Get("uploadId")]
+	if !ok || upload.bucket != bucket || upload.key != key {
+		writeError(w, r, http.StatusNotFound, "NoSuchUpload", "the specified multipart upload does not exist")
+		return nil, false
+	}
+	return upload, true
+}
+
+func newObject(data []byte) *object {
+	sum := md5.Sum(data)
+	return &object{
+		data:         data,
+		etag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		lastModified: time.Now().UTC(),
+	}
+}
+
+func splitPath(path string) (bucket string, key string) {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+	return path, ""
+}
+
+// readBody reads the request body and validates its Content-MD5 header, if set.
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return nil, false
+	}
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		sum := md5.Sum(data)
+		if base64.StdEncoding.EncodeToString(sum[:]) != contentMD5 {
+			writeError(w, r, http.StatusBadRequest, "BadDigest", "the Content-MD5 you specified did not match what was received")
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code string, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	data, _ := xml.Marshal(&errorResponse{
+		Code:      code,
+		Message:   message,
+		Resource:  r.URL.Path,
+		RequestID: "s3test",
+	})
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
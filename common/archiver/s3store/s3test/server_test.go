@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/suite"
+)
+
+type serverSuite struct {
+	suite.Suite
+	server *Server
+	client *s3.S3
+}
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(serverSuite))
+}
+
+func (s *serverSuite) SetupTest() {
+	s.server = NewServer()
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(s.server.URL()),
+		Region:           aws.String("us-east-1"),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+	})
+	s.Require().NoError(err)
+	s.client = s3.New(sess)
+}
+
+func (s *serverSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *serverSuite) TestBucket() {
+	_, err := s.client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	s.Error(err)
+	_, err = s.client.PutObject(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key"), Body: bytes.NewReader(nil)})
+	s.Equal(s3.ErrCodeNoSuchBucket, err.(awserr.Error).Code())
+
+	_, err = s.client.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("bucket")})
+	s.NoError(err)
+	_, err = s.client.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	s.NoError(err)
+}
+
+func (s *serverSuite) TestObject() {
+	s.server.CreateBucket("bucket")
+	ctx := context.Background()
+
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/key"),
+		Body:   bytes.NewReader([]byte("content")),
+	})
+	s.NoError(err)
+
+	_, err = s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	s.NoError(err)
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	s.NoError(err)
+	data, err := io.ReadAll(out.Body)
+	s.NoError(err)
+	s.Equal("content", string(data))
+
+	_, err = s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	s.NoError(err)
+	_, err = s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	s.Equal("NotFound", err.(awserr.Error).Code())
+	_, err = s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("dir/key")})
+	s.Equal(s3.ErrCodeNoSuchKey, err.(awserr.Error).Code())
+}
+
+func (s *serverSuite) TestListObjectsV2() {
+	s.server.CreateBucket("bucket")
+	for _, key := range []string{"a/1", "a/2", "a/b/1", "a/c/1", "a/c/2", "b/1"} {
+		_, err := s.client.PutObject(&s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String(key),
+			Body:   bytes.NewReader([]byte(key)),
+		})
+		s.Require().NoError(err)
+	}
+
+	var keys, prefixes []string
+	err := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket:    aws.String("bucket"),
+		Prefix:    aws.String("a/"),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int64(1),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		s.Equal(int64(1), *page.KeyCount)
+		for _, content := range page.Contents {
+			keys = append(keys, *content.Key)
+		}
+		for _, prefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, *prefix.Prefix)
+		}
+		return true
+	})
+	s.NoError(err)
+	s.Equal([]string{"a/1", "a/2"}, keys)
+	s.Equal([]string{"a/b/", "a/c/"}, prefixes)
+
+	out, err := s.client.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket:     aws.String("bucket"),
+		StartAfter: aws.String("a/c/1"),
+	})
+	s.NoError(err)
+	s.False(*out.IsTruncated)
+	s.Len(out.Contents, 2)
+	s.Equal("a/c/2", *out.Contents[0].Key)
+	s.Equal("b/1", *out.Contents[1].Key)
+}
+
+func (s *serverSuite) TestMultipartUpload() {
+	s.server.CreateBucket("bucket")
+	ctx := context.Background()
+
+	create, err := s.client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	s.Require().NoError(err)
+	s.Equal(1, s.server.MultipartUploads())
+
+	var parts []*s3.CompletedPart
+	for i, content := range []string{"part1-", "part2-", "part3"} {
+		out, err := s.client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String("bucket"),
+			Key:        aws.String("key"),
+			UploadId:   create.UploadId,
+			PartNumber: aws.Int64(int64(i + 1)),
+			Body:       bytes.NewReader([]byte(content)),
+		})
+		s.Require().NoError(err)
+		parts = append(parts, &s3.CompletedPart{ETag: out.ETag, PartNumber: aws.Int64(int64(i + 1))})
+	}
+	_, ok := s.server.Object("bucket", "key")
+	s.False(ok)
+
+	_, err = s.client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String("bucket"),
+		Key:             aws.String("key"),
+		UploadId:        create.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	s.NoError(err)
+	data, ok := s.server.Object("bucket", "key")
+	s.True(ok)
+	s.Equal("part1-part2-part3", string(data))
+	s.Zero(s.server.MultipartUploads())
+	s.Equal(1, s.server.CompletedMultipartUploads())
+
+	_, err = s.client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String("bucket"),
+		Key:        aws.String("key"),
+		UploadId:   create.UploadId,
+		PartNumber: aws.Int64(1),
+		Body:       bytes.NewReader([]byte("late")),
+	})
+	s.Equal(s3.ErrCodeNoSuchUpload, err.(awserr.Error).Code())
+}
+
+func (s *serverSuite) TestAbortMultipartUpload() {
+	s.server.CreateBucket("bucket")
+
+	create, err := s.client.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	s.Require().NoError(err)
+	_, err = s.client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		UploadId: create.UploadId,
+	})
+	s.NoError(err)
+	s.Zero(s.server.MultipartUploads())
+	s.Zero(s.server.CompletedMultipartUploads())
+	s.Empty(s.server.Keys("bucket"))
+}
+
+func (s *serverSuite) TestFailRequest() {
+	s.server.CreateBucket("bucket")
+	s.server.SetFailRequest(func(r *http.Request) bool {
+		return r.Method == http.MethodPut
+	})
+	_, err := s.client.PutObject(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key"), Body: bytes.NewReader(nil)})
+	s.Error(err)
+	s.Empty(s.server.Keys("bucket"))
+
+	s.server.SetFailRequest(nil)
+	_, err = s.client.PutObject(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key"), Body: bytes.NewReader(nil)})
+	s.NoError(err)
+	s.Equal([]string{"key"}, s.server.Keys("bucket"))
+}
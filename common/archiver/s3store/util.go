@@ -203,6 +203,87 @@ func upload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key stri
 	return nil
 }
 
+func createMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) (string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	result, err := s3cli.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", convertUploadError(err)
+	}
+	return aws.StringValue(result.UploadId), nil
+}
+
+func uploadPart(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string, partNumber int64, data []byte, contentMD5 string) (string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	result, err := s3cli.UploadPartWithContext(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(URI.Hostname()),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int64(partNumber),
+		ContentMD5: aws.String(contentMD5),
+		Body:       bytes.NewReader(data),
+	})
+	if err != nil {
+		return "", convertUploadError(err)
+	}
+	return aws.StringValue(result.ETag), nil
+}
+
+func completeMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string, parts []*uploadedPart) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	completedParts := make([]*s3.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, &s3.CompletedPart{
+			PartNumber: aws.Int64(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+	_, err := s3cli.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(URI.Hostname()),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	return convertUploadError(err)
+}
+
+// abortMultipartUpload is a best effort operation, as s3 can be configured
+// to clean up incomplete multipart uploads anyway.
+func abortMultipartUpload(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string, uploadID string) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	_, _ = s3cli.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(URI.Hostname()),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+}
+
+func convertUploadError(err error) error {
+	if aerr, ok := err.(awserr.Error); ok {
+		if aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return serviceerror.NewInvalidArgument(errBucketNotExists.Error())
+		}
+	}
+	return err
+}
+
+func isNoSuchUploadError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == s3.ErrCodeNoSuchUpload
+	}
+	return false
+}
+
 func download(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) ([]byte, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
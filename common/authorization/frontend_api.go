@@ -38,6 +38,11 @@ var readOnlyNamespaceAPI = map[string]struct{}{
 	"DescribeTaskQueue":              {},
 	"GetSystemInfo":                  {},
 	"ListTaskQueuePartitions":        {},
+	"DescribeSchedule":               {},
+	"ListSchedules":                  {},
+	"PreviewScheduleSpec":            {},
+	"GetWorkerBuildIdOrdering":       {},
+	"DescribeTaskQueueBacklog":       {},
 }
 
 var readOnlyGlobalAPI = map[string]struct{}{
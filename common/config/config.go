@@ -369,6 +369,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// Custom contains the config blocks for archivers registered with
+		// temporal.WithCustomHistoryArchiver, keyed by URI scheme
+		Custom map[string]yaml.Node `yaml:",inline"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -386,6 +389,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		// Custom contains the config blocks for archivers registered with
+		// temporal.WithCustomVisibilityArchiver, keyed by URI scheme
+		Custom map[string]yaml.Node `yaml:",inline"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -404,6 +410,13 @@ type (
 		Region           string  `yaml:"region"`
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// MultipartUploadThreshold is the size in bytes above which history blobs are uploaded
+		// in multiple parts. History is then read into blobs of up to 4 times this size instead
+		// of 2MB. Defaults to 16MiB, a negative value disables multipart uploads.
+		MultipartUploadThreshold int64 `yaml:"multipartUploadThreshold"`
+		// MultipartUploadPartSize is the size in bytes of each part of a multipart upload.
+		// Must be at least 5MiB. Defaults to 8MiB.
+		MultipartUploadPartSize int64 `yaml:"multipartUploadPartSize"`
 	}
 
 	// PublicClient is config for connecting to temporal frontend
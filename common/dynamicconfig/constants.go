@@ -79,6 +79,12 @@ const (
 	EnableReadVisibilityFromES = "system.enableReadVisibilityFromES"
 	// EnableReadFromSecondaryAdvancedVisibility is the config to enable read from secondary Elasticsearch
 	EnableReadFromSecondaryAdvancedVisibility = "system.enableReadFromSecondaryAdvancedVisibility"
+	// EnableVisibilityShadowRead is the config to enable reading from both visibility stores and comparing results.
+	// Results of the store which is not selected for read are never returned to the caller.
+	EnableVisibilityShadowRead = "system.enableVisibilityShadowRead"
+	// VisibilityShadowReadConsistencyWindow is the time after last update of workflow execution
+	// during which differences between visibility stores are not reported by shadow read.
+	VisibilityShadowReadConsistencyWindow = "system.visibilityShadowReadConsistencyWindow"
 	// HistoryArchivalState is key for the state of history archival
 	HistoryArchivalState = "system.historyArchivalState"
 	// EnableReadFromHistoryArchival is key for enabling reading history from archival store
@@ -174,6 +180,8 @@ const (
 	FrontendMaxBadBinaries = "frontend.maxBadBinaries"
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	SendRawWorkflowHistory = "frontend.sendRawWorkflowHistory"
+	// FrontendEnableSchedules enables schedule-related RPCs in the frontend
+	FrontendEnableSchedules = "frontend.enableSchedules"
 	// SearchAttributesNumberOfKeysLimit is the limit of number of keys
 	SearchAttributesNumberOfKeysLimit = "frontend.searchAttributesNumberOfKeysLimit"
 	// SearchAttributesSizeOfValueLimit is the size limit of each value
@@ -270,6 +278,20 @@ const (
 	MatchingForwarderMaxChildrenPerNode = "matching.forwarderMaxChildrenPerNode"
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration = "matching.shutdownDrainDuration"
+	// MatchingEnablePriorityFairness makes matching dispatch tasks of all priority levels in proportion
+	// to their weight instead of strictly by priority, so that low priority tasks are not starved
+	MatchingEnablePriorityFairness = "matching.enablePriorityFairness"
+	// MatchingFairnessKeyWeights is the dispatch weight of task fairness keys, as a map from fairness key
+	// to integer weight. Keys without a weight get a weight of 1
+	MatchingFairnessKeyWeights = "matching.fairnessKeyWeights"
+	// MatchingEnableAdaptivePartitions makes the root partition of a task queue adjust its partition counts
+	// to the observed load. The configured numbers of read and write partitions become the maximum counts
+	MatchingEnableAdaptivePartitions = "matching.enableAdaptivePartitions"
+	// MatchingAdaptivePartitionsTargetRate is the task rate per partition the adaptive partition controller aims for
+	MatchingAdaptivePartitionsTargetRate = "matching.adaptivePartitionsTargetRate"
+	// MatchingAdaptivePartitionsUpdateInterval is the interval at which the adaptive partition controller
+	// observes the partitions of a task queue and updates its partition counts
+	MatchingAdaptivePartitionsUpdateInterval = "matching.adaptivePartitionsUpdateInterval"
 
 	// key for history
 
@@ -410,6 +432,9 @@ const (
 	VisibilityTaskWorkerCount = "history.visibilityTaskWorkerCount"
 	// VisibilityTaskMaxRetryCount is max times of retry for visibilityQueueProcessor
 	VisibilityTaskMaxRetryCount = "history.visibilityTaskMaxRetryCount"
+	// VisibilityTaskDLQMaxAttempts is the number of attempts after which a failing visibility task is moved
+	// to the visibility task DLQ of its shard, 0 means failing tasks are retried forever
+	VisibilityTaskDLQMaxAttempts = "history.visibilityTaskDLQMaxAttempts"
 	// VisibilityProcessorEnablePriorityTaskScheduler indicates whether host level priority task scheduler should be used for visibilityQueueProcessor
 	VisibilityProcessorEnablePriorityTaskScheduler = "history.visibilityProcessorEnablePriorityTaskScheduler"
 	// VisibilityProcessorSchedulerWorkerCount is the number of workers in the host level task scheduler for visibilityQueueProcessor
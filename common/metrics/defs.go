@@ -371,6 +371,8 @@ const (
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope
+	// HistoryClientUpsertWorkflowExecutionMetadataScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowExecutionMetadataScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
 	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
@@ -429,6 +431,10 @@ const (
 	MatchingClientDescribeTaskQueueScope
 	// MatchingClientListTaskQueuePartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskQueuePartitionsScope
+	// MatchingClientUpdateWorkerBuildIdOrderingScope tracks RPC calls to matching service
+	MatchingClientUpdateWorkerBuildIdOrderingScope
+	// MatchingClientGetWorkerBuildIdOrderingScope tracks RPC calls to matching service
+	MatchingClientGetWorkerBuildIdOrderingScope
 	// FrontendClientDeprecateNamespaceScope tracks RPC calls to frontend service
 	FrontendClientDeprecateNamespaceScope
 	// FrontendClientDescribeNamespaceScope tracks RPC calls to frontend service
@@ -565,6 +571,28 @@ const (
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
 	AdminClientGetTaskQueueTasksScope
+	// AdminClientCreateScheduleScope tracks RPC calls to admin service
+	AdminClientCreateScheduleScope
+	// AdminClientDescribeScheduleScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleScope
+	// AdminClientUpdateScheduleScope tracks RPC calls to admin service
+	AdminClientUpdateScheduleScope
+	// AdminClientPatchScheduleScope tracks RPC calls to admin service
+	AdminClientPatchScheduleScope
+	// AdminClientDeleteScheduleScope tracks RPC calls to admin service
+	AdminClientDeleteScheduleScope
+	// AdminClientListSchedulesScope tracks RPC calls to admin service
+	AdminClientListSchedulesScope
+	// AdminClientPreviewScheduleSpecScope tracks RPC calls to admin service
+	AdminClientPreviewScheduleSpecScope
+	// AdminClientCountWorkflowExecutionsScope tracks RPC calls to admin service
+	AdminClientCountWorkflowExecutionsScope
+	// AdminClientUpdateWorkerBuildIdOrderingScope tracks RPC calls to admin service
+	AdminClientUpdateWorkerBuildIdOrderingScope
+	// AdminClientGetWorkerBuildIdOrderingScope tracks RPC calls to admin service
+	AdminClientGetWorkerBuildIdOrderingScope
+	// AdminClientDescribeTaskQueueBacklogScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueueBacklogScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -645,6 +673,20 @@ const (
 	DCRedirectionUpdateNamespaceScope
 	// DCRedirectionListTaskQueuePartitionsScope tracks RPC calls for dc redirection
 	DCRedirectionListTaskQueuePartitionsScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPatchScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPatchScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
+	// DCRedirectionPreviewScheduleSpecScope tracks RPC calls for dc redirection
+	DCRedirectionPreviewScheduleSpecScope
 
 	// MessagingClientPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -763,6 +805,28 @@ const (
 	AdminResendReplicationTasksScope
 	// AdminGetTaskQueueTasksScope is the metric scope for admin.GetTaskQueueTasks
 	AdminGetTaskQueueTasksScope
+	// AdminCreateScheduleScope is the metric scope for admin.CreateSchedule
+	AdminCreateScheduleScope
+	// AdminDescribeScheduleScope is the metric scope for admin.DescribeSchedule
+	AdminDescribeScheduleScope
+	// AdminUpdateScheduleScope is the metric scope for admin.UpdateSchedule
+	AdminUpdateScheduleScope
+	// AdminPatchScheduleScope is the metric scope for admin.PatchSchedule
+	AdminPatchScheduleScope
+	// AdminDeleteScheduleScope is the metric scope for admin.DeleteSchedule
+	AdminDeleteScheduleScope
+	// AdminListSchedulesScope is the metric scope for admin.ListSchedules
+	AdminListSchedulesScope
+	// AdminPreviewScheduleSpecScope is the metric scope for admin.PreviewScheduleSpec
+	AdminPreviewScheduleSpecScope
+	// AdminCountWorkflowExecutionsScope is the metric scope for admin.CountWorkflowExecutions
+	AdminCountWorkflowExecutionsScope
+	// AdminUpdateWorkerBuildIdOrderingScope is the metric scope for admin.UpdateWorkerBuildIdOrdering
+	AdminUpdateWorkerBuildIdOrderingScope
+	// AdminGetWorkerBuildIdOrderingScope is the metric scope for admin.GetWorkerBuildIdOrdering
+	AdminGetWorkerBuildIdOrderingScope
+	// AdminDescribeTaskQueueBacklogScope is the metric scope for admin.DescribeTaskQueueBacklog
+	AdminDescribeTaskQueueBacklogScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardScope is the metric scope for admin.AdminCloseShardScope
@@ -935,6 +999,8 @@ const (
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope
+	// HistoryUpsertWorkflowExecutionMetadataScope tracks UpsertWorkflowExecutionMetadata API calls received by service
+	HistoryUpsertWorkflowExecutionMetadataScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
 	// HistoryScheduleWorkflowTaskScope tracks ScheduleWorkflowTask API calls received by service
@@ -1147,6 +1213,10 @@ const (
 	MatchingDescribeTaskQueueScope
 	// MatchingListTaskQueuePartitionsScope tracks ListTaskQueuePartitions API calls received by service
 	MatchingListTaskQueuePartitionsScope
+	// MatchingUpdateWorkerBuildIdOrderingScope tracks UpdateWorkerBuildIdOrdering API calls received by service
+	MatchingUpdateWorkerBuildIdOrderingScope
+	// MatchingGetWorkerBuildIdOrderingScope tracks GetWorkerBuildIdOrdering API calls received by service
+	MatchingGetWorkerBuildIdOrderingScope
 
 	NumMatchingScopes
 )
@@ -1344,6 +1414,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWorkflowExecutionScope:                {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:       {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:               {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpsertWorkflowExecutionMetadataScope:        {operation: "HistoryClientUpsertWorkflowExecutionMetadata", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:             {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientDeleteWorkflowExecutionScope:                {operation: "HistoryClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:                 {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
@@ -1365,15 +1436,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGenerateLastHistoryReplicationTasksScope:    {operation: "HistoryClientGenerateLastHistoryReplicationTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGetReplicationStatusScope:                   {operation: "HistoryClientGetReplicationStatusScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 
-		MatchingClientPollWorkflowTaskQueueScope:     {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientPollActivityTaskQueueScope:     {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientAddActivityTaskScope:           {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientAddWorkflowTaskScope:           {operation: "MatchingClientAddWorkflowTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientQueryWorkflowScope:             {operation: "MatchingClientQueryWorkflow", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientRespondQueryTaskCompletedScope: {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientCancelOutstandingPollScope:     {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientDescribeTaskQueueScope:         {operation: "MatchingClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientListTaskQueuePartitionsScope:   {operation: "MatchingClientListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientPollWorkflowTaskQueueScope:       {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientPollActivityTaskQueueScope:       {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientAddActivityTaskScope:             {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientAddWorkflowTaskScope:             {operation: "MatchingClientAddWorkflowTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientQueryWorkflowScope:               {operation: "MatchingClientQueryWorkflow", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientRespondQueryTaskCompletedScope:   {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientCancelOutstandingPollScope:       {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientDescribeTaskQueueScope:           {operation: "MatchingClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientListTaskQueuePartitionsScope:     {operation: "MatchingClientListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateWorkerBuildIdOrderingScope: {operation: "MatchingClientUpdateWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientGetWorkerBuildIdOrderingScope:    {operation: "MatchingClientGetWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 
 		FrontendClientDeprecateNamespaceScope:                 {operation: "FrontendClientDeprecateNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeNamespaceScope:                  {operation: "FrontendClientDescribeNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
@@ -1437,6 +1510,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientRefreshWorkflowTasksScope:             {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:           {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetTaskQueueTasksScope:                {operation: "AdminClientGetTaskQueueTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCreateScheduleScope:                   {operation: "AdminClientCreateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeScheduleScope:                 {operation: "AdminClientDescribeSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateScheduleScope:                   {operation: "AdminClientUpdateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPatchScheduleScope:                    {operation: "AdminClientPatchSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteScheduleScope:                   {operation: "AdminClientDeleteSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListSchedulesScope:                    {operation: "AdminClientListSchedules", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPreviewScheduleSpecScope:              {operation: "AdminClientPreviewScheduleSpec", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCountWorkflowExecutionsScope:          {operation: "AdminClientCountWorkflowExecutions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkerBuildIdOrderingScope:      {operation: "AdminClientUpdateWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkerBuildIdOrderingScope:         {operation: "AdminClientGetWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskQueueBacklogScope:         {operation: "AdminClientDescribeTaskQueueBacklog", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientListClusterMembersScope:               {operation: "AdminClientListClusterMembers", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                       {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetShardScope:                         {operation: "AdminClientGetShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1485,6 +1569,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionTerminateWorkflowExecutionScope:         {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateNamespaceScope:                    {operation: "DCRedirectionUpdateNamespace", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskQueuePartitionsScope:            {operation: "DCRedirectionListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                     {operation: "DCRedirectionCreateSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                   {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                     {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPatchScheduleScope:                      {operation: "DCRedirectionPatchSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                     {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                      {operation: "DCRedirectionListSchedules", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPreviewScheduleSpecScope:                {operation: "DCRedirectionPreviewScheduleSpec", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1541,6 +1632,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminRefreshWorkflowTasksScope:                  {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:                {operation: "ResendReplicationTasks"},
 		AdminGetTaskQueueTasksScope:                     {operation: "GetTaskQueueTasks"},
+		AdminCreateScheduleScope:                        {operation: "AdminCreateSchedule"},
+		AdminDescribeScheduleScope:                      {operation: "AdminDescribeSchedule"},
+		AdminUpdateScheduleScope:                        {operation: "AdminUpdateSchedule"},
+		AdminPatchScheduleScope:                         {operation: "AdminPatchSchedule"},
+		AdminDeleteScheduleScope:                        {operation: "AdminDeleteSchedule"},
+		AdminListSchedulesScope:                         {operation: "AdminListSchedules"},
+		AdminPreviewScheduleSpecScope:                   {operation: "AdminPreviewScheduleSpec"},
+		AdminCountWorkflowExecutionsScope:               {operation: "AdminCountWorkflowExecutions"},
+		AdminUpdateWorkerBuildIdOrderingScope:           {operation: "AdminUpdateWorkerBuildIdOrdering"},
+		AdminGetWorkerBuildIdOrderingScope:              {operation: "AdminGetWorkerBuildIdOrdering"},
+		AdminDescribeTaskQueueBacklogScope:              {operation: "AdminDescribeTaskQueueBacklog"},
 		AdminDescribeClusterScope:                       {operation: "AdminDescribeCluster"},
 		AdminListClustersScope:                          {operation: "AdminListClusters"},
 		AdminAddOrUpdateRemoteClusterScope:              {operation: "AdminAddOrUpdateRemoteCluster"},
@@ -1613,6 +1715,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWorkflowExecutionScope:                {operation: "SignalWorkflowExecution"},
 		HistorySignalWithStartWorkflowExecutionScope:       {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:               {operation: "RemoveSignalMutableState"},
+		HistoryUpsertWorkflowExecutionMetadataScope:        {operation: "UpsertWorkflowExecutionMetadata"},
 		HistoryTerminateWorkflowExecutionScope:             {operation: "TerminateWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                 {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                          {operation: "QueryWorkflow"},
@@ -1715,17 +1818,19 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollWorkflowTaskQueueScope:     {operation: "PollWorkflowTaskQueue"},
-		MatchingPollActivityTaskQueueScope:     {operation: "PollActivityTaskQueue"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddWorkflowTaskScope:           {operation: "AddWorkflowTask"},
-		MatchingTaskQueueMgrScope:              {operation: "TaskQueueMgr"},
-		MatchingEngineScope:                    {operation: "MatchingEngine"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskQueueScope:         {operation: "DescribeTaskQueue"},
-		MatchingListTaskQueuePartitionsScope:   {operation: "ListTaskQueuePartitions"},
+		MatchingPollWorkflowTaskQueueScope:       {operation: "PollWorkflowTaskQueue"},
+		MatchingPollActivityTaskQueueScope:       {operation: "PollActivityTaskQueue"},
+		MatchingAddActivityTaskScope:             {operation: "AddActivityTask"},
+		MatchingAddWorkflowTaskScope:             {operation: "AddWorkflowTask"},
+		MatchingTaskQueueMgrScope:                {operation: "TaskQueueMgr"},
+		MatchingEngineScope:                      {operation: "MatchingEngine"},
+		MatchingQueryWorkflowScope:               {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:   {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:       {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskQueueScope:           {operation: "DescribeTaskQueue"},
+		MatchingListTaskQueuePartitionsScope:     {operation: "ListTaskQueuePartitions"},
+		MatchingUpdateWorkerBuildIdOrderingScope: {operation: "UpdateWorkerBuildIdOrdering"},
+		MatchingGetWorkerBuildIdOrderingScope:    {operation: "GetWorkerBuildIdOrdering"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1841,6 +1946,11 @@ const (
 	VisibilityPersistenceInternal
 	VisibilityPersistenceUnavailable
 
+	VisibilityShadowReadRequests
+	VisibilityShadowReadFailures
+	VisibilityShadowReadSkipped
+	VisibilityShadowReadMismatches
+
 	SequentialTaskSubmitRequest
 	SequentialTaskSubmitRequestTaskQueueExist
 	SequentialTaskSubmitRequestTaskQueueMissing
@@ -1934,6 +2044,7 @@ const (
 	TaskLatency
 	TaskFailures
 	TaskDiscarded
+	TaskMovedToDLQ
 	TaskSkipped
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
@@ -2329,6 +2440,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		VisibilityPersistenceInternal:          NewCounterDef("visibility_persistence_internal"),
 		VisibilityPersistenceUnavailable:       NewCounterDef("visibility_persistence_unavailable"),
 
+		VisibilityShadowReadRequests:   NewCounterDef("visibility_shadow_read_requests"),
+		VisibilityShadowReadFailures:   NewCounterDef("visibility_shadow_read_errors"),
+		VisibilityShadowReadSkipped:    NewCounterDef("visibility_shadow_read_skipped"),
+		VisibilityShadowReadMismatches: NewCounterDef("visibility_shadow_read_mismatches"),
+
 		SequentialTaskSubmitRequest:                 NewCounterDef("sequentialtask_submit_request"),
 		SequentialTaskSubmitRequestTaskQueueExist:   NewCounterDef("sequentialtask_submit_request_taskqueue_exist"),
 		SequentialTaskSubmitRequestTaskQueueMissing: NewCounterDef("sequentialtask_submit_request_taskqueue_missing"),
@@ -2415,6 +2531,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskAttemptTimer:         NewDimensionlessHistogramDef("task_attempt"),
 		TaskFailures:             NewCounterDef("task_errors"),
 		TaskDiscarded:            NewCounterDef("task_errors_discarded"),
+		TaskMovedToDLQ:           NewCounterDef("task_errors_moved_to_dlq"),
 		TaskSkipped:              NewCounterDef("task_skipped"),
 		TaskStandbyRetryCounter:  NewCounterDef("task_errors_standby_retry_counter"),
 		TaskWorkflowBusyCounter:  NewCounterDef("task_errors_workflow_busy"),
@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// CustomSearchAttributesMapper maps custom search attribute field names to namespace aliases and vice versa.
+	// Zero value has no aliases.
+	CustomSearchAttributesMapper struct {
+		fieldToAlias map[string]string
+		aliasToField map[string]string
+	}
+
+	// searchAttributesMapper is searchattribute.Mapper implementation which uses aliases
+	// persisted in namespace config.
+	searchAttributesMapper struct {
+		registry Registry
+	}
+)
+
+var _ searchattribute.Mapper = (*searchAttributesMapper)(nil)
+
+func newCustomSearchAttributesMapper(fieldToAlias map[string]string) CustomSearchAttributesMapper {
+	if len(fieldToAlias) == 0 {
+		return CustomSearchAttributesMapper{}
+	}
+	m := CustomSearchAttributesMapper{
+		fieldToAlias: make(map[string]string, len(fieldToAlias)),
+		aliasToField: make(map[string]string, len(fieldToAlias)),
+	}
+	for fieldName, alias := range fieldToAlias {
+		m.fieldToAlias[fieldName] = alias
+		m.aliasToField[alias] = fieldName
+	}
+	return m
+}
+
+// GetAlias returns alias for field name and true if field has alias.
+func (m CustomSearchAttributesMapper) GetAlias(fieldName string) (string, bool) {
+	alias, ok := m.fieldToAlias[fieldName]
+	return alias, ok
+}
+
+// GetFieldName returns field name for alias and true if alias exists.
+func (m CustomSearchAttributesMapper) GetFieldName(alias string) (string, bool) {
+	fieldName, ok := m.aliasToField[alias]
+	return fieldName, ok
+}
+
+// FieldToAliasMap returns copy of field name to alias map.
+func (m CustomSearchAttributesMapper) FieldToAliasMap() map[string]string {
+	result := make(map[string]string, len(m.fieldToAlias))
+	for fieldName, alias := range m.fieldToAlias {
+		result[fieldName] = alias
+	}
+	return result
+}
+
+// NewSearchAttributesMapper returns searchattribute.Mapper which uses custom search attribute aliases from namespace config.
+// Custom search attributes without alias are mapped to itself to keep namespaces which don't use aliases working as before.
+// If customMapper is set (using temporal.WithSearchAttributesMapper server option), it is used instead.
+func NewSearchAttributesMapper(registry Registry, customMapper searchattribute.Mapper) searchattribute.Mapper {
+	if customMapper != nil {
+		return customMapper
+	}
+	return &searchAttributesMapper{
+		registry: registry,
+	}
+}
+
+func (m *searchAttributesMapper) GetAlias(fieldName string, namespaceName string) (string, error) {
+	ns, err := m.registry.GetNamespace(Name(namespaceName))
+	if err != nil {
+		return "", err
+	}
+	if alias, ok := ns.CustomSearchAttributesMapper().GetAlias(fieldName); ok {
+		return alias, nil
+	}
+	return fieldName, nil
+}
+
+func (m *searchAttributesMapper) GetFieldName(alias string, namespaceName string) (string, error) {
+	ns, err := m.registry.GetNamespace(Name(namespaceName))
+	if err != nil {
+		return "", err
+	}
+	if fieldName, ok := ns.CustomSearchAttributesMapper().GetFieldName(alias); ok {
+		return fieldName, nil
+	}
+	return alias, nil
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestSearchAttributesMapper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	registry := namespace.NewMockRegistry(ctrl)
+	mapper := namespace.NewSearchAttributesMapper(registry, nil)
+
+	ns := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "test-namespace"},
+		&persistencespb.NamespaceConfig{
+			CustomSearchAttributeAliases: map[string]string{
+				"CustomKeywordField01": "CustomerId",
+				"CustomIntField01":     "Priority",
+			},
+		},
+		"active",
+	)
+	registry.EXPECT().GetNamespace(namespace.Name("test-namespace")).Return(ns, nil).AnyTimes()
+
+	alias, err := mapper.GetAlias("CustomKeywordField01", "test-namespace")
+	require.NoError(t, err)
+	require.Equal(t, "CustomerId", alias)
+	fieldName, err := mapper.GetFieldName("Priority", "test-namespace")
+	require.NoError(t, err)
+	require.Equal(t, "CustomIntField01", fieldName)
+
+	// Fields without aliases are mapped to itself.
+	alias, err = mapper.GetAlias("CustomKeywordField", "test-namespace")
+	require.NoError(t, err)
+	require.Equal(t, "CustomKeywordField", alias)
+	fieldName, err = mapper.GetFieldName("CustomKeywordField", "test-namespace")
+	require.NoError(t, err)
+	require.Equal(t, "CustomKeywordField", fieldName)
+
+	registry.EXPECT().GetNamespace(namespace.Name("unknown-namespace")).Return(nil, serviceerror.NewNamespaceNotFound("unknown-namespace"))
+	_, err = mapper.GetFieldName("CustomerId", "unknown-namespace")
+	var nsNotFound *serviceerror.NamespaceNotFound
+	require.ErrorAs(t, err, &nsNotFound)
+
+	sa := map[string]string{"CustomKeywordField01": "CustomerId", "CustomIntField01": "Priority"}
+	require.Equal(t, sa, ns.CustomSearchAttributesMapper().FieldToAliasMap())
+}
+
+func TestSearchAttributesMapper_CustomMapper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	customMapper := searchattribute.NewMockMapper(ctrl)
+	require.Equal(t, customMapper, namespace.NewSearchAttributesMapper(namespace.NewMockRegistry(ctrl), customMapper))
+}
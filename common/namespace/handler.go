@@ -71,6 +71,11 @@ type (
 			ctx context.Context,
 			registerRequest *workflowservice.RegisterNamespaceRequest,
 		) (*workflowservice.RegisterNamespaceResponse, error)
+		UpdateCustomSearchAttributeAliases(
+			ctx context.Context,
+			name Name,
+			updateFn func(fieldToAlias map[string]string) error,
+		) error
 		UpdateNamespace(
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
@@ -599,6 +604,80 @@ func (d *HandlerImpl) UpdateNamespace(
 	return response, nil
 }
 
+// UpdateCustomSearchAttributeAliases updates custom search attribute aliases of the namespace.
+// updateFn modifies the map from field name to alias in place. Aliases of global namespace
+// are replicated to other clusters like the rest of namespace config.
+func (d *HandlerImpl) UpdateCustomSearchAttributeAliases(
+	ctx context.Context,
+	name Name,
+	updateFn func(fieldToAlias map[string]string) error,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: name.String()})
+	if err != nil {
+		return err
+	}
+
+	info := getResponse.Namespace.Info
+	config := getResponse.Namespace.Config
+	replicationConfig := getResponse.Namespace.ReplicationConfig
+	if getResponse.IsGlobalNamespace && replicationConfig.ActiveClusterName != d.clusterMetadata.GetCurrentClusterName() {
+		return serviceerror.NewNamespaceNotActive(name.String(), d.clusterMetadata.GetCurrentClusterName(), replicationConfig.ActiveClusterName)
+	}
+
+	fieldToAlias := make(map[string]string, len(config.CustomSearchAttributeAliases))
+	for fieldName, alias := range config.CustomSearchAttributeAliases {
+		fieldToAlias[fieldName] = alias
+	}
+	if err := updateFn(fieldToAlias); err != nil {
+		return err
+	}
+	config.CustomSearchAttributeAliases = fieldToAlias
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        info,
+			Config:                      config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		info,
+		config,
+		replicationConfig,
+		configVersion,
+		getResponse.Namespace.FailoverVersion,
+		getResponse.IsGlobalNamespace,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Update namespace custom search attribute aliases succeeded",
+		tag.WorkflowNamespace(info.Name),
+		tag.WorkflowNamespaceID(info.Id),
+	)
+	return nil
+}
+
 // DeprecateNamespace deprecates a namespace
 // Deprecated.
 func (d *HandlerImpl) DeprecateNamespace(
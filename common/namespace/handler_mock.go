@@ -119,6 +119,20 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// UpdateCustomSearchAttributeAliases mocks base method.
+func (m *MockHandler) UpdateCustomSearchAttributeAliases(ctx context.Context, name Name, updateFn func(map[string]string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomSearchAttributeAliases", ctx, name, updateFn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomSearchAttributeAliases indicates an expected call of UpdateCustomSearchAttributeAliases.
+func (mr *MockHandlerMockRecorder) UpdateCustomSearchAttributeAliases(ctx, name, updateFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomSearchAttributeAliases", reflect.TypeOf((*MockHandler)(nil).UpdateCustomSearchAttributeAliases), ctx, name, updateFn)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
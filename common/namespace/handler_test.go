@@ -26,6 +26,7 @@ package namespace
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -459,6 +460,38 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_PromoteLocalNamespace(
 	s.Equal(cluster.TestCurrentClusterInitialFailoverVersion, descResp.FailoverVersion)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateCustomSearchAttributeAliases() {
+	namespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        namespace,
+		Description:                      namespace,
+		WorkflowExecutionRetentionPeriod: timestamp.DurationPtr(24 * time.Hour),
+		IsGlobalNamespace:                false,
+	}
+	_, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+
+	err = s.handler.UpdateCustomSearchAttributeAliases(context.Background(), Name(namespace), func(fieldToAlias map[string]string) error {
+		s.Empty(fieldToAlias)
+		fieldToAlias["CustomKeywordField01"] = "Alias01"
+		return nil
+	})
+	s.NoError(err)
+
+	updateErr := errors.New("update error")
+	err = s.handler.UpdateCustomSearchAttributeAliases(context.Background(), Name(namespace), func(fieldToAlias map[string]string) error {
+		s.Equal(map[string]string{"CustomKeywordField01": "Alias01"}, fieldToAlias)
+		delete(fieldToAlias, "CustomKeywordField01")
+		return updateErr
+	})
+	s.Equal(updateErr, err)
+
+	resp, err := s.metadataMgr.GetNamespace(context.Background(), &persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Equal(map[string]string{"CustomKeywordField01": "Alias01"}, resp.Namespace.Config.CustomSearchAttributeAliases)
+	s.Equal(int64(1), resp.Namespace.ConfigVersion)
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
@@ -67,6 +67,8 @@ type (
 		isGlobalNamespace           bool
 		failoverNotificationVersion int64
 		notificationVersion         int64
+
+		customSearchAttributesMapper CustomSearchAttributesMapper
 	}
 )
 
@@ -89,6 +91,8 @@ func FromPersistentState(record *persistence.GetNamespaceResponse) *Namespace {
 		isGlobalNamespace:           record.IsGlobalNamespace,
 		failoverNotificationVersion: record.Namespace.FailoverNotificationVersion,
 		notificationVersion:         record.NotificationVersion,
+
+		customSearchAttributesMapper: newCustomSearchAttributesMapper(record.Namespace.Config.GetCustomSearchAttributeAliases()),
 	}
 }
 
@@ -246,6 +250,11 @@ func (t Namespaces) Less(i, j int) bool {
 	return t[i].notificationVersion < t[j].notificationVersion
 }
 
+// CustomSearchAttributesMapper returns mapper between custom search attribute field names and aliases defined for this namespace.
+func (ns *Namespace) CustomSearchAttributesMapper() CustomSearchAttributesMapper {
+	return ns.customSearchAttributesMapper
+}
+
 // Retention returns retention duration for this namespace.
 func (ns *Namespace) Retention() time.Duration {
 	if ns.config.Retention == nil {
@@ -46,6 +46,8 @@ func NewLocalNamespaceForTest(
 			Clusters:          []string{targetCluster},
 		},
 		failoverVersion: common.EmptyVersion,
+
+		customSearchAttributesMapper: newCustomSearchAttributesMapper(config.GetCustomSearchAttributeAliases()),
 	}
 }
 
@@ -63,6 +65,8 @@ func NewNamespaceForTest(
 		isGlobalNamespace: isGlobalNamespace,
 		replicationConfig: derefRepConfig(repConfig),
 		failoverVersion:   failoverVersion,
+
+		customSearchAttributesMapper: newCustomSearchAttributesMapper(config.GetCustomSearchAttributeAliases()),
 	}
 }
 
@@ -79,6 +83,8 @@ func NewGlobalNamespaceForTest(
 		isGlobalNamespace: true,
 		replicationConfig: derefRepConfig(repConfig),
 		failoverVersion:   failoverVersion,
+
+		customSearchAttributesMapper: newCustomSearchAttributesMapper(config.GetCustomSearchAttributeAliases()),
 	}
 }
 
@@ -114,8 +114,9 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          namespaceReplicator.convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
+			ConfigVersion:                configVersion,
+			FailoverVersion:              failoverVersion,
+			CustomSearchAttributeAliases: config.CustomSearchAttributeAliases,
 		},
 	}
 
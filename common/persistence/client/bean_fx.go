@@ -44,6 +44,7 @@ var BeanDepsModule = fx.Options(
 	fx.Provide(MetadataManagerProvider),
 	fx.Provide(TaskManagerProvider),
 	fx.Provide(NamespaceReplicationQueueProvider),
+	fx.Provide(VisibilityTaskDLQProvider),
 	fx.Provide(ShardManagerProvider),
 	fx.Provide(ExecutionManagerProvider),
 )
@@ -82,6 +83,10 @@ func TaskManagerProvider(factory Factory) (persistence.TaskManager, error) {
 func NamespaceReplicationQueueProvider(factory Factory) (persistence.NamespaceReplicationQueue, error) {
 	return factory.NewNamespaceReplicationQueue()
 }
+
+func VisibilityTaskDLQProvider(factory Factory) (persistence.VisibilityTaskDLQ, error) {
+	return factory.NewVisibilityTaskDLQ()
+}
 func ShardManagerProvider(factory Factory) (persistence.ShardManager, error) {
 	return factory.NewShardManager()
 }
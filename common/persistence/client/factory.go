@@ -50,6 +50,8 @@ type (
 		NewExecutionManager() (p.ExecutionManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewVisibilityTaskDLQ returns a new DLQ for visibility tasks history failed to process
+		NewVisibilityTaskDLQ() (p.VisibilityTaskDLQ, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 	}
@@ -193,6 +195,25 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsClient, f.logger)
 }
 
+func (f *factoryImpl) NewVisibilityTaskDLQ() (p.VisibilityTaskDLQ, error) {
+	newQueue := func(queueType p.QueueType) (p.Queue, error) {
+		result, err := f.dataStoreFactory.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.ratelimiter != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		return result, nil
+	}
+
+	return p.NewVisibilityTaskDLQ(newQueue, f.serializer), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
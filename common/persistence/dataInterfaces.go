@@ -59,6 +59,10 @@ const (
 	NamespaceReplicationQueueType QueueType = iota + 1
 )
 
+// visibilityTaskDLQQueueTypeBase is the first queue type used by the per shard
+// visibility task DLQs, leaving the lower range for the static queue types above.
+const visibilityTaskDLQQueueTypeBase QueueType = 1 << 20
+
 // Create Workflow Execution Mode
 const (
 	// CreateWorkflowModeBrandNew fail if current record exists
@@ -142,9 +142,9 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 
 	if options.DBPort == 0 {
 		switch options.SQLDBPluginName {
-		case mysql.PluginName:
+		case mysql.PluginName, mysql.PluginNameV8:
 			options.DBPort = environment.GetMySQLPort()
-		case postgresql.PluginName:
+		case postgresql.PluginName, postgresql.PluginNameV12:
 			options.DBPort = environment.GetPostgreSQLPort()
 		case sqlite.PluginName:
 			options.DBPort = 0
@@ -154,9 +154,9 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	}
 	if options.DBHost == "" {
 		switch options.SQLDBPluginName {
-		case mysql.PluginName:
+		case mysql.PluginName, mysql.PluginNameV8:
 			options.DBHost = environment.GetMySQLAddress()
-		case postgresql.PluginName:
+		case postgresql.PluginName, postgresql.PluginNameV12:
 			options.DBHost = environment.GetPostgreSQLAddress()
 		case sqlite.PluginName:
 			options.DBHost = environment.Localhost
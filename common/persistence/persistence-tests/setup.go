@@ -37,10 +37,14 @@ const (
 	testMySQLPassword  = "temporal"
 	testMySQLSchemaDir = "schema/mysql/v57"
 
+	testMySQL8SchemaDir = "schema/mysql/v8"
+
 	testPostgreSQLUser      = "temporal"
 	testPostgreSQLPassword  = "temporal"
 	testPostgreSQLSchemaDir = "schema/postgresql/v96"
 
+	testPostgreSQL12SchemaDir = "schema/postgresql/v12"
+
 	testSQLiteUser      = ""
 	testSQLitePassword  = ""
 	testSQLiteMode      = "memory"
@@ -61,6 +65,20 @@ func GetMySQLTestClusterOption() *TestBaseOptions {
 	}
 }
 
+// GetMySQL8TestClusterOption return test options for the mysql8 plugin,
+// whose schema dir only contains the advanced visibility schema
+func GetMySQL8TestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: mysql.PluginNameV8,
+		DBUsername:      testMySQLUser,
+		DBPassword:      testMySQLPassword,
+		DBHost:          environment.GetMySQLAddress(),
+		DBPort:          environment.GetMySQLPort(),
+		SchemaDir:       testMySQL8SchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
 // GetPostgreSQLTestClusterOption return test options
 func GetPostgreSQLTestClusterOption() *TestBaseOptions {
 	return &TestBaseOptions{
@@ -74,6 +92,20 @@ func GetPostgreSQLTestClusterOption() *TestBaseOptions {
 	}
 }
 
+// GetPostgreSQL12TestClusterOption return test options for the postgres12 plugin,
+// whose schema dir only contains the advanced visibility schema
+func GetPostgreSQL12TestClusterOption() *TestBaseOptions {
+	return &TestBaseOptions{
+		SQLDBPluginName: postgresql.PluginNameV12,
+		DBUsername:      testPostgreSQLUser,
+		DBPassword:      testPostgreSQLPassword,
+		DBHost:          environment.GetPostgreSQLAddress(),
+		DBPort:          environment.GetPostgreSQLPort(),
+		SchemaDir:       testPostgreSQL12SchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
+
 // GetSQLiteTestClusterOption return test options
 func GetSQLiteFileTestClusterOption() *TestBaseOptions {
 	return &TestBaseOptions{
@@ -104,7 +104,10 @@ func (s *TestCluster) SetupTestDatabase() {
 		}
 		schemaDir = path.Join(temporalPackageDir, schemaDir)
 	}
-	s.LoadSchema(path.Join(schemaDir, "temporal", "schema.sql"))
+	// schema dirs of the advanced visibility plugins only contain the visibility schema
+	if _, err := os.Stat(path.Join(schemaDir, "temporal", "schema.sql")); err == nil {
+		s.LoadSchema(path.Join(schemaDir, "temporal", "schema.sql"))
+	}
 	s.LoadSchema(path.Join(schemaDir, "visibility", "schema.sql"))
 }
 
@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	mysqlschema "go.temporal.io/server/schema/mysql"
+)
+
+const (
+	templateAdvancedVisibilityFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, close_time, history_length, state_transition_count, memo, encoding, task_queue, search_attributes`
+
+	templateInsertIntoAdvancedVisibility = `INSERT INTO executions_visibility (` +
+		`namespace_id, run_id, start_time, execution_time, workflow_id, workflow_type_name, status, close_time, history_length, execution_duration, state_transition_count, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CAST(? AS JSON)) `
+
+	templateCreateAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON DUPLICATE KEY UPDATE ` +
+		`run_id=VALUES(run_id)`
+
+	templateReplaceAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON DUPLICATE KEY UPDATE start_time = VALUES(start_time), execution_time = VALUES(execution_time), workflow_id = VALUES(workflow_id), workflow_type_name = VALUES(workflow_type_name), ` +
+		`status = VALUES(status), close_time = VALUES(close_time), history_length = VALUES(history_length), execution_duration = VALUES(execution_duration), ` +
+		`state_transition_count = VALUES(state_transition_count), memo = VALUES(memo), encoding = VALUES(encoding), task_queue = VALUES(task_queue), search_attributes = VALUES(search_attributes)`
+
+	templateUpsertAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON DUPLICATE KEY UPDATE ` +
+		`execution_time = IF(close_time IS NULL, VALUES(execution_time), execution_time), ` +
+		`state_transition_count = IF(close_time IS NULL, VALUES(state_transition_count), state_transition_count), ` +
+		`memo = IF(close_time IS NULL, VALUES(memo), memo), ` +
+		`encoding = IF(close_time IS NULL, VALUES(encoding), encoding), ` +
+		`task_queue = IF(close_time IS NULL, VALUES(task_queue), task_queue), ` +
+		`search_attributes = IF(close_time IS NULL, VALUES(search_attributes), search_attributes)`
+)
+
+// advancedVisibilityDB is the visibility database of the mysql8 plugin,
+// which uses the advanced visibility schema from schema/mysql/v8/visibility
+type advancedVisibilityDB struct {
+	*db
+}
+
+var _ sqlplugin.AdminDB = (*advancedVisibilityDB)(nil)
+var _ sqlplugin.DB = (*advancedVisibilityDB)(nil)
+var _ sqlplugin.AdvancedVisibility = (*advancedVisibilityDB)(nil)
+
+func newAdvancedVisibilityDB(mdb *db) *advancedVisibilityDB {
+	return &advancedVisibilityDB{db: mdb}
+}
+
+// PluginName returns the name of the mysql8 plugin
+func (mdb *advancedVisibilityDB) PluginName() string {
+	return PluginNameV8
+}
+
+// ExpectedVersion returns expected version.
+func (mdb *advancedVisibilityDB) ExpectedVersion() string {
+	return mysqlschema.AdvancedVisibilityVersion
+}
+
+// VerifyVersion verify schema version is up to date
+func (mdb *advancedVisibilityDB) VerifyVersion() error {
+	expectedVersion := mdb.ExpectedVersion()
+	return schema.VerifyCompatibleVersion(mdb, mdb.dbName, expectedVersion)
+}
+
+// InsertIntoAdvancedVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (mdb *advancedVisibilityDB) InsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateCreateAdvancedVisibility,
+		mdb.rowArgs(row)...,
+	)
+}
+
+// ReplaceIntoAdvancedVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (mdb *advancedVisibilityDB) ReplaceIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateReplaceAdvancedVisibility,
+		mdb.rowArgs(row)...,
+	)
+}
+
+// UpsertIntoAdvancedVisibility creates a new row in visibility table or updates the mutable columns
+// of an existing row, unless the workflow of that row is already closed
+func (mdb *advancedVisibilityDB) UpsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		templateUpsertAdvancedVisibility,
+		mdb.rowArgs(row)...,
+	)
+}
+
+// SelectFromAdvancedVisibility reads one page of rows which match the filter from visibility table
+func (mdb *advancedVisibilityDB) SelectFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) ([]sqlplugin.AdvancedVisibilityRow, error) {
+	whereClause, args := mdb.whereClause(filter)
+	query := `SELECT ` + templateAdvancedVisibilityFieldNames + ` FROM executions_visibility WHERE ` + whereClause
+	if len(filter.OrderBy) > 0 {
+		query += ` ORDER BY ` + strings.Join(filter.OrderBy, ", ")
+	}
+	query += ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqlplugin.AdvancedVisibilityRow
+	if err := mdb.conn.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromAdvancedVisibility counts the rows which match the filter in visibility table
+func (mdb *advancedVisibilityDB) CountFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) (int64, error) {
+	whereClause, args := mdb.whereClause(filter)
+	var count int64
+	err := mdb.conn.GetContext(ctx,
+		&count,
+		`SELECT COUNT(*) FROM executions_visibility WHERE `+whereClause,
+		args...,
+	)
+	return count, err
+}
+
+func (mdb *advancedVisibilityDB) rowArgs(row *sqlplugin.AdvancedVisibilityRow) []interface{} {
+	var closeTime *time.Time
+	if row.CloseTime != nil {
+		t := mdb.converter.ToMySQLDateTime(*row.CloseTime)
+		closeTime = &t
+	}
+	// JSON values can't be created from binary strings.
+	var searchAttributes *string
+	if row.SearchAttributes != nil {
+		s := string(row.SearchAttributes)
+		searchAttributes = &s
+	}
+	return []interface{}{
+		row.NamespaceID,
+		row.RunID,
+		mdb.converter.ToMySQLDateTime(row.StartTime),
+		mdb.converter.ToMySQLDateTime(row.ExecutionTime),
+		row.WorkflowID,
+		row.WorkflowTypeName,
+		row.Status,
+		closeTime,
+		row.HistoryLength,
+		row.ExecutionDuration,
+		row.StateTransitionCount,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributes,
+	}
+}
+
+func (mdb *advancedVisibilityDB) whereClause(filter sqlplugin.AdvancedVisibilitySelectFilter) (string, []interface{}) {
+	args := []interface{}{filter.NamespaceID}
+	if filter.Where == "" {
+		return "namespace_id = ?", args
+	}
+	for _, arg := range filter.WhereArgs {
+		if t, ok := arg.(time.Time); ok {
+			arg = mdb.converter.ToMySQLDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return fmt.Sprintf("namespace_id = ? AND (%s)", filter.Where), args
+}
@@ -37,14 +37,20 @@ import (
 const (
 	// PluginName is the name of the plugin
 	PluginName = "mysql"
+	// PluginNameV8 is the name of the plugin for MySQL 8, which uses the advanced visibility schema
+	PluginNameV8 = "mysql8"
 )
 
-type plugin struct{}
+type plugin struct {
+	// advancedVisibility is set if the visibility database uses the advanced visibility schema
+	advancedVisibility bool
+}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{})
+	sql.RegisterPlugin(PluginNameV8, &plugin{advancedVisibility: true})
 }
 
 // CreateDB initialize the db object
@@ -58,6 +64,9 @@ func (p *plugin) CreateDB(
 		return nil, err
 	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	if p.advancedVisibility && dbKind == sqlplugin.DbKindVisibility {
+		return newAdvancedVisibilityDB(db), nil
+	}
 	return db, nil
 }
 
@@ -72,6 +81,9 @@ func (p *plugin) CreateAdminDB(
 		return nil, err
 	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	if p.advancedVisibility && dbKind == sqlplugin.DbKindVisibility {
+		return newAdvancedVisibilityDB(db), nil
+	}
 	return db, nil
 }
 
@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/persistence/schema"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	postgresqlschema "go.temporal.io/server/schema/postgresql"
+)
+
+const (
+	templateAdvancedVisibilityFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, close_time, history_length, state_transition_count, memo, encoding, task_queue, search_attributes`
+
+	templateInsertIntoAdvancedVisibility = `INSERT INTO executions_visibility (` +
+		`namespace_id, run_id, start_time, execution_time, workflow_id, workflow_type_name, status, close_time, history_length, execution_duration, state_transition_count, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15::jsonb) `
+
+	templateCreateAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON CONFLICT (namespace_id, run_id) DO NOTHING`
+
+	templateReplaceAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON CONFLICT (namespace_id, run_id) DO UPDATE ` +
+		`SET start_time = excluded.start_time, execution_time = excluded.execution_time, workflow_id = excluded.workflow_id, workflow_type_name = excluded.workflow_type_name, ` +
+		`status = excluded.status, close_time = excluded.close_time, history_length = excluded.history_length, execution_duration = excluded.execution_duration, ` +
+		`state_transition_count = excluded.state_transition_count, memo = excluded.memo, encoding = excluded.encoding, task_queue = excluded.task_queue, search_attributes = excluded.search_attributes`
+
+	templateUpsertAdvancedVisibility = templateInsertIntoAdvancedVisibility +
+		`ON CONFLICT (namespace_id, run_id) DO UPDATE ` +
+		`SET execution_time = excluded.execution_time, state_transition_count = excluded.state_transition_count, memo = excluded.memo, encoding = excluded.encoding, ` +
+		`task_queue = excluded.task_queue, search_attributes = excluded.search_attributes ` +
+		`WHERE executions_visibility.close_time IS NULL`
+)
+
+// advancedVisibilityDB is the visibility database of the postgres12 plugin,
+// which uses the advanced visibility schema from schema/postgresql/v12/visibility
+type advancedVisibilityDB struct {
+	*db
+}
+
+var _ sqlplugin.AdminDB = (*advancedVisibilityDB)(nil)
+var _ sqlplugin.DB = (*advancedVisibilityDB)(nil)
+var _ sqlplugin.AdvancedVisibility = (*advancedVisibilityDB)(nil)
+
+func newAdvancedVisibilityDB(pdb *db) *advancedVisibilityDB {
+	return &advancedVisibilityDB{db: pdb}
+}
+
+// PluginName returns the name of the postgres12 plugin
+func (pdb *advancedVisibilityDB) PluginName() string {
+	return PluginNameV12
+}
+
+// ExpectedVersion returns expected version.
+func (pdb *advancedVisibilityDB) ExpectedVersion() string {
+	return postgresqlschema.AdvancedVisibilityVersion
+}
+
+// VerifyVersion verify schema version is up to date
+func (pdb *advancedVisibilityDB) VerifyVersion() error {
+	expectedVersion := pdb.ExpectedVersion()
+	return schema.VerifyCompatibleVersion(pdb, pdb.dbName, expectedVersion)
+}
+
+// InsertIntoAdvancedVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (pdb *advancedVisibilityDB) InsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		templateCreateAdvancedVisibility,
+		pdb.rowArgs(row)...,
+	)
+}
+
+// ReplaceIntoAdvancedVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (pdb *advancedVisibilityDB) ReplaceIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		templateReplaceAdvancedVisibility,
+		pdb.rowArgs(row)...,
+	)
+}
+
+// UpsertIntoAdvancedVisibility creates a new row in visibility table or updates the mutable columns
+// of an existing row, unless the workflow of that row is already closed
+func (pdb *advancedVisibilityDB) UpsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.AdvancedVisibilityRow,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		templateUpsertAdvancedVisibility,
+		pdb.rowArgs(row)...,
+	)
+}
+
+// SelectFromAdvancedVisibility reads one page of rows which match the filter from visibility table
+func (pdb *advancedVisibilityDB) SelectFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) ([]sqlplugin.AdvancedVisibilityRow, error) {
+	whereClause, args := pdb.whereClause(filter)
+	query := `SELECT ` + templateAdvancedVisibilityFieldNames + ` FROM executions_visibility WHERE ` + whereClause
+	if len(filter.OrderBy) > 0 {
+		query += ` ORDER BY ` + strings.Join(filter.OrderBy, ", ")
+	}
+	query += ` LIMIT ? OFFSET ?`
+	args = append(args, filter.PageSize, filter.Offset)
+
+	var rows []sqlplugin.AdvancedVisibilityRow
+	if err := pdb.conn.SelectContext(ctx, &rows, pdb.conn.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgreSQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgreSQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgreSQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromAdvancedVisibility counts the rows which match the filter in visibility table
+func (pdb *advancedVisibilityDB) CountFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) (int64, error) {
+	whereClause, args := pdb.whereClause(filter)
+	var count int64
+	err := pdb.conn.GetContext(ctx,
+		&count,
+		pdb.conn.Rebind(`SELECT COUNT(*) FROM executions_visibility WHERE `+whereClause),
+		args...,
+	)
+	return count, err
+}
+
+func (pdb *advancedVisibilityDB) rowArgs(row *sqlplugin.AdvancedVisibilityRow) []interface{} {
+	var closeTime *time.Time
+	if row.CloseTime != nil {
+		t := pdb.converter.ToPostgreSQLDateTime(*row.CloseTime)
+		closeTime = &t
+	}
+	// Byte slices are sent as bytea, which can't be cast to jsonb.
+	var searchAttributes *string
+	if row.SearchAttributes != nil {
+		s := string(row.SearchAttributes)
+		searchAttributes = &s
+	}
+	return []interface{}{
+		row.NamespaceID,
+		row.RunID,
+		pdb.converter.ToPostgreSQLDateTime(row.StartTime),
+		pdb.converter.ToPostgreSQLDateTime(row.ExecutionTime),
+		row.WorkflowID,
+		row.WorkflowTypeName,
+		row.Status,
+		closeTime,
+		row.HistoryLength,
+		row.ExecutionDuration,
+		row.StateTransitionCount,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		searchAttributes,
+	}
+}
+
+func (pdb *advancedVisibilityDB) whereClause(filter sqlplugin.AdvancedVisibilitySelectFilter) (string, []interface{}) {
+	args := []interface{}{filter.NamespaceID}
+	if filter.Where == "" {
+		return "namespace_id = ?", args
+	}
+	for _, arg := range filter.WhereArgs {
+		if t, ok := arg.(time.Time); ok {
+			arg = pdb.converter.ToPostgreSQLDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return fmt.Sprintf("namespace_id = ? AND (%s)", filter.Where), args
+}
@@ -41,6 +41,8 @@ import (
 const (
 	// PluginName is the name of the plugin
 	PluginName = "postgres"
+	// PluginNameV12 is the name of the plugin for PostgreSQL 12+, which uses the advanced visibility schema
+	PluginNameV12 = "postgres12"
 )
 
 var (
@@ -50,12 +52,16 @@ var (
 	}
 )
 
-type plugin struct{}
+type plugin struct {
+	// advancedVisibility is set if the visibility database uses the advanced visibility schema
+	advancedVisibility bool
+}
 
 var _ sqlplugin.Plugin = (*plugin)(nil)
 
 func init() {
 	sql.RegisterPlugin(PluginName, &plugin{})
+	sql.RegisterPlugin(PluginNameV12, &plugin{advancedVisibility: true})
 }
 
 // CreateDB initialize the db object
@@ -69,6 +75,9 @@ func (d *plugin) CreateDB(
 		return nil, err
 	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	if d.advancedVisibility && dbKind == sqlplugin.DbKindVisibility {
+		return newAdvancedVisibilityDB(db), nil
+	}
 	return db, nil
 }
 
@@ -83,6 +92,9 @@ func (d *plugin) CreateAdminDB(
 		return nil, err
 	}
 	db := newDB(dbKind, cfg.DatabaseName, conn, nil)
+	if d.advancedVisibility && dbKind == sqlplugin.DbKindVisibility {
+		return newAdvancedVisibilityDB(db), nil
+	}
 	return db, nil
 }
 
@@ -65,6 +65,45 @@ type (
 		RunID       string
 	}
 
+	// AdvancedVisibilityRow represents a row in executions_visibility table of the advanced visibility schema
+	AdvancedVisibilityRow struct {
+		VisibilityRow
+		ExecutionDuration    *int64
+		StateTransitionCount *int64
+		// SearchAttributes is a JSON object of search attribute values
+		SearchAttributes []byte
+	}
+
+	// AdvancedVisibilitySelectFilter contains the conditions of a query against the advanced visibility schema
+	AdvancedVisibilitySelectFilter struct {
+		NamespaceID string
+		// Where is a condition on executions_visibility columns with ? placeholders for WhereArgs.
+		// It is built by the visibility query converter and may be empty.
+		Where     string
+		WhereArgs []interface{}
+		// OrderBy is the list of ORDER BY expressions. Ignored by CountFromAdvancedVisibility.
+		OrderBy  []string
+		PageSize int
+		Offset   int
+	}
+
+	// AdvancedVisibility is implemented by the databases of plugins which use the advanced visibility
+	// schema. It stores search attributes and runs queries of the visibility query language.
+	AdvancedVisibility interface {
+		Visibility
+
+		// InsertIntoAdvancedVisibility inserts a row into visibility table. If a row already exist,
+		// no changes will be made by this API
+		InsertIntoAdvancedVisibility(ctx context.Context, row *AdvancedVisibilityRow) (sql.Result, error)
+		// ReplaceIntoAdvancedVisibility inserts a row into visibility table or replaces all columns of an existing row
+		ReplaceIntoAdvancedVisibility(ctx context.Context, row *AdvancedVisibilityRow) (sql.Result, error)
+		// UpsertIntoAdvancedVisibility inserts a row into visibility table or updates the columns which
+		// may change while the workflow is running. Rows of closed workflows are left unchanged
+		UpsertIntoAdvancedVisibility(ctx context.Context, row *AdvancedVisibilityRow) (sql.Result, error)
+		SelectFromAdvancedVisibility(ctx context.Context, filter AdvancedVisibilitySelectFilter) ([]AdvancedVisibilityRow, error)
+		CountFromAdvancedVisibility(ctx context.Context, filter AdvancedVisibilitySelectFilter) (int64, error)
+	}
+
 	Visibility interface {
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
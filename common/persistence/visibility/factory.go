@@ -59,6 +59,8 @@ func NewManager(
 	advancedVisibilityWritingMode dynamicconfig.StringPropertyFn,
 	enableReadFromSecondaryAdvancedVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	enableWriteToSecondaryAdvancedVisibility dynamicconfig.BoolPropertyFn,
+	enableShadowRead dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	shadowReadConsistencyWindow dynamicconfig.DurationPropertyFn,
 
 	metricsClient metrics.Client,
 	logger log.Logger,
@@ -66,6 +68,9 @@ func NewManager(
 	stdVisibilityManager, err := NewStandardManager(
 		persistenceCfg,
 		persistenceResolver,
+		defaultIndexName,
+		searchAttributesProvider,
+		searchAttributesMapper,
 		standardVisibilityPersistenceMaxReadQPS,
 		standardVisibilityPersistenceMaxWriteQPS,
 		metricsClient,
@@ -135,6 +140,10 @@ func NewManager(
 			advVisibilityManager,
 			secondaryVisibilityManager,
 			managerSelector,
+			enableShadowRead,
+			shadowReadConsistencyWindow,
+			metricsClient,
+			logger,
 		), nil
 	}
 
@@ -148,6 +157,10 @@ func NewManager(
 		stdVisibilityManager,
 		advVisibilityManager,
 		managerSelector,
+		enableShadowRead,
+		shadowReadConsistencyWindow,
+		metricsClient,
+		logger,
 	), nil
 }
 
@@ -155,6 +168,10 @@ func NewStandardManager(
 	persistenceCfg config.Persistence,
 	persistenceResolver resolver.ServiceResolver,
 
+	defaultIndexName string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+
 	standardVisibilityPersistenceMaxReadQPS dynamicconfig.IntPropertyFn,
 	standardVisibilityPersistenceMaxWriteQPS dynamicconfig.IntPropertyFn,
 
@@ -165,6 +182,9 @@ func NewStandardManager(
 	stdVisibilityStore, err := newStandardVisibilityStore(
 		persistenceCfg,
 		persistenceResolver,
+		defaultIndexName,
+		searchAttributesProvider,
+		searchAttributesMapper,
 		logger)
 	if err != nil {
 		return nil, err
@@ -247,6 +267,9 @@ func newVisibilityManager(
 func newStandardVisibilityStore(
 	persistenceCfg config.Persistence,
 	persistenceResolver resolver.ServiceResolver,
+	defaultIndexName string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
 	logger log.Logger,
 ) (store.VisibilityStore, error) {
 	// If standard visibility is not configured.
@@ -264,6 +287,20 @@ func newStandardVisibilityStore(
 	case visibilityStoreCfg.Cassandra != nil:
 		store, err = cassandra.NewVisibilityStore(*visibilityStoreCfg.Cassandra, persistenceResolver, logger)
 	case visibilityStoreCfg.SQL != nil:
+		// SQL plugins with advanced visibility schema support queries natively and don't need to be wrapped.
+		advancedStore, advancedErr := sql.NewSQLAdvancedVisibilityStore(
+			*visibilityStoreCfg.SQL,
+			persistenceResolver,
+			defaultIndexName,
+			searchAttributesProvider,
+			searchAttributesMapper,
+			logger)
+		if advancedErr != nil {
+			return nil, advancedErr
+		}
+		if advancedStore != nil {
+			return advancedStore, nil
+		}
 		store, err = sql.NewSQLVisibilityStore(*visibilityStoreCfg.SQL, persistenceResolver, logger)
 	}
 
@@ -139,6 +139,14 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups are set (possibly to empty slice) if query has GROUP BY clause.
+		Groups []CountWorkflowExecutionsGroup
+	}
+
+	// CountWorkflowExecutionsGroup is the number of workflow executions which have the same value of GROUP BY field
+	CountWorkflowExecutionsGroup struct {
+		Value string
+		Count int64
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
@@ -37,6 +37,8 @@ import (
 type (
 	managerSelector interface {
 		readManager(namespace namespace.Name) manager.VisibilityManager
+		// shadowReadManager returns manager which is not selected for read for the namespace.
+		shadowReadManager(namespace namespace.Name) manager.VisibilityManager
 		writeManagers() ([]manager.VisibilityManager, error)
 	}
 
@@ -106,6 +108,13 @@ func (v *sqlToESManagerSelector) readManager(namespace namespace.Name) manager.V
 	return v.stdVisibilityManager
 }
 
+func (v *sqlToESManagerSelector) shadowReadManager(namespace namespace.Name) manager.VisibilityManager {
+	if v.enableAdvancedVisibilityRead(namespace.String()) {
+		return v.stdVisibilityManager
+	}
+	return v.advVisibilityManager
+}
+
 func (v *esManagerSelector) writeManagers() ([]manager.VisibilityManager, error) {
 	managers := []manager.VisibilityManager{v.visibilityManager}
 	if v.enableWriteToSecondaryVisibility() {
@@ -121,3 +130,10 @@ func (v *esManagerSelector) readManager(namespace namespace.Name) manager.Visibi
 	}
 	return v.visibilityManager
 }
+
+func (v *esManagerSelector) shadowReadManager(namespace namespace.Name) manager.VisibilityManager {
+	if v.enableReadFromSecondaryVisibility(namespace.String()) {
+		return v.visibilityManager
+	}
+	return v.secondaryVisibilityManager
+}
@@ -38,3 +38,10 @@ func TestMySQLVisibilityPersistenceSuite(t *testing.T) {
 	}
 	suite.Run(t, s)
 }
+
+func TestMySQL8VisibilityPersistenceSuite(t *testing.T) {
+	s := &VisibilityPersistenceSuite{
+		TestBase: persistencetests.NewTestBaseWithSQL(persistencetests.GetMySQL8TestClusterOption()),
+	}
+	suite.Run(t, s)
+}
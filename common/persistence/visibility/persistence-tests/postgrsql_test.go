@@ -38,3 +38,10 @@ func TestPostgreSQLVisibilityPersistenceSuite(t *testing.T) {
 	}
 	suite.Run(t, s)
 }
+
+func TestPostgreSQL12VisibilityPersistenceSuite(t *testing.T) {
+	s := &VisibilityPersistenceSuite{
+		TestBase: persistencetests.NewTestBaseWithSQL(persistencetests.GetPostgreSQL12TestClusterOption()),
+	}
+	suite.Run(t, s)
+}
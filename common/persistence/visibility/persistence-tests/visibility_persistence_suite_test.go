@@ -73,6 +73,9 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 	s.VisibilityMgr, err = visibility.NewStandardManager(
 		cfg,
 		resolver.NewNoopResolver(),
+		"",
+		searchattribute.NewTestProvider(),
+		nil,
 		dynamicconfig.GetIntPropertyFn(1000),
 		dynamicconfig.GetIntPropertyFn(1000),
 		metrics.NoopClient,
@@ -664,6 +667,46 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+// TestUpsertWorkflowExecutionAfterClose test
+func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecutionAfterClose() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Now().UTC().Add(time.Second * -5)
+	startReq := s.createOpenWorkflowRecord(testNamespaceUUID, "visibility-workflow-test-upsert-after-close", "visibility-workflow", startTime, "test-queue")
+	closeReq := s.createClosedWorkflowRecord(startReq, time.Now())
+
+	// upsert task processed after the close record must not reopen the workflow
+	err := s.VisibilityMgr.UpsertWorkflowExecution(s.ctx, &manager.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: &manager.VisibilityRequestBase{
+			NamespaceID:      startReq.NamespaceID,
+			Execution:        startReq.Execution,
+			WorkflowTypeName: startReq.WorkflowTypeName,
+			StartTime:        startReq.StartTime,
+			TaskQueue:        startReq.TaskQueue,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	})
+	s.Nil(err)
+
+	resp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       testNamespaceUUID,
+		PageSize:          1,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	})
+	s.Nil(err)
+	s.Equal(0, len(resp.Executions))
+
+	resp, err = s.VisibilityMgr.ListClosedWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequest{
+		NamespaceID:       testNamespaceUUID,
+		PageSize:          1,
+		EarliestStartTime: startTime,
+		LatestStartTime:   time.Now(),
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.assertClosedExecutionEquals(closeReq, resp.Executions[0])
+}
+
 // TestAdvancedVisibilityPagination test
 func (s *VisibilityPersistenceSuite) TestAdvancedVisibilityPagination() {
 	testNamespaceUUID := namespace.ID(uuid.New())
@@ -0,0 +1,341 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	shadowReadTimeout             = 10 * time.Second
+	shadowReadMismatchLogRPS      = 1
+	shadowReadMaxLoggedExecutions = 10
+	// shadowReadMaxConcurrency bounds the number of shadow reads in flight per visibility manager,
+	// so a slow shadow store doesn't pile up goroutines. Shadow reads over the limit are skipped.
+	shadowReadMaxConcurrency = 10
+)
+
+type (
+	// shadowReadComparator reads from the visibility store which is not selected for read
+	// and compares its results with results returned to the caller.
+	// It is used to verify a new visibility store before switching reads to it.
+	shadowReadComparator struct {
+		consistencyWindow dynamicconfig.DurationPropertyFn
+		metricsClient     metrics.Client
+		logger            log.Logger
+		timeNow           func() time.Time
+		// inFlight is a semaphore which holds a token for each shadow read in flight.
+		inFlight chan struct{}
+	}
+
+	// listOrder returns true if execution a is listed before execution b.
+	listOrder func(a *workflowpb.WorkflowExecutionInfo, b *workflowpb.WorkflowExecutionInfo) bool
+
+	// listDiff is the difference between two pages of workflow executions.
+	listDiff struct {
+		missingInShadow  []string
+		missingInPrimary []string
+		statusMismatch   []string
+	}
+)
+
+func newShadowReadComparator(
+	consistencyWindow dynamicconfig.DurationPropertyFn,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *shadowReadComparator {
+	return &shadowReadComparator{
+		consistencyWindow: consistencyWindow,
+		metricsClient:     metricsClient,
+		logger:            log.NewThrottledLogger(logger, func() float64 { return shadowReadMismatchLogRPS }),
+		timeNow:           time.Now,
+		inFlight:          make(chan struct{}, shadowReadMaxConcurrency),
+	}
+}
+
+// compareList reads the page from shadow manager asynchronously and compares it with primary response.
+// Only the first page of a list is compared: page tokens are specific to visibility store,
+// so next pages can't be read from shadow manager. The shadow read uses the same request, so both
+// first pages have the same size and, if order is set, are the first executions in that order.
+// They are compared as prefixes of the list up to the last execution of the shorter one (see
+// listPrefix). If order is nil, e.g. for queries with ORDER BY clause, the first page is
+// compared only if it is also the last page in both stores.
+func (c *shadowReadComparator) compareList(
+	scope int,
+	operation string,
+	ns namespace.Name,
+	token []byte,
+	order listOrder,
+	primaryResponse *manager.ListWorkflowExecutionsResponse,
+	listFn func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error),
+) {
+	if len(token) != 0 {
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadSkipped)
+		return
+	}
+
+	c.goCompare(scope, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shadowReadTimeout)
+		defer cancel()
+
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadRequests)
+		shadowResponse, err := listFn(ctx)
+		if err != nil {
+			c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadFailures)
+			c.logger.Warn("Visibility shadow read failed.", tag.Operation(operation), tag.WorkflowNamespace(ns.String()), tag.Error(err))
+			return
+		}
+
+		primaryExecutions, shadowExecutions := primaryResponse.Executions, shadowResponse.Executions
+		if len(primaryResponse.NextPageToken) != 0 || len(shadowResponse.NextPageToken) != 0 {
+			if order == nil {
+				c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadSkipped)
+				return
+			}
+			primaryExecutions, shadowExecutions = listPrefix(order, primaryResponse, shadowResponse)
+		}
+
+		diff := c.diffList(primaryExecutions, shadowExecutions)
+		if diff.isEmpty() {
+			return
+		}
+
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadMismatches)
+		c.logger.Warn("Visibility shadow read returned different workflow executions.",
+			tag.Operation(operation),
+			tag.WorkflowNamespace(ns.String()),
+			tag.NewStringsTag("missing-in-shadow", truncate(diff.missingInShadow)),
+			tag.NewStringsTag("missing-in-primary", truncate(diff.missingInPrimary)),
+			tag.NewStringsTag("status-mismatch", truncate(diff.statusMismatch)),
+		)
+	})
+}
+
+// compareCount counts executions with shadow manager asynchronously and compares result with primary response.
+func (c *shadowReadComparator) compareCount(
+	scope int,
+	operation string,
+	ns namespace.Name,
+	query string,
+	primaryResponse *manager.CountWorkflowExecutionsResponse,
+	countFn func(ctx context.Context) (*manager.CountWorkflowExecutionsResponse, error),
+) {
+	c.goCompare(scope, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shadowReadTimeout)
+		defer cancel()
+
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadRequests)
+		shadowResponse, err := countFn(ctx)
+		if err != nil {
+			c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadFailures)
+			c.logger.Warn("Visibility shadow read failed.", tag.Operation(operation), tag.WorkflowNamespace(ns.String()), tag.Error(err))
+			return
+		}
+
+		difference := diffCount(primaryResponse, shadowResponse)
+		if difference == "" {
+			return
+		}
+
+		// Counts can't be adjusted for executions updated within consistency window,
+		// so small differences are expected while workflows are being updated.
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadMismatches)
+		c.logger.Warn("Visibility shadow read returned different count of workflow executions.",
+			tag.Operation(operation),
+			tag.WorkflowNamespace(ns.String()),
+			tag.NewStringTag("query", query),
+			tag.NewStringTag("difference", difference),
+		)
+	})
+}
+
+// goCompare runs compareFn in a new goroutine if the number of shadow reads in flight is below
+// shadowReadMaxConcurrency, otherwise the shadow read is skipped.
+func (c *shadowReadComparator) goCompare(scope int, compareFn func()) {
+	select {
+	case c.inFlight <- struct{}{}:
+	default:
+		c.metricsClient.IncCounter(scope, metrics.VisibilityShadowReadSkipped)
+		return
+	}
+
+	go func() {
+		defer func() { <-c.inFlight }()
+		compareFn()
+	}()
+}
+
+// diffList compares executions ignoring their order and executions which were updated within consistency window.
+func (c *shadowReadComparator) diffList(
+	primaryExecutions []*workflowpb.WorkflowExecutionInfo,
+	shadowExecutions []*workflowpb.WorkflowExecutionInfo,
+) listDiff {
+	consistentBefore := c.timeNow().Add(-c.consistencyWindow())
+
+	shadowByKey := make(map[string]*workflowpb.WorkflowExecutionInfo, len(shadowExecutions))
+	for _, execution := range shadowExecutions {
+		shadowByKey[executionKey(execution)] = execution
+	}
+
+	var diff listDiff
+	for _, primary := range primaryExecutions {
+		key := executionKey(primary)
+		shadow, ok := shadowByKey[key]
+		delete(shadowByKey, key)
+
+		if !ok {
+			if isConsistent(primary, consistentBefore) {
+				diff.missingInShadow = append(diff.missingInShadow, key)
+			}
+			continue
+		}
+		if primary.GetStatus() != shadow.GetStatus() && isConsistent(primary, consistentBefore) && isConsistent(shadow, consistentBefore) {
+			diff.statusMismatch = append(diff.statusMismatch, key)
+		}
+	}
+	for _, shadow := range shadowExecutions {
+		key := executionKey(shadow)
+		if _, ok := shadowByKey[key]; ok && isConsistent(shadow, consistentBefore) {
+			diff.missingInPrimary = append(diff.missingInPrimary, key)
+		}
+	}
+	return diff
+}
+
+// listPrefix returns the executions of both pages which are listed before the last execution of
+// every page which has a next page. Both stores must list these executions in their first pages,
+// whereas executions after that, including those equal to a last execution in order, can be on the
+// next page of one store and on the first page of the other.
+func listPrefix(
+	order listOrder,
+	primaryResponse *manager.ListWorkflowExecutionsResponse,
+	shadowResponse *manager.ListWorkflowExecutionsResponse,
+) ([]*workflowpb.WorkflowExecutionInfo, []*workflowpb.WorkflowExecutionInfo) {
+	var bounds []*workflowpb.WorkflowExecutionInfo
+	for _, response := range []*manager.ListWorkflowExecutionsResponse{primaryResponse, shadowResponse} {
+		if len(response.NextPageToken) != 0 && len(response.Executions) != 0 {
+			bounds = append(bounds, response.Executions[len(response.Executions)-1])
+		}
+	}
+	inPrefix := func(execution *workflowpb.WorkflowExecutionInfo) bool {
+		for _, bound := range bounds {
+			if !order(execution, bound) {
+				return false
+			}
+		}
+		return true
+	}
+	filter := func(executions []*workflowpb.WorkflowExecutionInfo) []*workflowpb.WorkflowExecutionInfo {
+		var result []*workflowpb.WorkflowExecutionInfo
+		for _, execution := range executions {
+			if inPrefix(execution) {
+				result = append(result, execution)
+			}
+		}
+		return result
+	}
+	return filter(primaryResponse.Executions), filter(shadowResponse.Executions)
+}
+
+// listByStartTime is the order of open workflow executions lists.
+func listByStartTime(a *workflowpb.WorkflowExecutionInfo, b *workflowpb.WorkflowExecutionInfo) bool {
+	return timestamp.TimeValue(a.GetStartTime()).After(timestamp.TimeValue(b.GetStartTime()))
+}
+
+// listByCloseTime is the order of closed workflow executions lists.
+func listByCloseTime(a *workflowpb.WorkflowExecutionInfo, b *workflowpb.WorkflowExecutionInfo) bool {
+	return timestamp.TimeValue(a.GetCloseTime()).After(timestamp.TimeValue(b.GetCloseTime()))
+}
+
+// listByDefaultOrder is the order of queries without ORDER BY clause: open executions first,
+// then by close time and start time.
+func listByDefaultOrder(a *workflowpb.WorkflowExecutionInfo, b *workflowpb.WorkflowExecutionInfo) bool {
+	aClosed, bClosed := a.GetCloseTime() != nil, b.GetCloseTime() != nil
+	switch {
+	case aClosed != bClosed:
+		return bClosed
+	case aClosed && !a.GetCloseTime().Equal(*b.GetCloseTime()):
+		return listByCloseTime(a, b)
+	default:
+		return listByStartTime(a, b)
+	}
+}
+
+func (d listDiff) isEmpty() bool {
+	return len(d.missingInShadow) == 0 && len(d.missingInPrimary) == 0 && len(d.statusMismatch) == 0
+}
+
+// diffCount returns description of difference between count responses or empty string if they are equal.
+func diffCount(primary *manager.CountWorkflowExecutionsResponse, shadow *manager.CountWorkflowExecutionsResponse) string {
+	if primary.Count != shadow.Count {
+		return fmt.Sprintf("count is %d in primary and %d in shadow", primary.Count, shadow.Count)
+	}
+
+	shadowGroups := make(map[string]int64, len(shadow.Groups))
+	for _, group := range shadow.Groups {
+		shadowGroups[group.Value] = group.Count
+	}
+	for _, group := range primary.Groups {
+		if shadowCount, ok := shadowGroups[group.Value]; !ok || shadowCount != group.Count {
+			return fmt.Sprintf("count of group %q is %d in primary and %d in shadow", group.Value, group.Count, shadowCount)
+		}
+	}
+	if len(primary.Groups) != len(shadow.Groups) {
+		return fmt.Sprintf("number of groups is %d in primary and %d in shadow", len(primary.Groups), len(shadow.Groups))
+	}
+	return ""
+}
+
+// isConsistent returns true if execution wasn't updated after consistentBefore.
+func isConsistent(execution *workflowpb.WorkflowExecutionInfo, consistentBefore time.Time) bool {
+	lastUpdateTime := timestamp.TimeValue(execution.GetStartTime())
+	if closeTime := timestamp.TimeValue(execution.GetCloseTime()); closeTime.After(lastUpdateTime) {
+		lastUpdateTime = closeTime
+	}
+	return lastUpdateTime.Before(consistentBefore)
+}
+
+func executionKey(execution *workflowpb.WorkflowExecutionInfo) string {
+	return execution.GetExecution().GetWorkflowId() + "/" + execution.GetExecution().GetRunId()
+}
+
+func truncate(keys []string) []string {
+	if len(keys) > shadowReadMaxLoggedExecutions {
+		return keys[:shadowReadMaxLoggedExecutions]
+	}
+	return keys
+}
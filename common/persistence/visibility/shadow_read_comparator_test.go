@@ -0,0 +1,324 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+type shadowReadComparatorSuite struct {
+	*require.Assertions
+	suite.Suite
+	controller *gomock.Controller
+
+	now        time.Time
+	comparator *shadowReadComparator
+}
+
+func TestShadowReadComparatorSuite(t *testing.T) {
+	suite.Run(t, new(shadowReadComparatorSuite))
+}
+
+func (s *shadowReadComparatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.now = time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	s.comparator = newShadowReadComparator(dynamicconfig.GetDurationPropertyFn(time.Minute), metrics.NoopClient, log.NewNoopLogger())
+	s.comparator.timeNow = func() time.Time { return s.now }
+}
+
+func (s *shadowReadComparatorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *shadowReadComparatorSuite) newExecution(workflowID string, status enumspb.WorkflowExecutionStatus, startTime time.Time, closeTime *time.Time) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: workflowID + "-run"},
+		Status:    status,
+		StartTime: &startTime,
+		CloseTime: closeTime,
+	}
+}
+
+func (s *shadowReadComparatorSuite) TestDiffList_Equal() {
+	oldTime := s.now.Add(-time.Hour)
+	primary := []*workflowpb.WorkflowExecutionInfo{
+		s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &oldTime),
+	}
+	// Order of executions is ignored.
+	shadow := []*workflowpb.WorkflowExecutionInfo{
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &oldTime),
+		s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+	}
+
+	diff := s.comparator.diffList(primary, shadow)
+	s.True(diff.isEmpty())
+}
+
+func (s *shadowReadComparatorSuite) TestDiffList_Mismatch() {
+	oldTime := s.now.Add(-time.Hour)
+	primary := []*workflowpb.WorkflowExecutionInfo{
+		s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &oldTime),
+	}
+	shadow := []*workflowpb.WorkflowExecutionInfo{
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, oldTime, &oldTime),
+		s.newExecution("wid3", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+	}
+
+	diff := s.comparator.diffList(primary, shadow)
+	s.False(diff.isEmpty())
+	s.Equal([]string{"wid1/wid1-run"}, diff.missingInShadow)
+	s.Equal([]string{"wid3/wid3-run"}, diff.missingInPrimary)
+	s.Equal([]string{"wid2/wid2-run"}, diff.statusMismatch)
+}
+
+func (s *shadowReadComparatorSuite) TestDiffList_ConsistencyWindow() {
+	oldTime := s.now.Add(-time.Hour)
+	recentTime := s.now.Add(-time.Second)
+	primary := []*workflowpb.WorkflowExecutionInfo{
+		// Just started and not yet visible in shadow.
+		s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, recentTime, nil),
+		// Just closed and not yet closed in shadow.
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &recentTime),
+	}
+	shadow := []*workflowpb.WorkflowExecutionInfo{
+		s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+	}
+
+	diff := s.comparator.diffList(primary, shadow)
+	s.True(diff.isEmpty())
+}
+
+func (s *shadowReadComparatorSuite) TestDiffCount() {
+	s.Equal("", diffCount(
+		&manager.CountWorkflowExecutionsResponse{Count: 10},
+		&manager.CountWorkflowExecutionsResponse{Count: 10},
+	))
+	s.Equal("count is 10 in primary and 9 in shadow", diffCount(
+		&manager.CountWorkflowExecutionsResponse{Count: 10},
+		&manager.CountWorkflowExecutionsResponse{Count: 9},
+	))
+	s.Equal("", diffCount(
+		&manager.CountWorkflowExecutionsResponse{Count: 3, Groups: []manager.CountWorkflowExecutionsGroup{{Value: "a", Count: 1}, {Value: "b", Count: 2}}},
+		&manager.CountWorkflowExecutionsResponse{Count: 3, Groups: []manager.CountWorkflowExecutionsGroup{{Value: "b", Count: 2}, {Value: "a", Count: 1}}},
+	))
+	s.Equal(`count of group "a" is 1 in primary and 2 in shadow`, diffCount(
+		&manager.CountWorkflowExecutionsResponse{Count: 3, Groups: []manager.CountWorkflowExecutionsGroup{{Value: "a", Count: 1}, {Value: "b", Count: 2}}},
+		&manager.CountWorkflowExecutionsResponse{Count: 3, Groups: []manager.CountWorkflowExecutionsGroup{{Value: "a", Count: 2}, {Value: "b", Count: 1}}},
+	))
+}
+
+func (s *shadowReadComparatorSuite) TestListPrefix() {
+	t1, t2, t3, t4 := s.now.Add(-4*time.Hour), s.now.Add(-3*time.Hour), s.now.Add(-2*time.Hour), s.now.Add(-time.Hour)
+	wid1 := s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, t4, nil)
+	wid2 := s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, t3, nil)
+	wid3 := s.newExecution("wid3", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, t2, nil)
+	wid4 := s.newExecution("wid4", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, t2, nil)
+	wid5 := s.newExecution("wid5", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, t1, nil)
+
+	// Executions with the same start time as the last one can be on either page.
+	primary, shadow := listPrefix(listByStartTime,
+		&manager.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{wid1, wid2, wid3}, NextPageToken: []byte("primary")},
+		&manager.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{wid1, wid2, wid4}, NextPageToken: []byte("shadow")},
+	)
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{wid1, wid2}, primary)
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{wid1, wid2}, shadow)
+
+	// The last page of one store is cut at the last execution of the other.
+	primary, shadow = listPrefix(listByStartTime,
+		&manager.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{wid1, wid2}, NextPageToken: []byte("primary")},
+		&manager.ListWorkflowExecutionsResponse{Executions: []*workflowpb.WorkflowExecutionInfo{wid1, wid5}},
+	)
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{wid1}, primary)
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{wid1}, shadow)
+}
+
+func (s *shadowReadComparatorSuite) TestListByDefaultOrder() {
+	oldTime, recentTime := s.now.Add(-2*time.Hour), s.now.Add(-time.Hour)
+	open := s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil)
+	recentlyClosed := s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &recentTime)
+	closed := s.newExecution("wid3", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, recentTime, &oldTime)
+	closedEarlier := s.newExecution("wid4", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, oldTime, &oldTime)
+
+	s.True(listByDefaultOrder(open, recentlyClosed))
+	s.False(listByDefaultOrder(recentlyClosed, open))
+	s.True(listByDefaultOrder(recentlyClosed, closed))
+	s.True(listByDefaultOrder(closed, closedEarlier))
+	s.False(listByDefaultOrder(closedEarlier, closedEarlier))
+
+	s.Nil(queryListOrder("WorkflowType = 'wt' order by StartTime"))
+	s.NotNil(queryListOrder("WorkflowType = 'wt'"))
+}
+
+func (s *shadowReadComparatorSuite) TestCompareList_FirstPagePrefix() {
+	metricsClient := metrics.NewMockClient(s.controller)
+	s.comparator.metricsClient = metricsClient
+	oldTime, olderTime := s.now.Add(-time.Hour), s.now.Add(-2*time.Hour)
+	primaryResponse := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			s.newExecution("wid1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+			s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, olderTime, nil),
+		},
+		NextPageToken: []byte("next-page-token"),
+	}
+	shadowResponse := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			s.newExecution("wid3", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, oldTime, nil),
+			s.newExecution("wid2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, olderTime, nil),
+		},
+		NextPageToken: []byte("next-page-token"),
+	}
+
+	done := make(chan struct{})
+	metricsClient.EXPECT().IncCounter(0, metrics.VisibilityShadowReadRequests)
+	metricsClient.EXPECT().IncCounter(0, metrics.VisibilityShadowReadMismatches).Do(func(int, int) { close(done) })
+	s.comparator.compareList(0, "ListOpenWorkflowExecutions", testNamespace, nil, listByStartTime, primaryResponse,
+		func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+			return shadowResponse, nil
+		})
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		s.Fail("mismatch in first page prefix was not reported")
+	}
+
+	// Next pages and truncated pages in unknown order are skipped.
+	metricsClient.EXPECT().IncCounter(0, metrics.VisibilityShadowReadSkipped)
+	s.comparator.compareList(0, "ListOpenWorkflowExecutions", testNamespace, []byte("next-page-token"), listByStartTime, primaryResponse,
+		func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Fail("next page was read from shadow")
+			return nil, nil
+		})
+
+	done = make(chan struct{})
+	metricsClient.EXPECT().IncCounter(0, metrics.VisibilityShadowReadRequests)
+	metricsClient.EXPECT().IncCounter(0, metrics.VisibilityShadowReadSkipped).Do(func(int, int) { close(done) })
+	s.comparator.compareList(0, "ListWorkflowExecutions", testNamespace, nil, nil, primaryResponse,
+		func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+			return shadowResponse, nil
+		})
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		s.Fail("skipped comparison was not reported")
+	}
+}
+
+func (s *shadowReadComparatorSuite) TestCompareList_MaxConcurrency() {
+	blocked := make(chan struct{})
+	started := make(chan struct{}, shadowReadMaxConcurrency)
+	for i := 0; i < shadowReadMaxConcurrency; i++ {
+		s.comparator.compareList(0, "ListWorkflowExecutions", testNamespace, nil, listByDefaultOrder, &manager.ListWorkflowExecutionsResponse{},
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				started <- struct{}{}
+				<-blocked
+				return &manager.ListWorkflowExecutionsResponse{}, nil
+			})
+	}
+	for i := 0; i < shadowReadMaxConcurrency; i++ {
+		<-started
+	}
+
+	// Shadow reads over the limit are skipped.
+	s.comparator.compareList(0, "ListWorkflowExecutions", testNamespace, nil, listByDefaultOrder, &manager.ListWorkflowExecutionsResponse{},
+		func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Fail("shadow read over the limit was issued")
+			return nil, nil
+		})
+	close(blocked)
+}
+
+func (s *shadowReadComparatorSuite) TestVisibilityManagerDual_ShadowRead() {
+	primaryManager := manager.NewMockVisibilityManager(s.controller)
+	shadowManager := manager.NewMockVisibilityManager(s.controller)
+	visibilityManager := NewVisibilityManagerDual(
+		primaryManager,
+		shadowManager,
+		NewSQLToESManagerSelector(
+			primaryManager,
+			shadowManager,
+			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+			dynamicconfig.GetStringPropertyFn(AdvancedVisibilityWritingModeDual),
+		),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		metrics.NoopClient,
+		log.NewNoopLogger(),
+	)
+
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       "WorkflowType = 'visibility-workflow'",
+	}
+	response := &manager.ListWorkflowExecutionsResponse{}
+	primaryManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(response, nil)
+
+	shadowReadDone := make(chan struct{})
+	shadowManager.EXPECT().ListWorkflowExecutions(gomock.Any(), request).DoAndReturn(
+		func(_ context.Context, _ *manager.ListWorkflowExecutionsRequestV2) (*manager.ListWorkflowExecutionsResponse, error) {
+			close(shadowReadDone)
+			return &manager.ListWorkflowExecutionsResponse{}, nil
+		})
+
+	actualResponse, err := visibilityManager.ListWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(response, actualResponse)
+
+	select {
+	case <-shadowReadDone:
+	case <-time.After(10 * time.Second):
+		s.Fail("shadow read was not issued")
+	}
+
+	// Next pages are not compared because page tokens are specific to visibility store.
+	nextPageRequest := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceUUID,
+		Namespace:     testNamespace,
+		PageSize:      10,
+		NextPageToken: []byte("next-page-token"),
+	}
+	primaryManager.EXPECT().ListWorkflowExecutions(gomock.Any(), nextPageRequest).Return(response, nil)
+	_, err = visibilityManager.ListWorkflowExecutions(context.Background(), nextPageRequest)
+	s.NoError(err)
+}
@@ -88,5 +88,12 @@ type (
 
 		SearchAfter []interface{}
 		PointInTime *elastic.PointInTime
+
+		// Aggregations are computed over all documents which match the query.
+		// If Aggregations are set and PageSize is 0, no documents are returned.
+		Aggregations map[string]elastic.Aggregation
+		// TrackTotalHits makes total hits exact instead of lower bound when there are more than 10000 hits.
+		// Elasticsearch 6 always returns exact total hits.
+		TrackTotalHits bool
 	}
 )
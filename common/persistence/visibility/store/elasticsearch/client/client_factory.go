@@ -25,41 +25,135 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/blang/semver/v4"
 
 	"go.temporal.io/server/common/log"
 )
 
+const (
+	versionV6          = "v6"
+	versionV7          = "v7"
+	versionV8          = "v8"
+	versionOpenSearch2 = "opensearch2"
+	// versionAuto detects version of the cluster on client creation.
+	versionAuto = "auto"
+
+	detectVersionTimeout = 10 * time.Second
+)
+
+type (
+	// fullClient is implemented by all clients.
+	fullClient interface {
+		CLIClient
+		IntegrationTestsClient
+	}
+
+	// clusterInfoResponse is response of root endpoint of Elasticsearch and OpenSearch.
+	clusterInfoResponse struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"` // only OpenSearch sets distribution
+		} `json:"version"`
+	}
+)
+
 func NewClient(config *Config, httpClient *http.Client, logger log.Logger) (Client, error) {
-	switch config.Version {
-	case "v6":
+	return newClient(config, httpClient, logger)
+}
+
+func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
+	return newClient(config, nil, logger)
+}
+
+func NewIntegrationTestsClient(config *Config, logger log.Logger) (IntegrationTestsClient, error) {
+	return newClient(config, nil, logger)
+}
+
+func newClient(config *Config, httpClient *http.Client, logger log.Logger) (fullClient, error) {
+	version := config.Version
+	if version == versionAuto {
+		var err error
+		version, err = detectVersion(config, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("unable to detect Elasticsearch version: %w", err)
+		}
+	}
+
+	switch version {
+	case versionV6:
 		return newClientV6(config, httpClient, logger)
-	case "v7", "":
+	case versionV7, "":
 		return newClientV7(config, httpClient, logger)
+	case versionV8:
+		return newClientV8(config, httpClient, logger)
+	case versionOpenSearch2:
+		return newClientOpenSearch2(config, httpClient, logger)
 	default:
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
 }
 
-func NewCLIClient(config *Config, logger log.Logger) (CLIClient, error) {
-	switch config.Version {
-	case "v6":
-		return newClientV6(config, nil, logger)
-	case "v7", "":
-		return newClientV7(config, nil, logger)
-	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
+// detectVersion requests cluster info and returns client version which should be used with the cluster.
+func detectVersion(config *Config, httpClient *http.Client) (string, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), detectVersionTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.URL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if config.Username != "" || config.Password != "" {
+		req.SetBasicAuth(config.Username, config.Password)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
 	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var info clusterInfoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", err
+	}
+	return versionFromClusterInfo(&info)
 }
 
-func NewIntegrationTestsClient(config *Config, logger log.Logger) (IntegrationTestsClient, error) {
-	switch config.Version {
-	case "v6":
-		return newClientV6(config, nil, logger)
-	case "v7", "":
-		return newClientV7(config, nil, logger)
+func versionFromClusterInfo(info *clusterInfoResponse) (string, error) {
+	version, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse version %q: %w", info.Version.Number, err)
+	}
+
+	if info.Version.Distribution == distributionOpenSearch {
+		// OpenSearch 1.x is compatible with Elasticsearch 7.10.
+		if version.Major >= 2 {
+			return versionOpenSearch2, nil
+		}
+		return versionV7, nil
+	}
+
+	switch {
+	case version.Major == 6:
+		return versionV6, nil
+	case version.Major == 7:
+		return versionV7, nil
+	case version.Major >= 8:
+		return versionV8, nil
 	default:
-		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
+		return "", fmt.Errorf("not supported Elasticsearch version: %v", info.Version.Number)
 	}
 }
@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/searchattribute"
 )
 
 func Test_BuildPutMappingBody(t *testing.T) {
@@ -49,6 +51,10 @@ func Test_BuildPutMappingBody(t *testing.T) {
 			input:    map[string]enumspb.IndexedValueType{"Field": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
 			expected: "map[properties:map[Field:map[type:keyword]]]",
 		},
+		{
+			input:    map[string]enumspb.IndexedValueType{"Field": searchattribute.IndexedValueTypeKeywordList},
+			expected: "map[properties:map[Field:map[type:keyword]]]",
+		},
 		{
 			input:    map[string]enumspb.IndexedValueType{"Field": enumspb.INDEXED_VALUE_TYPE_INT},
 			expected: "map[properties:map[Field:map[type:long]]]",
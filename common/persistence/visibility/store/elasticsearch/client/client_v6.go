@@ -36,6 +36,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -107,7 +108,7 @@ func (c *clientV6) Search(ctx context.Context, p *SearchParameters) (*elastic.Se
 		Query(p.Query).
 		SortBy(convertV7SortersToV6(p.Sorter)...)
 
-	if p.PageSize != 0 {
+	if p.PageSize != 0 || len(p.Aggregations) != 0 {
 		searchSource.Size(p.PageSize)
 	}
 
@@ -115,6 +116,10 @@ func (c *clientV6) Search(ctx context.Context, p *SearchParameters) (*elastic.Se
 		searchSource.SearchAfter(p.SearchAfter...)
 	}
 
+	for name, aggregation := range p.Aggregations {
+		searchSource.Aggregation(name, aggregation)
+	}
+
 	searchResult, err := c.esClient.Search(p.Index).SearchSource(searchSource).Do(ctx)
 	if err != nil {
 		return nil, convertV6ErrorToV7(err)
@@ -625,7 +630,7 @@ func buildMappingBodyV6(mapping map[string]enumspb.IndexedValueType) map[string]
 		switch fieldType {
 		case enumspb.INDEXED_VALUE_TYPE_TEXT:
 			typeMap = map[string]interface{}{"type": "text"}
-		case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		case enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList:
 			typeMap = map[string]interface{}{"type": "keyword"}
 		case enumspb.INDEXED_VALUE_TYPE_INT:
 			typeMap = map[string]interface{}{"type": "long"}
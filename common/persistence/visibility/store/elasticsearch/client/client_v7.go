@@ -37,6 +37,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -127,7 +128,7 @@ func (c *clientV7) Search(ctx context.Context, p *SearchParameters) (*elastic.Se
 		searchSource.PointInTime(p.PointInTime)
 	}
 
-	if p.PageSize != 0 {
+	if p.PageSize != 0 || len(p.Aggregations) != 0 {
 		searchSource.Size(p.PageSize)
 	}
 
@@ -135,6 +136,14 @@ func (c *clientV7) Search(ctx context.Context, p *SearchParameters) (*elastic.Se
 		searchSource.SearchAfter(p.SearchAfter...)
 	}
 
+	for name, aggregation := range p.Aggregations {
+		searchSource.Aggregation(name, aggregation)
+	}
+
+	if p.TrackTotalHits {
+		searchSource.TrackTotalHits(true)
+	}
+
 	searchService := c.esClient.Search().SearchSource(searchSource)
 	// When pit.id is specified index must not be used.
 	if p.PointInTime == nil {
@@ -347,7 +356,7 @@ func buildMappingBody(mapping map[string]enumspb.IndexedValueType) map[string]in
 		switch fieldType {
 		case enumspb.INDEXED_VALUE_TYPE_TEXT:
 			typeMap = map[string]interface{}{"type": "text"}
-		case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		case enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList:
 			typeMap = map[string]interface{}{"type": "keyword"}
 		case enumspb.INDEXED_VALUE_TYPE_INT:
 			typeMap = map[string]interface{}{"type": "long"}
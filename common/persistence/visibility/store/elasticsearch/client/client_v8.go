@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientV8 implements Client for Elasticsearch 8 and OpenSearch 2.
+	// Search, count, mapping and bulk APIs of both are compatible with Elasticsearch 7 and are inherited from clientV7.
+	// Index template and point in time APIs are different and are implemented on top of raw REST requests.
+	clientV8 struct {
+		*clientV7
+		distribution string
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+
+	acknowledgedResponse struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+)
+
+const (
+	distributionElasticsearch = "elasticsearch"
+	distributionOpenSearch    = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ ClientV7 = (*clientV8)(nil)
+
+// newClientV8 creates Elasticsearch 8 client.
+func newClientV8(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientV8, error) {
+	return newClientV8WithDistribution(cfg, httpClient, logger, distributionElasticsearch)
+}
+
+// newClientOpenSearch2 creates OpenSearch 2 client.
+func newClientOpenSearch2(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientV8, error) {
+	return newClientV8WithDistribution(cfg, httpClient, logger, distributionOpenSearch)
+}
+
+func newClientV8WithDistribution(cfg *Config, httpClient *http.Client, logger log.Logger, distribution string) (*clientV8, error) {
+	c, err := newClientV7(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientV8{
+		clientV7:     c,
+		distribution: distribution,
+	}, nil
+}
+
+func (c *clientV8) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsPointInTimeSupported.Do(func() {
+		c.isPointInTimeSupported = c.queryPointInTimeSupported(ctx)
+	})
+	return c.isPointInTimeSupported
+}
+
+func (c *clientV8) queryPointInTimeSupported(ctx context.Context) bool {
+	if c.distribution != distributionOpenSearch {
+		// All Elasticsearch 8 distributions support point in time.
+		return true
+	}
+
+	result, _, err := c.esClient.Ping(c.url.String()).Do(ctx)
+	if err != nil || result == nil {
+		return false
+	}
+	version, err := semver.ParseTolerant(result.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(version)
+}
+
+func (c *clientV8) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	if c.distribution != distributionOpenSearch {
+		return c.clientV7.OpenPointInTime(ctx, index, keepAliveInterval)
+	}
+
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/%s/_search/point_in_time", url.PathEscape(index)),
+		Params: url.Values{"keep_alive": []string{keepAliveInterval}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var pitResponse openSearchOpenPointInTimeResponse
+	if err := json.Unmarshal(resp.Body, &pitResponse); err != nil {
+		return "", err
+	}
+	return pitResponse.PitID, nil
+}
+
+func (c *clientV8) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	if c.distribution != distributionOpenSearch {
+		return c.clientV7.ClosePointInTime(ctx, id)
+	}
+
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodDelete,
+		Path:   "/_search/point_in_time",
+		Body:   map[string]interface{}{"pit_id": []string{id}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var pitResponse openSearchClosePointInTimeResponse
+	if err := json.Unmarshal(resp.Body, &pitResponse); err != nil {
+		return false, err
+	}
+	for _, pit := range pitResponse.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
+
+// IndexPutTemplate puts composable index template. Legacy templates are deprecated in Elasticsearch 8 and OpenSearch 2,
+// therefore template body in legacy format is converted to composable template.
+func (c *clientV8) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	body, err := convertToComposableIndexTemplate(bodyString)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPut,
+		Path:   fmt.Sprintf("/_index_template/%s", url.PathEscape(templateName)),
+		Body:   body,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var ackResponse acknowledgedResponse
+	if err := json.Unmarshal(resp.Body, &ackResponse); err != nil {
+		return false, err
+	}
+	return ackResponse.Acknowledged, nil
+}
+
+// convertToComposableIndexTemplate moves settings, mappings and aliases of legacy index template under "template" key
+// and replaces "order" with "priority". Body which is already in composable format is returned as is.
+func convertToComposableIndexTemplate(bodyString string) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(bodyString), &body); err != nil {
+		return nil, fmt.Errorf("unable to parse index template: %w", err)
+	}
+	if _, isComposable := body["template"]; isComposable {
+		return body, nil
+	}
+
+	template := make(map[string]interface{})
+	for _, key := range []string{"settings", "mappings", "aliases"} {
+		if value, ok := body[key]; ok {
+			template[key] = value
+			delete(body, key)
+		}
+	}
+	body["template"] = template
+
+	if order, ok := body["order"]; ok {
+		body["priority"] = order
+		delete(body, "order")
+	}
+	return body, nil
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *Config) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return server, &Config{URL: *serverURL}
+}
+
+func Test_ConvertToComposableIndexTemplate(t *testing.T) {
+	legacy := `{"order": 0, "index_patterns": ["temporal_visibility_v1*"], "settings": {"index": {"number_of_shards": "1"}}, "mappings": {"dynamic": "false"}, "aliases": {}}`
+	body, err := convertToComposableIndexTemplate(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"index_patterns": []interface{}{"temporal_visibility_v1*"},
+		"priority":       float64(0),
+		"template": map[string]interface{}{
+			"settings": map[string]interface{}{"index": map[string]interface{}{"number_of_shards": "1"}},
+			"mappings": map[string]interface{}{"dynamic": "false"},
+			"aliases":  map[string]interface{}{},
+		},
+	}, body)
+
+	composable := `{"index_patterns": ["temporal_visibility_v1*"], "priority": 1, "template": {"mappings": {"dynamic": "false"}}}`
+	body, err = convertToComposableIndexTemplate(composable)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"index_patterns": []interface{}{"temporal_visibility_v1*"},
+		"priority":       float64(1),
+		"template": map[string]interface{}{
+			"mappings": map[string]interface{}{"dynamic": "false"},
+		},
+	}, body)
+
+	_, err = convertToComposableIndexTemplate("not a json")
+	assert.Error(t, err)
+}
+
+func Test_VersionFromClusterInfo(t *testing.T) {
+	tests := []struct {
+		number       string
+		distribution string
+		expected     string
+	}{
+		{number: "6.8.23", expected: versionV6},
+		{number: "7.16.2", expected: versionV7},
+		{number: "8.2.0", expected: versionV8},
+		{number: "1.3.2", distribution: distributionOpenSearch, expected: versionV7},
+		{number: "2.0.0", distribution: distributionOpenSearch, expected: versionOpenSearch2},
+	}
+
+	for _, test := range tests {
+		info := &clusterInfoResponse{}
+		info.Version.Number = test.number
+		info.Version.Distribution = test.distribution
+		version, err := versionFromClusterInfo(info)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, version, test.number)
+	}
+
+	info := &clusterInfoResponse{}
+	info.Version.Number = "5.6.0"
+	_, err := versionFromClusterInfo(info)
+	assert.Error(t, err)
+}
+
+func Test_NewClient_DetectVersion(t *testing.T) {
+	_, cfg := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/", r.URL.Path)
+		_, _ = w.Write([]byte(`{"version": {"distribution": "opensearch", "number": "2.4.0"}}`))
+	})
+	cfg.Version = versionAuto
+
+	esClient, err := NewClient(cfg, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	openSearchClient, ok := esClient.(*clientV8)
+	require.True(t, ok)
+	assert.Equal(t, distributionOpenSearch, openSearchClient.distribution)
+}
+
+func Test_OpenSearchPointInTime(t *testing.T) {
+	_, cfg := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search/point_in_time":
+			assert.Equal(t, "1m", r.URL.Query().Get("keep_alive"))
+			_, _ = w.Write([]byte(`{"pit_id": "test-pit-id", "creation_time": 1658146048666}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			var request map[string][]string
+			assert.NoError(t, json.Unmarshal(body, &request))
+			assert.Equal(t, []string{"test-pit-id"}, request["pit_id"])
+			_, _ = w.Write([]byte(`{"pits": [{"successful": true, "pit_id": "test-pit-id"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	esClient, err := newClientOpenSearch2(cfg, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	pitID, err := esClient.OpenPointInTime(context.Background(), "test-index", "1m")
+	require.NoError(t, err)
+	assert.Equal(t, "test-pit-id", pitID)
+
+	closed, err := esClient.ClosePointInTime(context.Background(), pitID)
+	require.NoError(t, err)
+	assert.True(t, closed)
+}
+
+func Test_IndexPutComposableTemplate(t *testing.T) {
+	_, cfg := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/_index_template/test-template", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var request map[string]interface{}
+		assert.NoError(t, json.Unmarshal(body, &request))
+		assert.Contains(t, request, "template")
+		assert.NotContains(t, request, "mappings")
+		_, _ = w.Write([]byte(`{"acknowledged": true}`))
+	})
+
+	esClient, err := newClientV8(cfg, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	acknowledged, err := esClient.IndexPutTemplate(context.Background(), "test-template", `{"index_patterns": ["test*"], "mappings": {"dynamic": "false"}}`)
+	require.NoError(t, err)
+	assert.True(t, acknowledged)
+}
@@ -60,11 +60,13 @@ func newQueryConverter(
 	rangeCond := query.NewRangeCondConverter(fnInterceptor, fvInterceptor, true)
 	comparisonExpr := query.NewComparisonExprConverter(fnInterceptor, fvInterceptor, allowedComparisonOperators)
 	is := query.NewIsConverter(fnInterceptor)
+	funcExpr := query.NewFuncConverter(fnInterceptor, fvInterceptor)
 
 	whereConverter := &query.WhereConverter{
 		RangeCond:      rangeCond,
 		ComparisonExpr: comparisonExpr,
 		Is:             is,
+		Func:           funcExpr,
 	}
 	whereConverter.And = query.NewAndConverter(whereConverter)
 	whereConverter.Or = query.NewOrConverter(whereConverter)
@@ -50,6 +50,14 @@ var errorCases = map[string]string{
 	"select * from a group by k":      query.NotSupportedErrMessage,
 	"invalid query":                   query.MalformedSqlQueryErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
+	"select * from a where contains(tags)":            query.InvalidExpressionErrMessage,
+	"select * from a where contains(tags, id)":        query.InvalidExpressionErrMessage,
+	"select * from a where contains('a', 'b')":        query.InvalidExpressionErrMessage,
+	"select * from a where not contains(a, 'b')":      query.NotSupportedErrMessage,
+	"select * from a where starts_with(id)":           query.InvalidExpressionErrMessage,
+	"select * from a where starts_with(id, 1)":        query.InvalidExpressionErrMessage,
+	"select * from a where starts_with(id, 'a', 'b')": query.InvalidExpressionErrMessage,
+	"select * from a where match_phrase(content, '')": query.InvalidExpressionErrMessage,
 }
 
 var supportedWhereCases = map[string]string{
@@ -77,6 +85,12 @@ var supportedWhereCases = map[string]string{
 	"`by` = 1":                                     `{"bool":{"filter":{"match":{"by":{"query":1}}}}}`,
 	"id not like '%aaa%'":                          `{"bool":{"must_not":{"match":{"id":{"query":"aaa"}}}}}`,
 	"id not IN (1, 2,3)":                           `{"bool":{"must_not":{"terms":{"id":[1,2,3]}}}}`,
+	"contains(tags, 'a')":                          `{"bool":{"filter":{"term":{"tags":"a"}}}}`,
+	"contains(tags, 'a', 'b')":                     `{"bool":{"filter":[{"term":{"tags":"a"}},{"term":{"tags":"b"}}]}}`,
+	"tags in ('a', 'b') and contains(tags, 'c')":   `{"bool":{"filter":[{"terms":{"tags":["a","b"]}},{"term":{"tags":"c"}}]}}`,
+	"starts_with(id, 'order-2022-')":               `{"bool":{"filter":{"prefix":{"id":"order-2022-"}}}}`,
+	"STARTS_WITH(`id`, 'order-')":                  `{"bool":{"filter":{"prefix":{"id":"order-"}}}}`,
+	"match_phrase(content, 'quick brown fox')":     `{"bool":{"filter":{"match_phrase":{"content":{"query":"quick brown fox"}}}}}`,
 	"id iS not null":                               `{"bool":{"filter":{"exists":{"field":"id"}}}}`,
 	"id is NULL":                                   `{"bool":{"must_not":{"exists":{"field":"id"}}}}`,
 	"value = '1'":                                  `{"bool":{"filter":{"match":{"value":{"query":"1"}}}}}`,
@@ -171,3 +185,33 @@ func TestErrors(t *testing.T) {
 		assert.Contains(t, err.Error(), expectedErrMessage, sql)
 	}
 }
+
+func TestSupportedSelectWhereGroupBy(t *testing.T) {
+	c := newQueryConverter(nil, nil)
+
+	query, groupBy, err := c.ConvertWhereGroupBy("id > 1 group by status")
+	assert.NoError(t, err)
+	actualQueryMap, _ := query.Source()
+	actualQueryJson, _ := json.Marshal(actualQueryMap)
+	assert.Equal(t, `{"bool":{"filter":{"range":{"id":{"from":1,"include_lower":false,"include_upper":true,"to":null}}}}}`, string(actualQueryJson))
+	assert.Equal(t, "status", groupBy)
+
+	query, groupBy, err = c.ConvertWhereGroupBy("group by `status`")
+	assert.NoError(t, err)
+	assert.Nil(t, query)
+	assert.Equal(t, "status", groupBy)
+
+	query, groupBy, err = c.ConvertWhereGroupBy("id > 1")
+	assert.NoError(t, err)
+	assert.NotNil(t, query)
+	assert.Equal(t, "", groupBy)
+
+	for _, sql := range []string{
+		"id > 1 group by status, type",
+		"id > 1 limit 10",
+		"group by 1",
+	} {
+		_, _, err = c.ConvertWhereGroupBy(sql)
+		assert.Error(t, err, sql)
+	}
+}
@@ -80,9 +80,22 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 		return "", query.NewConverterError("invalid search attribute: %s", name)
 	}
 
-	if usage == query.FieldNameSorter {
-		if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT {
-			return "", query.NewConverterError("unable to sort by field of %s type, use field of type %s", enumspb.INDEXED_VALUE_TYPE_TEXT.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+	switch usage {
+	case query.FieldNameSorter:
+		if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT || fieldType == searchattribute.IndexedValueTypeKeywordList {
+			return "", query.NewConverterError("unable to sort by field of %s type, use field of type %s", searchattribute.TypeName(fieldType), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	case query.FieldNameGroupBy:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return "", query.NewConverterError("unable to group by field of %s type, use field of type %s", searchattribute.TypeName(fieldType), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	case query.FieldNamePrefixFilter:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && fieldType != searchattribute.IndexedValueTypeKeywordList {
+			return "", query.NewConverterError("unable to match prefix of field of %s type, use field of type %s", searchattribute.TypeName(fieldType), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+		}
+	case query.FieldNameFullTextFilter:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_TEXT {
+			return "", query.NewConverterError("unable to match phrase in field of %s type, use field of type %s", searchattribute.TypeName(fieldType), enumspb.INDEXED_VALUE_TYPE_TEXT.String())
 		}
 	}
 
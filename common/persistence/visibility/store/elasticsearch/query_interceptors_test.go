@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"go.temporal.io/server/common/persistence/visibility/store/query"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -55,6 +56,29 @@ func (s *QueryInterceptorSuite) TearDownTest() {
 	s.controller.Finish()
 }
 
+func (s *QueryInterceptorSuite) TestNameInterceptor_Usage() {
+	ni := newNameInterceptor("test-namespace", "test-index", searchattribute.TestNameTypeMap, nil)
+
+	fieldName, err := ni.Name(searchattribute.WorkflowID, query.FieldNamePrefixFilter)
+	s.NoError(err)
+	s.Equal(searchattribute.WorkflowID, fieldName)
+	_, err = ni.Name("CustomKeywordListField", query.FieldNamePrefixFilter)
+	s.NoError(err)
+	_, err = ni.Name("CustomTextField", query.FieldNamePrefixFilter)
+	s.Error(err)
+	s.Equal("unable to match prefix of field of Text type, use field of type Keyword", err.Error())
+
+	_, err = ni.Name("CustomTextField", query.FieldNameFullTextFilter)
+	s.NoError(err)
+	_, err = ni.Name("CustomKeywordField", query.FieldNameFullTextFilter)
+	s.Error(err)
+	s.Equal("unable to match phrase in field of Keyword type, use field of type Text", err.Error())
+
+	_, err = ni.Name("CustomKeywordListField", query.FieldNameSorter)
+	s.Error(err)
+	s.Equal("unable to sort by field of KeywordList type, use field of type Keyword", err.Error())
+}
+
 func (s *QueryInterceptorSuite) TestTimeProcessFunc() {
 	vi := NewValuesInterceptor()
 
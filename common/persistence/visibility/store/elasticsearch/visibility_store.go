@@ -60,6 +60,12 @@ const (
 	scrollKeepAliveInterval      = "1m"
 
 	readTimeout = 16 * time.Second
+
+	// countGroupsAggregationName is the name of terms aggregation used by CountWorkflowExecutions with GROUP BY clause.
+	countGroupsAggregationName = "groups"
+	// countMaxGroups is the maximum number of groups returned by CountWorkflowExecutions with GROUP BY clause.
+	// Groups with most workflow executions are returned.
+	countMaxGroups = 1000
 )
 
 // Default sort by uses the sorting order defined in the index template, so no
@@ -485,47 +491,73 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
+	token, err := s.deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scan which was started with scroll must be finished with scroll even if cluster supports PIT now.
+	if token != nil && token.ScrollID != "" {
+		return s.scanWorkflowExecutionsWithScroll(ctx, request, token)
+	}
+
 	if esClientV7, isV7 := s.esClient.(client.ClientV7); isV7 {
 		// Elasticsearch 7.10+ can use "point in time" (PIT) instead of scroll to scan over all workflows without skipping or duplicating them.
 		// https://www.elastic.co/guide/en/elasticsearch/reference/7.10/point-in-time-api.html
 		if esClientV7.IsPointInTimeSupported(ctx) {
-			return s.scanWorkflowExecutionsWithPit(ctx, request, esClientV7)
+			return s.scanWorkflowExecutionsWithPit(ctx, request, token, esClientV7)
 		}
 	}
 
-	return s.scanWorkflowExecutionsWithScroll(ctx, request)
+	if token != nil {
+		return nil, serviceerror.NewInvalidArgument("scrollId must present in pagination token")
+	}
+	return s.scanWorkflowExecutionsWithScroll(ctx, request, nil)
 }
 
-func (s *visibilityStore) scanWorkflowExecutionsWithPit(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2, esClient client.ClientV7) (*store.InternalListWorkflowExecutionsResponse, error) {
+func (s *visibilityStore) scanWorkflowExecutionsWithPit(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+	token *visibilityPageToken,
+	esClient client.ClientV7,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
 	p, err := s.buildSearchParametersV2(request)
 	if err != nil {
 		return nil, err
 	}
 
 	// First call doesn't have token with PointInTimeID.
-	if len(request.NextPageToken) == 0 {
-		pitID, err := esClient.OpenPointInTime(ctx, s.index, pointInTimeKeepAliveInterval)
-		if err != nil {
-			return nil, convertElasticsearchClientError("Unable to create point in time", err)
-		}
-		p.PointInTime = elastic.NewPointInTimeWithKeepAlive(pitID, pointInTimeKeepAliveInterval)
-	} else {
-		token, err := s.deserializePageToken(request.NextPageToken)
-		if err != nil {
+	// Token without PointInTimeID (i.e. created by ListWorkflowExecutions) starts new PIT and continues from SearchAfter.
+	if token == nil || token.PointInTimeID == "" {
+		if p.PointInTime, err = s.openPointInTime(ctx, esClient); err != nil {
 			return nil, err
 		}
-		if token.PointInTimeID == "" {
-			return nil, serviceerror.NewInvalidArgument("pointInTimeId must present in pagination token")
-		}
-		p.SearchAfter = token.SearchAfter
+	} else {
 		p.PointInTime = elastic.NewPointInTimeWithKeepAlive(token.PointInTimeID, pointInTimeKeepAliveInterval)
 	}
+	if token != nil {
+		p.SearchAfter = token.SearchAfter
+	}
 
 	searchResult, err := esClient.Search(ctx, p)
+	if err != nil && token != nil && token.PointInTimeID != "" && elastic.IsNotFound(err) {
+		// PIT from the page token has expired (i.e. caller paused for longer than keep alive interval).
+		// Sort always has RunID as tiebreaker, therefore scan can be safely resumed
+		// from the last SearchAfter values using new PIT.
+		if p.PointInTime, err = s.openPointInTime(ctx, esClient); err != nil {
+			return nil, err
+		}
+		searchResult, err = esClient.Search(ctx, p)
+	}
 	if err != nil {
 		return nil, convertElasticsearchClientError("ScanWorkflowExecutions failed", err)
 	}
 
+	// ES might return new PIT id with every response.
+	if searchResult.PitId == "" {
+		searchResult.PitId = p.PointInTime.Id
+	}
+
 	// Empty hits list indicate that this is a last page.
 	if searchResult.Hits != nil && len(searchResult.Hits.Hits) < request.PageSize {
 		_, err = esClient.ClosePointInTime(ctx, searchResult.PitId)
@@ -537,14 +569,26 @@ func (s *visibilityStore) scanWorkflowExecutionsWithPit(ctx context.Context, req
 	return s.getListWorkflowExecutionsResponse(searchResult, request.Namespace, request.PageSize, nil)
 }
 
-func (s *visibilityStore) scanWorkflowExecutionsWithScroll(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*store.InternalListWorkflowExecutionsResponse, error) {
+func (s *visibilityStore) openPointInTime(ctx context.Context, esClient client.ClientV7) (*elastic.PointInTime, error) {
+	pitID, err := esClient.OpenPointInTime(ctx, s.index, pointInTimeKeepAliveInterval)
+	if err != nil {
+		return nil, convertElasticsearchClientError("Unable to create point in time", err)
+	}
+	return elastic.NewPointInTimeWithKeepAlive(pitID, pointInTimeKeepAliveInterval), nil
+}
+
+func (s *visibilityStore) scanWorkflowExecutionsWithScroll(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+	token *visibilityPageToken,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
 	var (
 		searchResult *elastic.SearchResult
 		scrollErr    error
 	)
 
 	// First call doesn't have token with ScrollID.
-	if len(request.NextPageToken) == 0 {
+	if token == nil {
 		// First page.
 		p, err := s.buildSearchParametersV2(request)
 		if err != nil {
@@ -552,13 +596,6 @@ func (s *visibilityStore) scanWorkflowExecutionsWithScroll(ctx context.Context,
 		}
 		searchResult, scrollErr = s.esClient.OpenScroll(ctx, p, scrollKeepAliveInterval)
 	} else {
-		token, err := s.deserializePageToken(request.NextPageToken)
-		if err != nil {
-			return nil, err
-		}
-		if token.ScrollID == "" {
-			return nil, serviceerror.NewInvalidArgument("scrollId must present in pagination token")
-		}
 		searchResult, scrollErr = s.esClient.Scroll(ctx, token.ScrollID, scrollKeepAliveInterval)
 	}
 
@@ -582,11 +619,15 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	boolQuery, _, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	boolQuery, groupBy, err := s.convertCountQuery(request.Namespace, request.NamespaceID, request.Query)
 	if err != nil {
 		return nil, err
 	}
 
+	if groupBy != "" {
+		return s.countGroups(ctx, boolQuery, groupBy)
+	}
+
 	count, err := s.esClient.Count(ctx, s.index, boolQuery)
 	if err != nil {
 		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
@@ -596,6 +637,48 @@ func (s *visibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+// countGroups counts workflow executions for each value of groupBy field using terms aggregation.
+// Total count is taken from hits of the same search request, so it is consistent with the groups.
+// Workflow executions which have multiple values of groupBy field are counted in each of their groups.
+func (s *visibilityStore) countGroups(
+	ctx context.Context,
+	boolQuery *elastic.BoolQuery,
+	groupBy string,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	p := &client.SearchParameters{
+		Index: s.index,
+		Query: boolQuery,
+		Aggregations: map[string]elastic.Aggregation{
+			countGroupsAggregationName: elastic.NewTermsAggregation().Field(groupBy).Size(countMaxGroups),
+		},
+		TrackTotalHits: true,
+	}
+	searchResult, err := s.esClient.Search(ctx, p)
+	if err != nil {
+		return nil, convertElasticsearchClientError("CountWorkflowExecutions failed", err)
+	}
+
+	terms, found := searchResult.Aggregations.Terms(countGroupsAggregationName)
+	if !found {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions failed: %q aggregation is missing in Elasticsearch response", countGroupsAggregationName))
+	}
+	groups := make([]manager.CountWorkflowExecutionsGroup, 0, len(terms.Buckets))
+	for _, bucket := range terms.Buckets {
+		value := fmt.Sprintf("%v", bucket.Key)
+		if bucket.KeyAsString != nil {
+			value = *bucket.KeyAsString
+		}
+		groups = append(groups, manager.CountWorkflowExecutionsGroup{
+			Value: value,
+			Count: bucket.DocCount,
+		})
+	}
+	return &manager.CountWorkflowExecutionsResponse{
+		Count:  searchResult.TotalHits(),
+		Groups: groups,
+	}, nil
+}
+
 func (s *visibilityStore) buildSearchParameters(
 	request *manager.ListWorkflowExecutionsRequest,
 	boolQuery *elastic.BoolQuery,
@@ -660,31 +743,60 @@ func (s *visibilityStore) buildSearchParametersV2(
 	return params, nil
 }
 func (s *visibilityStore) convertQuery(namespace namespace.Name, namespaceID namespace.ID, requestQueryStr string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
-	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	queryConverter, err := s.newNamespaceQueryConverter(namespace)
 	if err != nil {
-		return nil, nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+		return nil, nil, err
 	}
-	queryConverter := newQueryConverter(
-		newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapper),
-		NewValuesInterceptor(),
-	)
 	requestQuery, fieldSorts, err := queryConverter.ConvertWhereOrderBy(requestQueryStr)
 	if err != nil {
-		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
-		var converterErr *query.ConverterError
-		if errors.As(err, &converterErr) {
-			return nil, nil, converterErr.ToInvalidArgument()
-		}
-		return nil, nil, err
+		return nil, nil, convertQueryConverterError(err)
 	}
 
+	return s.addNamespaceFilter(namespaceID, requestQuery), fieldSorts, nil
+}
+
+// convertCountQuery converts query of CountWorkflowExecutions, which can have GROUP BY clause instead of ORDER BY.
+func (s *visibilityStore) convertCountQuery(namespace namespace.Name, namespaceID namespace.ID, requestQueryStr string) (*elastic.BoolQuery, string, error) {
+	queryConverter, err := s.newNamespaceQueryConverter(namespace)
+	if err != nil {
+		return nil, "", err
+	}
+	requestQuery, groupBy, err := queryConverter.ConvertWhereGroupBy(requestQueryStr)
+	if err != nil {
+		return nil, "", convertQueryConverterError(err)
+	}
+
+	return s.addNamespaceFilter(namespaceID, requestQuery), groupBy, nil
+}
+
+func (s *visibilityStore) newNamespaceQueryConverter(namespace namespace.Name) (*query.Converter, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	return newQueryConverter(
+		newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapper),
+		NewValuesInterceptor(),
+	), nil
+}
+
+func (s *visibilityStore) addNamespaceFilter(namespaceID namespace.ID, requestQuery *elastic.BoolQuery) *elastic.BoolQuery {
 	// Create new bool query because request query might have only "should" (="or") queries.
 	namespaceFilterQuery := elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.NamespaceID, namespaceID.String()))
 	if requestQuery != nil {
 		namespaceFilterQuery.Filter(requestQuery)
 	}
+	return namespaceFilterQuery
+}
 
-	return namespaceFilterQuery, fieldSorts, nil
+// convertQueryConverterError converts ConverterError to InvalidArgument and passes through
+// all other errors (which should be only mapper errors).
+func convertQueryConverterError(err error) error {
+	var converterErr *query.ConverterError
+	if errors.As(err, &converterErr) {
+		return converterErr.ToInvalidArgument()
+	}
+	return err
 }
 
 func (s *visibilityStore) setDefaultFieldSort(fieldSorts []*elastic.FieldSort) []elastic.Sorter {
@@ -938,6 +1050,11 @@ func (s *visibilityStore) parseESDoc(hit *elastic.SearchHit, saTypeMap searchatt
 //     map[string]interface{}, for JSON objects (should never be a case)
 //     nil for JSON null
 func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interface{}, error) {
+	// KeywordList values are always returned as array, even if document has a single value.
+	if _, isArray := val.([]interface{}); !isArray && t == searchattribute.IndexedValueTypeKeywordList {
+		val = []interface{}{val}
+	}
+
 	// Custom search attributes support array of particular type.
 	if arrayValue, isArray := val.([]interface{}); isArray {
 		retArray := make([]interface{}, len(arrayValue))
@@ -949,7 +1066,7 @@ func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interfac
 	}
 
 	switch t {
-	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList, enumspb.INDEXED_VALUE_TYPE_DATETIME:
 		stringVal, isString := val.(string)
 		if !isString {
 			return nil, fmt.Errorf("%w: expected string got %T", errUnexpectedJSONFieldType, val)
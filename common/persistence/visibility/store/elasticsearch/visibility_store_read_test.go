@@ -873,6 +873,27 @@ func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes() {
 	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, info.Status)
 }
 
+func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes_KeywordList() {
+	searchHit := &elastic.SearchHit{
+		Source: []byte(`{"ExecutionStatus": "Completed",
+          "CustomKeywordListField": "tenant1"}`),
+	}
+	info, err := s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap, testNamespace)
+	s.NoError(err)
+	customSearchAttributes, err := searchattribute.Decode(info.SearchAttributes, &searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	// Single value is returned as a list.
+	s.Equal([]string{"tenant1"}, customSearchAttributes["CustomKeywordListField"])
+
+	searchHit.Source = []byte(`{"ExecutionStatus": "Completed",
+          "CustomKeywordListField": ["tenant1", "tenant2"]}`)
+	info, err = s.visibilityStore.parseESDoc(searchHit, searchattribute.TestNameTypeMap, testNamespace)
+	s.NoError(err)
+	customSearchAttributes, err = searchattribute.Decode(info.SearchAttributes, &searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Equal([]string{"tenant1", "tenant2"}, customSearchAttributes["CustomKeywordListField"])
+}
+
 func (s *ESVisibilitySuite) TestParseESDoc_SearchAttributes_WithMapper() {
 	searchHit := &elastic.SearchHit{
 		Source: []byte(`{"ExecutionStatus": "Completed",
@@ -1207,6 +1228,95 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutionsV7_PIT() {
 	s.True(strings.Contains(err.Error(), "ScanWorkflowExecutions failed"))
 }
 
+func (s *ESVisibilitySuite) TestScanWorkflowExecutionsV7_PIT_Resume() {
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    1,
+		Query:       `ExecutionStatus = "Terminated"`,
+	}
+	searchAfter := []interface{}{json.Number("1528358645123456789"), "qwe"}
+	searchResult := &elastic.SearchResult{
+		Hits: &elastic.SearchHits{
+			Hits: []*elastic.SearchHit{
+				{
+					Source: json.RawMessage(`{"ExecutionStatus": "Terminated", "RunId": "e481009e-14b3-45ae-91af-dce6e2a88365", "WorkflowId": "wid"}`),
+					Sort:   []interface{}{json.Number("123"), "runId"},
+				},
+			},
+		},
+	}
+	s.mockESClient.EXPECT().IsPointInTimeSupported(gomock.Any()).Return(true).AnyTimes()
+
+	// test expired PIT is reopened and scan continues from the same position
+	token := &visibilityPageToken{PointInTimeID: "expiredPitID", SearchAfter: searchAfter}
+	tokenBytes, err := s.visibilityStore.serializePageToken(token)
+	s.NoError(err)
+	request.NextPageToken = tokenBytes
+	gomock.InOrder(
+		s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+				s.Equal("expiredPitID", p.PointInTime.Id)
+				return nil, &elastic.Error{Status: 404}
+			}),
+		s.mockESClient.EXPECT().OpenPointInTime(gomock.Any(), testIndex, gomock.Any()).Return("newPitID", nil),
+		s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+				s.Equal("newPitID", p.PointInTime.Id)
+				s.Equal(searchAfter, p.SearchAfter)
+				return searchResult, nil
+			}),
+	)
+	result, err := s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	responseToken, err := s.visibilityStore.deserializePageToken(result.NextPageToken)
+	s.NoError(err)
+	s.Equal("newPitID", responseToken.PointInTimeID)
+	s.Equal([]interface{}{json.Number("123"), "runId"}, responseToken.SearchAfter)
+
+	// test token without PIT opens new PIT
+	token = &visibilityPageToken{SearchAfter: searchAfter}
+	tokenBytes, err = s.visibilityStore.serializePageToken(token)
+	s.NoError(err)
+	request.NextPageToken = tokenBytes
+	s.mockESClient.EXPECT().OpenPointInTime(gomock.Any(), testIndex, gomock.Any()).Return("pitID", nil)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal("pitID", p.PointInTime.Id)
+			s.Equal(searchAfter, p.SearchAfter)
+			return searchResult, nil
+		})
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+
+	// test scan started with scroll is finished with scroll
+	token = &visibilityPageToken{ScrollID: "scrollID"}
+	tokenBytes, err = s.visibilityStore.serializePageToken(token)
+	s.NoError(err)
+	request.NextPageToken = tokenBytes
+	s.mockESClient.EXPECT().Scroll(gomock.Any(), "scrollID", "1m").Return(testSearchResult, io.EOF)
+	s.mockESClient.EXPECT().CloseScroll(gomock.Any(), gomock.Any()).Return(nil)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *ESVisibilitySuite) TestScanWorkflowExecutionsV7_PITNotSupported() {
+	s.mockESClient.EXPECT().IsPointInTimeSupported(gomock.Any()).Return(false).AnyTimes()
+
+	token := &visibilityPageToken{PointInTimeID: "pitID", SearchAfter: []interface{}{json.Number("123"), "runId"}}
+	tokenBytes, err := s.visibilityStore.serializePageToken(token)
+	s.NoError(err)
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceID,
+		Namespace:     testNamespace,
+		PageSize:      1,
+		NextPageToken: tokenBytes,
+	}
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
 func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, query elastic.Query) (int64, error) {
@@ -1255,6 +1365,52 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.HasPrefix(err.Error(), "invalid query"), err.Error())
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	expectedQuery := elastic.NewBoolQuery().Filter(
+		elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+		elastic.NewBoolQuery().Filter(elastic.NewMatchQuery("WorkflowType", "wt")))
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal(testIndex, p.Index)
+			s.Equal(expectedQuery, p.Query)
+			s.Zero(p.PageSize)
+			s.Equal(
+				map[string]elastic.Aggregation{
+					countGroupsAggregationName: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countMaxGroups),
+				},
+				p.Aggregations,
+			)
+			s.True(p.TrackTotalHits)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{TotalHits: &elastic.TotalHits{Value: 3}},
+				Aggregations: elastic.Aggregations{
+					countGroupsAggregationName: json.RawMessage(`{"buckets":[{"key":"Running","doc_count":2},{"key":"Completed","doc_count":1}]}`),
+				},
+			}, nil
+		})
+
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowType = 'wt' GROUP BY ExecutionStatus`,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+	s.Equal([]manager.CountWorkflowExecutionsGroup{
+		{Value: "Running", Count: 2},
+		{Value: "Completed", Count: 1},
+	}, resp.Groups)
+
+	// only keyword fields can be used in GROUP BY
+	request.Query = `GROUP BY StartTime`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	_, ok := err.(*serviceerror.InvalidArgument)
+	s.True(ok)
+	s.True(strings.HasPrefix(err.Error(), "invalid query"), err.Error())
+}
+
 func (s *ESVisibilitySuite) Test_detailedErrorMessage() {
 	err := errors.New("test message")
 	s.Equal("test message", detailedErrorMessage(err))
@@ -37,6 +37,18 @@ import (
 	"github.com/xwb1989/sqlparser"
 )
 
+const (
+	// ContainsFuncName is the name of the function which checks if list field contains all passed values:
+	// contains(CustomKeywordListField, 'value1', 'value2').
+	ContainsFuncName = "contains"
+	// StartsWithFuncName is the name of the function which checks if keyword field starts with the prefix:
+	// starts_with(WorkflowId, 'order-2022-').
+	StartsWithFuncName = "starts_with"
+	// MatchPhraseFuncName is the name of the function which checks if text field contains the phrase:
+	// match_phrase(CustomTextField, 'quick brown fox').
+	MatchPhraseFuncName = "match_phrase"
+)
+
 type (
 	ExprConverter interface {
 		Convert(expr sqlparser.Expr) (elastic.Query, error)
@@ -53,6 +65,8 @@ type (
 		RangeCond      ExprConverter
 		ComparisonExpr ExprConverter
 		Is             ExprConverter
+		// Func is optional, function expressions are not supported if it is nil.
+		Func ExprConverter
 	}
 
 	andConverter struct {
@@ -79,6 +93,11 @@ type (
 		fnInterceptor FieldNameInterceptor
 	}
 
+	funcConverter struct {
+		fnInterceptor FieldNameInterceptor
+		fvInterceptor FieldValuesInterceptor
+	}
+
 	notSupportedExprConverter struct{}
 )
 
@@ -181,6 +200,22 @@ func NewIsConverter(fnInterceptor FieldNameInterceptor) ExprConverter {
 	}
 }
 
+func NewFuncConverter(
+	fnInterceptor FieldNameInterceptor,
+	fvInterceptor FieldValuesInterceptor,
+) ExprConverter {
+	if fnInterceptor == nil {
+		fnInterceptor = &NopFieldNameInterceptor{}
+	}
+	if fvInterceptor == nil {
+		fvInterceptor = &NopFieldValuesInterceptor{}
+	}
+	return &funcConverter{
+		fnInterceptor: fnInterceptor,
+		fvInterceptor: fvInterceptor,
+	}
+}
+
 func NewNotSupportedExprConverter() ExprConverter {
 	return &notSupportedExprConverter{}
 }
@@ -188,29 +223,82 @@ func NewNotSupportedExprConverter() ExprConverter {
 // ConvertWhereOrderBy transforms WHERE SQL statement to Elasticsearch query.
 // It also supports ORDER BY clause.
 func (c *Converter) ConvertWhereOrderBy(whereOrderBy string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+	selectStmt, err := ParseWhereOrderBy(whereOrderBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.convertSelect(selectStmt)
+}
+
+// ConvertWhereGroupBy transforms WHERE SQL statement to Elasticsearch query.
+// It also supports GROUP BY clause and returns the name of the field to group by,
+// which is empty if there is no GROUP BY clause. ORDER BY clause is ignored because it doesn't affect counts.
+func (c *Converter) ConvertWhereGroupBy(whereGroupBy string) (*elastic.BoolQuery, string, error) {
+	selectStmt, err := ParseWhereOrderBy(whereGroupBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if selectStmt.Limit != nil {
+		return nil, "", NewConverterError("%s: 'limit' clause", NotSupportedErrMessage)
+	}
+
+	query, err := c.convertWhere(selectStmt.Where)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var groupBy string
+	switch len(selectStmt.GroupBy) {
+	case 0:
+	case 1:
+		groupBy, err = ConvertColName(c.fnInterceptor, selectStmt.GroupBy[0], FieldNameGroupBy)
+		if err != nil {
+			return nil, "", wrapConverterError("unable to convert 'group by' column name", err)
+		}
+	default:
+		return nil, "", NewConverterError("%s: 'group by' clause with more than one field", NotSupportedErrMessage)
+	}
+
+	return query, groupBy, nil
+}
+
+// ConvertSql transforms SQL to Elasticsearch query.
+func (c *Converter) ConvertSql(sql string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+	selectStmt, err := parseSelect(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.convertSelect(selectStmt)
+}
+
+// ParseWhereOrderBy parses WHERE SQL statement, which may also have ORDER BY clause,
+// into SELECT statement. It is used by converters to other query languages.
+func ParseWhereOrderBy(whereOrderBy string) (*sqlparser.Select, error) {
 	whereOrderBy = strings.TrimSpace(whereOrderBy)
 
-	if whereOrderBy != "" && !strings.HasPrefix(strings.ToLower(whereOrderBy), "order by ") {
+	lowerWhereOrderBy := strings.ToLower(whereOrderBy)
+	if whereOrderBy != "" &&
+		!strings.HasPrefix(lowerWhereOrderBy, "order by ") &&
+		!strings.HasPrefix(lowerWhereOrderBy, "group by ") {
 		whereOrderBy = "where " + whereOrderBy
 	}
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
 	sql := fmt.Sprintf("select * from table1 %s", whereOrderBy)
-	return c.ConvertSql(sql)
+	return parseSelect(sql)
 }
 
-// ConvertSql transforms SQL to Elasticsearch query.
-func (c *Converter) ConvertSql(sql string) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
+func parseSelect(sql string) (*sqlparser.Select, error) {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
-		return nil, nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
+		return nil, NewConverterError("%s: %v", MalformedSqlQueryErrMessage, err)
 	}
 
 	selectStmt, isSelect := stmt.(*sqlparser.Select)
 	if !isSelect {
-		return nil, nil, NewConverterError("%s: statement must be 'select' not %T", NotSupportedErrMessage, stmt)
+		return nil, NewConverterError("%s: statement must be 'select' not %T", NotSupportedErrMessage, stmt)
 	}
-
-	return c.convertSelect(selectStmt)
+	return selectStmt, nil
 }
 
 func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []*elastic.FieldSort, error) {
@@ -222,22 +310,14 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []
 		return nil, nil, NewConverterError("%s: 'limit' clause", NotSupportedErrMessage)
 	}
 
-	var query *elastic.BoolQuery
-	if sel.Where != nil {
-		q, err := c.whereConverter.Convert(sel.Where.Expr)
-		if err != nil {
-			return nil, nil, wrapConverterError("unable to convert filter expression", err)
-		}
-		// Result must be BoolQuery.
-		var isBoolQuery bool
-		if query, isBoolQuery = q.(*elastic.BoolQuery); !isBoolQuery {
-			query = elastic.NewBoolQuery().Filter(q)
-		}
+	query, err := c.convertWhere(sel.Where)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var fieldSorts []*elastic.FieldSort
 	for _, orderByExpr := range sel.OrderBy {
-		colName, err := convertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter)
+		colName, err := ConvertColName(c.fnInterceptor, orderByExpr.Expr, FieldNameSorter)
 		if err != nil {
 			return nil, nil, wrapConverterError("unable to convert 'order by' column name", err)
 		}
@@ -251,6 +331,22 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*elastic.BoolQuery, []
 	return query, fieldSorts, nil
 }
 
+func (c *Converter) convertWhere(where *sqlparser.Where) (*elastic.BoolQuery, error) {
+	if where == nil {
+		return nil, nil
+	}
+
+	q, err := c.whereConverter.Convert(where.Expr)
+	if err != nil {
+		return nil, wrapConverterError("unable to convert filter expression", err)
+	}
+	// Result must be BoolQuery.
+	if query, isBoolQuery := q.(*elastic.BoolQuery); isBoolQuery {
+		return query, nil
+	}
+	return elastic.NewBoolQuery().Filter(q), nil
+}
+
 func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	if expr == nil {
 		return nil, errors.New("cannot be nil")
@@ -272,7 +368,10 @@ func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	case *sqlparser.NotExpr:
 		return nil, NewConverterError("%s: 'not' expression", NotSupportedErrMessage)
 	case *sqlparser.FuncExpr:
-		return nil, NewConverterError("%s: function expression", NotSupportedErrMessage)
+		if w.Func == nil {
+			return nil, NewConverterError("%s: function expression", NotSupportedErrMessage)
+		}
+		return w.Func.Convert(e)
 	case *sqlparser.ColName:
 		return nil, NewConverterError("incomplete expression")
 	default:
@@ -352,16 +451,16 @@ func (r *rangeCondConverter) Convert(expr sqlparser.Expr) (elastic.Query, error)
 		return nil, NewConverterError("%v is not a range condition", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(r.fnInterceptor, rangeCond.Left, FieldNameFilter)
+	colName, err := ConvertColName(r.fnInterceptor, rangeCond.Left, FieldNameFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of 'between' expression", err)
 	}
 
-	fromValue, err := parseSqlValue(sqlparser.String(rangeCond.From))
+	fromValue, err := ParseSqlValue(sqlparser.String(rangeCond.From))
 	if err != nil {
 		return nil, err
 	}
-	toValue, err := parseSqlValue(sqlparser.String(rangeCond.To))
+	toValue, err := ParseSqlValue(sqlparser.String(rangeCond.To))
 	if err != nil {
 		return nil, err
 	}
@@ -394,7 +493,7 @@ func (i *isConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 		return nil, NewConverterError("%v is not an 'is' expression", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(i.fnInterceptor, isExpr.Expr, FieldNameFilter)
+	colName, err := ConvertColName(i.fnInterceptor, isExpr.Expr, FieldNameFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of 'is' expression", err)
 	}
@@ -412,18 +511,126 @@ func (i *isConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	return query, nil
 }
 
+// Convert converts contains function to term queries, starts_with function to prefix query,
+// and match_phrase function to match_phrase query.
+func (c *funcConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok {
+		return nil, NewConverterError("%v is not a function expression", sqlparser.String(expr))
+	}
+
+	funcName, colNameExpr, values, err := ConvertFuncExpr(funcExpr)
+	if err != nil {
+		return nil, err
+	}
+	colName, err := ConvertColName(c.fnInterceptor, colNameExpr, funcFieldNameUsage(funcName))
+	if err != nil {
+		return nil, wrapConverterError(fmt.Sprintf("unable to convert first argument of '%s' function", funcName), err)
+	}
+	values, err = c.fvInterceptor.Values(colName, values...)
+	if err != nil {
+		return nil, wrapConverterError(fmt.Sprintf("unable to convert values of '%s' function", funcName), err)
+	}
+
+	switch funcName {
+	case StartsWithFuncName:
+		prefix, isString := values[0].(string)
+		if !isString {
+			return nil, NewConverterError("%s: '%s' function value must be a string but was %T", InvalidExpressionErrMessage, funcName, values[0])
+		}
+		return elastic.NewPrefixQuery(colName, prefix), nil
+	case MatchPhraseFuncName:
+		return elastic.NewMatchPhraseQuery(colName, values[0]), nil
+	}
+
+	if len(values) == 1 {
+		return elastic.NewTermQuery(colName, values[0]), nil
+	}
+	query := elastic.NewBoolQuery()
+	for _, value := range values {
+		query.Filter(elastic.NewTermQuery(colName, value))
+	}
+	return query, nil
+}
+
+// ConvertFuncExpr validates function expression and returns lowercased function name,
+// its column name expression and values. Supported functions are contains(Field, 'value1', 'value2', ...),
+// starts_with(Field, 'prefix') and match_phrase(Field, 'phrase').
+func ConvertFuncExpr(funcExpr *sqlparser.FuncExpr) (string, sqlparser.Expr, []interface{}, error) {
+	funcName := funcExpr.Name.Lowered()
+	var usage string
+	switch funcName {
+	case ContainsFuncName:
+		usage = fmt.Sprintf("%s(Field, 'value1', 'value2', ...)", funcName)
+	case StartsWithFuncName:
+		usage = fmt.Sprintf("%s(Field, 'prefix')", funcName)
+	case MatchPhraseFuncName:
+		usage = fmt.Sprintf("%s(Field, 'phrase')", funcName)
+	default:
+		return "", nil, nil, NewConverterError("%s: function %s", NotSupportedErrMessage, funcExpr.Name.String())
+	}
+
+	invalidCallErr := NewConverterError("%s: function '%s' must be called as %s", InvalidExpressionErrMessage, funcName, usage)
+	if funcExpr.Distinct || !funcExpr.Qualifier.IsEmpty() || len(funcExpr.Exprs) < 2 {
+		return "", nil, nil, invalidCallErr
+	}
+	if funcName != ContainsFuncName && len(funcExpr.Exprs) != 2 {
+		return "", nil, nil, invalidCallErr
+	}
+
+	args := make([]sqlparser.Expr, len(funcExpr.Exprs))
+	for i, selectExpr := range funcExpr.Exprs {
+		aliasedExpr, isAliased := selectExpr.(*sqlparser.AliasedExpr)
+		if !isAliased || !aliasedExpr.As.IsEmpty() {
+			return "", nil, nil, NewConverterError("%s: invalid argument %s of '%s' function", InvalidExpressionErrMessage, sqlparser.String(selectExpr), funcName)
+		}
+		args[i] = aliasedExpr.Expr
+	}
+
+	values := make([]interface{}, len(args)-1)
+	for i, arg := range args[1:] {
+		sqlVal, isSQLVal := arg.(*sqlparser.SQLVal)
+		if !isSQLVal {
+			return "", nil, nil, NewConverterError("%s: invalid argument %s of '%s' function", InvalidExpressionErrMessage, sqlparser.String(arg), funcName)
+		}
+		value, err := ParseSqlValue(sqlparser.String(sqlVal))
+		if err != nil {
+			return "", nil, nil, err
+		}
+		values[i] = value
+	}
+
+	if funcName != ContainsFuncName {
+		if str, isString := values[0].(string); !isString || str == "" {
+			return "", nil, nil, NewConverterError("%s: '%s' function value must be a non-empty string but was %v", InvalidExpressionErrMessage, funcName, values[0])
+		}
+	}
+	return funcName, args[0], values, nil
+}
+
+func funcFieldNameUsage(funcName string) FieldNameUsage {
+	switch funcName {
+	case StartsWithFuncName:
+		return FieldNamePrefixFilter
+	case MatchPhraseFuncName:
+		return FieldNameFullTextFilter
+	default:
+		return FieldNameFilter
+	}
+}
+
 func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	comparisonExpr, ok := expr.(*sqlparser.ComparisonExpr)
 	if !ok {
 		return nil, NewConverterError("%v is not a comparison expression", sqlparser.String(expr))
 	}
 
-	colName, err := convertColName(c.fnInterceptor, comparisonExpr.Left, FieldNameFilter)
+	colName, err := ConvertColName(c.fnInterceptor, comparisonExpr.Left, FieldNameFilter)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert left part of comparison expression", err)
 	}
 
-	colValue, err := convertComparisonExprValue(comparisonExpr.Right)
+	colValue, err := ConvertComparisonExprValue(comparisonExpr.Right)
 	if err != nil {
 		return nil, wrapConverterError("unable to convert right part of comparison expression", err)
 	}
@@ -475,10 +682,12 @@ func (c *comparisonExprConverter) Convert(expr sqlparser.Expr) (elastic.Query, e
 	return query, nil
 }
 
-func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
+// ConvertComparisonExprValue converts right part of comparison expression into a value,
+// or into a slice of values for "in (1,2,3)" expressions.
+func ConvertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 	switch e := expr.(type) {
 	case *sqlparser.SQLVal:
-		v, err := parseSqlValue(sqlparser.String(e))
+		v, err := ParseSqlValue(sqlparser.String(e))
 		if err != nil {
 			return nil, err
 		}
@@ -490,7 +699,7 @@ func convertComparisonExprValue(expr sqlparser.Expr) (interface{}, error) {
 		exprs := []sqlparser.Expr(e)
 		var result []interface{}
 		for _, expr := range exprs {
-			v, err := convertComparisonExprValue(expr)
+			v, err := ConvertComparisonExprValue(expr)
 			if err != nil {
 				return nil, err
 			}
@@ -520,7 +729,8 @@ func (n *notSupportedExprConverter) Convert(expr sqlparser.Expr) (elastic.Query,
 	return nil, NewConverterError("%s: expression of type %T", NotSupportedErrMessage, expr)
 }
 
-func parseSqlValue(sqlValue string) (interface{}, error) {
+// ParseSqlValue parses SQL value literal into string, int64 or float64.
+func ParseSqlValue(sqlValue string) (interface{}, error) {
 	if sqlValue == "" {
 		return "", nil
 	}
@@ -543,7 +753,8 @@ func parseSqlValue(sqlValue string) (interface{}, error) {
 	return nil, NewConverterError("%s: unable to parse %s", InvalidExpressionErrMessage, sqlValue)
 }
 
-func convertColName(fnInterceptor FieldNameInterceptor, colNameExpr sqlparser.Expr, usage FieldNameUsage) (string, error) {
+// ConvertColName returns column name of the expression as converted by fnInterceptor.
+func ConvertColName(fnInterceptor FieldNameInterceptor, colNameExpr sqlparser.Expr, usage FieldNameUsage) (string, error) {
 	colName, isColName := colNameExpr.(*sqlparser.ColName)
 	if !isColName {
 		return "", NewConverterError("%s: must be a column name but was %T", InvalidExpressionErrMessage, colNameExpr)
@@ -42,6 +42,11 @@ type (
 const (
 	FieldNameFilter FieldNameUsage = iota
 	FieldNameSorter
+	FieldNameGroupBy
+	// FieldNamePrefixFilter is used for prefix matching which is supported for keyword fields only.
+	FieldNamePrefixFilter
+	// FieldNameFullTextFilter is used for phrase matching which is supported for text fields only.
+	FieldNameFullTextFilter
 )
 
 func (n *NopFieldNameInterceptor) Name(name string, _ FieldNameUsage) (string, error) {
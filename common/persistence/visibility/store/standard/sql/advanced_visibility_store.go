@@ -0,0 +1,460 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/converter"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	persistencesql "go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// advancedVisibilityStore is the visibility store of SQL plugins which use the advanced visibility schema.
+	// Besides the standard visibility APIs, it supports search attributes and the visibility query language.
+	advancedVisibilityStore struct {
+		*visibilityStore
+		db                       sqlplugin.AdvancedVisibility
+		dialect                  sqlDialect
+		indexName                string
+		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
+	}
+
+	// advancedVisibilityPageToken is a page token for queries with default order, which uses
+	// the last returned row as the position, or for queries with ORDER BY clause, which uses offset.
+	advancedVisibilityPageToken struct {
+		CloseTime time.Time
+		StartTime time.Time
+		RunID     string
+		Offset    int
+	}
+)
+
+var (
+	_ store.VisibilityStore = (*advancedVisibilityStore)(nil)
+
+	// maxCloseTime is the value of coalesce_close_time column for running workflow executions.
+	maxCloseTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// NewSQLAdvancedVisibilityStore creates an instance of VisibilityStore which supports search attributes
+// and the visibility query language. It returns nil if the SQL plugin doesn't use the advanced visibility schema.
+func NewSQLAdvancedVisibilityStore(
+	cfg config.SQL,
+	r resolver.ServiceResolver,
+	indexName string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+	logger log.Logger,
+) (store.VisibilityStore, error) {
+	var dialect sqlDialect
+	switch cfg.PluginName {
+	case mysql.PluginNameV8:
+		dialect = &mysqlDialect{}
+	case postgresql.PluginNameV12:
+		dialect = &postgresqlDialect{}
+	default:
+		return nil, nil
+	}
+
+	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindVisibility, &cfg, r)
+	db, err := refDbConn.Get()
+	if err != nil {
+		return nil, err
+	}
+	advancedDB, ok := refDbConn.DB.(sqlplugin.AdvancedVisibility)
+	if !ok {
+		_ = refDbConn.Close()
+		return nil, fmt.Errorf("SQL plugin %s doesn't support advanced visibility", cfg.PluginName)
+	}
+	return &advancedVisibilityStore{
+		visibilityStore: &visibilityStore{
+			sqlStore: persistencesql.NewSqlStore(db, logger),
+		},
+		db:                       advancedDB,
+		dialect:                  dialect,
+		indexName:                indexName,
+		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
+	}, nil
+}
+
+func (s *advancedVisibilityStore) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionStartedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoAdvancedVisibility(ctx, row)
+	return err
+}
+
+func (s *advancedVisibilityStore) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	executionDuration := request.CloseTime.Sub(request.ExecutionTime).Nanoseconds()
+	row.CloseTime = &request.CloseTime
+	row.HistoryLength = &request.HistoryLength
+	row.ExecutionDuration = &executionDuration
+
+	result, err := s.db.ReplaceIntoAdvancedVisibility(ctx, row)
+	if err != nil {
+		return err
+	}
+	noRowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("RecordWorkflowExecutionClosed rowsAffected error: %v", err)
+	}
+	if noRowsAffected > 2 { // either adds a new row or updates existing row
+		return fmt.Errorf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected)
+	}
+	return nil
+}
+
+func (s *advancedVisibilityStore) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *store.InternalUpsertWorkflowExecutionRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	// Upsert tasks can be processed after the close record was written, so the row
+	// is only updated while the workflow is open to keep its close time and status.
+	_, err = s.db.UpsertIntoAdvancedVisibility(ctx, row)
+	return err
+}
+
+func (s *advancedVisibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request, true)
+}
+
+func (s *advancedVisibilityStore) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	// Scan doesn't guarantee any order, so ORDER BY clause is ignored and the cheapest default order is used.
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request, false)
+}
+
+func (s *advancedVisibilityStore) CountWorkflowExecutions(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	convertedQuery, err := s.convertQuery(request.Namespace, saTypeMap, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountFromAdvancedVisibility(ctx, sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: request.NamespaceID.String(),
+		Where:       convertedQuery.where,
+		WhereArgs:   convertedQuery.args,
+	})
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return &manager.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *advancedVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *manager.ListWorkflowExecutionsRequestV2,
+	allowOrderBy bool,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	convertedQuery, err := s.convertQuery(request.Namespace, saTypeMap, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	var token *advancedVisibilityPageToken
+	if len(request.NextPageToken) > 0 {
+		token, err = s.deserializeAdvancedPageToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to deserialize page token: %v", err))
+		}
+	}
+
+	filter := sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: request.NamespaceID.String(),
+		Where:       convertedQuery.where,
+		WhereArgs:   convertedQuery.args,
+		PageSize:    request.PageSize,
+	}
+	useDefaultOrder := !allowOrderBy || len(convertedQuery.orderBy) == 0
+	if useDefaultOrder {
+		filter.OrderBy = defaultOrderBy
+		if token != nil {
+			// Rows are read starting after the last row of the previous page, which works with indexes.
+			filter.Where = "(coalesce_close_time, start_time, run_id) < (?, ?, ?)"
+			filter.WhereArgs = []interface{}{token.CloseTime, token.StartTime, token.RunID}
+			if convertedQuery.where != "" {
+				filter.Where = fmt.Sprintf("%s AND (%s)", filter.Where, convertedQuery.where)
+				filter.WhereArgs = append(filter.WhereArgs, convertedQuery.args...)
+			}
+		}
+	} else {
+		// RunID is explicit tiebreaker.
+		filter.OrderBy = append(convertedQuery.orderBy, "run_id DESC")
+		if token != nil {
+			filter.Offset = token.Offset
+		}
+	}
+
+	rows, err := s.db.SelectFromAdvancedVisibility(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+	if len(rows) == 0 {
+		return &store.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	infos := make([]*store.InternalWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i], err = s.advancedRowToInfo(&rows[i], saTypeMap, request.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) == request.PageSize {
+		nextToken := &advancedVisibilityPageToken{Offset: filter.Offset + len(rows)}
+		if useDefaultOrder {
+			lastRow := rows[len(rows)-1]
+			nextToken = &advancedVisibilityPageToken{
+				CloseTime: maxCloseTime,
+				StartTime: lastRow.StartTime,
+				RunID:     lastRow.RunID,
+			}
+			if lastRow.CloseTime != nil {
+				nextToken.CloseTime = *lastRow.CloseTime
+			}
+		}
+		nextPageToken, err = s.serializeAdvancedPageToken(nextToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &store.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *advancedVisibilityStore) convertQuery(
+	namespaceName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	requestQuery string,
+) (*convertedQuery, error) {
+	c := newQueryConverter(s.dialect, namespaceName, saTypeMap, s.searchAttributesMapper)
+	convertedQuery, err := c.convertWhereOrderBy(requestQuery)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+	return convertedQuery, nil
+}
+
+func (s *advancedVisibilityStore) generateRow(
+	request *store.InternalVisibilityRequestBase,
+) (*sqlplugin.AdvancedVisibilityRow, error) {
+	searchAttributes, err := s.encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return nil, err
+	}
+	stateTransitionCount := request.StateTransitionCount
+	return &sqlplugin.AdvancedVisibilityRow{
+		VisibilityRow: sqlplugin.VisibilityRow{
+			NamespaceID:      request.NamespaceID,
+			WorkflowID:       request.WorkflowID,
+			RunID:            request.RunID,
+			StartTime:        request.StartTime,
+			ExecutionTime:    request.ExecutionTime,
+			WorkflowTypeName: request.WorkflowTypeName,
+			Status:           int32(request.Status),
+			Memo:             request.Memo.GetData(),
+			Encoding:         request.Memo.GetEncodingType().String(),
+			TaskQueue:        request.TaskQueue,
+		},
+		StateTransitionCount: &stateTransitionCount,
+		SearchAttributes:     searchAttributes,
+	}, nil
+}
+
+// encodeSearchAttributes encodes search attributes as JSON object. Datetime values are stored
+// in the same format as they are passed in queries, other values are stored as JSON values.
+func (s *advancedVisibilityStore) encodeSearchAttributes(searchAttributes *commonpb.SearchAttributes) ([]byte, error) {
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.indexName, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+	values, err := searchattribute.Decode(searchAttributes, &typeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode search attributes: %v", err))
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	for saName, saValue := range values {
+		switch v := saValue.(type) {
+		case time.Time:
+			values[saName] = formatSearchAttributeTime(v)
+		case []time.Time:
+			formatted := make([]string, len(v))
+			for i, t := range v {
+				formatted[i] = formatSearchAttributeTime(t)
+			}
+			values[saName] = formatted
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes: %v", err))
+	}
+	return data, nil
+}
+
+func (s *advancedVisibilityStore) decodeSearchAttributes(
+	data []byte,
+	saTypeMap searchattribute.NameTypeMap,
+	namespaceName namespace.Name,
+) (*commonpb.SearchAttributes, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to unmarshal search attributes: %v", err))
+	}
+
+	values := make(map[string]interface{}, len(fields))
+	for saName, saData := range fields {
+		saType, err := saTypeMap.GetType(saName)
+		if err != nil {
+			// Silently ignore ErrInvalidName because it indicates search attribute which was removed.
+			if errors.Is(err, searchattribute.ErrInvalidName) {
+				continue
+			}
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to get type for search attribute %q: %v", saName, err))
+		}
+		saPayload := &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingJSON)},
+			Data:     saData,
+		}
+		values[saName], err = searchattribute.DecodeValue(saPayload, saType)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode search attribute %q value %s: %v", saName, saData, err))
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	searchAttributes, err := searchattribute.Encode(values, &saTypeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes: %v", err))
+	}
+	err = searchattribute.ApplyAliases(s.searchAttributesMapper, searchAttributes, namespaceName.String())
+	if err != nil {
+		return nil, err
+	}
+	return searchAttributes, nil
+}
+
+func (s *advancedVisibilityStore) advancedRowToInfo(
+	row *sqlplugin.AdvancedVisibilityRow,
+	saTypeMap searchattribute.NameTypeMap,
+	namespaceName namespace.Name,
+) (*store.InternalWorkflowExecutionInfo, error) {
+	info := s.rowToInfo(&row.VisibilityRow)
+	if row.StateTransitionCount != nil {
+		info.StateTransitionCount = *row.StateTransitionCount
+	}
+	var err error
+	info.SearchAttributes, err = s.decodeSearchAttributes(row.SearchAttributes, saTypeMap, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *advancedVisibilityStore) deserializeAdvancedPageToken(
+	data []byte,
+) (*advancedVisibilityPageToken, error) {
+	var token advancedVisibilityPageToken
+	err := json.Unmarshal(data, &token)
+	return &token, err
+}
+
+func (s *advancedVisibilityStore) serializeAdvancedPageToken(
+	token *advancedVisibilityPageToken,
+) ([]byte, error) {
+	data, err := json.Marshal(token)
+	return data, err
+}
@@ -0,0 +1,664 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	// Datetime search attributes are stored in JSON as fixed width strings,
+	// so they can be compared as strings.
+	searchAttributeTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+
+	// Text is matched word by word, where words are runs of letters, digits and underscores.
+	// The patterns use POSIX classes, which MySQL 5.7 and 8 and PostgreSQL all support.
+	textWordBoundaryStart = "(^|[^[:alnum:]_])"
+	textWordBoundaryEnd   = "([^[:alnum:]_]|$)"
+	textWordSeparator     = "[^[:alnum:]_]+"
+)
+
+var textWordRegexp = regexp.MustCompile(`[\pL\pN_]+`)
+
+type (
+	// sqlDialect builds the expressions which are different in MySQL and PostgreSQL.
+	sqlDialect interface {
+		// jsonValue returns an expression for JSON value of custom search attribute.
+		jsonValue(name string) string
+		// jsonText returns an expression for unquoted string value of custom search attribute.
+		jsonText(name string) string
+		jsonInt(name string) string
+		jsonDouble(name string) string
+		// jsonContains returns a condition which is true if JSON expression, an array or a scalar,
+		// contains the string passed as the next query argument.
+		jsonContains(jsonExpr string) string
+		// regexpMatch returns a condition which is true if string expression matches the regular
+		// expression passed as the next query argument.
+		regexpMatch(expr string) string
+	}
+
+	mysqlDialect      struct{}
+	postgresqlDialect struct{}
+
+	// queryConverter converts visibility query to parameterized SQL condition and ORDER BY
+	// expressions over executions_visibility table of the advanced visibility schema.
+	queryConverter struct {
+		dialect       sqlDialect
+		namespace     namespace.Name
+		saTypeMap     searchattribute.NameTypeMap
+		saMapper      searchattribute.Mapper
+		fvInterceptor query.FieldValuesInterceptor
+
+		args []interface{}
+	}
+
+	convertedQuery struct {
+		where string
+		args  []interface{}
+		// orderBy is empty if query doesn't have ORDER BY clause.
+		orderBy []string
+	}
+
+	// queryField is a search attribute used in the query.
+	queryField struct {
+		name   string
+		saType enumspb.IndexedValueType
+		// column is a column name or an expression for scalar value of the search attribute.
+		// It is empty for search attributes which are always lists.
+		column string
+		// jsonColumn is an expression for JSON value of keyword search attributes which are stored in JSON
+		// and can be lists. Equality with such search attributes means that the list contains the value.
+		jsonColumn string
+		// isSystem is set for search attributes which are stored in their own typed columns.
+		isSystem bool
+	}
+)
+
+var (
+	systemColumns = map[string]string{
+		searchattribute.WorkflowID:           "workflow_id",
+		searchattribute.RunID:                "run_id",
+		searchattribute.WorkflowType:         "workflow_type_name",
+		searchattribute.StartTime:            "start_time",
+		searchattribute.ExecutionTime:        "execution_time",
+		searchattribute.CloseTime:            "close_time",
+		searchattribute.ExecutionStatus:      "status",
+		searchattribute.TaskQueue:            "task_queue",
+		searchattribute.HistoryLength:        "history_length",
+		searchattribute.ExecutionDuration:    "execution_duration",
+		searchattribute.StateTransitionCount: "state_transition_count",
+	}
+
+	// predefinedColumns are generated columns for predefined search attributes.
+	predefinedColumns = map[string]string{
+		searchattribute.BatcherNamespace: "batcher_namespace",
+		searchattribute.BatcherUser:      "batcher_user",
+	}
+
+	// predefinedListColumns are generated JSON columns for predefined search attributes which are lists.
+	predefinedListColumns = map[string]string{
+		searchattribute.TemporalChangeVersion: "temporal_change_version",
+		searchattribute.BinaryChecksums:       "binary_checksums",
+	}
+
+	defaultOrderBy = []string{"coalesce_close_time DESC", "start_time DESC", "run_id DESC"}
+)
+
+func newQueryConverter(
+	dialect sqlDialect,
+	namespace namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) *queryConverter {
+	return &queryConverter{
+		dialect:       dialect,
+		namespace:     namespace,
+		saTypeMap:     saTypeMap,
+		saMapper:      saMapper,
+		fvInterceptor: elasticsearch.NewValuesInterceptor(),
+	}
+}
+
+// convertWhereOrderBy converts WHERE SQL statement, which may also have ORDER BY clause.
+// Values from the query are never put in SQL but passed as arguments.
+func (c *queryConverter) convertWhereOrderBy(whereOrderBy string) (*convertedQuery, error) {
+	sel, err := query.ParseWhereOrderBy(whereOrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if sel.GroupBy != nil {
+		return nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	if sel.Limit != nil {
+		return nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+	}
+
+	c.args = nil
+	result := &convertedQuery{}
+	if sel.Where != nil {
+		result.where, err = c.convertExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		result.args = c.args
+	}
+
+	for _, orderByExpr := range sel.OrderBy {
+		field, err := c.convertField(orderByExpr.Expr, query.FieldNameSorter)
+		if err != nil {
+			return nil, err
+		}
+		if field.column == "" {
+			return nil, query.NewConverterError("unable to sort by list field %s", field.name)
+		}
+		direction := "ASC"
+		if orderByExpr.Direction == sqlparser.DescScr {
+			direction = "DESC"
+		}
+		result.orderBy = append(result.orderBy, fmt.Sprintf("%s %s", field.column, direction))
+	}
+
+	return result, nil
+}
+
+func (c *queryConverter) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr(e.Left, e.Right, "AND")
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr(e.Left, e.Right, "OR")
+	case *sqlparser.ParenExpr:
+		return c.convertExpr(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(e)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(e)
+	case *sqlparser.IsExpr:
+		return c.convertIsExpr(e)
+	case *sqlparser.NotExpr:
+		return "", query.NewConverterError("%s: 'not' expression", query.NotSupportedErrMessage)
+	case *sqlparser.FuncExpr:
+		return c.convertFuncExpr(e)
+	case *sqlparser.ColName:
+		return "", query.NewConverterError("incomplete expression")
+	default:
+		return "", query.NewConverterError("%s: expression of type %T", query.NotSupportedErrMessage, expr)
+	}
+}
+
+func (c *queryConverter) convertBinaryExpr(left sqlparser.Expr, right sqlparser.Expr, operator string) (string, error) {
+	leftCond, err := c.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightCond, err := c.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftCond, operator, rightCond), nil
+}
+
+func (c *queryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	field, err := c.convertField(expr.Left, query.FieldNameFilter)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := query.ConvertComparisonExprValue(expr.Right)
+	if err != nil {
+		return "", err
+	}
+	values, isList := value.([]interface{})
+	isListOperator := expr.Operator == sqlparser.InStr || expr.Operator == sqlparser.NotInStr
+	if isList != isListOperator {
+		return "", query.NewConverterError("%s: operator '%s' can't be used with value %s", query.InvalidExpressionErrMessage, expr.Operator, sqlparser.String(expr.Right))
+	}
+	if !isList {
+		values = []interface{}{value}
+	}
+
+	switch expr.Operator {
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		// Unlike other operators, LIKE takes SQL pattern, so the value is passed as is.
+		if field.column == "" || (field.saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && field.saType != enumspb.INDEXED_VALUE_TYPE_TEXT) || field.name == searchattribute.ExecutionStatus {
+			return "", query.NewConverterError("%s: operator '%s' can be used with keyword and text fields only", query.InvalidExpressionErrMessage, expr.Operator)
+		}
+		pattern, isString := values[0].(string)
+		if !isString {
+			return "", query.NewConverterError("%s: '%s' operator value must be a string but was %T", query.InvalidExpressionErrMessage, expr.Operator, values[0])
+		}
+		return fmt.Sprintf("%s %s %s", field.column, strings.ToUpper(expr.Operator), c.addArg(pattern)), nil
+	}
+
+	values, err = c.convertValues(field, values)
+	if err != nil {
+		return "", err
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr:
+		return c.equal(field, values[0]), nil
+	case sqlparser.NotEqualStr:
+		if field.isPlainColumn() {
+			return fmt.Sprintf("%s != %s", field.column, c.addArg(values[0])), nil
+		}
+		return fmt.Sprintf("NOT %s", c.equal(field, values[0])), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		var cond string
+		if field.isPlainColumn() {
+			placeholders := make([]string, len(values))
+			for i, v := range values {
+				placeholders[i] = c.addArg(v)
+			}
+			cond = fmt.Sprintf("%s IN (%s)", field.column, strings.Join(placeholders, ", "))
+		} else {
+			conds := make([]string, len(values))
+			for i, v := range values {
+				conds[i] = c.equal(field, v)
+			}
+			cond = fmt.Sprintf("(%s)", strings.Join(conds, " OR "))
+		}
+		if expr.Operator == sqlparser.NotInStr {
+			return fmt.Sprintf("NOT %s", cond), nil
+		}
+		return cond, nil
+	case sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr, sqlparser.GreaterEqualStr:
+		if err := c.checkOrderedField(field, expr.Operator); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %s %s", field.column, expr.Operator, c.addArg(values[0])), nil
+	default:
+		return "", query.NewConverterError("operator '%v' not allowed in comparison expression", expr.Operator)
+	}
+}
+
+// convertFuncExpr converts contains(Field, 'value1', 'value2') function which is true if list field contains all values,
+// starts_with(Field, 'prefix') function for keyword fields and match_phrase(Field, 'phrase') function for text fields.
+func (c *queryConverter) convertFuncExpr(expr *sqlparser.FuncExpr) (string, error) {
+	funcName, colNameExpr, values, err := query.ConvertFuncExpr(expr)
+	if err != nil {
+		return "", err
+	}
+
+	switch funcName {
+	case query.StartsWithFuncName:
+		field, err := c.convertField(colNameExpr, query.FieldNamePrefixFilter)
+		if err != nil {
+			return "", err
+		}
+		if field.column == "" || field.saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD || field.name == searchattribute.ExecutionStatus {
+			return "", query.NewConverterError("%s: function '%s' can be used with keyword fields only", query.InvalidExpressionErrMessage, funcName)
+		}
+		return fmt.Sprintf("%s LIKE %s", field.column, c.addArg(escapeLikeValue(values[0].(string))+"%")), nil
+	case query.MatchPhraseFuncName:
+		field, err := c.convertField(colNameExpr, query.FieldNameFullTextFilter)
+		if err != nil {
+			return "", err
+		}
+		if field.saType != enumspb.INDEXED_VALUE_TYPE_TEXT {
+			return "", query.NewConverterError("%s: function '%s' can be used with text fields only", query.InvalidExpressionErrMessage, funcName)
+		}
+		return c.equal(field, values[0]), nil
+	}
+
+	field, err := c.convertField(colNameExpr, query.FieldNameFilter)
+	if err != nil {
+		return "", err
+	}
+	if field.jsonColumn == "" {
+		return "", query.NewConverterError("%s: function '%s' can be used with keyword and keyword list fields only", query.InvalidExpressionErrMessage, funcName)
+	}
+
+	values, err = c.convertValues(field, values)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 1 {
+		return c.equal(field, values[0]), nil
+	}
+	conds := make([]string, len(values))
+	for i, v := range values {
+		conds[i] = c.equal(field, v)
+	}
+	return fmt.Sprintf("(%s)", strings.Join(conds, " AND ")), nil
+}
+
+func (c *queryConverter) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	field, err := c.convertField(expr.Left, query.FieldNameFilter)
+	if err != nil {
+		return "", err
+	}
+	if err := c.checkOrderedField(field, expr.Operator); err != nil {
+		return "", err
+	}
+
+	fromValue, err := query.ParseSqlValue(sqlparser.String(expr.From))
+	if err != nil {
+		return "", err
+	}
+	toValue, err := query.ParseSqlValue(sqlparser.String(expr.To))
+	if err != nil {
+		return "", err
+	}
+	values, err := c.convertValues(field, []interface{}{fromValue, toValue})
+	if err != nil {
+		return "", err
+	}
+
+	switch expr.Operator {
+	case sqlparser.BetweenStr:
+		return fmt.Sprintf("%s BETWEEN %s AND %s", field.column, c.addArg(values[0]), c.addArg(values[1])), nil
+	case sqlparser.NotBetweenStr:
+		return fmt.Sprintf("%s NOT BETWEEN %s AND %s", field.column, c.addArg(values[0]), c.addArg(values[1])), nil
+	default:
+		return "", query.NewConverterError("%s: range condition operator must be 'between' or 'not between'", query.InvalidExpressionErrMessage)
+	}
+}
+
+func (c *queryConverter) convertIsExpr(expr *sqlparser.IsExpr) (string, error) {
+	field, err := c.convertField(expr.Expr, query.FieldNameFilter)
+	if err != nil {
+		return "", err
+	}
+
+	column := field.column
+	if field.jsonColumn != "" {
+		column = field.jsonColumn
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return fmt.Sprintf("%s IS NULL", column), nil
+	case sqlparser.IsNotNullStr:
+		return fmt.Sprintf("%s IS NOT NULL", column), nil
+	default:
+		return "", query.NewConverterError("%s: 'is' operator can be used with 'null' and 'not null' only", query.InvalidExpressionErrMessage)
+	}
+}
+
+// Name implements query.FieldNameInterceptor. It resolves search attribute alias to its field name.
+func (c *queryConverter) Name(name string, usage query.FieldNameUsage) (string, error) {
+	fieldName := name
+	if searchattribute.IsMappable(name) && c.saMapper != nil {
+		var err error
+		fieldName, err = c.saMapper.GetFieldName(name, c.namespace.String())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	fieldType, err := c.saTypeMap.GetType(fieldName)
+	if err != nil {
+		return "", query.NewConverterError("invalid search attribute: %s", name)
+	}
+
+	if usage == query.FieldNameSorter && fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT {
+		return "", query.NewConverterError("unable to sort by field of %s type, use field of type %s", enumspb.INDEXED_VALUE_TYPE_TEXT.String(), enumspb.INDEXED_VALUE_TYPE_KEYWORD.String())
+	}
+
+	return fieldName, nil
+}
+
+func (c *queryConverter) convertField(expr sqlparser.Expr, usage query.FieldNameUsage) (*queryField, error) {
+	name, err := query.ConvertColName(c, expr, usage)
+	if err != nil {
+		return nil, err
+	}
+	saType, err := c.saTypeMap.GetType(name)
+	if err != nil {
+		return nil, query.NewConverterError("invalid search attribute: %s", name)
+	}
+
+	field := &queryField{name: name, saType: saType}
+	if column, ok := systemColumns[name]; ok {
+		field.column = column
+		field.isSystem = true
+		return field, nil
+	}
+	if column, ok := predefinedColumns[name]; ok {
+		field.column = column
+		return field, nil
+	}
+	if column, ok := predefinedListColumns[name]; ok {
+		field.jsonColumn = column
+		return field, nil
+	}
+
+	// Custom search attribute names are used as JSON keys in SQL.
+	if strings.ContainsAny(name, `'"\?`) {
+		return nil, query.NewConverterError("%s: search attribute name %s", query.NotSupportedErrMessage, name)
+	}
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		field.column = c.dialect.jsonInt(name)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		field.column = c.dialect.jsonDouble(name)
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		field.column = c.dialect.jsonText(name)
+		field.jsonColumn = c.dialect.jsonValue(name)
+	case searchattribute.IndexedValueTypeKeywordList:
+		field.jsonColumn = c.dialect.jsonValue(name)
+	default:
+		field.column = c.dialect.jsonText(name)
+	}
+	return field, nil
+}
+
+// convertValues converts values from the query to arguments for comparison with the field.
+func (c *queryConverter) convertValues(field *queryField, values []interface{}) ([]interface{}, error) {
+	values, err := c.fvInterceptor.Values(field.name, values...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i], err = c.convertValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (c *queryConverter) convertValue(field *queryField, value interface{}) (interface{}, error) {
+	invalidValueErr := query.NewConverterError("%s: invalid value %v for field %s of %s type", query.InvalidExpressionErrMessage, value, field.name, searchattribute.TypeName(field.saType))
+
+	if field.name == searchattribute.ExecutionStatus {
+		statusStr, isString := value.(string)
+		if !isString {
+			return nil, invalidValueErr
+		}
+		status, ok := enumspb.WorkflowExecutionStatus_value[statusStr]
+		if !ok {
+			return nil, invalidValueErr
+		}
+		return status, nil
+	}
+
+	switch field.saType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, searchattribute.IndexedValueTypeKeywordList, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if _, isString := value.(string); !isString {
+			return nil, invalidValueErr
+		}
+		return value, nil
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if _, isInt := value.(int64); !isInt {
+			return nil, invalidValueErr
+		}
+		return value, nil
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+		return nil, invalidValueErr
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		// Bool search attributes are compared as JSON text.
+		switch v := value.(type) {
+		case bool:
+			return fmt.Sprintf("%t", v), nil
+		case string:
+			if v = strings.ToLower(v); v == "true" || v == "false" {
+				return v, nil
+			}
+		}
+		return nil, invalidValueErr
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		var t time.Time
+		switch v := value.(type) {
+		case int64:
+			t = time.Unix(0, v)
+		case string:
+			var err error
+			if t, err = time.Parse(time.RFC3339Nano, v); err != nil {
+				return nil, invalidValueErr
+			}
+		default:
+			return nil, invalidValueErr
+		}
+		if field.isSystem {
+			return t.UTC(), nil
+		}
+		return formatSearchAttributeTime(t), nil
+	default:
+		return nil, invalidValueErr
+	}
+}
+
+func (c *queryConverter) checkOrderedField(field *queryField, operator string) error {
+	if field.column == "" || field.saType == enumspb.INDEXED_VALUE_TYPE_TEXT || field.saType == enumspb.INDEXED_VALUE_TYPE_BOOL {
+		return query.NewConverterError("%s: operator '%s' can't be used with field %s of %s type", query.InvalidExpressionErrMessage, operator, field.name, searchattribute.TypeName(field.saType))
+	}
+	return nil
+}
+
+// equal returns a condition which is true if the field is equal to the value,
+// contains the value if it is a list, or contains the words of the value if it is text.
+func (c *queryConverter) equal(field *queryField, value interface{}) string {
+	switch {
+	case field.jsonColumn != "":
+		c.args = append(c.args, value)
+		return c.dialect.jsonContains(field.jsonColumn)
+	case field.saType == enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return c.textMatch(field.column, value.(string))
+	default:
+		return fmt.Sprintf("%s = %s", field.column, c.addArg(value))
+	}
+}
+
+// textMatch returns a condition which is true if the text contains the words of the value as
+// consecutive whole words, so "foo" matches "foo bar" and "foo-bar" but not "foobar". This is
+// close to a match_phrase query with Elasticsearch's standard analyzer, except that case
+// sensitivity depends on the column collation. The condition is a regular expression match,
+// which scans the rows selected by the other conditions rather than using an index.
+func (c *queryConverter) textMatch(column string, value string) string {
+	words := textWordRegexp.FindAllString(value, -1)
+	if len(words) == 0 {
+		// like Elasticsearch, a value without words matches nothing
+		return "FALSE"
+	}
+	c.args = append(c.args, textWordBoundaryStart+strings.Join(words, textWordSeparator)+textWordBoundaryEnd)
+	return c.dialect.regexpMatch(column)
+}
+
+func (c *queryConverter) addArg(value interface{}) string {
+	c.args = append(c.args, value)
+	return "?"
+}
+
+// isPlainColumn returns true if the field can be compared using standard SQL operators.
+func (f *queryField) isPlainColumn() bool {
+	return f.jsonColumn == "" && f.saType != enumspb.INDEXED_VALUE_TYPE_TEXT
+}
+
+func escapeLikeValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func formatSearchAttributeTime(t time.Time) string {
+	return t.UTC().Format(searchAttributeTimeFormat)
+}
+
+func (d *mysqlDialect) jsonValue(name string) string {
+	return fmt.Sprintf(`JSON_EXTRACT(search_attributes, '$."%s"')`, name)
+}
+
+func (d *mysqlDialect) jsonText(name string) string {
+	return fmt.Sprintf("JSON_UNQUOTE(%s)", d.jsonValue(name))
+}
+
+func (d *mysqlDialect) jsonInt(name string) string {
+	return fmt.Sprintf("CAST(%s AS SIGNED)", d.jsonValue(name))
+}
+
+func (d *mysqlDialect) jsonDouble(name string) string {
+	return fmt.Sprintf("CAST(%s AS DOUBLE)", d.jsonValue(name))
+}
+
+func (d *mysqlDialect) jsonContains(jsonExpr string) string {
+	return fmt.Sprintf("JSON_CONTAINS(%s, JSON_QUOTE(?))", jsonExpr)
+}
+
+func (d *mysqlDialect) regexpMatch(expr string) string {
+	return fmt.Sprintf("%s REGEXP ?", expr)
+}
+
+func (d *postgresqlDialect) jsonValue(name string) string {
+	return fmt.Sprintf("search_attributes->'%s'", name)
+}
+
+func (d *postgresqlDialect) jsonText(name string) string {
+	return fmt.Sprintf("search_attributes->>'%s'", name)
+}
+
+func (d *postgresqlDialect) jsonInt(name string) string {
+	return fmt.Sprintf("(%s)::bigint", d.jsonValue(name))
+}
+
+func (d *postgresqlDialect) jsonDouble(name string) string {
+	return fmt.Sprintf("(%s)::double precision", d.jsonValue(name))
+}
+
+func (d *postgresqlDialect) jsonContains(jsonExpr string) string {
+	return fmt.Sprintf("%s @> to_jsonb(?::text)", jsonExpr)
+}
+
+func (d *postgresqlDialect) regexpMatch(expr string) string {
+	return fmt.Sprintf("%s ~ ?", expr)
+}
@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	queryConverterSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestQueryConverterSuite(t *testing.T) {
+	suite.Run(t, &queryConverterSuite{})
+}
+
+func (s *queryConverterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *queryConverterSuite) convert(dialect sqlDialect, whereOrderBy string) (*convertedQuery, error) {
+	c := newQueryConverter(dialect, namespace.Name("test-namespace"), searchattribute.TestNameTypeMap, nil)
+	return c.convertWhereOrderBy(whereOrderBy)
+}
+
+func (s *queryConverterSuite) TestConvertWhereOrderBy_MySQL() {
+	startTime := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		query   string
+		where   string
+		args    []interface{}
+		orderBy []string
+	}{
+		{
+			query: "",
+		},
+		{
+			query: "WorkflowId = 'wid' and ExecutionStatus = 'Running'",
+			where: "(workflow_id = ? AND status = ?)",
+			args:  []interface{}{"wid", int32(1)},
+		},
+		{
+			query: "ExecutionStatus = 1 or (WorkflowType = 'type' and HistoryLength > 10)",
+			where: "(status = ? OR (workflow_type_name = ? AND history_length > ?))",
+			args:  []interface{}{int32(1), "type", int64(10)},
+		},
+		{
+			query: "StartTime between '2022-05-01T10:00:00Z' and 1651399200000000000",
+			where: "start_time BETWEEN ? AND ?",
+			args:  []interface{}{startTime, startTime},
+		},
+		{
+			query: "WorkflowId in ('wid1', 'wid2') and CloseTime is null",
+			where: "(workflow_id IN (?, ?) AND close_time IS NULL)",
+			args:  []interface{}{"wid1", "wid2"},
+		},
+		{
+			query: "WorkflowType like 'type%'",
+			where: "workflow_type_name LIKE ?",
+			args:  []interface{}{"type%"},
+		},
+		{
+			query: "ExecutionDuration > '1h'",
+			where: "execution_duration > ?",
+			args:  []interface{}{int64(time.Hour)},
+		},
+		{
+			query: "CustomKeywordField = 'value'",
+			where: `JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"'), JSON_QUOTE(?))`,
+			args:  []interface{}{"value"},
+		},
+		{
+			query: "CustomKeywordField not in ('a', 'b')",
+			where: `NOT (JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"'), JSON_QUOTE(?)) OR JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"'), JSON_QUOTE(?)))`,
+			args:  []interface{}{"a", "b"},
+		},
+		{
+			query: "CustomTextField = 'some_text'",
+			where: `JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomTextField"')) REGEXP ?`,
+			args:  []interface{}{`(^|[^[:alnum:]_])some_text([^[:alnum:]_]|$)`},
+		},
+		{
+			query: "CustomTextField != '-'",
+			where: "NOT FALSE",
+		},
+		{
+			query: "CustomIntField >= 5 and CustomDoubleField < 3",
+			where: `(CAST(JSON_EXTRACT(search_attributes, '$."CustomIntField"') AS SIGNED) >= ? AND CAST(JSON_EXTRACT(search_attributes, '$."CustomDoubleField"') AS DOUBLE) < ?)`,
+			args:  []interface{}{int64(5), float64(3)},
+		},
+		{
+			query: "CustomDatetimeField > '2022-05-01T12:00:00+02:00'",
+			where: `JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomDatetimeField"')) > ?`,
+			args:  []interface{}{"2022-05-01T10:00:00.000000000Z"},
+		},
+		{
+			query: "CustomBoolField = 'true'",
+			where: `JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomBoolField"')) = ?`,
+			args:  []interface{}{"true"},
+		},
+		{
+			query: "BinaryChecksums = 'checksum'",
+			where: "JSON_CONTAINS(binary_checksums, JSON_QUOTE(?))",
+			args:  []interface{}{"checksum"},
+		},
+		{
+			query: "CustomKeywordListField in ('tenant1', 'tenant2')",
+			where: `(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordListField"'), JSON_QUOTE(?)) OR JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordListField"'), JSON_QUOTE(?)))`,
+			args:  []interface{}{"tenant1", "tenant2"},
+		},
+		{
+			query: "contains(CustomKeywordListField, 'tenant1', 'tenant2')",
+			where: `(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordListField"'), JSON_QUOTE(?)) AND JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$."CustomKeywordListField"'), JSON_QUOTE(?)))`,
+			args:  []interface{}{"tenant1", "tenant2"},
+		},
+		{
+			query: "BatcherUser = 'user' and TemporalChangeVersion is not null",
+			where: "(batcher_user = ? AND temporal_change_version IS NOT NULL)",
+			args:  []interface{}{"user"},
+		},
+		{
+			query: "starts_with(WorkflowId, 'order_2022-') and starts_with(CustomKeywordField, 'a%')",
+			where: `(workflow_id LIKE ? AND JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomKeywordField"')) LIKE ?)`,
+			args:  []interface{}{`order\_2022-%`, `a\%%`},
+		},
+		{
+			query: "match_phrase(CustomTextField, 'quick, brown  fox')",
+			where: `JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$."CustomTextField"')) REGEXP ?`,
+			args:  []interface{}{`(^|[^[:alnum:]_])quick[^[:alnum:]_]+brown[^[:alnum:]_]+fox([^[:alnum:]_]|$)`},
+		},
+		{
+			query:   "order by StartTime asc, CustomIntField desc",
+			orderBy: []string{"start_time ASC", `CAST(JSON_EXTRACT(search_attributes, '$."CustomIntField"') AS SIGNED) DESC`},
+		},
+	}
+
+	for _, tc := range cases {
+		result, err := s.convert(&mysqlDialect{}, tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.where, result.where, tc.query)
+		s.Equal(tc.args, result.args, tc.query)
+		s.Equal(tc.orderBy, result.orderBy, tc.query)
+	}
+}
+
+func (s *queryConverterSuite) TestConvertWhereOrderBy_PostgreSQL() {
+	cases := []struct {
+		query string
+		where string
+		args  []interface{}
+	}{
+		{
+			query: "CustomKeywordField = 'value' or CustomKeywordField like 'val%'",
+			where: "(search_attributes->'CustomKeywordField' @> to_jsonb(?::text) OR search_attributes->>'CustomKeywordField' LIKE ?)",
+			args:  []interface{}{"value", "val%"},
+		},
+		{
+			query: "CustomIntField between 1 and 5",
+			where: "(search_attributes->'CustomIntField')::bigint BETWEEN ? AND ?",
+			args:  []interface{}{int64(1), int64(5)},
+		},
+		{
+			query: "CustomDoubleField != 1.5",
+			where: "(search_attributes->'CustomDoubleField')::double precision != ?",
+			args:  []interface{}{1.5},
+		},
+		{
+			query: "match_phrase(CustomTextField, 'quick fox')",
+			where: "search_attributes->>'CustomTextField' ~ ?",
+			args:  []interface{}{`(^|[^[:alnum:]_])quick[^[:alnum:]_]+fox([^[:alnum:]_]|$)`},
+		},
+		{
+			query: "CustomKeywordListField != 'tenant1' and contains(CustomKeywordListField, 'tenant2')",
+			where: "(NOT search_attributes->'CustomKeywordListField' @> to_jsonb(?::text) AND search_attributes->'CustomKeywordListField' @> to_jsonb(?::text))",
+			args:  []interface{}{"tenant1", "tenant2"},
+		},
+	}
+
+	for _, tc := range cases {
+		result, err := s.convert(&postgresqlDialect{}, tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.where, result.where, tc.query)
+		s.Equal(tc.args, result.args, tc.query)
+	}
+}
+
+func (s *queryConverterSuite) TestConvertWhereOrderBy_Errors() {
+	cases := []struct {
+		query  string
+		errMsg string
+	}{
+		{query: "UnknownField = 'value'", errMsg: "invalid search attribute: UnknownField"},
+		{query: "not WorkflowId = 'wid'", errMsg: "operation is not supported: 'not' expression"},
+		{query: "WorkflowId = 'wid' group by WorkflowType", errMsg: "operation is not supported: 'group by' clause"},
+		{query: "WorkflowId = 'wid' limit 10", errMsg: "operation is not supported: 'limit' clause"},
+		{query: "order by CustomTextField", errMsg: "unable to sort by field of Text type, use field of type Keyword"},
+		{query: "order by BinaryChecksums", errMsg: "unable to sort by list field BinaryChecksums"},
+		{query: "CustomTextField > 'text'", errMsg: "invalid expression: operator '>' can't be used with field CustomTextField of Text type"},
+		{query: "CustomIntField = 'abc'", errMsg: "invalid expression: invalid value abc for field CustomIntField of Int type"},
+		{query: "ExecutionStatus = 'Unknown'", errMsg: "invalid expression: invalid value Unknown for field ExecutionStatus of Keyword type"},
+		{query: "CustomIntField like '1%'", errMsg: "invalid expression: operator 'like' can be used with keyword and text fields only"},
+		{query: "`Custom'Field` = 'value'", errMsg: "invalid search attribute: Custom'Field"},
+		{query: "order by CustomKeywordListField", errMsg: "unable to sort by list field CustomKeywordListField"},
+		{query: "CustomKeywordListField like 'tenant%'", errMsg: "invalid expression: operator 'like' can be used with keyword and text fields only"},
+		{query: "CustomKeywordListField > 'tenant'", errMsg: "invalid expression: operator '>' can't be used with field CustomKeywordListField of KeywordList type"},
+		{query: "contains(CustomIntField, 1)", errMsg: "invalid expression: function 'contains' can be used with keyword and keyword list fields only"},
+		{query: "starts_with(CustomTextField, 'a')", errMsg: "invalid expression: function 'starts_with' can be used with keyword fields only"},
+		{query: "starts_with(BinaryChecksums, 'a')", errMsg: "invalid expression: function 'starts_with' can be used with keyword fields only"},
+		{query: "starts_with(WorkflowId, 'a', 'b')", errMsg: "invalid expression: function 'starts_with' must be called as starts_with(Field, 'prefix')"},
+		{query: "match_phrase(CustomKeywordField, 'a b')", errMsg: "invalid expression: function 'match_phrase' can be used with text fields only"},
+		{query: "upper(WorkflowId)", errMsg: "operation is not supported: function upper"},
+	}
+
+	for _, tc := range cases {
+		_, err := s.convert(&mysqlDialect{}, tc.query)
+		s.Error(err, tc.query)
+		var converterErr *query.ConverterError
+		s.ErrorAs(err, &converterErr, tc.query)
+		s.Equal(tc.errMsg, err.Error(), tc.query)
+	}
+}
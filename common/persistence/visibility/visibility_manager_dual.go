@@ -28,6 +28,10 @@ import (
 	"context"
 	"strings"
 
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
@@ -36,22 +40,31 @@ type (
 		visibilityManager          manager.VisibilityManager
 		secondaryVisibilityManager manager.VisibilityManager
 		managerSelector            managerSelector
+		enableShadowRead           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		shadowReadComparator       *shadowReadComparator
 	}
 )
 
 var _ manager.VisibilityManager = (*visibilityManagerDual)(nil)
 
 // NewVisibilityManagerDual create a visibility manager that operate on multiple manager
-// implementations based on dynamic config.
+// implementations based on dynamic config. If shadow read is enabled for namespace,
+// reads are also sent to the manager which is not selected for read and results are compared.
 func NewVisibilityManagerDual(
 	visibilityManager manager.VisibilityManager,
 	secondaryVisibilityManager manager.VisibilityManager,
 	managerSelector managerSelector,
+	enableShadowRead dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	shadowReadConsistencyWindow dynamicconfig.DurationPropertyFn,
+	metricsClient metrics.Client,
+	logger log.Logger,
 ) *visibilityManagerDual {
 	return &visibilityManagerDual{
 		visibilityManager:          visibilityManager,
 		secondaryVisibilityManager: secondaryVisibilityManager,
 		managerSelector:            managerSelector,
+		enableShadowRead:           enableShadowRead,
+		shadowReadComparator:       newShadowReadComparator(shadowReadConsistencyWindow, metricsClient, logger),
 	}
 }
 
@@ -136,56 +149,112 @@ func (v *visibilityManagerDual) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutions(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutions(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListOpenWorkflowExecutionsScope, "ListOpenWorkflowExecutions", request.Namespace, request.NextPageToken, listByStartTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListOpenWorkflowExecutions(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutions(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutions(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListClosedWorkflowExecutionsScope, "ListClosedWorkflowExecutions", request.Namespace, request.NextPageToken, listByCloseTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListClosedWorkflowExecutions(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutionsByType(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutionsByType(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListOpenWorkflowExecutionsByTypeScope, "ListOpenWorkflowExecutionsByType", request.Namespace, request.NextPageToken, listByStartTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListOpenWorkflowExecutionsByType(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByTypeRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByType(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByType(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListClosedWorkflowExecutionsByTypeScope, "ListClosedWorkflowExecutionsByType", request.Namespace, request.NextPageToken, listByCloseTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListClosedWorkflowExecutionsByType(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListOpenWorkflowExecutionsByWorkflowIDScope, "ListOpenWorkflowExecutionsByWorkflowID", request.Namespace, request.NextPageToken, listByStartTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListClosedWorkflowExecutionsByWorkflowIDScope, "ListClosedWorkflowExecutionsByWorkflowID", request.Namespace, request.NextPageToken, listByCloseTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListClosedWorkflowExecutionsByStatus(
 	ctx context.Context,
 	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByStatus(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListClosedWorkflowExecutionsByStatus(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListClosedWorkflowExecutionsByStatusScope, "ListClosedWorkflowExecutionsByStatus", request.Namespace, request.NextPageToken, listByCloseTime, response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListClosedWorkflowExecutionsByStatus(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*manager.ListWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).ListWorkflowExecutions(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).ListWorkflowExecutions(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareList(metrics.VisibilityPersistenceListWorkflowExecutionsScope, "ListWorkflowExecutions", request.Namespace, request.NextPageToken, queryListOrder(request.Query), response,
+			func(ctx context.Context) (*manager.ListWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).ListWorkflowExecutions(ctx, request)
+			})
+	}
+	return response, err
 }
 
 func (v *visibilityManagerDual) ScanWorkflowExecutions(
@@ -199,5 +268,25 @@ func (v *visibilityManagerDual) CountWorkflowExecutions(
 	ctx context.Context,
 	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
+	response, err := v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
+	if err == nil && v.isShadowReadEnabled(request.Namespace) {
+		v.shadowReadComparator.compareCount(metrics.VisibilityPersistenceCountWorkflowExecutionsScope, "CountWorkflowExecutions", request.Namespace, request.Query, response,
+			func(ctx context.Context) (*manager.CountWorkflowExecutionsResponse, error) {
+				return v.managerSelector.shadowReadManager(request.Namespace).CountWorkflowExecutions(ctx, request)
+			})
+	}
+	return response, err
+}
+
+func (v *visibilityManagerDual) isShadowReadEnabled(namespace namespace.Name) bool {
+	return v.enableShadowRead(namespace.String())
+}
+
+// queryListOrder returns the order of executions listed by the query, or nil if the query sets
+// the order with ORDER BY clause.
+func queryListOrder(query string) listOrder {
+	if strings.Contains(strings.ToLower(query), "order by") {
+		return nil
+	}
+	return listByDefaultOrder
 }
@@ -0,0 +1,180 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination visibilityTaskDLQ_mock.go
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// VisibilityTaskDLQ stores visibility tasks which history failed to process
+	// within the configured number of attempts. Each history shard has its own DLQ,
+	// backed by a dedicated queue type in the queue table.
+	VisibilityTaskDLQ interface {
+		EnqueueTask(ctx context.Context, shardID int32, task *persistencespb.VisibilityTaskInfo) (int64, error)
+		ReadTasks(ctx context.Context, shardID int32, lastMessageID int64, pageSize int, pageToken []byte) ([]*persistencespb.VisibilityTaskInfo, []byte, error)
+		DeleteTask(ctx context.Context, shardID int32, messageID int64) error
+		RangeDeleteTasks(ctx context.Context, shardID int32, lastMessageID int64) error
+	}
+
+	visibilityTaskDLQImpl struct {
+		newQueue   func(queueType QueueType) (Queue, error)
+		serializer serialization.Serializer
+
+		sync.Mutex
+		queues map[int32]Queue
+	}
+)
+
+var _ VisibilityTaskDLQ = (*visibilityTaskDLQImpl)(nil)
+
+// VisibilityTaskDLQQueueType returns the queue type of the visibility task DLQ of given shard
+func VisibilityTaskDLQQueueType(shardID int32) QueueType {
+	return visibilityTaskDLQQueueTypeBase + QueueType(shardID)
+}
+
+// NewVisibilityTaskDLQ creates a new VisibilityTaskDLQ instance,
+// queues of individual shards are created and initialized lazily with newQueue
+func NewVisibilityTaskDLQ(
+	newQueue func(queueType QueueType) (Queue, error),
+	serializer serialization.Serializer,
+) VisibilityTaskDLQ {
+	return &visibilityTaskDLQImpl{
+		newQueue:   newQueue,
+		serializer: serializer,
+		queues:     make(map[int32]Queue),
+	}
+}
+
+func (d *visibilityTaskDLQImpl) EnqueueTask(
+	ctx context.Context,
+	shardID int32,
+	task *persistencespb.VisibilityTaskInfo,
+) (int64, error) {
+	queue, err := d.getQueue(ctx, shardID)
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+
+	blob, err := serialization.VisibilityTaskInfoToBlob(task)
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+	return queue.EnqueueMessageToDLQ(ctx, blob)
+}
+
+func (d *visibilityTaskDLQImpl) ReadTasks(
+	ctx context.Context,
+	shardID int32,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistencespb.VisibilityTaskInfo, []byte, error) {
+	queue, err := d.getQueue(ctx, shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, token, err := queue.ReadMessagesFromDLQ(ctx, EmptyQueueMessageID, lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	visibilityTasks := make([]*persistencespb.VisibilityTaskInfo, 0, len(messages))
+	for _, message := range messages {
+		visibilityTask, err := serialization.VisibilityTaskInfoFromBlob(message.Data, message.Encoding)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode visibility dlq task: %v", err)
+		}
+
+		// Overwrite to dlq message id
+		visibilityTask.TaskId = message.ID
+		visibilityTasks = append(visibilityTasks, visibilityTask)
+	}
+	return visibilityTasks, token, nil
+}
+
+func (d *visibilityTaskDLQImpl) DeleteTask(
+	ctx context.Context,
+	shardID int32,
+	messageID int64,
+) error {
+	queue, err := d.getQueue(ctx, shardID)
+	if err != nil {
+		return err
+	}
+	return queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (d *visibilityTaskDLQImpl) RangeDeleteTasks(
+	ctx context.Context,
+	shardID int32,
+	lastMessageID int64,
+) error {
+	queue, err := d.getQueue(ctx, shardID)
+	if err != nil {
+		return err
+	}
+	return queue.RangeDeleteMessagesFromDLQ(ctx, EmptyQueueMessageID, lastMessageID)
+}
+
+func (d *visibilityTaskDLQImpl) getQueue(
+	ctx context.Context,
+	shardID int32,
+) (Queue, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	if queue, ok := d.queues[shardID]; ok {
+		return queue, nil
+	}
+
+	queue, err := d.newQueue(VisibilityTaskDLQQueueType(shardID))
+	if err != nil {
+		return nil, err
+	}
+	blob, err := d.serializer.QueueMetadataToBlob(
+		&persistencespb.QueueMetadata{
+			ClusterAckLevels: make(map[string]int64),
+		}, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	if err := queue.Init(ctx, blob); err != nil {
+		return nil, err
+	}
+
+	d.queues[shardID] = queue
+	return queue, nil
+}
@@ -387,8 +387,8 @@ func ArchivalMetadataProvider(dc *dynamicconfig.Collection, cfg *config.Config)
 	)
 }
 
-func ArchiverProviderProvider(cfg *config.Config) provider.ArchiverProvider {
-	return provider.NewArchiverProvider(cfg.Archival.History.Provider, cfg.Archival.Visibility.Provider)
+func ArchiverProviderProvider(cfg *config.Config, customArchivers *provider.CustomArchivers) provider.ArchiverProvider {
+	return provider.NewArchiverProvider(cfg.Archival.History.Provider, cfg.Archival.Visibility.Provider, customArchivers)
 }
 
 func SdkClientFactoryProvider(cfg *config.Config, tlsConfigProvider encryption.TLSConfigProvider, metricsClient metrics.Client) (sdk.ClientFactory, error) {
@@ -25,6 +25,7 @@
 package searchattribute
 
 import (
+	"fmt"
 	"strings"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -54,6 +55,18 @@ const (
 	VisibilityTaskKey = "VisibilityTaskKey"
 
 	ReservedPrefix = "Temporal"
+
+	aliasPoolFieldPrefixFormat = "Custom%sField"
+
+	// IndexedValueTypeKeywordList is a search attribute type for a list of keywords.
+	// Unlike Keyword, which may hold a list implicitly, KeywordList values are always lists,
+	// and equality conditions in queries check if the list contains the value.
+	// This type is not defined in the API enum yet, therefore the value reserved for it there is used.
+	// It is used only inside the server (persistence and internal RPCs) and is never returned to
+	// or accepted from API clients: use APIType to convert it before types are returned to clients.
+	IndexedValueTypeKeywordList = enumspb.IndexedValueType(7)
+
+	keywordListTypeName = "KeywordList"
 )
 
 var (
@@ -103,6 +116,21 @@ func IsReserved(name string) bool {
 	return strings.HasPrefix(name, ReservedPrefix)
 }
 
+// IsAliasPoolField returns true if name is one of pre-created custom search attribute fields of specified type
+// which can be assigned to per-namespace aliases. Pool fields are named like CustomKeywordField01, CustomIntField02, etc.
+func IsAliasPoolField(name string, saType enumspb.IndexedValueType) bool {
+	prefix := fmt.Sprintf(aliasPoolFieldPrefixFormat, TypeName(saType))
+	if !strings.HasPrefix(name, prefix) || len(name) == len(prefix) {
+		return false
+	}
+	for _, r := range name[len(prefix):] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // IsMappable returns true if name can have be mapped tho the alias.
 func IsMappable(name string) bool {
 	if _, ok := system[name]; ok {
@@ -113,3 +141,50 @@ func IsMappable(name string) bool {
 	}
 	return true
 }
+
+// IsValidType returns true if t is a known search attribute type.
+func IsValidType(t enumspb.IndexedValueType) bool {
+	return t == IndexedValueTypeKeywordList || IsAPIType(t)
+}
+
+// IsAPIType returns true if t is a search attribute type defined in the API enum.
+// KeywordList is not, and can't be sent by clients as enum value.
+func IsAPIType(t enumspb.IndexedValueType) bool {
+	_, ok := enumspb.IndexedValueType_name[int32(t)]
+	return ok
+}
+
+// APIType returns search attribute type which can be returned to API clients.
+// KeywordList is not defined in the API enum and is returned as Keyword.
+func APIType(t enumspb.IndexedValueType) enumspb.IndexedValueType {
+	if t == IndexedValueTypeKeywordList {
+		return enumspb.INDEXED_VALUE_TYPE_KEYWORD
+	}
+	return t
+}
+
+// APITypes returns copy of search attribute name to type map with types converted by APIType.
+func APITypes(searchAttributes map[string]enumspb.IndexedValueType) map[string]enumspb.IndexedValueType {
+	result := make(map[string]enumspb.IndexedValueType, len(searchAttributes))
+	for saName, saType := range searchAttributes {
+		result[saName] = APIType(saType)
+	}
+	return result
+}
+
+// TypeName returns name of search attribute type as it is reported to the users and stored in payload metadata.
+func TypeName(t enumspb.IndexedValueType) string {
+	if t == IndexedValueTypeKeywordList {
+		return keywordListTypeName
+	}
+	return t.String()
+}
+
+// ParseType returns search attribute type by its name.
+func ParseType(name string) (enumspb.IndexedValueType, bool) {
+	if name == keywordListTypeName {
+		return IndexedValueTypeKeywordList, true
+	}
+	t, ok := enumspb.IndexedValueType_value[name]
+	return enumspb.IndexedValueType(t), ok
+}
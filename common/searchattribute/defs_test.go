@@ -0,0 +1,75 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func Test_IsAliasPoolField(t *testing.T) {
+	assert.True(t, IsAliasPoolField("CustomKeywordField01", enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+	assert.True(t, IsAliasPoolField("CustomDatetimeField1", enumspb.INDEXED_VALUE_TYPE_DATETIME))
+	assert.False(t, IsAliasPoolField("CustomKeywordField01", enumspb.INDEXED_VALUE_TYPE_INT))
+	assert.False(t, IsAliasPoolField("CustomKeywordField", enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+	assert.False(t, IsAliasPoolField("CustomKeywordFieldA", enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+	assert.False(t, IsAliasPoolField("CustomerId", enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+}
+
+func Test_TypeName(t *testing.T) {
+	assert.Equal(t, "Keyword", TypeName(enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+	assert.Equal(t, "KeywordList", TypeName(IndexedValueTypeKeywordList))
+
+	saType, ok := ParseType("KeywordList")
+	assert.True(t, ok)
+	assert.Equal(t, IndexedValueTypeKeywordList, saType)
+	saType, ok = ParseType("Datetime")
+	assert.True(t, ok)
+	assert.Equal(t, enumspb.INDEXED_VALUE_TYPE_DATETIME, saType)
+	_, ok = ParseType("UnknownType")
+	assert.False(t, ok)
+
+	assert.True(t, IsValidType(IndexedValueTypeKeywordList))
+	assert.True(t, IsValidType(enumspb.INDEXED_VALUE_TYPE_BOOL))
+	assert.False(t, IsValidType(enumspb.IndexedValueType(8)))
+	assert.True(t, IsAliasPoolField("CustomKeywordListField01", IndexedValueTypeKeywordList))
+}
+
+func Test_APIType(t *testing.T) {
+	assert.False(t, IsAPIType(IndexedValueTypeKeywordList))
+	assert.True(t, IsAPIType(enumspb.INDEXED_VALUE_TYPE_KEYWORD))
+
+	assert.Equal(t, enumspb.INDEXED_VALUE_TYPE_KEYWORD, APIType(IndexedValueTypeKeywordList))
+	assert.Equal(t, enumspb.INDEXED_VALUE_TYPE_INT, APIType(enumspb.INDEXED_VALUE_TYPE_INT))
+	assert.Equal(t, map[string]enumspb.IndexedValueType{
+		"CustomKeywordListField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"CustomIntField":         enumspb.INDEXED_VALUE_TYPE_INT,
+	}, APITypes(map[string]enumspb.IndexedValueType{
+		"CustomKeywordListField": IndexedValueTypeKeywordList,
+		"CustomIntField":         enumspb.INDEXED_VALUE_TYPE_INT,
+	}))
+}
@@ -45,12 +45,18 @@ func EncodeValue(val interface{}, t enumspb.IndexedValueType) (*commonpb.Payload
 	return valPayload, nil
 }
 
+// IsEmptyValue returns true if search attribute value Payload has no data (i.e. it is empty or encoded nil).
+// Empty value removes search attribute from the workflow when it is upserted with UpsertWorkflowExecutionMetadata.
+func IsEmptyValue(value *commonpb.Payload) bool {
+	return len(value.GetData()) == 0
+}
+
 // DecodeValue decodes search attribute value from Payload using (in order):
 // 1. passed type t.
 // 2. type from MetadataType field, if t is not specified.
 func DecodeValue(value *commonpb.Payload, t enumspb.IndexedValueType) (interface{}, error) {
 	if t == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
-		t = enumspb.IndexedValueType(enumspb.IndexedValueType_value[string(value.Metadata[MetadataType])])
+		t, _ = ParseType(string(value.Metadata[MetadataType]))
 	}
 
 	switch t {
@@ -62,6 +68,17 @@ func DecodeValue(value *commonpb.Payload, t enumspb.IndexedValueType) (interface
 			return listVal, err
 		}
 		return val, nil
+	case IndexedValueTypeKeywordList:
+		// KeywordList is always decoded to a list, single value is accepted as a list of one element.
+		var listVal []string
+		if err := payload.Decode(value, &listVal); err != nil {
+			var val string
+			if err = payload.Decode(value, &val); err != nil {
+				return nil, err
+			}
+			return []string{val}, nil
+		}
+		return listVal, nil
 	case enumspb.INDEXED_VALUE_TYPE_INT:
 		var val int64
 		if err := payload.Decode(value, &val); err != nil {
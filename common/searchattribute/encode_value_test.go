@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/converter"
 
@@ -87,6 +88,29 @@ func Test_DecodeValue_FromParameter_Success(t *testing.T) {
 	assert.Equal(true, decodedBool)
 }
 
+func Test_DecodeValue_KeywordList(t *testing.T) {
+	assert := assert.New(t)
+
+	payloadList, err := EncodeValue([]string{"tenant1", "tenant2"}, IndexedValueTypeKeywordList)
+	assert.NoError(err)
+	assert.Equal("KeywordList", string(payloadList.Metadata["type"]))
+	decodedList, err := DecodeValue(payloadList, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED) // MetadataType is used.
+	assert.NoError(err)
+	assert.Equal([]string{"tenant1", "tenant2"}, decodedList)
+
+	// Single value is decoded as a list.
+	payloadStr := payload.EncodeString("tenant1")
+	decodedList, err = DecodeValue(payloadStr, IndexedValueTypeKeywordList)
+	assert.NoError(err)
+	assert.Equal([]string{"tenant1"}, decodedList)
+
+	payloadInt, err := payload.Encode([]int{1, 2})
+	assert.NoError(err)
+	decodedList, err = DecodeValue(payloadInt, IndexedValueTypeKeywordList)
+	assert.Error(err)
+	assert.Nil(decodedList)
+}
+
 func Test_DecodeValue_Error(t *testing.T) {
 	assert := assert.New(t)
 
@@ -113,6 +137,21 @@ func Test_DecodeValue_Error(t *testing.T) {
 	assert.Nil(decodedInt)
 }
 
+func Test_IsEmptyValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(IsEmptyValue(nil))
+	assert.True(IsEmptyValue(&commonpb.Payload{}))
+
+	nilPayload, err := payload.Encode(nil)
+	assert.NoError(err)
+	assert.True(IsEmptyValue(nilPayload))
+
+	strPayload, err := EncodeValue("qwe", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	assert.NoError(err)
+	assert.False(IsEmptyValue(strPayload))
+}
+
 func Test_EncodeValue(t *testing.T) {
 	assert := assert.New(t)
 
@@ -76,9 +76,8 @@ func setMetadataType(p *commonpb.Payload, t enumspb.IndexedValueType) {
 		return
 	}
 
-	tString, isValidT := enumspb.IndexedValueType_name[int32(t)]
-	if !isValidT {
+	if !IsValidType(t) {
 		panic(fmt.Sprintf("unknown index value type %v", t))
 	}
-	p.Metadata[MetadataType] = []byte(tString)
+	p.Metadata[MetadataType] = []byte(TypeName(t))
 }
@@ -156,6 +156,8 @@ func parseValueTyped(valStr string, t enumspb.IndexedValueType) (interface{}, er
 	switch t {
 	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
 		val = valStr
+	case IndexedValueTypeKeywordList:
+		val = []string{valStr}
 	case enumspb.INDEXED_VALUE_TYPE_INT:
 		val, err = strconv.ParseInt(valStr, 10, 64)
 	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
@@ -202,7 +204,7 @@ func isJsonArray(str string) bool {
 
 func parseJsonArray(str string, t enumspb.IndexedValueType) (interface{}, error) {
 	switch t {
-	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+	case enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, IndexedValueTypeKeywordList:
 		var result []string
 		err := json.Unmarshal([]byte(str), &result)
 		return result, err
@@ -35,12 +35,23 @@ type (
 var (
 	TestNameTypeMap = NameTypeMap{
 		customSearchAttributes: map[string]enumspb.IndexedValueType{
-			"CustomIntField":      enumspb.INDEXED_VALUE_TYPE_INT,
-			"CustomTextField":     enumspb.INDEXED_VALUE_TYPE_TEXT,
-			"CustomKeywordField":  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-			"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
-			"CustomDoubleField":   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
-			"CustomBoolField":     enumspb.INDEXED_VALUE_TYPE_BOOL,
+			"CustomIntField":         enumspb.INDEXED_VALUE_TYPE_INT,
+			"CustomTextField":        enumspb.INDEXED_VALUE_TYPE_TEXT,
+			"CustomKeywordField":     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordListField": IndexedValueTypeKeywordList,
+			"CustomDatetimeField":    enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			"CustomDoubleField":      enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+			"CustomBoolField":        enumspb.INDEXED_VALUE_TYPE_BOOL,
+		},
+	}
+
+	// TestAliasPoolNameTypeMap has pre-created custom search attribute fields which can be assigned to namespace aliases.
+	TestAliasPoolNameTypeMap = NameTypeMap{
+		customSearchAttributes: map[string]enumspb.IndexedValueType{
+			"CustomKeywordField":   enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordField01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordField02": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomIntField01":     enumspb.INDEXED_VALUE_TYPE_INT,
 		},
 	}
 )
@@ -111,19 +111,26 @@ func (v *Validator) Validate(searchAttributes *commonpb.SearchAttributes, namesp
 			if err = payload.Decode(saPayload, &invalidValue); err != nil {
 				invalidValue = fmt.Sprintf("value from <%s>", saPayload.String())
 			}
-			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid value for search attribute %s of type %s: %v", saName, saType, invalidValue))
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid value for search attribute %s of type %s: %v", saName, TypeName(saType), invalidValue))
 		}
 	}
 	return nil
 }
 
-// ValidateSize validate search attributes are valid for writing and not exceed limits
+// ValidateSize validate search attributes are valid for writing and not exceed limits.
+// Value size limit is applied to every item of KeywordList search attribute separately.
 func (v *Validator) ValidateSize(searchAttributes *commonpb.SearchAttributes, namespace string) error {
 	if searchAttributes == nil {
 		return nil
 	}
 
 	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		if saType, _ := ParseType(string(saPayload.GetMetadata()[MetadataType])); saType == IndexedValueTypeKeywordList {
+			if err := v.validateKeywordListSize(saName, saPayload, namespace); err != nil {
+				return err
+			}
+			continue
+		}
 		if len(saPayload.GetData()) > v.searchAttributesSizeOfValueLimit(namespace) {
 			return fmt.Errorf("search attribute %s value of size %d: %w %d", saName, len(saPayload.GetData()), ErrExceedSizeLimit, v.searchAttributesSizeOfValueLimit(namespace))
 		}
@@ -135,3 +142,16 @@ func (v *Validator) ValidateSize(searchAttributes *commonpb.SearchAttributes, na
 
 	return nil
 }
+
+func (v *Validator) validateKeywordListSize(saName string, saPayload *commonpb.Payload, namespace string) error {
+	saValue, err := DecodeValue(saPayload, IndexedValueTypeKeywordList)
+	if err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid value for search attribute %s of type %s: %v", saName, keywordListTypeName, err))
+	}
+	for _, item := range saValue.([]string) {
+		if len(item) > v.searchAttributesSizeOfValueLimit(namespace) {
+			return fmt.Errorf("search attribute %s item of size %d: %w %d", saName, len(item), ErrExceedSizeLimit, v.searchAttributesSizeOfValueLimit(namespace))
+		}
+	}
+	return nil
+}
@@ -212,3 +212,35 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize() {
 	s.Error(err)
 	s.Equal("total size of search attributes 106: exceeds size limit 20", err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize_KeywordList() {
+	numOfKeysLimit := 2
+	sizeOfValueLimit := 5
+	sizeOfTotalLimit := 200
+
+	saValidator := NewValidator(
+		NewTestProvider(),
+		nil,
+		dynamicconfig.GetIntPropertyFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfValueLimit),
+		dynamicconfig.GetIntPropertyFilteredByNamespace(sizeOfTotalLimit))
+
+	namespace := "namespace"
+
+	// Value size limit is applied to every item but not to the whole list.
+	listPayload, err := EncodeValue([]string{"t1", "t2", "t3"}, IndexedValueTypeKeywordList)
+	s.NoError(err)
+	attr := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomKeywordListField": listPayload,
+		},
+	}
+	s.NoError(saValidator.ValidateSize(attr, namespace))
+
+	listPayload, err = EncodeValue([]string{"t1", "tenant2"}, IndexedValueTypeKeywordList)
+	s.NoError(err)
+	attr.IndexedFields["CustomKeywordListField"] = listPayload
+	err = saValidator.ValidateSize(attr, namespace)
+	s.Error(err)
+	s.Equal("search attribute CustomKeywordListField item of size 7: exceeds size limit 5", err.Error())
+}
@@ -70,7 +70,7 @@ func (s *integrationSuite) TestArchival_TimerQueueProcessor() {
 		RunId:      runID,
 	}
 	s.True(s.isHistoryArchived(s.archivalNamespace, execution))
-	s.True(s.isHistoryDeleted(execution))
+	s.True(s.isHistoryDeleted(namespaceID, execution))
 	s.True(s.isMutableStateDeleted(namespaceID, execution))
 }
 
@@ -91,7 +91,7 @@ func (s *integrationSuite) TestArchival_ContinueAsNew() {
 			RunId:      runID,
 		}
 		s.True(s.isHistoryArchived(s.archivalNamespace, execution))
-		s.True(s.isHistoryDeleted(execution))
+		s.True(s.isHistoryDeleted(namespaceID, execution))
 		s.True(s.isMutableStateDeleted(namespaceID, execution))
 	}
 }
@@ -111,7 +111,7 @@ func (s *integrationSuite) TestArchival_ArchiverWorker() {
 		RunId:      runID,
 	}
 	s.True(s.isHistoryArchived(s.archivalNamespace, execution))
-	s.True(s.isHistoryDeleted(execution))
+	s.True(s.isHistoryDeleted(namespaceID, execution))
 	s.True(s.isMutableStateDeleted(namespaceID, execution))
 }
 
@@ -160,9 +160,78 @@ func (s *integrationSuite) TestVisibilityArchival() {
 	}
 }
 
+func (s *integrationSuite) TestArchival_S3() {
+	s.True(s.testCluster.archiverBase.metadata.GetHistoryConfig().ClusterConfiguredForArchival())
+
+	namespaceID := s.getNamespaceID(s.s3ArchivalNamespace)
+	workflowID := "archival-s3-workflow-id"
+	workflowType := "archival-s3-workflow-type"
+	taskQueue := "archival-s3-task-queue"
+	numActivities := 10
+	numRuns := 2
+	runIDs := s.startAndFinishWorkflow(workflowID, workflowType, taskQueue, s.s3ArchivalNamespace, namespaceID, numActivities, numRuns)
+
+	for _, runID := range runIDs {
+		execution := &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		}
+		s.True(s.isHistoryArchived(s.s3ArchivalNamespace, execution))
+		s.True(s.isHistoryDeleted(namespaceID, execution))
+		s.True(s.isMutableStateDeleted(namespaceID, execution))
+	}
+	s.NotEmpty(s.testCluster.archiverBase.s3Server.Keys(s3HistoryBucket))
+	s.Zero(s.testCluster.archiverBase.s3Server.MultipartUploads())
+	// the multipart upload threshold of the test cluster is below the size of any history
+	s.GreaterOrEqual(s.testCluster.archiverBase.s3Server.CompletedMultipartUploads(), numRuns)
+}
+
+func (s *integrationSuite) TestVisibilityArchival_S3() {
+	s.True(s.testCluster.archiverBase.metadata.GetVisibilityConfig().ClusterConfiguredForArchival())
+
+	namespaceID := s.getNamespaceID(s.s3ArchivalNamespace)
+	workflowID := "archival-s3-visibility-workflow-id"
+	workflowType := "archival-s3-visibility-workflow-type"
+	taskQueue := "archival-s3-visibility-task-queue"
+	numActivities := 3
+	numRuns := 5
+	s.startAndFinishWorkflow(workflowID, workflowType, taskQueue, s.s3ArchivalNamespace, namespaceID, numActivities, numRuns)
+	s.startAndFinishWorkflow("some other s3 workflowID", "some other s3 workflow type", taskQueue, s.s3ArchivalNamespace, namespaceID, numActivities, numRuns)
+
+	var executions []*workflowpb.WorkflowExecutionInfo
+
+	for i := 0; i != retryLimit; i++ {
+		executions = []*workflowpb.WorkflowExecutionInfo{}
+		request := &workflowservice.ListArchivedWorkflowExecutionsRequest{
+			Namespace: s.s3ArchivalNamespace,
+			PageSize:  2,
+			Query:     fmt.Sprintf("WorkflowTypeName = '%s'", workflowType),
+		}
+		for len(executions) == 0 || request.NextPageToken != nil {
+			response, err := s.engine.ListArchivedWorkflowExecutions(NewContext(), request)
+			s.NoError(err)
+			s.NotNil(response)
+			executions = append(executions, response.GetExecutions()...)
+			request.NextPageToken = response.NextPageToken
+		}
+		if len(executions) == numRuns {
+			break
+		}
+		time.Sleep(retryBackoffTime)
+	}
+
+	s.Len(executions, numRuns)
+	for _, execution := range executions {
+		s.Equal(workflowID, execution.GetExecution().GetWorkflowId())
+		s.Equal(workflowType, execution.GetType().GetName())
+		s.NotZero(execution.StartTime)
+		s.NotZero(execution.CloseTime)
+	}
+}
+
 func (s *integrationSuite) getNamespaceID(namespace string) string {
 	namespaceResp, err := s.engine.DescribeNamespace(NewContext(), &workflowservice.DescribeNamespaceRequest{
-		Namespace: s.archivalNamespace,
+		Namespace: namespace,
 	})
 	s.NoError(err)
 	return namespaceResp.NamespaceInfo.GetId()
@@ -170,7 +239,7 @@ func (s *integrationSuite) getNamespaceID(namespace string) string {
 
 func (s *integrationSuite) isHistoryArchived(namespace string, execution *commonpb.WorkflowExecution) bool {
 	request := &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: s.archivalNamespace,
+		Namespace: namespace,
 		Execution: execution,
 	}
 
@@ -184,8 +253,7 @@ func (s *integrationSuite) isHistoryArchived(namespace string, execution *common
 	return false
 }
 
-func (s *integrationSuite) isHistoryDeleted(execution *commonpb.WorkflowExecution) bool {
-	namespaceID := s.getNamespaceID(s.archivalNamespace)
+func (s *integrationSuite) isHistoryDeleted(namespaceID string, execution *commonpb.WorkflowExecution) bool {
 	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(),
 		s.testClusterConfig.HistoryConfig.NumHistoryShards)
 	request := &persistence.GetHistoryTreeRequest{
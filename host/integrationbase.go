@@ -69,6 +69,7 @@ type (
 		testRawHistoryNamespaceName string
 		foreignNamespace            string
 		archivalNamespace           string
+		s3ArchivalNamespace         string
 	}
 )
 
@@ -111,7 +112,9 @@ func (s *IntegrationBase) setupSuite(defaultClusterConfigFile string) {
 
 	if clusterConfig.EnableArchival {
 		s.archivalNamespace = s.randomizeStr("integration-archival-enabled-namespace")
-		s.Require().NoError(s.registerArchivalNamespace(s.archivalNamespace))
+		s.Require().NoError(s.registerArchivalNamespace(s.archivalNamespace, s.testCluster.archiverBase.historyURI, s.testCluster.archiverBase.visibilityURI))
+		s.s3ArchivalNamespace = s.randomizeStr("integration-s3-archival-enabled-namespace")
+		s.Require().NoError(s.registerArchivalNamespace(s.s3ArchivalNamespace, s.testCluster.archiverBase.s3HistoryURI, s.testCluster.archiverBase.s3VisibilityURI))
 	}
 
 	if clusterConfig.FrontendAddress == "" {
@@ -163,6 +166,9 @@ func (s *IntegrationBase) tearDownSuite() {
 	if s.archivalNamespace != "" {
 		s.Require().NoError(s.deleteNamespace(s.archivalNamespace))
 	}
+	if s.s3ArchivalNamespace != "" {
+		s.Require().NoError(s.deleteNamespace(s.s3ArchivalNamespace))
+	}
 
 	if s.testCluster != nil {
 		s.testCluster.TearDownCluster()
@@ -282,7 +288,7 @@ func (s *IntegrationBase) DurationNear(value, target, tolerance time.Duration) {
 // To register archival namespace we can't use frontend API as the retention period is set to 0 for testing,
 // and request will be rejected by frontend. Here we make a call directly to persistence to register
 // the namespace.
-func (s *IntegrationBase) registerArchivalNamespace(archivalNamespace string) error {
+func (s *IntegrationBase) registerArchivalNamespace(archivalNamespace string, historyURI string, visibilityURI string) error {
 	currentClusterName := s.testCluster.testBase.ClusterMetadata.GetCurrentClusterName()
 	namespaceRequest := &persistence.CreateNamespaceRequest{
 		Namespace: &persistencespb.NamespaceDetail{
@@ -294,9 +300,9 @@ func (s *IntegrationBase) registerArchivalNamespace(archivalNamespace string) er
 			Config: &persistencespb.NamespaceConfig{
 				Retention:               timestamp.DurationFromDays(0),
 				HistoryArchivalState:    enumspb.ARCHIVAL_STATE_ENABLED,
-				HistoryArchivalUri:      s.testCluster.archiverBase.historyURI,
+				HistoryArchivalUri:      historyURI,
 				VisibilityArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
-				VisibilityArchivalUri:   s.testCluster.archiverBase.visibilityURI,
+				VisibilityArchivalUri:   visibilityURI,
 				BadBinaries:             &namespacepb.BadBinaries{Binaries: map[string]*namespacepb.BadBinaryInfo{}},
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
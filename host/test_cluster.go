@@ -37,6 +37,8 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/filestore"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/archiver/s3store/s3test"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -69,6 +71,9 @@ type (
 		visibilityStoreDirectory string
 		historyURI               string
 		visibilityURI            string
+		s3Server                 *s3test.Server
+		s3HistoryURI             string
+		s3VisibilityURI          string
 	}
 
 	// TestClusterConfig are config for a test cluster
@@ -97,6 +102,9 @@ type (
 const (
 	defaultTestValueOfESIndexMaxResultWindow = 5
 	pprofTestPort                            = 7000
+
+	s3HistoryBucket    = "test-history-archival"
+	s3VisibilityBucket = "test-visibility-archival"
 )
 
 // NewCluster creates and sets up the test cluster
@@ -243,7 +251,7 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 	if !enabled {
 		return &ArchiverBase{
 			metadata: archiver.NewArchivalMetadata(dcCollection, "", false, "", false, &config.ArchivalNamespaceDefaults{}),
-			provider: provider.NewArchiverProvider(nil, nil),
+			provider: provider.NewArchiverProvider(nil, nil, nil),
 		}
 	}
 
@@ -259,13 +267,37 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 		FileMode: "0666",
 		DirMode:  "0766",
 	}
+
+	s3Server := s3test.NewServer()
+	s3Server.CreateBucket(s3HistoryBucket)
+	s3Server.CreateBucket(s3VisibilityBucket)
+	// the fake server doesn't check credentials, but the sdk needs some to sign requests
+	for name, value := range map[string]string{"AWS_ACCESS_KEY_ID": "test", "AWS_SECRET_ACCESS_KEY": "test"} {
+		if _, ok := os.LookupEnv(name); !ok {
+			if err := os.Setenv(name, value); err != nil {
+				logger.Fatal("Failed to set aws credentials for s3 archival", tag.Error(err))
+			}
+		}
+	}
+	s3Endpoint := s3Server.URL()
+	s3Cfg := &config.S3Archiver{
+		Region:           "us-east-1",
+		Endpoint:         &s3Endpoint,
+		S3ForcePathStyle: true,
+		// upload all histories in parts to cover multipart uploads
+		MultipartUploadThreshold: 1,
+	}
+
 	provider := provider.NewArchiverProvider(
 		&config.HistoryArchiverProvider{
 			Filestore: cfg,
+			S3store:   s3Cfg,
 		},
 		&config.VisibilityArchiverProvider{
 			Filestore: cfg,
+			S3store:   s3Cfg,
 		},
+		nil,
 	)
 	return &ArchiverBase{
 		metadata: archiver.NewArchivalMetadata(dcCollection, "enabled", true, "enabled", true, &config.ArchivalNamespaceDefaults{
@@ -283,6 +315,9 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 		visibilityStoreDirectory: visibilityStoreDirectory,
 		historyURI:               filestore.URIScheme + "://" + historyStoreDirectory,
 		visibilityURI:            filestore.URIScheme + "://" + visibilityStoreDirectory,
+		s3Server:                 s3Server,
+		s3HistoryURI:             s3store.URIScheme + "://" + s3HistoryBucket,
+		s3VisibilityURI:          s3store.URIScheme + "://" + s3VisibilityBucket,
 	}
 }
 
@@ -312,6 +347,9 @@ func (tc *TestCluster) TearDownCluster() {
 	tc.testBase.TearDownWorkflowStore()
 	os.RemoveAll(tc.archiverBase.historyStoreDirectory)
 	os.RemoveAll(tc.archiverBase.visibilityStoreDirectory)
+	if tc.archiverBase.s3Server != nil {
+		tc.archiverBase.s3Server.Close()
+	}
 }
 
 // GetFrontendClient returns a frontend client from the test cluster
@@ -0,0 +1 @@
+CREATE DATABASE temporal_visibility character set utf8;
\ No newline at end of file
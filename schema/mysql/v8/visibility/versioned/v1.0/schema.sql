@@ -0,0 +1,51 @@
+CREATE TABLE executions_visibility (
+  namespace_id            CHAR(64)      NOT NULL,
+  run_id                  CHAR(64)      NOT NULL,
+  start_time              DATETIME(6)   NOT NULL,
+  execution_time          DATETIME(6)   NOT NULL,
+  workflow_id             VARCHAR(255)  NOT NULL,
+  workflow_type_name      VARCHAR(255)  NOT NULL,
+  status                  INT           NOT NULL,  -- enum WorkflowExecutionStatus {RUNNING, COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time              DATETIME(6)   NULL,
+  history_length          BIGINT        NULL,
+  execution_duration      BIGINT        NULL,
+  state_transition_count  BIGINT        NULL,
+  memo                    BLOB,
+  encoding                VARCHAR(64)   NOT NULL,
+  task_queue              VARCHAR(255)  DEFAULT '' NOT NULL,
+  search_attributes       JSON          NULL,
+
+  -- Open workflows are sorted first by the default ORDER BY.
+  coalesce_close_time     DATETIME(6)   GENERATED ALWAYS AS (COALESCE(close_time, '9999-12-31 23:59:59')) STORED NOT NULL,
+
+  -- Predefined search attributes.
+  temporal_change_version JSON          GENERATED ALWAYS AS (search_attributes->'$.TemporalChangeVersion'),
+  binary_checksums        JSON          GENERATED ALWAYS AS (search_attributes->'$.BinaryChecksums'),
+  batcher_namespace       VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'$.BatcherNamespace'),
+  batcher_user            VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'$.BatcherUser'),
+
+  PRIMARY KEY (namespace_id, run_id)
+);
+
+CREATE INDEX default_idx                ON executions_visibility (namespace_id, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_execution_time          ON executions_visibility (namespace_id, execution_time, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_workflow_id             ON executions_visibility (namespace_id, workflow_id, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_workflow_type           ON executions_visibility (namespace_id, workflow_type_name, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_status                  ON executions_visibility (namespace_id, status, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_task_queue              ON executions_visibility (namespace_id, task_queue, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_history_length          ON executions_visibility (namespace_id, history_length, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_execution_duration      ON executions_visibility (namespace_id, execution_duration, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_state_transition_count  ON executions_visibility (namespace_id, state_transition_count, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_temporal_change_version ON executions_visibility (namespace_id, (CAST(temporal_change_version AS CHAR(255) ARRAY)));
+CREATE INDEX by_binary_checksums        ON executions_visibility (namespace_id, (CAST(binary_checksums AS CHAR(255) ARRAY)));
+CREATE INDEX by_batcher_namespace       ON executions_visibility (namespace_id, batcher_namespace);
+CREATE INDEX by_batcher_user            ON executions_visibility (namespace_id, batcher_user);
+
+-- Indexes used by the list APIs which don't take a query.
+CREATE INDEX by_type_start_time         ON executions_visibility (namespace_id, workflow_type_name, status, start_time DESC, run_id);
+CREATE INDEX by_workflow_id_start_time  ON executions_visibility (namespace_id, workflow_id, status, start_time DESC, run_id);
+CREATE INDEX by_status_by_start_time    ON executions_visibility (namespace_id, status, start_time DESC, run_id);
+CREATE INDEX by_type_close_time         ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
+CREATE INDEX by_workflow_id_close_time  ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
+CREATE INDEX by_status_by_close_time    ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_close_time_by_status    ON executions_visibility (namespace_id, close_time DESC, run_id, status);
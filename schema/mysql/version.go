@@ -31,3 +31,6 @@ const Version = "1.8"
 
 // VisibilityVersion is the MySQL visibility database release version
 const VisibilityVersion = "1.1"
+
+// AdvancedVisibilityVersion is the MySQL advanced visibility database release version
+const AdvancedVisibilityVersion = "1.0"
@@ -0,0 +1,52 @@
+CREATE TABLE executions_visibility (
+  namespace_id            CHAR(64)      NOT NULL,
+  run_id                  CHAR(64)      NOT NULL,
+  start_time              TIMESTAMP     NOT NULL,
+  execution_time          TIMESTAMP     NOT NULL,
+  workflow_id             VARCHAR(255)  NOT NULL,
+  workflow_type_name      VARCHAR(255)  NOT NULL,
+  status                  INTEGER       NOT NULL,  -- enum WorkflowExecutionStatus {RUNNING, COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time              TIMESTAMP     NULL,
+  history_length          BIGINT        NULL,
+  execution_duration      BIGINT        NULL,
+  state_transition_count  BIGINT        NULL,
+  memo                    BYTEA,
+  encoding                VARCHAR(64)   NOT NULL,
+  task_queue              VARCHAR(255)  DEFAULT '' NOT NULL,
+  search_attributes       JSONB         NULL,
+
+  -- Open workflows are sorted first by the default ORDER BY.
+  coalesce_close_time     TIMESTAMP     NOT NULL GENERATED ALWAYS AS (COALESCE(close_time, '9999-12-31 23:59:59')) STORED,
+
+  -- Predefined search attributes.
+  temporal_change_version JSONB         GENERATED ALWAYS AS (search_attributes->'TemporalChangeVersion') STORED,
+  binary_checksums        JSONB         GENERATED ALWAYS AS (search_attributes->'BinaryChecksums') STORED,
+  batcher_namespace       VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'BatcherNamespace') STORED,
+  batcher_user            VARCHAR(255)  GENERATED ALWAYS AS (search_attributes->>'BatcherUser') STORED,
+
+  PRIMARY KEY (namespace_id, run_id)
+);
+
+CREATE INDEX default_idx                ON executions_visibility (namespace_id, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_execution_time          ON executions_visibility (namespace_id, execution_time, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_workflow_id             ON executions_visibility (namespace_id, workflow_id, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_workflow_type           ON executions_visibility (namespace_id, workflow_type_name, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_status                  ON executions_visibility (namespace_id, status, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_task_queue              ON executions_visibility (namespace_id, task_queue, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_history_length          ON executions_visibility (namespace_id, history_length, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_execution_duration      ON executions_visibility (namespace_id, execution_duration, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_state_transition_count  ON executions_visibility (namespace_id, state_transition_count, coalesce_close_time DESC, start_time DESC, run_id DESC);
+CREATE INDEX by_temporal_change_version ON executions_visibility USING GIN (temporal_change_version jsonb_path_ops);
+CREATE INDEX by_binary_checksums        ON executions_visibility USING GIN (binary_checksums jsonb_path_ops);
+CREATE INDEX by_batcher_namespace       ON executions_visibility (namespace_id, batcher_namespace);
+CREATE INDEX by_batcher_user            ON executions_visibility (namespace_id, batcher_user);
+CREATE INDEX by_search_attributes       ON executions_visibility USING GIN (search_attributes jsonb_path_ops);
+
+-- Indexes used by the list APIs which don't take a query.
+CREATE INDEX by_type_start_time         ON executions_visibility (namespace_id, workflow_type_name, status, start_time DESC, run_id);
+CREATE INDEX by_workflow_id_start_time  ON executions_visibility (namespace_id, workflow_id, status, start_time DESC, run_id);
+CREATE INDEX by_status_by_start_time    ON executions_visibility (namespace_id, status, start_time DESC, run_id);
+CREATE INDEX by_type_close_time         ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
+CREATE INDEX by_workflow_id_close_time  ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
+CREATE INDEX by_status_by_close_time    ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_close_time_by_status    ON executions_visibility (namespace_id, close_time DESC, run_id, status);
@@ -33,3 +33,6 @@ const Version = "1.8"
 // VisibilityVersion is the Postgres visibility database release version
 // Temporal supports both MySQL and Postgres officially, so upgrade should be performed for both MySQL and Postgres
 const VisibilityVersion = "1.1"
+
+// AdvancedVisibilityVersion is the Postgres advanced visibility database release version
+const AdvancedVisibilityVersion = "1.0"
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -36,7 +37,9 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -46,6 +49,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
@@ -101,6 +105,7 @@ type (
 		visibilityMgr               manager.VisibilityManager
 		persistenceExecutionManager persistence.ExecutionManager
 		namespaceReplicationQueue   persistence.NamespaceReplicationQueue
+		visibilityTaskDLQ           persistence.VisibilityTaskDLQ
 		taskManager                 persistence.TaskManager
 		clusterMetadataManager      persistence.ClusterMetadataManager
 		persistenceMetadataManager  persistence.MetadataManager
@@ -115,6 +120,8 @@ type (
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		scheduleHandler             ScheduleHandler
+		operatorHandler             OperatorHandler
 	}
 
 	NewAdminHandlerArgs struct {
@@ -144,6 +151,9 @@ type (
 		ArchivalMetadata                    archiver.ArchivalMetadata
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
+		VisibilityTaskDLQ                   persistence.VisibilityTaskDLQ
+		ScheduleHandler                     ScheduleHandler
+		OperatorHandler                     OperatorHandler
 	}
 )
 
@@ -189,6 +199,7 @@ func NewAdminHandler(
 		ESClient:                    args.EsClient,
 		persistenceExecutionManager: args.PersistenceExecutionManager,
 		namespaceReplicationQueue:   args.NamespaceReplicationQueue,
+		visibilityTaskDLQ:           args.VisibilityTaskDLQ,
 		taskManager:                 args.TaskManager,
 		clusterMetadataManager:      args.ClusterMetadataManager,
 		persistenceMetadataManager:  args.PersistenceMetadataManager,
@@ -203,6 +214,8 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		scheduleHandler:             args.ScheduleHandler,
+		operatorHandler:             args.OperatorHandler,
 	}
 }
 
@@ -247,10 +260,29 @@ func (adh *AdminHandler) AddSearchAttributes(ctx context.Context, request *admin
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 
-	if len(request.GetSearchAttributes()) == 0 {
+	// KeywordList type is not defined in the API enum, therefore search attributes of this type are passed separately.
+	searchAttributesToAdd := make(map[string]enumspb.IndexedValueType, len(request.GetSearchAttributes())+len(request.GetKeywordListSearchAttributes()))
+	for saName, saType := range request.GetSearchAttributes() {
+		if !searchattribute.IsAPIType(saType) {
+			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, saType)), scope)
+		}
+		searchAttributesToAdd[saName] = saType
+	}
+	for _, saName := range request.GetKeywordListSearchAttributes() {
+		searchAttributesToAdd[saName] = searchattribute.IndexedValueTypeKeywordList
+	}
+
+	if len(searchAttributesToAdd) == 0 {
 		return nil, adh.error(errSearchAttributesNotSet, scope)
 	}
 
+	if request.GetNamespace() != "" {
+		if err := adh.operatorHandler.AddNamespaceSearchAttributes(ctx, namespace.Name(request.GetNamespace()), searchAttributesToAdd); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.AddSearchAttributesResponse{}, nil
+	}
+
 	indexName := request.GetIndexName()
 	if indexName == "" {
 		indexName = adh.ESConfig.GetVisibilityIndex()
@@ -261,21 +293,18 @@ func (adh *AdminHandler) AddSearchAttributes(ctx context.Context, request *admin
 		return nil, adh.error(serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err)), scope)
 	}
 
-	for saName, saType := range request.GetSearchAttributes() {
+	for saName := range searchAttributesToAdd {
 		if searchattribute.IsReserved(saName) {
 			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, saName)), scope)
 		}
 		if currentSearchAttributes.IsDefined(saName) {
 			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, saName)), scope)
 		}
-		if _, ok := enumspb.IndexedValueType_name[int32(saType)]; !ok {
-			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, saType)), scope)
-		}
 	}
 
 	// Execute workflow.
 	wfParams := addsearchattributes.WorkflowParams{
-		CustomAttributesToAdd: request.GetSearchAttributes(),
+		CustomAttributesToAdd: searchAttributesToAdd,
 		IndexName:             indexName,
 		SkipSchemaUpdate:      request.GetSkipSchemaUpdate(),
 	}
@@ -321,6 +350,13 @@ func (adh *AdminHandler) RemoveSearchAttributes(ctx context.Context, request *ad
 		return nil, adh.error(errSearchAttributesNotSet, scope)
 	}
 
+	if request.GetNamespace() != "" {
+		if err := adh.operatorHandler.RemoveNamespaceSearchAttributes(ctx, namespace.Name(request.GetNamespace()), request.GetSearchAttributes()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.RemoveSearchAttributesResponse{}, nil
+	}
+
 	indexName := request.GetIndexName()
 	if indexName == "" {
 		indexName = adh.ESConfig.GetVisibilityIndex()
@@ -374,9 +410,45 @@ func (adh *AdminHandler) GetSearchAttributes(ctx context.Context, request *admin
 		return nil, adh.error(err, scope)
 	}
 
+	if request.GetNamespace() != "" {
+		nsResponse, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		resp.CustomAttributes = namespaceCustomSearchAttributes(resp.CustomAttributes, namespace.FromPersistentState(nsResponse).CustomSearchAttributesMapper())
+	}
+
+	// KeywordList type is not defined in the API enum, therefore it is reported as Keyword
+	// and search attributes of this type are listed separately.
+	for saName, saType := range resp.CustomAttributes {
+		if saType == searchattribute.IndexedValueTypeKeywordList {
+			resp.KeywordListAttributes = append(resp.KeywordListAttributes, saName)
+		}
+	}
+	sort.Strings(resp.KeywordListAttributes)
+	resp.CustomAttributes = searchattribute.APITypes(resp.CustomAttributes)
+
 	return resp, nil
 }
 
+// namespaceCustomSearchAttributes replaces pool fields with namespace aliases and hides pool fields which are not assigned to the namespace.
+func namespaceCustomSearchAttributes(
+	customSearchAttributes map[string]enumspb.IndexedValueType,
+	mapper namespace.CustomSearchAttributesMapper,
+) map[string]enumspb.IndexedValueType {
+	result := make(map[string]enumspb.IndexedValueType, len(customSearchAttributes))
+	for fieldName, saType := range customSearchAttributes {
+		if alias, ok := mapper.GetAlias(fieldName); ok {
+			result[alias] = saType
+			continue
+		}
+		if !searchattribute.IsAliasPoolField(fieldName, saType) {
+			result[fieldName] = saType
+		}
+	}
+	return result
+}
+
 func (adh *AdminHandler) getSearchAttributes(ctx context.Context, indexName string, runID string) (*adminservice.GetSearchAttributesResponse, error) {
 	var lastErr error
 
@@ -1203,6 +1275,7 @@ func (adh *AdminHandler) GetDLQMessages(
 	}
 
 	var tasks []*replicationspb.ReplicationTask
+	var visibilityTasks []*persistencespb.VisibilityTaskInfo
 	var token []byte
 	var op func() error
 	switch request.GetType() {
@@ -1240,6 +1313,25 @@ func (adh *AdminHandler) GetDLQMessages(
 				return err
 			}
 		}
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY:
+		if err := adh.validateShardID(request.GetShardId()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		op = func() error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+				var err error
+				visibilityTasks, token, err = adh.visibilityTaskDLQ.ReadTasks(
+					ctx,
+					request.GetShardId(),
+					request.GetInclusiveEndMessageId(),
+					int(request.GetMaximumPageSize()),
+					request.GetNextPageToken())
+				return err
+			}
+		}
 	default:
 		return nil, adh.error(errDLQTypeIsNotSupported, scope)
 	}
@@ -1250,6 +1342,7 @@ func (adh *AdminHandler) GetDLQMessages(
 
 	return &adminservice.GetDLQMessagesResponse{
 		ReplicationTasks: tasks,
+		VisibilityTasks:  visibilityTasks,
 		NextPageToken:    token,
 	}, nil
 }
@@ -1296,6 +1389,18 @@ func (adh *AdminHandler) PurgeDLQMessages(
 				return adh.namespaceDLQHandler.Purge(ctx, request.GetInclusiveEndMessageId())
 			}
 		}
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY:
+		if err := adh.validateShardID(request.GetShardId()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		op = func() error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+				return adh.visibilityTaskDLQ.RangeDeleteTasks(ctx, request.GetShardId(), request.GetInclusiveEndMessageId())
+			}
+		}
 	default:
 		return nil, adh.error(errDLQTypeIsNotSupported, scope)
 	}
@@ -1344,6 +1449,25 @@ func (adh *AdminHandler) MergeDLQMessages(
 		return &adminservice.MergeDLQMessagesResponse{
 			NextPageToken: request.GetNextPageToken(),
 		}, nil
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY:
+		if err := adh.validateShardID(request.GetShardId()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		// tasks are merged by the owner of the shard, which re-enqueues them to the shard's visibility queue
+		resp, err := adh.historyClient.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
+			Type:                  request.GetType(),
+			ShardId:               request.GetShardId(),
+			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
+			MaximumPageSize:       request.GetMaximumPageSize(),
+			NextPageToken:         request.GetNextPageToken(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+
+		return &adminservice.MergeDLQMessagesResponse{
+			NextPageToken: resp.GetNextPageToken(),
+		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 
 		op = func() error {
@@ -1479,6 +1603,284 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// CreateSchedule creates a new schedule.
+func (adh *AdminHandler) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+) (_ *adminservice.CreateScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminCreateScheduleScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.CreateSchedule(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeSchedule returns the schedule specification and current state of a schedule.
+func (adh *AdminHandler) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (_ *adminservice.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeScheduleScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.DescribeSchedule(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UpdateSchedule replaces the specification, action, policies and state of a schedule.
+func (adh *AdminHandler) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+) (_ *adminservice.UpdateScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateScheduleScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.UpdateSchedule(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PatchSchedule makes a one-off change to a schedule.
+func (adh *AdminHandler) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+) (_ *adminservice.PatchScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminPatchScheduleScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.PatchSchedule(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DeleteSchedule deletes a schedule.
+func (adh *AdminHandler) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+) (_ *adminservice.DeleteScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteScheduleScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.DeleteSchedule(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListSchedules lists the schedules in a namespace.
+func (adh *AdminHandler) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+) (_ *adminservice.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListSchedulesScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.ListSchedules(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PreviewScheduleSpec validates a schedule spec and returns its upcoming action times.
+func (adh *AdminHandler) PreviewScheduleSpec(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleSpecRequest,
+) (_ *adminservice.PreviewScheduleSpecResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminPreviewScheduleSpecScope)
+	defer sw.Stop()
+
+	resp, err := adh.scheduleHandler.PreviewScheduleSpec(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// CountWorkflowExecutions counts workflow executions which match the query, by value of the
+// GROUP BY search attribute if the query has GROUP BY clause.
+func (adh *AdminHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.CountWorkflowExecutionsRequest,
+) (_ *adminservice.CountWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminCountWorkflowExecutionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	persistenceResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp := &adminservice.CountWorkflowExecutionsResponse{
+		Count: persistenceResp.Count,
+	}
+	for _, group := range persistenceResp.Groups {
+		resp.Groups = append(resp.Groups, &adminservice.CountWorkflowExecutionsGroup{
+			Value: group.Value,
+			Count: group.Count,
+		})
+	}
+	return resp, nil
+}
+
+// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a workflow task queue
+func (adh *AdminHandler) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdOrderingRequest,
+) (_ *adminservice.UpdateWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkerBuildIdOrderingScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	matchingClient, err := adh.clientBean.GetMatchingClient(adh.namespaceRegistry.GetNamespaceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = matchingClient.UpdateWorkerBuildIdOrdering(ctx, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueue:          request.GetTaskQueue(),
+		BuildId:            request.GetBuildId(),
+		PreviousCompatible: request.GetPreviousCompatible(),
+		BecomeDefault:      request.GetBecomeDefault(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateWorkerBuildIdOrderingResponse{}, nil
+}
+
+// GetWorkerBuildIdOrdering returns the worker build id version sets of a workflow task queue
+func (adh *AdminHandler) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdOrderingRequest,
+) (_ *adminservice.GetWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkerBuildIdOrderingScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	matchingClient, err := adh.clientBean.GetMatchingClient(adh.namespaceRegistry.GetNamespaceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := matchingClient.GetWorkerBuildIdOrdering(ctx, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   request.GetTaskQueue(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetWorkerBuildIdOrderingResponse{
+		VersioningData: resp.GetVersioningData(),
+	}, nil
+}
+
+// DescribeTaskQueueBacklog returns the backlog stats of a task queue aggregated across its
+// partitions, the public DescribeTaskQueue response has no room for them
+func (adh *AdminHandler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+) (_ *adminservice.DescribeTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskQueueBacklogScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	matchingClient, err := adh.clientBean.GetMatchingClient(adh.namespaceRegistry.GetNamespaceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace: request.GetNamespace(),
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: request.GetTaskQueue(),
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			TaskQueueType: request.GetTaskQueueType(),
+		},
+		AggregatePartitions: true,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	backlogStats := resp.GetBacklogStats()
+	return &adminservice.DescribeTaskQueueBacklogResponse{
+		ApproximateBacklogCount: backlogStats.GetApproximateBacklogCount(),
+		ApproximateBacklogAge:   backlogStats.GetApproximateBacklogAge(),
+		TasksAddRate:            backlogStats.GetTasksAddRate(),
+		TasksDispatchRate:       backlogStats.GetTasksDispatchRate(),
+	}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1509,6 +1911,13 @@ func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	return nil
 }
 
+func (adh *AdminHandler) validateShardID(shardID int32) error {
+	if shardID <= 0 || shardID > adh.numberOfHistoryShards {
+		return errInvalidShardID
+	}
+	return nil
+}
+
 func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
 	// Verify remote cluster config
 	currentClusterInfo := adh.clusterMetadata
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/health"
 
@@ -45,13 +46,16 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkmocks "go.temporal.io/sdk/mocks"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
@@ -62,8 +66,10 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/addsearchattributes"
 )
 
 type (
@@ -83,6 +89,7 @@ type (
 		mockAdminClient            *adminservicemock.MockAdminServiceClient
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
+		mockVisibilityTaskDLQ      *persistence.MockVisibilityTaskDLQ
 
 		namespace   namespace.Name
 		namespaceID namespace.ID
@@ -113,6 +120,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.mockVisibilityTaskDLQ = persistence.NewMockVisibilityTaskDLQ(s.controller)
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
@@ -146,6 +154,9 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetArchivalMetadata(),
 		health.NewServer(),
 		serialization.NewSerializer(),
+		s.mockVisibilityTaskDLQ,
+		nil,
+		nil,
 	}
 	s.handler = NewAdminHandler(args)
 	s.handler.Start()
@@ -461,6 +472,15 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 			Request:  &adminservice.AddSearchAttributesRequest{},
 			Expected: &serviceerror.InvalidArgument{Message: "SearchAttributes are not set on request."},
 		},
+		{
+			Name: "KeywordList type is not defined in API",
+			Request: &adminservice.AddSearchAttributesRequest{
+				SearchAttributes: map[string]enumspb.IndexedValueType{
+					"CustomAttr": searchattribute.IndexedValueTypeKeywordList,
+				},
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Unknown search attribute type: 7."},
+		},
 	}
 	for _, testCase := range testCases1 {
 		s.T().Run(testCase.Name, func(t *testing.T) {
@@ -570,9 +590,17 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 		SearchAttributes: map[string]enumspb.IndexedValueType{
 			"CustomAttr": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		},
+		KeywordListSearchAttributes: []string{"CustomKeywordListAttr"},
 	})
 	s.NoError(err)
 	s.NotNil(resp)
+	mockSdkClient.AssertCalled(s.T(), "ExecuteWorkflow", mock.Anything, mock.Anything, "temporal-sys-add-search-attributes-workflow", addsearchattributes.WorkflowParams{
+		CustomAttributesToAdd: map[string]enumspb.IndexedValueType{
+			"CustomAttr":            enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordListAttr": searchattribute.IndexedValueTypeKeywordList,
+		},
+		IndexName: "random-index-name",
+	})
 	mockRun.AssertExpectations(s.T())
 	mockSdkClient.AssertExpectations(s.T())
 }
@@ -598,6 +626,8 @@ func (s *adminHandlerSuite) Test_GetSearchAttributes() {
 	resp, err = handler.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{})
 	s.NoError(err)
 	s.NotNil(resp)
+	s.Equal(enumspb.INDEXED_VALUE_TYPE_KEYWORD, resp.CustomAttributes["CustomKeywordListField"])
+	s.Equal([]string{"CustomKeywordListField"}, resp.KeywordListAttributes)
 
 	// Configure Elasticsearch: add advanced visibility store config with index name.
 	handler.ESConfig = &client.Config{
@@ -739,6 +769,81 @@ func (s *adminHandlerSuite) Test_RemoveSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *adminHandlerSuite) Test_AddSearchAttributes_Namespace() {
+	handler := s.handler
+	ctx := context.Background()
+	mockOperatorHandler := NewMockOperatorHandler(s.controller)
+	handler.operatorHandler = mockOperatorHandler
+
+	mockOperatorHandler.EXPECT().AddNamespaceSearchAttributes(gomock.Any(), namespace.Name("test-namespace"), map[string]enumspb.IndexedValueType{
+		"CustomerId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"Tags":       searchattribute.IndexedValueTypeKeywordList,
+	}).Return(nil)
+	resp, err := handler.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		SearchAttributes:            map[string]enumspb.IndexedValueType{"CustomerId": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		KeywordListSearchAttributes: []string{"Tags"},
+		Namespace:                   "test-namespace",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	mockOperatorHandler.EXPECT().AddNamespaceSearchAttributes(gomock.Any(), namespace.Name("test-namespace"), gomock.Any()).Return(
+		&serviceerror.AlreadyExist{Message: "Search attribute CustomerId already exists."})
+	resp, err = handler.AddSearchAttributes(ctx, &adminservice.AddSearchAttributesRequest{
+		SearchAttributes: map[string]enumspb.IndexedValueType{"CustomerId": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		Namespace:        "test-namespace",
+	})
+	s.Equal(&serviceerror.AlreadyExist{Message: "Search attribute CustomerId already exists."}, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_RemoveSearchAttributes_Namespace() {
+	handler := s.handler
+	ctx := context.Background()
+	mockOperatorHandler := NewMockOperatorHandler(s.controller)
+	handler.operatorHandler = mockOperatorHandler
+
+	mockOperatorHandler.EXPECT().RemoveNamespaceSearchAttributes(gomock.Any(), namespace.Name("test-namespace"), []string{"CustomerId"}).Return(nil)
+	resp, err := handler.RemoveSearchAttributes(ctx, &adminservice.RemoveSearchAttributesRequest{
+		SearchAttributes: []string{"CustomerId"},
+		Namespace:        "test-namespace",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_GetSearchAttributes_Namespace() {
+	handler := s.handler
+	ctx := context.Background()
+
+	mockSdkClient := &sdkmocks.Client{}
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient(gomock.Any()).Return(mockSdkClient)
+	mockSdkClient.On("DescribeWorkflowExecution", mock.Anything, "temporal-sys-add-search-attributes-workflow", "").Return(
+		&workflowservice.DescribeWorkflowExecutionResponse{}, nil).Once()
+	s.mockResource.ESClient.EXPECT().GetMapping(gomock.Any(), "").Return(map[string]string{"col": "type"}, nil)
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestAliasPoolNameTypeMap, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: "test-namespace"}).Return(
+		&persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:   "test-namespace-id",
+					Name: "test-namespace",
+				},
+				Config: &persistencespb.NamespaceConfig{
+					CustomSearchAttributeAliases: map[string]string{"CustomKeywordField01": "CustomerId"},
+				},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			},
+		}, nil)
+
+	resp, err := handler.GetSearchAttributes(ctx, &adminservice.GetSearchAttributesRequest{Namespace: "test-namespace"})
+	s.NoError(err)
+	s.Equal(map[string]enumspb.IndexedValueType{
+		"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"CustomerId":         enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}, resp.CustomAttributes)
+}
+
 func (s *adminHandlerSuite) Test_RemoveRemoteCluster_Success() {
 	var clusterName = "cluster"
 	s.mockClusterMetadataManager.EXPECT().DeleteClusterMetadata(
@@ -1186,3 +1291,177 @@ func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	s.Equal(1, len(resp.Clusters))
 	s.Equal(0, len(resp.GetNextPageToken()))
 }
+
+func (s *adminHandlerSuite) Test_GetDLQMessages_Visibility_Success() {
+	shardID := int32(1)
+	visibilityTasks := []*persistencespb.VisibilityTaskInfo{
+		{
+			NamespaceId: s.namespaceID.String(),
+			WorkflowId:  "some random workflow ID",
+			RunId:       uuid.New(),
+			TaskId:      1,
+		},
+	}
+	s.mockVisibilityTaskDLQ.EXPECT().ReadTasks(gomock.Any(), shardID, int64(100), 10, []byte("token")).
+		Return(visibilityTasks, []byte("next token"), nil)
+
+	resp, err := s.handler.GetDLQMessages(context.Background(), &adminservice.GetDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY,
+		ShardId:               shardID,
+		InclusiveEndMessageId: 100,
+		MaximumPageSize:       10,
+		NextPageToken:         []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(visibilityTasks, resp.GetVisibilityTasks())
+	s.Empty(resp.GetReplicationTasks())
+	s.Equal([]byte("next token"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_GetDLQMessages_Visibility_InvalidShardID() {
+	_, err := s.handler.GetDLQMessages(context.Background(), &adminservice.GetDLQMessagesRequest{
+		Type:    enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY,
+		ShardId: 2,
+	})
+	s.Equal(errInvalidShardID, err)
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessages_Visibility_Success() {
+	shardID := int32(1)
+	s.mockVisibilityTaskDLQ.EXPECT().RangeDeleteTasks(gomock.Any(), shardID, common.EndMessageID).Return(nil)
+
+	_, err := s.handler.PurgeDLQMessages(context.Background(), &adminservice.PurgeDLQMessagesRequest{
+		Type:    enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY,
+		ShardId: shardID,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_MergeDLQMessages_Visibility_Success() {
+	shardID := int32(1)
+	s.mockHistoryClient.EXPECT().MergeDLQMessages(gomock.Any(), &historyservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY,
+		ShardId:               shardID,
+		InclusiveEndMessageId: 100,
+		MaximumPageSize:       10,
+	}).Return(&historyservice.MergeDLQMessagesResponse{NextPageToken: []byte("next token")}, nil)
+
+	resp, err := s.handler.MergeDLQMessages(context.Background(), &adminservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY,
+		ShardId:               shardID,
+		InclusiveEndMessageId: 100,
+		MaximumPageSize:       10,
+	})
+	s.NoError(err)
+	s.Equal([]byte("next token"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_CountWorkflowExecutions_GroupBy() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "GROUP BY ExecutionStatus",
+	}).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []manager.CountWorkflowExecutionsGroup{
+			{Value: "Running", Count: 2},
+			{Value: "Completed", Count: 1},
+		},
+	}, nil)
+
+	resp, err := s.handler.CountWorkflowExecutions(context.Background(), &adminservice.CountWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "GROUP BY ExecutionStatus",
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.CountWorkflowExecutionsResponse{
+		Count: 3,
+		Groups: []*adminservice.CountWorkflowExecutionsGroup{
+			{Value: "Running", Count: 2},
+			{Value: "Completed", Count: 1},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkerBuildIdOrdering() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().UpdateWorkerBuildIdOrdering(gomock.Any(), &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId:        s.namespaceID.String(),
+		TaskQueue:          "task-queue",
+		BuildId:            "2.0",
+		PreviousCompatible: "1.0",
+		BecomeDefault:      true,
+	}).Return(&matchingservice.UpdateWorkerBuildIdOrderingResponse{}, nil)
+
+	resp, err := s.handler.UpdateWorkerBuildIdOrdering(context.Background(), &adminservice.UpdateWorkerBuildIdOrderingRequest{
+		Namespace:          s.namespace.String(),
+		TaskQueue:          "task-queue",
+		BuildId:            "2.0",
+		PreviousCompatible: "1.0",
+		BecomeDefault:      true,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	_, err = s.handler.UpdateWorkerBuildIdOrdering(context.Background(), &adminservice.UpdateWorkerBuildIdOrderingRequest{
+		Namespace: s.namespace.String(),
+		BuildId:   "2.0",
+	})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_GetWorkerBuildIdOrdering() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().GetWorkerBuildIdOrdering(gomock.Any(), &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "task-queue",
+	}).Return(&matchingservice.GetWorkerBuildIdOrderingResponse{VersioningData: versioningData}, nil)
+
+	resp, err := s.handler.GetWorkerBuildIdOrdering(context.Background(), &adminservice.GetWorkerBuildIdOrderingRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "task-queue",
+	})
+	s.NoError(err)
+	s.Equal(versioningData, resp.GetVersioningData())
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskQueueBacklog() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: s.namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			Namespace: s.namespace.String(),
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: "task-queue",
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		},
+		AggregatePartitions: true,
+	}).Return(&matchingservice.DescribeTaskQueueResponse{
+		BacklogStats: &matchingservice.TaskQueueBacklogStats{
+			ApproximateBacklogCount: 10,
+			ApproximateBacklogAge:   timestamp.DurationPtr(time.Minute),
+			TasksAddRate:            1.5,
+			TasksDispatchRate:       0.5,
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeTaskQueueBacklog(context.Background(), &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "task-queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.Equal(int64(10), resp.GetApproximateBacklogCount())
+	s.Equal(time.Minute, *resp.GetApproximateBacklogAge())
+	s.Equal(1.5, resp.GetTasksAddRate())
+	s.Equal(0.5, resp.GetTasksDispatchRate())
+}
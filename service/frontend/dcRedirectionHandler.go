@@ -30,6 +30,7 @@ import (
 
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
@@ -41,6 +42,7 @@ import (
 )
 
 var _ Handler = (*DCRedirectionHandlerImpl)(nil)
+var _ ScheduleHandler = (*DCRedirectionHandlerImpl)(nil)
 
 type (
 	// DCRedirectionHandlerImpl is simple wrapper over frontend service, doing redirection based on policy
@@ -50,6 +52,7 @@ type (
 		redirectionPolicy  DCRedirectionPolicy
 		tokenSerializer    common.TaskTokenSerializer
 		frontendHandler    Handler
+		scheduleHandler    ScheduleHandler
 		logger             log.Logger
 		clientBean         client.Bean
 		metricsClient      metrics.Client
@@ -60,6 +63,7 @@ type (
 // NewDCRedirectionHandler creates a thrift handler for the temporal service, frontend
 func NewDCRedirectionHandler(
 	wfHandler Handler,
+	scheduleHandler ScheduleHandler,
 	policy config.DCRedirectionPolicy,
 	logger log.Logger,
 	clientBean client.Bean,
@@ -81,6 +85,7 @@ func NewDCRedirectionHandler(
 		redirectionPolicy:  dcRedirectionPolicy,
 		tokenSerializer:    common.NewProtoTaskTokenSerializer(),
 		frontendHandler:    wfHandler,
+		scheduleHandler:    scheduleHandler,
 		logger:             logger,
 		clientBean:         clientBean,
 		metricsClient:      metricsClient,
@@ -1211,6 +1216,212 @@ func (handler *DCRedirectionHandlerImpl) GetSystemInfo(
 	return handler.frontendHandler.GetSystemInfo(ctx, request)
 }
 
+// Schedule APIs are served by the admin service, so calls for a namespace that is active in
+// another cluster are forwarded to that cluster's admin service.
+
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+) (resp *adminservice.CreateScheduleResponse, retError error) {
+
+	var apiName = "CreateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.CreateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.CreateSchedule(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (resp *adminservice.DescribeScheduleResponse, retError error) {
+
+	var apiName = "DescribeSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.DescribeSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.DescribeSchedule(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+) (resp *adminservice.UpdateScheduleResponse, retError error) {
+
+	var apiName = "UpdateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.UpdateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.UpdateSchedule(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// PatchSchedule API call
+func (handler *DCRedirectionHandlerImpl) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+) (resp *adminservice.PatchScheduleResponse, retError error) {
+
+	var apiName = "PatchSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPatchScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.PatchSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.PatchSchedule(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// DeleteSchedule API call
+func (handler *DCRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+) (resp *adminservice.DeleteScheduleResponse, retError error) {
+
+	var apiName = "DeleteSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.DeleteSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.DeleteSchedule(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// ListSchedules API call
+func (handler *DCRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+) (resp *adminservice.ListSchedulesResponse, retError error) {
+
+	var apiName = "ListSchedules"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.ListSchedules(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.ListSchedules(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
+// PreviewScheduleSpec API call
+func (handler *DCRedirectionHandlerImpl) PreviewScheduleSpec(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleSpecRequest,
+) (resp *adminservice.PreviewScheduleSpecResponse, retError error) {
+
+	var apiName = "PreviewScheduleSpec"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPreviewScheduleSpecScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, namespace.Name(request.GetNamespace()), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.scheduleHandler.PreviewScheduleSpec(ctx, request)
+		default:
+			remoteClient := handler.clientBean.GetRemoteAdminClient(targetDC)
+			resp, err = remoteClient.PreviewScheduleSpec(ctx, request)
+		}
+		return err
+	})
+	return resp, err
+}
+
 func (handler *DCRedirectionHandlerImpl) beforeCall(
 	scope int,
 ) (metrics.Scope, time.Time) {
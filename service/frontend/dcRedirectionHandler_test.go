@@ -36,6 +36,8 @@ import (
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc/health"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -54,6 +56,8 @@ type (
 		mockResource             *resource.Test
 		mockFrontendHandler      *workflowservicemock.MockWorkflowServiceServer
 		mockRemoteFrontendClient *workflowservicemock.MockWorkflowServiceClient
+		mockScheduleHandler      *MockScheduleHandler
+		mockRemoteAdminClient    *adminservicemock.MockAdminServiceClient
 		mockClusterMetadata      *cluster.MockMetadata
 
 		mockDCRedirectionPolicy *MockDCRedirectionPolicy
@@ -101,6 +105,7 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 	s.mockResource = resource.NewTest(s.controller, metrics.Frontend)
 	s.mockClusterMetadata = s.mockResource.ClusterMetadata
 	s.mockRemoteFrontendClient = s.mockResource.RemoteFrontendClient
+	s.mockRemoteAdminClient = s.mockResource.RemoteAdminClient
 
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(s.currentClusterName).AnyTimes()
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -129,7 +134,9 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 	)
 
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
+	s.mockScheduleHandler = NewMockScheduleHandler(s.controller)
 	s.handler = NewDCRedirectionHandler(
+		frontendHandlerGRPC,
 		frontendHandlerGRPC,
 		config.DCRedirectionPolicy{},
 		s.mockResource.Logger,
@@ -141,6 +148,7 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 	)
 
 	s.handler.frontendHandler = newTestServerHandler(s.mockFrontendHandler)
+	s.handler.scheduleHandler = s.mockScheduleHandler
 	s.handler.redirectionPolicy = s.mockDCRedirectionPolicy
 }
 
@@ -897,6 +905,167 @@ func (s *dcRedirectionHandlerSuite) TestListTaskQueuePartitions() {
 	s.Equal(&workflowservice.ListTaskQueuePartitionsResponse{}, resp)
 }
 
+func (s *dcRedirectionHandlerSuite) TestCreateSchedule() {
+	apiName := "CreateSchedule"
+
+	req := &adminservice.CreateScheduleRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().CreateSchedule(gomock.Any(), req).Return(&adminservice.CreateScheduleResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().CreateSchedule(gomock.Any(), req).Return(&adminservice.CreateScheduleResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.CreateSchedule(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.CreateScheduleResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDescribeSchedule() {
+	apiName := "DescribeSchedule"
+
+	req := &adminservice.DescribeScheduleRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&adminservice.DescribeScheduleResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().DescribeSchedule(gomock.Any(), req).Return(&adminservice.DescribeScheduleResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.DescribeSchedule(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.DescribeScheduleResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateSchedule() {
+	apiName := "UpdateSchedule"
+
+	req := &adminservice.UpdateScheduleRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().UpdateSchedule(gomock.Any(), req).Return(&adminservice.UpdateScheduleResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().UpdateSchedule(gomock.Any(), req).Return(&adminservice.UpdateScheduleResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.UpdateSchedule(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.UpdateScheduleResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPatchSchedule() {
+	apiName := "PatchSchedule"
+
+	req := &adminservice.PatchScheduleRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().PatchSchedule(gomock.Any(), req).Return(&adminservice.PatchScheduleResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().PatchSchedule(gomock.Any(), req).Return(&adminservice.PatchScheduleResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.PatchSchedule(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.PatchScheduleResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDeleteSchedule() {
+	apiName := "DeleteSchedule"
+
+	req := &adminservice.DeleteScheduleRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().DeleteSchedule(gomock.Any(), req).Return(&adminservice.DeleteScheduleResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().DeleteSchedule(gomock.Any(), req).Return(&adminservice.DeleteScheduleResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.DeleteSchedule(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.DeleteScheduleResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestListSchedules() {
+	apiName := "ListSchedules"
+
+	req := &adminservice.ListSchedulesRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().ListSchedules(gomock.Any(), req).Return(&adminservice.ListSchedulesResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().ListSchedules(gomock.Any(), req).Return(&adminservice.ListSchedulesResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.ListSchedules(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.ListSchedulesResponse{}, resp)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPreviewScheduleSpec() {
+	apiName := "PreviewScheduleSpec"
+
+	req := &adminservice.PreviewScheduleSpecRequest{
+		Namespace: s.namespace.String(),
+	}
+
+	s.mockDCRedirectionPolicy.EXPECT().WithNamespaceRedirect(gomock.Any(), s.namespace, apiName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, namespace namespace.Name, apiName string, callFn func(string) error) error {
+			s.mockScheduleHandler.EXPECT().PreviewScheduleSpec(gomock.Any(), req).Return(&adminservice.PreviewScheduleSpecResponse{}, nil)
+			err := callFn(s.currentClusterName)
+			s.NoError(err)
+			s.mockRemoteAdminClient.EXPECT().PreviewScheduleSpec(gomock.Any(), req).Return(&adminservice.PreviewScheduleSpecResponse{}, nil)
+			err = callFn(s.alternativeClusterName)
+			s.NoError(err)
+			return nil
+		})
+
+	resp, err := s.handler.PreviewScheduleSpec(context.Background(), req)
+	s.NoError(err)
+	s.Equal(&adminservice.PreviewScheduleSpecResponse{}, resp)
+}
+
 func (serverHandler *testServerHandler) Start() {
 }
 
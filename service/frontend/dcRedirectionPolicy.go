@@ -86,6 +86,11 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"QueryWorkflow":                    {},
+	"CreateSchedule":                   {},
+	"DescribeSchedule":                 {},
+	"UpdateSchedule":                   {},
+	"PatchSchedule":                    {},
+	"DeleteSchedule":                   {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
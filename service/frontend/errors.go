@@ -68,11 +68,23 @@ var (
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
+	errInvalidShardID                                     = serviceerror.NewInvalidArgument("Invalid ShardId.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errSchedulesNotAllowed                                = serviceerror.NewPermissionDenied("Schedules are disabled for this namespace.", "")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errScheduleNotSet                                     = serviceerror.NewInvalidArgument("Schedule is not set on request.")
+	errScheduleActionNotSet                               = serviceerror.NewInvalidArgument("Schedule action is not set on request.")
+	errScheduleSpecNotSet                                 = serviceerror.NewInvalidArgument("Spec is not set on request.")
+	errSchedulePatchNotSet                                = serviceerror.NewInvalidArgument("Patch is not set on request.")
+	errInvalidOverlapPolicy                               = serviceerror.NewInvalidArgument("Invalid OverlapPolicy.")
+	errInvalidBackfillRange                               = serviceerror.NewInvalidArgument("Backfill EndTime should not be earlier than StartTime.")
+	errBackfillTimeNotSet                                 = serviceerror.NewInvalidArgument("Backfill StartTime and EndTime must be set.")
+	errGroupByNotSupported                                = serviceerror.NewInvalidArgument("GROUP BY is only supported by CountWorkflowExecutions of admin service.")
 	errShuttingDown                                       = serviceerror.NewUnavailable("Shutting down")
 
-	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
+	errPageSizeTooBigMessage      = "PageSize is larger than allowed %d."
+	errInvalidPreviewCountMessage = "Count must be between 0 and %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
 	errSearchAttributeAlreadyExistsMessage            = "Search attribute %s already exists."
@@ -82,6 +94,7 @@ var (
 	errUnableToRemoveNonCustomSearchAttributesMessage = "Unable to remove non-custom search attributes: %v."
 	errUnableToSaveSearchAttributesMessage            = "Unable to save search attributes: %v."
 	errUnableToStartWorkflowMessage                   = "Unable to start %s workflow: %v."
+	errNoFreeSearchAttributeFieldMessage              = "Unable to find free custom search attribute field of type %v, add more Custom<Type>Field<N> search attributes to the cluster."
 	errWorkflowReturnedErrorMessage                   = "Workflow %s returned an error: %v."
 
 	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.", "")
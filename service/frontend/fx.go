@@ -85,7 +85,10 @@ var Module = fx.Options(
 	fx.Provide(FEReplicatorNamespaceReplicationQueueProvider),
 	fx.Provide(func(so []grpc.ServerOption) *grpc.Server { return grpc.NewServer(so...) }),
 	fx.Provide(healthServerProvider),
+	fx.Provide(WorkflowHandlerProvider),
 	fx.Provide(HandlerProvider),
+	fx.Provide(func(h *DCRedirectionHandlerImpl) Handler { return h }),
+	fx.Provide(func(h *DCRedirectionHandlerImpl) ScheduleHandler { return h }),
 	fx.Provide(AdminHandlerProvider),
 	fx.Provide(OperatorHandlerProvider),
 	fx.Provide(NewVersionChecker),
@@ -334,6 +337,8 @@ func VisibilityManagerProvider(
 		dynamicconfig.GetStringPropertyFn(visibility.AdvancedVisibilityWritingModeOff), // frontend visibility never write
 		serviceConfig.EnableReadFromSecondaryAdvancedVisibility,
 		dynamicconfig.GetBoolPropertyFn(false), // frontend visibility never write
+		serviceConfig.EnableVisibilityShadowRead,
+		serviceConfig.VisibilityShadowReadConsistencyWindow,
 		metricsClient,
 		logger,
 	)
@@ -385,6 +390,9 @@ func AdminHandlerProvider(
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
+	visibilityTaskDLQ persistence.VisibilityTaskDLQ,
+	scheduleHandler ScheduleHandler,
+	operatorHandler *OperatorHandlerImpl,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -413,6 +421,9 @@ func AdminHandlerProvider(
 		archivalMetadata,
 		healthServer,
 		eventSerializer,
+		visibilityTaskDLQ,
+		scheduleHandler,
+		operatorHandler,
 	}
 	return NewAdminHandler(args)
 }
@@ -429,6 +440,12 @@ func OperatorHandlerProvider(
 	healthServer *health.Server,
 	historyClient historyservice.HistoryServiceClient,
 	namespaceRegistry namespace.Registry,
+	namespaceReplicationQueue FEReplicatorNamespaceReplicationQueue,
+	metadataManager persistence.MetadataManager,
+	clusterMetadata cluster.Metadata,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
+	visibilityMgr manager.VisibilityManager,
 ) *OperatorHandlerImpl {
 	args := NewOperatorHandlerImplArgs{
 		config,
@@ -442,14 +459,22 @@ func OperatorHandlerProvider(
 		healthServer,
 		historyClient,
 		namespaceRegistry,
+		namespace.NewHandler(
+			config.MaxBadBinaries,
+			logger,
+			metadataManager,
+			clusterMetadata,
+			namespace.NewNamespaceReplicator(namespaceReplicationQueue, logger),
+			archivalMetadata,
+			archiverProvider,
+		),
+		visibilityMgr,
 	}
 	return NewOperatorHandlerImpl(args)
 }
 
-func HandlerProvider(
-	dcRedirectionPolicy config.DCRedirectionPolicy,
+func WorkflowHandlerProvider(
 	serviceConfig *Config,
-	versionChecker *VersionChecker,
 	namespaceReplicationQueue FEReplicatorNamespaceReplicationQueue,
 	visibilityMgr manager.VisibilityManager,
 	logger resource.SnTaggedLogger,
@@ -457,21 +482,18 @@ func HandlerProvider(
 	persistenceExecutionManager persistence.ExecutionManager,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	persistenceMetadataManager persistence.MetadataManager,
-	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
 	matchingClient resource.MatchingClient,
 	archiverProvider provider.ArchiverProvider,
-	metricsClient metrics.Client,
 	payloadSerializer serialization.Serializer,
-	timeSource clock.TimeSource,
 	namespaceRegistry namespace.Registry,
 	saMapper searchattribute.Mapper,
 	saProvider searchattribute.Provider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
-) Handler {
-	wfHandler := NewWorkflowHandler(
+) *WorkflowHandler {
+	return NewWorkflowHandler(
 		serviceConfig,
 		namespaceReplicationQueue,
 		visibilityMgr,
@@ -491,7 +513,22 @@ func HandlerProvider(
 		archivalMetadata,
 		healthServer,
 	)
-	handler := NewDCRedirectionHandler(wfHandler, dcRedirectionPolicy, logger, clientBean, metricsClient, timeSource, namespaceRegistry, clusterMetadata)
+}
+
+func HandlerProvider(
+	wfHandler *WorkflowHandler,
+	dcRedirectionPolicy config.DCRedirectionPolicy,
+	versionChecker *VersionChecker,
+	logger resource.SnTaggedLogger,
+	clientBean client.Bean,
+	metricsClient metrics.Client,
+	timeSource clock.TimeSource,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+) *DCRedirectionHandlerImpl {
+	// The workflow handler also serves the schedule APIs, which the admin handler reaches
+	// through the redirection handler so they are forwarded like other namespace APIs.
+	handler := NewDCRedirectionHandler(wfHandler, wfHandler, dcRedirectionPolicy, logger, clientBean, metricsClient, timeSource, namespaceRegistry, clusterMetadata)
 	return handler
 }
 
@@ -27,10 +27,14 @@
 package frontend
 
 import (
+	"context"
+
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
 )
 
 const (
@@ -52,5 +56,11 @@ type (
 	OperatorHandler interface {
 		operatorservice.OperatorServiceServer
 		common.Daemon
+
+		// AddNamespaceSearchAttributes and RemoveNamespaceSearchAttributes manage namespace search attribute aliases.
+		// Operator API requests don't have namespace field yet, therefore namespace scoped requests come through
+		// admin API.
+		AddNamespaceSearchAttributes(ctx context.Context, nsName namespace.Name, searchAttributes map[string]enumspb.IndexedValueType) error
+		RemoveNamespaceSearchAttributes(ctx context.Context, nsName namespace.Name, searchAttributes []string) error
 	}
 )
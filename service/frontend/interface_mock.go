@@ -33,8 +33,10 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "go.temporal.io/api/operatorservice/v1"
-	v10 "go.temporal.io/api/workflowservice/v1"
+	v1 "go.temporal.io/api/enums/v1"
+	v10 "go.temporal.io/api/operatorservice/v1"
+	v11 "go.temporal.io/api/workflowservice/v1"
+	namespace "go.temporal.io/server/common/namespace"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -61,10 +63,10 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 }
 
 // CountWorkflowExecutions mocks base method.
-func (m *MockHandler) CountWorkflowExecutions(arg0 context.Context, arg1 *v10.CountWorkflowExecutionsRequest) (*v10.CountWorkflowExecutionsResponse, error) {
+func (m *MockHandler) CountWorkflowExecutions(arg0 context.Context, arg1 *v11.CountWorkflowExecutionsRequest) (*v11.CountWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CountWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.CountWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.CountWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -76,10 +78,10 @@ func (mr *MockHandlerMockRecorder) CountWorkflowExecutions(arg0, arg1 interface{
 }
 
 // DeprecateNamespace mocks base method.
-func (m *MockHandler) DeprecateNamespace(arg0 context.Context, arg1 *v10.DeprecateNamespaceRequest) (*v10.DeprecateNamespaceResponse, error) {
+func (m *MockHandler) DeprecateNamespace(arg0 context.Context, arg1 *v11.DeprecateNamespaceRequest) (*v11.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeprecateNamespace", arg0, arg1)
-	ret0, _ := ret[0].(*v10.DeprecateNamespaceResponse)
+	ret0, _ := ret[0].(*v11.DeprecateNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -91,10 +93,10 @@ func (mr *MockHandlerMockRecorder) DeprecateNamespace(arg0, arg1 interface{}) *g
 }
 
 // DescribeNamespace mocks base method.
-func (m *MockHandler) DescribeNamespace(arg0 context.Context, arg1 *v10.DescribeNamespaceRequest) (*v10.DescribeNamespaceResponse, error) {
+func (m *MockHandler) DescribeNamespace(arg0 context.Context, arg1 *v11.DescribeNamespaceRequest) (*v11.DescribeNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DescribeNamespace", arg0, arg1)
-	ret0, _ := ret[0].(*v10.DescribeNamespaceResponse)
+	ret0, _ := ret[0].(*v11.DescribeNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -106,10 +108,10 @@ func (mr *MockHandlerMockRecorder) DescribeNamespace(arg0, arg1 interface{}) *go
 }
 
 // DescribeTaskQueue mocks base method.
-func (m *MockHandler) DescribeTaskQueue(arg0 context.Context, arg1 *v10.DescribeTaskQueueRequest) (*v10.DescribeTaskQueueResponse, error) {
+func (m *MockHandler) DescribeTaskQueue(arg0 context.Context, arg1 *v11.DescribeTaskQueueRequest) (*v11.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DescribeTaskQueue", arg0, arg1)
-	ret0, _ := ret[0].(*v10.DescribeTaskQueueResponse)
+	ret0, _ := ret[0].(*v11.DescribeTaskQueueResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -121,10 +123,10 @@ func (mr *MockHandlerMockRecorder) DescribeTaskQueue(arg0, arg1 interface{}) *go
 }
 
 // DescribeWorkflowExecution mocks base method.
-func (m *MockHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *v10.DescribeWorkflowExecutionRequest) (*v10.DescribeWorkflowExecutionResponse, error) {
+func (m *MockHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *v11.DescribeWorkflowExecutionRequest) (*v11.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DescribeWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.DescribeWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.DescribeWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -136,10 +138,10 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 interfac
 }
 
 // GetClusterInfo mocks base method.
-func (m *MockHandler) GetClusterInfo(arg0 context.Context, arg1 *v10.GetClusterInfoRequest) (*v10.GetClusterInfoResponse, error) {
+func (m *MockHandler) GetClusterInfo(arg0 context.Context, arg1 *v11.GetClusterInfoRequest) (*v11.GetClusterInfoResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetClusterInfo", arg0, arg1)
-	ret0, _ := ret[0].(*v10.GetClusterInfoResponse)
+	ret0, _ := ret[0].(*v11.GetClusterInfoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -165,10 +167,10 @@ func (mr *MockHandlerMockRecorder) GetConfig() *gomock.Call {
 }
 
 // GetSearchAttributes mocks base method.
-func (m *MockHandler) GetSearchAttributes(arg0 context.Context, arg1 *v10.GetSearchAttributesRequest) (*v10.GetSearchAttributesResponse, error) {
+func (m *MockHandler) GetSearchAttributes(arg0 context.Context, arg1 *v11.GetSearchAttributesRequest) (*v11.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*v10.GetSearchAttributesResponse)
+	ret0, _ := ret[0].(*v11.GetSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -180,10 +182,10 @@ func (mr *MockHandlerMockRecorder) GetSearchAttributes(arg0, arg1 interface{}) *
 }
 
 // GetSystemInfo mocks base method.
-func (m *MockHandler) GetSystemInfo(arg0 context.Context, arg1 *v10.GetSystemInfoRequest) (*v10.GetSystemInfoResponse, error) {
+func (m *MockHandler) GetSystemInfo(arg0 context.Context, arg1 *v11.GetSystemInfoRequest) (*v11.GetSystemInfoResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSystemInfo", arg0, arg1)
-	ret0, _ := ret[0].(*v10.GetSystemInfoResponse)
+	ret0, _ := ret[0].(*v11.GetSystemInfoResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -195,10 +197,10 @@ func (mr *MockHandlerMockRecorder) GetSystemInfo(arg0, arg1 interface{}) *gomock
 }
 
 // GetWorkflowExecutionHistory mocks base method.
-func (m *MockHandler) GetWorkflowExecutionHistory(arg0 context.Context, arg1 *v10.GetWorkflowExecutionHistoryRequest) (*v10.GetWorkflowExecutionHistoryResponse, error) {
+func (m *MockHandler) GetWorkflowExecutionHistory(arg0 context.Context, arg1 *v11.GetWorkflowExecutionHistoryRequest) (*v11.GetWorkflowExecutionHistoryResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistory", arg0, arg1)
-	ret0, _ := ret[0].(*v10.GetWorkflowExecutionHistoryResponse)
+	ret0, _ := ret[0].(*v11.GetWorkflowExecutionHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -210,10 +212,10 @@ func (mr *MockHandlerMockRecorder) GetWorkflowExecutionHistory(arg0, arg1 interf
 }
 
 // GetWorkflowExecutionHistoryReverse mocks base method.
-func (m *MockHandler) GetWorkflowExecutionHistoryReverse(arg0 context.Context, arg1 *v10.GetWorkflowExecutionHistoryReverseRequest) (*v10.GetWorkflowExecutionHistoryReverseResponse, error) {
+func (m *MockHandler) GetWorkflowExecutionHistoryReverse(arg0 context.Context, arg1 *v11.GetWorkflowExecutionHistoryReverseRequest) (*v11.GetWorkflowExecutionHistoryReverseResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistoryReverse", arg0, arg1)
-	ret0, _ := ret[0].(*v10.GetWorkflowExecutionHistoryReverseResponse)
+	ret0, _ := ret[0].(*v11.GetWorkflowExecutionHistoryReverseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -225,10 +227,10 @@ func (mr *MockHandlerMockRecorder) GetWorkflowExecutionHistoryReverse(arg0, arg1
 }
 
 // ListArchivedWorkflowExecutions mocks base method.
-func (m *MockHandler) ListArchivedWorkflowExecutions(arg0 context.Context, arg1 *v10.ListArchivedWorkflowExecutionsRequest) (*v10.ListArchivedWorkflowExecutionsResponse, error) {
+func (m *MockHandler) ListArchivedWorkflowExecutions(arg0 context.Context, arg1 *v11.ListArchivedWorkflowExecutionsRequest) (*v11.ListArchivedWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListArchivedWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListArchivedWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.ListArchivedWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -240,10 +242,10 @@ func (mr *MockHandlerMockRecorder) ListArchivedWorkflowExecutions(arg0, arg1 int
 }
 
 // ListClosedWorkflowExecutions mocks base method.
-func (m *MockHandler) ListClosedWorkflowExecutions(arg0 context.Context, arg1 *v10.ListClosedWorkflowExecutionsRequest) (*v10.ListClosedWorkflowExecutionsResponse, error) {
+func (m *MockHandler) ListClosedWorkflowExecutions(arg0 context.Context, arg1 *v11.ListClosedWorkflowExecutionsRequest) (*v11.ListClosedWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListClosedWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListClosedWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.ListClosedWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -255,10 +257,10 @@ func (mr *MockHandlerMockRecorder) ListClosedWorkflowExecutions(arg0, arg1 inter
 }
 
 // ListNamespaces mocks base method.
-func (m *MockHandler) ListNamespaces(arg0 context.Context, arg1 *v10.ListNamespacesRequest) (*v10.ListNamespacesResponse, error) {
+func (m *MockHandler) ListNamespaces(arg0 context.Context, arg1 *v11.ListNamespacesRequest) (*v11.ListNamespacesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListNamespaces", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListNamespacesResponse)
+	ret0, _ := ret[0].(*v11.ListNamespacesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -270,10 +272,10 @@ func (mr *MockHandlerMockRecorder) ListNamespaces(arg0, arg1 interface{}) *gomoc
 }
 
 // ListOpenWorkflowExecutions mocks base method.
-func (m *MockHandler) ListOpenWorkflowExecutions(arg0 context.Context, arg1 *v10.ListOpenWorkflowExecutionsRequest) (*v10.ListOpenWorkflowExecutionsResponse, error) {
+func (m *MockHandler) ListOpenWorkflowExecutions(arg0 context.Context, arg1 *v11.ListOpenWorkflowExecutionsRequest) (*v11.ListOpenWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListOpenWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListOpenWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.ListOpenWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -285,10 +287,10 @@ func (mr *MockHandlerMockRecorder) ListOpenWorkflowExecutions(arg0, arg1 interfa
 }
 
 // ListTaskQueuePartitions mocks base method.
-func (m *MockHandler) ListTaskQueuePartitions(arg0 context.Context, arg1 *v10.ListTaskQueuePartitionsRequest) (*v10.ListTaskQueuePartitionsResponse, error) {
+func (m *MockHandler) ListTaskQueuePartitions(arg0 context.Context, arg1 *v11.ListTaskQueuePartitionsRequest) (*v11.ListTaskQueuePartitionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListTaskQueuePartitions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListTaskQueuePartitionsResponse)
+	ret0, _ := ret[0].(*v11.ListTaskQueuePartitionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -300,10 +302,10 @@ func (mr *MockHandlerMockRecorder) ListTaskQueuePartitions(arg0, arg1 interface{
 }
 
 // ListWorkflowExecutions mocks base method.
-func (m *MockHandler) ListWorkflowExecutions(arg0 context.Context, arg1 *v10.ListWorkflowExecutionsRequest) (*v10.ListWorkflowExecutionsResponse, error) {
+func (m *MockHandler) ListWorkflowExecutions(arg0 context.Context, arg1 *v11.ListWorkflowExecutionsRequest) (*v11.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ListWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.ListWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -315,10 +317,10 @@ func (mr *MockHandlerMockRecorder) ListWorkflowExecutions(arg0, arg1 interface{}
 }
 
 // PollActivityTaskQueue mocks base method.
-func (m *MockHandler) PollActivityTaskQueue(arg0 context.Context, arg1 *v10.PollActivityTaskQueueRequest) (*v10.PollActivityTaskQueueResponse, error) {
+func (m *MockHandler) PollActivityTaskQueue(arg0 context.Context, arg1 *v11.PollActivityTaskQueueRequest) (*v11.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PollActivityTaskQueue", arg0, arg1)
-	ret0, _ := ret[0].(*v10.PollActivityTaskQueueResponse)
+	ret0, _ := ret[0].(*v11.PollActivityTaskQueueResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -330,10 +332,10 @@ func (mr *MockHandlerMockRecorder) PollActivityTaskQueue(arg0, arg1 interface{})
 }
 
 // PollWorkflowTaskQueue mocks base method.
-func (m *MockHandler) PollWorkflowTaskQueue(arg0 context.Context, arg1 *v10.PollWorkflowTaskQueueRequest) (*v10.PollWorkflowTaskQueueResponse, error) {
+func (m *MockHandler) PollWorkflowTaskQueue(arg0 context.Context, arg1 *v11.PollWorkflowTaskQueueRequest) (*v11.PollWorkflowTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PollWorkflowTaskQueue", arg0, arg1)
-	ret0, _ := ret[0].(*v10.PollWorkflowTaskQueueResponse)
+	ret0, _ := ret[0].(*v11.PollWorkflowTaskQueueResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -345,10 +347,10 @@ func (mr *MockHandlerMockRecorder) PollWorkflowTaskQueue(arg0, arg1 interface{})
 }
 
 // QueryWorkflow mocks base method.
-func (m *MockHandler) QueryWorkflow(arg0 context.Context, arg1 *v10.QueryWorkflowRequest) (*v10.QueryWorkflowResponse, error) {
+func (m *MockHandler) QueryWorkflow(arg0 context.Context, arg1 *v11.QueryWorkflowRequest) (*v11.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryWorkflow", arg0, arg1)
-	ret0, _ := ret[0].(*v10.QueryWorkflowResponse)
+	ret0, _ := ret[0].(*v11.QueryWorkflowResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -360,10 +362,10 @@ func (mr *MockHandlerMockRecorder) QueryWorkflow(arg0, arg1 interface{}) *gomock
 }
 
 // RecordActivityTaskHeartbeat mocks base method.
-func (m *MockHandler) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *v10.RecordActivityTaskHeartbeatRequest) (*v10.RecordActivityTaskHeartbeatResponse, error) {
+func (m *MockHandler) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *v11.RecordActivityTaskHeartbeatRequest) (*v11.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecordActivityTaskHeartbeat", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RecordActivityTaskHeartbeatResponse)
+	ret0, _ := ret[0].(*v11.RecordActivityTaskHeartbeatResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -375,10 +377,10 @@ func (mr *MockHandlerMockRecorder) RecordActivityTaskHeartbeat(arg0, arg1 interf
 }
 
 // RecordActivityTaskHeartbeatById mocks base method.
-func (m *MockHandler) RecordActivityTaskHeartbeatById(arg0 context.Context, arg1 *v10.RecordActivityTaskHeartbeatByIdRequest) (*v10.RecordActivityTaskHeartbeatByIdResponse, error) {
+func (m *MockHandler) RecordActivityTaskHeartbeatById(arg0 context.Context, arg1 *v11.RecordActivityTaskHeartbeatByIdRequest) (*v11.RecordActivityTaskHeartbeatByIdResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecordActivityTaskHeartbeatById", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RecordActivityTaskHeartbeatByIdResponse)
+	ret0, _ := ret[0].(*v11.RecordActivityTaskHeartbeatByIdResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -390,10 +392,10 @@ func (mr *MockHandlerMockRecorder) RecordActivityTaskHeartbeatById(arg0, arg1 in
 }
 
 // RegisterNamespace mocks base method.
-func (m *MockHandler) RegisterNamespace(arg0 context.Context, arg1 *v10.RegisterNamespaceRequest) (*v10.RegisterNamespaceResponse, error) {
+func (m *MockHandler) RegisterNamespace(arg0 context.Context, arg1 *v11.RegisterNamespaceRequest) (*v11.RegisterNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterNamespace", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RegisterNamespaceResponse)
+	ret0, _ := ret[0].(*v11.RegisterNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -405,10 +407,10 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(arg0, arg1 interface{}) *go
 }
 
 // RequestCancelWorkflowExecution mocks base method.
-func (m *MockHandler) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *v10.RequestCancelWorkflowExecutionRequest) (*v10.RequestCancelWorkflowExecutionResponse, error) {
+func (m *MockHandler) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *v11.RequestCancelWorkflowExecutionRequest) (*v11.RequestCancelWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RequestCancelWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RequestCancelWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.RequestCancelWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -420,10 +422,10 @@ func (mr *MockHandlerMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 int
 }
 
 // ResetStickyTaskQueue mocks base method.
-func (m *MockHandler) ResetStickyTaskQueue(arg0 context.Context, arg1 *v10.ResetStickyTaskQueueRequest) (*v10.ResetStickyTaskQueueResponse, error) {
+func (m *MockHandler) ResetStickyTaskQueue(arg0 context.Context, arg1 *v11.ResetStickyTaskQueueRequest) (*v11.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ResetStickyTaskQueue", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ResetStickyTaskQueueResponse)
+	ret0, _ := ret[0].(*v11.ResetStickyTaskQueueResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -435,10 +437,10 @@ func (mr *MockHandlerMockRecorder) ResetStickyTaskQueue(arg0, arg1 interface{})
 }
 
 // ResetWorkflowExecution mocks base method.
-func (m *MockHandler) ResetWorkflowExecution(arg0 context.Context, arg1 *v10.ResetWorkflowExecutionRequest) (*v10.ResetWorkflowExecutionResponse, error) {
+func (m *MockHandler) ResetWorkflowExecution(arg0 context.Context, arg1 *v11.ResetWorkflowExecutionRequest) (*v11.ResetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ResetWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ResetWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.ResetWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -450,10 +452,10 @@ func (mr *MockHandlerMockRecorder) ResetWorkflowExecution(arg0, arg1 interface{}
 }
 
 // RespondActivityTaskCanceled mocks base method.
-func (m *MockHandler) RespondActivityTaskCanceled(arg0 context.Context, arg1 *v10.RespondActivityTaskCanceledRequest) (*v10.RespondActivityTaskCanceledResponse, error) {
+func (m *MockHandler) RespondActivityTaskCanceled(arg0 context.Context, arg1 *v11.RespondActivityTaskCanceledRequest) (*v11.RespondActivityTaskCanceledResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskCanceled", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskCanceledResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskCanceledResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -465,10 +467,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskCanceled(arg0, arg1 interf
 }
 
 // RespondActivityTaskCanceledById mocks base method.
-func (m *MockHandler) RespondActivityTaskCanceledById(arg0 context.Context, arg1 *v10.RespondActivityTaskCanceledByIdRequest) (*v10.RespondActivityTaskCanceledByIdResponse, error) {
+func (m *MockHandler) RespondActivityTaskCanceledById(arg0 context.Context, arg1 *v11.RespondActivityTaskCanceledByIdRequest) (*v11.RespondActivityTaskCanceledByIdResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskCanceledById", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskCanceledByIdResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskCanceledByIdResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -480,10 +482,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskCanceledById(arg0, arg1 in
 }
 
 // RespondActivityTaskCompleted mocks base method.
-func (m *MockHandler) RespondActivityTaskCompleted(arg0 context.Context, arg1 *v10.RespondActivityTaskCompletedRequest) (*v10.RespondActivityTaskCompletedResponse, error) {
+func (m *MockHandler) RespondActivityTaskCompleted(arg0 context.Context, arg1 *v11.RespondActivityTaskCompletedRequest) (*v11.RespondActivityTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskCompleted", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskCompletedResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskCompletedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -495,10 +497,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskCompleted(arg0, arg1 inter
 }
 
 // RespondActivityTaskCompletedById mocks base method.
-func (m *MockHandler) RespondActivityTaskCompletedById(arg0 context.Context, arg1 *v10.RespondActivityTaskCompletedByIdRequest) (*v10.RespondActivityTaskCompletedByIdResponse, error) {
+func (m *MockHandler) RespondActivityTaskCompletedById(arg0 context.Context, arg1 *v11.RespondActivityTaskCompletedByIdRequest) (*v11.RespondActivityTaskCompletedByIdResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskCompletedById", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskCompletedByIdResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskCompletedByIdResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -510,10 +512,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskCompletedById(arg0, arg1 i
 }
 
 // RespondActivityTaskFailed mocks base method.
-func (m *MockHandler) RespondActivityTaskFailed(arg0 context.Context, arg1 *v10.RespondActivityTaskFailedRequest) (*v10.RespondActivityTaskFailedResponse, error) {
+func (m *MockHandler) RespondActivityTaskFailed(arg0 context.Context, arg1 *v11.RespondActivityTaskFailedRequest) (*v11.RespondActivityTaskFailedResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskFailed", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskFailedResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskFailedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -525,10 +527,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskFailed(arg0, arg1 interfac
 }
 
 // RespondActivityTaskFailedById mocks base method.
-func (m *MockHandler) RespondActivityTaskFailedById(arg0 context.Context, arg1 *v10.RespondActivityTaskFailedByIdRequest) (*v10.RespondActivityTaskFailedByIdResponse, error) {
+func (m *MockHandler) RespondActivityTaskFailedById(arg0 context.Context, arg1 *v11.RespondActivityTaskFailedByIdRequest) (*v11.RespondActivityTaskFailedByIdResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondActivityTaskFailedById", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondActivityTaskFailedByIdResponse)
+	ret0, _ := ret[0].(*v11.RespondActivityTaskFailedByIdResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -540,10 +542,10 @@ func (mr *MockHandlerMockRecorder) RespondActivityTaskFailedById(arg0, arg1 inte
 }
 
 // RespondQueryTaskCompleted mocks base method.
-func (m *MockHandler) RespondQueryTaskCompleted(arg0 context.Context, arg1 *v10.RespondQueryTaskCompletedRequest) (*v10.RespondQueryTaskCompletedResponse, error) {
+func (m *MockHandler) RespondQueryTaskCompleted(arg0 context.Context, arg1 *v11.RespondQueryTaskCompletedRequest) (*v11.RespondQueryTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondQueryTaskCompleted", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondQueryTaskCompletedResponse)
+	ret0, _ := ret[0].(*v11.RespondQueryTaskCompletedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -555,10 +557,10 @@ func (mr *MockHandlerMockRecorder) RespondQueryTaskCompleted(arg0, arg1 interfac
 }
 
 // RespondWorkflowTaskCompleted mocks base method.
-func (m *MockHandler) RespondWorkflowTaskCompleted(arg0 context.Context, arg1 *v10.RespondWorkflowTaskCompletedRequest) (*v10.RespondWorkflowTaskCompletedResponse, error) {
+func (m *MockHandler) RespondWorkflowTaskCompleted(arg0 context.Context, arg1 *v11.RespondWorkflowTaskCompletedRequest) (*v11.RespondWorkflowTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondWorkflowTaskCompleted", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondWorkflowTaskCompletedResponse)
+	ret0, _ := ret[0].(*v11.RespondWorkflowTaskCompletedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -570,10 +572,10 @@ func (mr *MockHandlerMockRecorder) RespondWorkflowTaskCompleted(arg0, arg1 inter
 }
 
 // RespondWorkflowTaskFailed mocks base method.
-func (m *MockHandler) RespondWorkflowTaskFailed(arg0 context.Context, arg1 *v10.RespondWorkflowTaskFailedRequest) (*v10.RespondWorkflowTaskFailedResponse, error) {
+func (m *MockHandler) RespondWorkflowTaskFailed(arg0 context.Context, arg1 *v11.RespondWorkflowTaskFailedRequest) (*v11.RespondWorkflowTaskFailedResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RespondWorkflowTaskFailed", arg0, arg1)
-	ret0, _ := ret[0].(*v10.RespondWorkflowTaskFailedResponse)
+	ret0, _ := ret[0].(*v11.RespondWorkflowTaskFailedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -585,10 +587,10 @@ func (mr *MockHandlerMockRecorder) RespondWorkflowTaskFailed(arg0, arg1 interfac
 }
 
 // ScanWorkflowExecutions mocks base method.
-func (m *MockHandler) ScanWorkflowExecutions(arg0 context.Context, arg1 *v10.ScanWorkflowExecutionsRequest) (*v10.ScanWorkflowExecutionsResponse, error) {
+func (m *MockHandler) ScanWorkflowExecutions(arg0 context.Context, arg1 *v11.ScanWorkflowExecutionsRequest) (*v11.ScanWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ScanWorkflowExecutions", arg0, arg1)
-	ret0, _ := ret[0].(*v10.ScanWorkflowExecutionsResponse)
+	ret0, _ := ret[0].(*v11.ScanWorkflowExecutionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -600,10 +602,10 @@ func (mr *MockHandlerMockRecorder) ScanWorkflowExecutions(arg0, arg1 interface{}
 }
 
 // SignalWithStartWorkflowExecution mocks base method.
-func (m *MockHandler) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *v10.SignalWithStartWorkflowExecutionRequest) (*v10.SignalWithStartWorkflowExecutionResponse, error) {
+func (m *MockHandler) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *v11.SignalWithStartWorkflowExecutionRequest) (*v11.SignalWithStartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SignalWithStartWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.SignalWithStartWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.SignalWithStartWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -615,10 +617,10 @@ func (mr *MockHandlerMockRecorder) SignalWithStartWorkflowExecution(arg0, arg1 i
 }
 
 // SignalWorkflowExecution mocks base method.
-func (m *MockHandler) SignalWorkflowExecution(arg0 context.Context, arg1 *v10.SignalWorkflowExecutionRequest) (*v10.SignalWorkflowExecutionResponse, error) {
+func (m *MockHandler) SignalWorkflowExecution(arg0 context.Context, arg1 *v11.SignalWorkflowExecutionRequest) (*v11.SignalWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SignalWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.SignalWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.SignalWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -642,10 +644,10 @@ func (mr *MockHandlerMockRecorder) Start() *gomock.Call {
 }
 
 // StartWorkflowExecution mocks base method.
-func (m *MockHandler) StartWorkflowExecution(arg0 context.Context, arg1 *v10.StartWorkflowExecutionRequest) (*v10.StartWorkflowExecutionResponse, error) {
+func (m *MockHandler) StartWorkflowExecution(arg0 context.Context, arg1 *v11.StartWorkflowExecutionRequest) (*v11.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StartWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.StartWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.StartWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -669,10 +671,10 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 }
 
 // TerminateWorkflowExecution mocks base method.
-func (m *MockHandler) TerminateWorkflowExecution(arg0 context.Context, arg1 *v10.TerminateWorkflowExecutionRequest) (*v10.TerminateWorkflowExecutionResponse, error) {
+func (m *MockHandler) TerminateWorkflowExecution(arg0 context.Context, arg1 *v11.TerminateWorkflowExecutionRequest) (*v11.TerminateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TerminateWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v10.TerminateWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v11.TerminateWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -684,10 +686,10 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 interfa
 }
 
 // UpdateNamespace mocks base method.
-func (m *MockHandler) UpdateNamespace(arg0 context.Context, arg1 *v10.UpdateNamespaceRequest) (*v10.UpdateNamespaceResponse, error) {
+func (m *MockHandler) UpdateNamespace(arg0 context.Context, arg1 *v11.UpdateNamespaceRequest) (*v11.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateNamespace", arg0, arg1)
-	ret0, _ := ret[0].(*v10.UpdateNamespaceResponse)
+	ret0, _ := ret[0].(*v11.UpdateNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -721,11 +723,25 @@ func (m *MockOperatorHandler) EXPECT() *MockOperatorHandlerMockRecorder {
 	return m.recorder
 }
 
+// AddNamespaceSearchAttributes mocks base method.
+func (m *MockOperatorHandler) AddNamespaceSearchAttributes(ctx context.Context, nsName namespace.Name, searchAttributes map[string]v1.IndexedValueType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNamespaceSearchAttributes", ctx, nsName, searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNamespaceSearchAttributes indicates an expected call of AddNamespaceSearchAttributes.
+func (mr *MockOperatorHandlerMockRecorder) AddNamespaceSearchAttributes(ctx, nsName, searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNamespaceSearchAttributes", reflect.TypeOf((*MockOperatorHandler)(nil).AddNamespaceSearchAttributes), ctx, nsName, searchAttributes)
+}
+
 // AddSearchAttributes mocks base method.
-func (m *MockOperatorHandler) AddSearchAttributes(arg0 context.Context, arg1 *v1.AddSearchAttributesRequest) (*v1.AddSearchAttributesResponse, error) {
+func (m *MockOperatorHandler) AddSearchAttributes(arg0 context.Context, arg1 *v10.AddSearchAttributesRequest) (*v10.AddSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*v1.AddSearchAttributesResponse)
+	ret0, _ := ret[0].(*v10.AddSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -737,10 +753,10 @@ func (mr *MockOperatorHandlerMockRecorder) AddSearchAttributes(arg0, arg1 interf
 }
 
 // DeleteNamespace mocks base method.
-func (m *MockOperatorHandler) DeleteNamespace(arg0 context.Context, arg1 *v1.DeleteNamespaceRequest) (*v1.DeleteNamespaceResponse, error) {
+func (m *MockOperatorHandler) DeleteNamespace(arg0 context.Context, arg1 *v10.DeleteNamespaceRequest) (*v10.DeleteNamespaceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteNamespaceResponse)
+	ret0, _ := ret[0].(*v10.DeleteNamespaceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -752,10 +768,10 @@ func (mr *MockOperatorHandlerMockRecorder) DeleteNamespace(arg0, arg1 interface{
 }
 
 // DeleteWorkflowExecution mocks base method.
-func (m *MockOperatorHandler) DeleteWorkflowExecution(arg0 context.Context, arg1 *v1.DeleteWorkflowExecutionRequest) (*v1.DeleteWorkflowExecutionResponse, error) {
+func (m *MockOperatorHandler) DeleteWorkflowExecution(arg0 context.Context, arg1 *v10.DeleteWorkflowExecutionRequest) (*v10.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
-	ret0, _ := ret[0].(*v1.DeleteWorkflowExecutionResponse)
+	ret0, _ := ret[0].(*v10.DeleteWorkflowExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -767,10 +783,10 @@ func (mr *MockOperatorHandlerMockRecorder) DeleteWorkflowExecution(arg0, arg1 in
 }
 
 // ListSearchAttributes mocks base method.
-func (m *MockOperatorHandler) ListSearchAttributes(arg0 context.Context, arg1 *v1.ListSearchAttributesRequest) (*v1.ListSearchAttributesResponse, error) {
+func (m *MockOperatorHandler) ListSearchAttributes(arg0 context.Context, arg1 *v10.ListSearchAttributesRequest) (*v10.ListSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ListSearchAttributesResponse)
+	ret0, _ := ret[0].(*v10.ListSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -781,11 +797,25 @@ func (mr *MockOperatorHandlerMockRecorder) ListSearchAttributes(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchAttributes", reflect.TypeOf((*MockOperatorHandler)(nil).ListSearchAttributes), arg0, arg1)
 }
 
+// RemoveNamespaceSearchAttributes mocks base method.
+func (m *MockOperatorHandler) RemoveNamespaceSearchAttributes(ctx context.Context, nsName namespace.Name, searchAttributes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNamespaceSearchAttributes", ctx, nsName, searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNamespaceSearchAttributes indicates an expected call of RemoveNamespaceSearchAttributes.
+func (mr *MockOperatorHandlerMockRecorder) RemoveNamespaceSearchAttributes(ctx, nsName, searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNamespaceSearchAttributes", reflect.TypeOf((*MockOperatorHandler)(nil).RemoveNamespaceSearchAttributes), ctx, nsName, searchAttributes)
+}
+
 // RemoveSearchAttributes mocks base method.
-func (m *MockOperatorHandler) RemoveSearchAttributes(arg0 context.Context, arg1 *v1.RemoveSearchAttributesRequest) (*v1.RemoveSearchAttributesResponse, error) {
+func (m *MockOperatorHandler) RemoveSearchAttributes(arg0 context.Context, arg1 *v10.RemoveSearchAttributesRequest) (*v10.RemoveSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*v1.RemoveSearchAttributesResponse)
+	ret0, _ := ret[0].(*v10.RemoveSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -27,8 +27,11 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync/atomic"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
@@ -41,10 +44,12 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/deletenamespace/deleteexecutions"
 
@@ -55,6 +60,11 @@ import (
 
 var _ OperatorHandler = (*OperatorHandlerImpl)(nil)
 
+const (
+	// clearSearchAttributesWorkflowIDPrefix is the prefix of batch workflow ID which clears values of removed namespace search attributes.
+	clearSearchAttributesWorkflowIDPrefix = "temporal-sys-clear-search-attributes"
+)
+
 type (
 	// OperatorHandlerImpl - gRPC handler interface for operatorservice
 	OperatorHandlerImpl struct {
@@ -72,6 +82,8 @@ type (
 		healthServer      *health.Server
 		historyClient     historyservice.HistoryServiceClient
 		namespaceRegistry namespace.Registry
+		namespaceHandler  namespace.Handler
+		visibilityMgr     manager.VisibilityManager
 	}
 
 	NewOperatorHandlerImplArgs struct {
@@ -86,6 +98,8 @@ type (
 		healthServer      *health.Server
 		historyClient     historyservice.HistoryServiceClient
 		namespaceRegistry namespace.Registry
+		namespaceHandler  namespace.Handler
+		visibilityMgr     manager.VisibilityManager
 	}
 )
 
@@ -107,6 +121,8 @@ func NewOperatorHandlerImpl(
 		healthServer:      args.healthServer,
 		historyClient:     args.historyClient,
 		namespaceRegistry: args.namespaceRegistry,
+		namespaceHandler:  args.namespaceHandler,
+		visibilityMgr:     args.visibilityMgr,
 	}
 
 	return handler
@@ -165,7 +181,7 @@ func (h *OperatorHandlerImpl) AddSearchAttributes(ctx context.Context, request *
 		if currentSearchAttributes.IsDefined(saName) {
 			return nil, h.error(serviceerror.NewAlreadyExist(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, saName)), scope, endpointName)
 		}
-		if _, ok := enumspb.IndexedValueType_name[int32(saType)]; !ok {
+		if !searchattribute.IsAPIType(saType) {
 			return nil, h.error(serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, saType)), scope, endpointName)
 		}
 	}
@@ -283,7 +299,7 @@ func (h *OperatorHandlerImpl) ListSearchAttributes(ctx context.Context, request
 	}
 
 	return &operatorservice.ListSearchAttributesResponse{
-		CustomAttributes: searchAttributes.Custom(),
+		CustomAttributes: searchattribute.APITypes(searchAttributes.Custom()),
 		SystemAttributes: searchAttributes.System(),
 		StorageSchema:    esMapping,
 	}, nil
@@ -373,6 +389,166 @@ func (h *OperatorHandlerImpl) DeleteWorkflowExecution(ctx context.Context, reque
 	return &operatorservice.DeleteWorkflowExecutionResponse{}, nil
 }
 
+// AddNamespaceSearchAttributes assigns free pre-created custom search attribute fields (i.e. CustomKeywordField01)
+// of the requested types to the new namespace aliases. Field is free if it has no alias in the namespace
+// and no workflow of the namespace has a value in it.
+func (h *OperatorHandlerImpl) AddNamespaceSearchAttributes(
+	ctx context.Context,
+	nsName namespace.Name,
+	searchAttributesToAdd map[string]enumspb.IndexedValueType,
+) error {
+	nsID, err := h.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		return err
+	}
+
+	currentSearchAttributes, err := h.saProvider.GetSearchAttributes(h.esConfig.GetVisibilityIndex(), true)
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
+	}
+
+	customFieldNames := make([]string, 0, len(currentSearchAttributes.Custom()))
+	for fieldName := range currentSearchAttributes.Custom() {
+		customFieldNames = append(customFieldNames, fieldName)
+	}
+	sort.Strings(customFieldNames)
+
+	// Sort aliases to make field assignment deterministic.
+	aliases := make([]string, 0, len(searchAttributesToAdd))
+	for alias := range searchAttributesToAdd {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	return h.namespaceHandler.UpdateCustomSearchAttributeAliases(ctx, nsName, func(fieldToAlias map[string]string) error {
+		aliasToField := make(map[string]string, len(fieldToAlias))
+		for fieldName, alias := range fieldToAlias {
+			aliasToField[alias] = fieldName
+		}
+
+		for _, alias := range aliases {
+			saType := searchAttributesToAdd[alias]
+			if searchattribute.IsReserved(alias) {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(errSearchAttributeIsReservedMessage, alias))
+			}
+			if _, ok := aliasToField[alias]; ok || currentSearchAttributes.IsDefined(alias) {
+				return serviceerror.NewAlreadyExist(fmt.Sprintf(errSearchAttributeAlreadyExistsMessage, alias))
+			}
+			if !searchattribute.IsValidType(saType) {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(errUnknownSearchAttributeTypeMessage, saType))
+			}
+
+			freeFieldName := ""
+			for _, fieldName := range customFieldNames {
+				if _, used := fieldToAlias[fieldName]; used || !searchattribute.IsAliasPoolField(fieldName, saType) {
+					continue
+				}
+				if fieldType, _ := currentSearchAttributes.GetType(fieldName); fieldType != saType {
+					continue
+				}
+				hasValues, err := h.searchAttributeFieldHasValues(ctx, nsID, nsName, fieldName)
+				if err != nil {
+					return err
+				}
+				if !hasValues {
+					freeFieldName = fieldName
+					break
+				}
+			}
+			if freeFieldName == "" {
+				return serviceerror.NewFailedPrecondition(fmt.Sprintf(errNoFreeSearchAttributeFieldMessage, saType))
+			}
+			fieldToAlias[freeFieldName] = alias
+			aliasToField[alias] = freeFieldName
+		}
+		return nil
+	})
+}
+
+// RemoveNamespaceSearchAttributes removes namespace aliases and starts batch operation which clears values
+// of the freed fields in running workflows of the namespace. The batch operation upserts empty values through
+// history UpsertWorkflowExecutionMetadata API, which records the change in workflow history, so it is replicated
+// to other clusters of global namespace.
+// Closed workflows keep their values until they are deleted by retention, and the field is not assigned
+// to a new alias until then (see AddNamespaceSearchAttributes).
+func (h *OperatorHandlerImpl) RemoveNamespaceSearchAttributes(
+	ctx context.Context,
+	nsName namespace.Name,
+	aliases []string,
+) error {
+	var freedFieldNames []string
+	err := h.namespaceHandler.UpdateCustomSearchAttributeAliases(ctx, nsName, func(fieldToAlias map[string]string) error {
+		aliasToField := make(map[string]string, len(fieldToAlias))
+		for fieldName, alias := range fieldToAlias {
+			aliasToField[alias] = fieldName
+		}
+
+		freedFieldNames = freedFieldNames[:0]
+		for _, alias := range aliases {
+			fieldName, ok := aliasToField[alias]
+			if !ok {
+				return serviceerror.NewNotFound(fmt.Sprintf(errSearchAttributeDoesntExistMessage, alias))
+			}
+			delete(fieldToAlias, fieldName)
+			freedFieldNames = append(freedFieldNames, fieldName)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(freedFieldNames)
+	indexedFields := make(map[string]*commonpb.Payload, len(freedFieldNames))
+	conditions := make([]string, 0, len(freedFieldNames))
+	for _, fieldName := range freedFieldNames {
+		// Empty value removes search attribute from the workflow.
+		indexedFields[fieldName] = &commonpb.Payload{}
+		conditions = append(conditions, fmt.Sprintf("%s IS NOT NULL", fieldName))
+	}
+
+	sdkClient := h.sdkClientFactory.GetSystemClient(h.logger)
+	_, err = sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: batcher.BatcherTaskQueueName,
+			ID:        fmt.Sprintf("%s-%s-%s", clearSearchAttributesWorkflowIDPrefix, nsName, uuid.New()),
+		},
+		batcher.BatchWFTypeName,
+		batcher.BatchParams{
+			Namespace: nsName.String(),
+			Query:     fmt.Sprintf("%s = 'Running' AND (%s)", searchattribute.ExecutionStatus, strings.Join(conditions, " OR ")),
+			Reason:    "clear values of removed search attributes",
+			BatchType: batcher.BatchTypeUpsertSearchAttributes,
+			UpsertSearchAttributesParams: batcher.UpsertSearchAttributesParams{
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: indexedFields},
+			},
+		},
+	)
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf(errUnableToStartWorkflowMessage, batcher.BatchWFTypeName, err))
+	}
+	return nil
+}
+
+// searchAttributeFieldHasValues returns true if any workflow of the namespace has a value in the custom search attribute field.
+func (h *OperatorHandlerImpl) searchAttributeFieldHasValues(
+	ctx context.Context,
+	nsID namespace.ID,
+	nsName namespace.Name,
+	fieldName string,
+) (bool, error) {
+	resp, err := h.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: nsID,
+		Namespace:   nsName,
+		Query:       fmt.Sprintf("%s IS NOT NULL", fieldName),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
 // startRequestProfile initiates recording of request metrics
 func (h *OperatorHandlerImpl) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
 	metricsScope := h.metricsClient.Scope(scope)
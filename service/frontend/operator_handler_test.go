@@ -46,9 +46,11 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deletenamespace"
 )
 
@@ -87,6 +89,8 @@ func (s *operatorHandlerSuite) SetupTest() {
 		health.NewServer(),
 		s.mockResource.GetHistoryClient(),
 		s.mockResource.GetNamespaceRegistry(),
+		nil,
+		nil,
 	}
 	s.handler = NewOperatorHandlerImpl(args)
 	s.handler.Start()
@@ -184,6 +188,24 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributes() {
 			},
 			Expected: &serviceerror.AlreadyExists{Message: "Search attribute CustomTextField already exists."},
 		},
+		{
+			Name: "unknown type (ES configured)",
+			Request: &operatorservice.AddSearchAttributesRequest{
+				SearchAttributes: map[string]enumspb.IndexedValueType{
+					"CustomAttr": enumspb.IndexedValueType(8),
+				},
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Unknown search attribute type: 8."},
+		},
+		{
+			Name: "KeywordList type is not defined in API (ES configured)",
+			Request: &operatorservice.AddSearchAttributesRequest{
+				SearchAttributes: map[string]enumspb.IndexedValueType{
+					"CustomAttr": searchattribute.IndexedValueTypeKeywordList,
+				},
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Unknown search attribute type: 7."},
+		},
 	}
 	for _, testCase := range testCases2 {
 		s.T().Run(testCase.Name, func(t *testing.T) {
@@ -237,6 +259,125 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributes() {
 	mockSdkClient.AssertExpectations(s.T())
 }
 
+func (s *operatorHandlerSuite) Test_AddNamespaceSearchAttributes() {
+	handler := s.handler
+	ctx := context.Background()
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	mockVisibilityMgr := manager.NewMockVisibilityManager(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+	handler.visibilityMgr = mockVisibilityMgr
+
+	var fieldToAlias map[string]string
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("", true).Return(searchattribute.TestAliasPoolNameTypeMap, nil).AnyTimes()
+	s.mockResource.NamespaceCache.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil).AnyTimes()
+	mockNamespaceHandler.EXPECT().UpdateCustomSearchAttributeAliases(gomock.Any(), namespace.Name("test-namespace"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ namespace.Name, updateFn func(map[string]string) error) error {
+			fieldToAlias = map[string]string{"CustomKeywordField01": "CustomerId"}
+			return updateFn(fieldToAlias)
+		}).AnyTimes()
+	mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "test-namespace-id",
+		Namespace:   "test-namespace",
+		Query:       "CustomKeywordField02 IS NOT NULL",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 1}, nil).AnyTimes()
+	mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: "test-namespace-id",
+		Namespace:   "test-namespace",
+		Query:       "CustomIntField01 IS NOT NULL",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil).AnyTimes()
+
+	type test struct {
+		Name     string
+		Request  map[string]enumspb.IndexedValueType
+		Expected error
+	}
+	testCases := []test{
+		{
+			Name: "reserved alias",
+			Request: map[string]enumspb.IndexedValueType{
+				"WorkflowId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "Search attribute WorkflowId is reserved by system."},
+		},
+		{
+			Name: "alias already exists",
+			Request: map[string]enumspb.IndexedValueType{
+				"CustomerId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			},
+			Expected: &serviceerror.AlreadyExist{Message: "Search attribute CustomerId already exists."},
+		},
+		{
+			Name: "alias is cluster search attribute",
+			Request: map[string]enumspb.IndexedValueType{
+				"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			},
+			Expected: &serviceerror.AlreadyExist{Message: "Search attribute CustomKeywordField already exists."},
+		},
+		{
+			Name: "no free field",
+			Request: map[string]enumspb.IndexedValueType{
+				"Price": enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+			},
+			Expected: &serviceerror.FailedPrecondition{Message: "Unable to find free custom search attribute field of type Double, add more Custom<Type>Field<N> search attributes to the cluster."},
+		},
+		{
+			Name: "free field has values",
+			Request: map[string]enumspb.IndexedValueType{
+				"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			},
+			Expected: &serviceerror.FailedPrecondition{Message: "Unable to find free custom search attribute field of type Keyword, add more Custom<Type>Field<N> search attributes to the cluster."},
+		},
+	}
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			err := handler.AddNamespaceSearchAttributes(ctx, "test-namespace", testCase.Request)
+			s.Equal(testCase.Expected, err)
+		})
+	}
+
+	// Success case.
+	err := handler.AddNamespaceSearchAttributes(ctx, "test-namespace", map[string]enumspb.IndexedValueType{
+		"Priority": enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{
+		"CustomKeywordField01": "CustomerId",
+		"CustomIntField01":     "Priority",
+	}, fieldToAlias)
+}
+
+func (s *operatorHandlerSuite) Test_RemoveNamespaceSearchAttributes() {
+	handler := s.handler
+	ctx := context.Background()
+	mockNamespaceHandler := namespace.NewMockHandler(s.controller)
+	handler.namespaceHandler = mockNamespaceHandler
+
+	var fieldToAlias map[string]string
+	mockNamespaceHandler.EXPECT().UpdateCustomSearchAttributeAliases(gomock.Any(), namespace.Name("test-namespace"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ namespace.Name, updateFn func(map[string]string) error) error {
+			fieldToAlias = map[string]string{"CustomKeywordField01": "CustomerId", "CustomIntField01": "Priority"}
+			return updateFn(fieldToAlias)
+		}).Times(2)
+
+	err := handler.RemoveNamespaceSearchAttributes(ctx, "test-namespace", []string{"OrderId"})
+	s.Equal(&serviceerror.NotFound{Message: "Search attribute OrderId doesn't exist."}, err)
+
+	// Success case.
+	mockSdkClient := &sdkmocks.Client{}
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient(gomock.Any()).Return(mockSdkClient)
+	mockSdkClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, "temporal-sys-batch-workflow", mock.MatchedBy(func(params batcher.BatchParams) bool {
+		s.Equal("test-namespace", params.Namespace)
+		s.Equal("ExecutionStatus = 'Running' AND (CustomKeywordField01 IS NOT NULL)", params.Query)
+		s.Equal(batcher.BatchTypeUpsertSearchAttributes, params.BatchType)
+		s.Equal(map[string]*commonpb.Payload{"CustomKeywordField01": {}}, params.UpsertSearchAttributesParams.SearchAttributes.GetIndexedFields())
+		return true
+	})).Return(nil, nil).Once()
+	err = handler.RemoveNamespaceSearchAttributes(ctx, "test-namespace", []string{"CustomerId"})
+	s.NoError(err)
+	s.Equal(map[string]string{"CustomIntField01": "Priority"}, fieldToAlias)
+	mockSdkClient.AssertExpectations(s.T())
+}
+
 func (s *operatorHandlerSuite) Test_ListSearchAttributes() {
 	handler := s.handler
 	ctx := context.Background()
@@ -266,6 +407,7 @@ func (s *operatorHandlerSuite) Test_ListSearchAttributes() {
 	resp, err = handler.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{})
 	s.NoError(err)
 	s.NotNil(resp)
+	s.Equal(enumspb.INDEXED_VALUE_TYPE_KEYWORD, resp.CustomAttributes["CustomKeywordListField"])
 
 	s.mockResource.ESClient.EXPECT().GetMapping(gomock.Any(), "random-index-name").Return(map[string]string{"col": "type"}, nil)
 	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes("random-index-name", true).Return(searchattribute.NameTypeMap{}, errors.New("random error"))
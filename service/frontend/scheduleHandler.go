@@ -0,0 +1,674 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination scheduleHandler_mock.go
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/worker/scheduler"
+)
+
+const (
+	defaultScheduleSpecPreviewCount = 10
+	maxScheduleSpecPreviewCount     = 1000
+)
+
+// Schedules are implemented as a scheduler workflow per schedule, running in the
+// schedule's namespace on the per-namespace scheduler worker. The handlers below
+// translate schedule operations into start, signal, query and terminate calls on that
+// workflow, and list schedules through visibility. They are served by the admin
+// service (see AdminHandler), so the authorization and namespace validation
+// interceptors apply to them like to any other RPC, and are wrapped by
+// DCRedirectionHandlerImpl so that calls for a global namespace reach its active cluster.
+// Requests and responses carry the typed messages of the schedule proto package,
+// converted to and from the scheduler workflow's own types at the bottom of this file.
+
+type (
+	// ScheduleHandler implements the schedule RPCs of the admin service.
+	ScheduleHandler interface {
+		CreateSchedule(ctx context.Context, request *adminservice.CreateScheduleRequest) (*adminservice.CreateScheduleResponse, error)
+		DescribeSchedule(ctx context.Context, request *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error)
+		UpdateSchedule(ctx context.Context, request *adminservice.UpdateScheduleRequest) (*adminservice.UpdateScheduleResponse, error)
+		PatchSchedule(ctx context.Context, request *adminservice.PatchScheduleRequest) (*adminservice.PatchScheduleResponse, error)
+		DeleteSchedule(ctx context.Context, request *adminservice.DeleteScheduleRequest) (*adminservice.DeleteScheduleResponse, error)
+		ListSchedules(ctx context.Context, request *adminservice.ListSchedulesRequest) (*adminservice.ListSchedulesResponse, error)
+		PreviewScheduleSpec(ctx context.Context, request *adminservice.PreviewScheduleSpecRequest) (*adminservice.PreviewScheduleSpecResponse, error)
+	}
+)
+
+var _ ScheduleHandler = (*WorkflowHandler)(nil)
+
+// CreateSchedule creates a new schedule.
+func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *adminservice.CreateScheduleRequest) (_ *adminservice.CreateScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	workflowID, err := wh.scheduleWorkflowID(request.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduleFromProto(request.GetSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	var initialPatch *scheduler.SchedulePatch
+	if request.GetInitialPatch() != nil {
+		if initialPatch, err = schedulePatchFromProto(request.GetInitialPatch()); err != nil {
+			return nil, err
+		}
+	}
+
+	if request.GetRequestId() == "" {
+		return nil, errRequestIDNotSet
+	}
+
+	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, errRequestIDTooLong
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wh.processIncomingSearchAttributes(request.GetSearchAttributes(), namespaceName); err != nil {
+		return nil, err
+	}
+
+	input, err := payloads.Encode(&scheduler.StartScheduleArgs{
+		Schedule: schedule,
+		State: scheduler.InternalState{
+			Namespace:  namespaceName.String(),
+			ScheduleID: request.GetScheduleId(),
+		},
+		InitialPatch: initialPatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	startRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:             namespaceName.String(),
+		WorkflowId:            workflowID,
+		WorkflowType:          &commonpb.WorkflowType{Name: scheduler.WorkflowType},
+		TaskQueue:             &taskqueuepb.TaskQueue{Name: scheduler.TaskQueueName, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		Input:                 input,
+		Identity:              request.GetIdentity(),
+		RequestId:             request.GetRequestId(),
+		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo:                  request.GetMemo(),
+		SearchAttributes:      request.GetSearchAttributes(),
+	}
+	_, err = wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startRequest, nil, time.Now().UTC()))
+	if err != nil {
+		return nil, err
+	}
+
+	// The scheduler workflow starts with a zero token.
+	return &adminservice.CreateScheduleResponse{}, nil
+}
+
+// DescribeSchedule returns the schedule specification and current state of a schedule.
+func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *adminservice.DescribeScheduleRequest) (_ *adminservice.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	workflowID, err := wh.scheduleWorkflowID(request.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.historyClient.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: request.GetNamespace(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			Query:     &querypb.WorkflowQuery{QueryType: scheduler.QueryNameDescribe},
+			// a schedule that is gone has no meaningful state
+			QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rejected := resp.GetResponse().GetQueryRejected(); rejected != nil {
+		return nil, serviceerror.NewNotFound("Schedule " + request.GetScheduleId() + " is not running.")
+	}
+
+	var describeResponse scheduler.DescribeResponse
+	if err := payloads.Decode(resp.GetResponse().GetQueryResult(), &describeResponse); err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DescribeScheduleResponse{
+		Schedule:      scheduleToProto(describeResponse.Schedule),
+		Info:          scheduleInfoToProto(&describeResponse.Info),
+		ConflictToken: describeResponse.ConflictToken,
+	}, nil
+}
+
+// UpdateSchedule replaces the specification, action, policies and state of a schedule.
+func (wh *WorkflowHandler) UpdateSchedule(ctx context.Context, request *adminservice.UpdateScheduleRequest) (_ *adminservice.UpdateScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduleFromProto(request.GetSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := payloads.Encode(&scheduler.FullUpdateRequest{
+		Schedule:      schedule,
+		ConflictToken: request.GetConflictToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wh.signalSchedule(ctx, request.GetNamespace(), request.GetScheduleId(), scheduler.SignalNameUpdate, input, request.GetIdentity(), request.GetRequestId()); err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateScheduleResponse{}, nil
+}
+
+// PatchSchedule makes a one-off change to a schedule: triggers an action immediately,
+// backfills a time range, or pauses or unpauses it.
+func (wh *WorkflowHandler) PatchSchedule(ctx context.Context, request *adminservice.PatchScheduleRequest) (_ *adminservice.PatchScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	if request.GetPatch() == nil {
+		return nil, errSchedulePatchNotSet
+	}
+	patch, err := schedulePatchFromProto(request.GetPatch())
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := payloads.Encode(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wh.signalSchedule(ctx, request.GetNamespace(), request.GetScheduleId(), scheduler.SignalNamePatch, input, request.GetIdentity(), request.GetRequestId()); err != nil {
+		return nil, err
+	}
+	return &adminservice.PatchScheduleResponse{}, nil
+}
+
+// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+func (wh *WorkflowHandler) DeleteSchedule(ctx context.Context, request *adminservice.DeleteScheduleRequest) (_ *adminservice.DeleteScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	workflowID, err := wh.scheduleWorkflowID(request.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = wh.historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         request.GetNamespace(),
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			Reason:            "Schedule deleted",
+			Identity:          request.GetIdentity(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DeleteScheduleResponse{}, nil
+}
+
+// ListSchedules lists the schedules in a namespace.
+func (wh *WorkflowHandler) ListSchedules(ctx context.Context, request *adminservice.ListSchedulesRequest) (_ *adminservice.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(request.GetMaximumPageSize(), request.GetNamespace()) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errPageSizeTooBigMessage, wh.config.ESIndexMaxResultWindow()))
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	persistenceResp, err := wh.visibilityMrg.ListOpenWorkflowExecutionsByType(ctx, &manager.ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: &manager.ListWorkflowExecutionsRequest{
+			NamespaceID:       namespaceID,
+			Namespace:         namespaceName,
+			EarliestStartTime: minTime,
+			LatestStartTime:   maxTime,
+			PageSize:          int(request.GetMaximumPageSize()),
+			NextPageToken:     request.GetNextPageToken(),
+		},
+		WorkflowTypeName: scheduler.WorkflowType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*adminservice.ScheduleListEntry, 0, len(persistenceResp.Executions))
+	for _, ex := range persistenceResp.Executions {
+		workflowID := ex.GetExecution().GetWorkflowId()
+		if !strings.HasPrefix(workflowID, scheduler.WorkflowIDPrefix) {
+			continue
+		}
+		schedules = append(schedules, &adminservice.ScheduleListEntry{
+			ScheduleId:       strings.TrimPrefix(workflowID, scheduler.WorkflowIDPrefix),
+			Memo:             ex.GetMemo(),
+			SearchAttributes: ex.GetSearchAttributes(),
+		})
+	}
+
+	return &adminservice.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: persistenceResp.NextPageToken,
+	}, nil
+}
+
+// PreviewScheduleSpec validates a schedule spec and returns its upcoming action times,
+// without creating a schedule.
+func (wh *WorkflowHandler) PreviewScheduleSpec(ctx context.Context, request *adminservice.PreviewScheduleSpecRequest) (_ *adminservice.PreviewScheduleSpecResponse, retError error) {
+	defer log.CapturePanic(wh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := wh.validateScheduleRequest(ctx, request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	if request.GetSpec() == nil && len(request.GetCronStrings()) == 0 {
+		return nil, errScheduleSpecNotSet
+	}
+
+	spec, err := scheduler.SpecWithCronStrings(request.GetSpec(), request.GetCronStrings())
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	count := int(request.GetCount())
+	if count == 0 {
+		count = defaultScheduleSpecPreviewCount
+	} else if count < 0 || count > maxScheduleSpecPreviewCount {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidPreviewCountMessage, maxScheduleSpecPreviewCount))
+	}
+
+	startTime := time.Now().UTC()
+	if request.GetStartTime() != nil {
+		startTime = *request.GetStartTime()
+	}
+
+	times, err := scheduler.PreviewSpec(spec, startTime, count)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	previewTimes := make([]*adminservice.ScheduleSpecPreviewTime, len(times))
+	for i, t := range times {
+		previewTimes[i] = &adminservice.ScheduleSpecPreviewTime{
+			NominalTime: timestamp.TimePtr(t.NominalTime),
+			ActualTime:  timestamp.TimePtr(t.ActualTime),
+		}
+	}
+	return &adminservice.PreviewScheduleSpecResponse{Times: previewTimes}, nil
+}
+
+// validateScheduleRequest does the checks common to all schedule requests.
+func (wh *WorkflowHandler) validateScheduleRequest(ctx context.Context, namespaceName string) error {
+	if wh.isStopped() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return err
+	}
+
+	if err := wh.validateNamespace(namespaceName); err != nil {
+		return err
+	}
+
+	if !wh.config.EnableSchedules(namespaceName) {
+		return errSchedulesNotAllowed
+	}
+
+	return nil
+}
+
+func (wh *WorkflowHandler) scheduleWorkflowID(scheduleID string) (string, error) {
+	if scheduleID == "" {
+		return "", errScheduleIDNotSet
+	}
+	workflowID := scheduler.WorkflowIDPrefix + scheduleID
+	if err := wh.validateWorkflowID(workflowID); err != nil {
+		return "", err
+	}
+	return workflowID, nil
+}
+
+func (wh *WorkflowHandler) signalSchedule(
+	ctx context.Context,
+	namespaceName string,
+	scheduleID string,
+	signalName string,
+	input *commonpb.Payloads,
+	identity string,
+	requestID string,
+) error {
+	workflowID, err := wh.scheduleWorkflowID(scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if len(requestID) > wh.config.MaxIDLengthLimit() {
+		return errRequestIDTooLong
+	}
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+
+	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return err
+	}
+
+	_, err = wh.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         namespaceName,
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			SignalName:        signalName,
+			Input:             input,
+			Identity:          identity,
+			RequestId:         requestID,
+		},
+	})
+	return err
+}
+
+// scheduleFromProto converts and validates the schedule of a create or update request.
+func scheduleFromProto(s *schedulespb.Schedule) (*scheduler.Schedule, error) {
+	if s == nil {
+		return nil, errScheduleNotSet
+	}
+	schedule := &scheduler.Schedule{
+		Spec:        s.GetSpec(),
+		CronStrings: s.GetCronStrings(),
+		Policies: scheduler.SchedulePolicies{
+			OverlapPolicy: scheduler.OverlapPolicy(s.GetPolicies().GetOverlapPolicy()),
+			CatchupWindow: timestamp.DurationValue(s.GetPolicies().GetCatchupWindow()),
+		},
+		State: scheduler.ScheduleState{
+			Notes:            s.GetState().GetNotes(),
+			Paused:           s.GetState().GetPaused(),
+			LimitedActions:   s.GetState().GetLimitedActions(),
+			RemainingActions: s.GetState().GetRemainingActions(),
+		},
+	}
+	if action := s.GetAction(); action != nil {
+		schedule.Action = &scheduler.StartWorkflowAction{
+			WorkflowId:               action.GetWorkflowId(),
+			WorkflowType:             action.GetWorkflowType(),
+			TaskQueue:                action.GetTaskQueue(),
+			Input:                    action.GetInput(),
+			WorkflowExecutionTimeout: timestamp.DurationValue(action.GetWorkflowExecutionTimeout()),
+			WorkflowRunTimeout:       timestamp.DurationValue(action.GetWorkflowRunTimeout()),
+			WorkflowTaskTimeout:      timestamp.DurationValue(action.GetWorkflowTaskTimeout()),
+			RetryPolicy:              action.GetRetryPolicy(),
+			Memo:                     action.GetMemo(),
+			SearchAttributes:         action.GetSearchAttributes(),
+			Header:                   action.GetHeader(),
+		}
+	}
+	if err := validateSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func scheduleToProto(schedule *scheduler.Schedule) *schedulespb.Schedule {
+	if schedule == nil {
+		return nil
+	}
+	s := &schedulespb.Schedule{
+		Spec:        schedule.Spec,
+		CronStrings: schedule.CronStrings,
+		Policies: &schedulespb.SchedulePolicies{
+			OverlapPolicy: enumsspb.ScheduleOverlapPolicy(schedule.Policies.OverlapPolicy),
+			CatchupWindow: timestamp.DurationPtr(schedule.Policies.CatchupWindow),
+		},
+		State: &schedulespb.ScheduleState{
+			Notes:            schedule.State.Notes,
+			Paused:           schedule.State.Paused,
+			LimitedActions:   schedule.State.LimitedActions,
+			RemainingActions: schedule.State.RemainingActions,
+		},
+	}
+	if action := schedule.Action; action != nil {
+		s.Action = &schedulespb.StartWorkflowAction{
+			WorkflowId:               action.WorkflowId,
+			WorkflowType:             action.WorkflowType,
+			TaskQueue:                action.TaskQueue,
+			Input:                    action.Input,
+			WorkflowExecutionTimeout: timestamp.DurationPtr(action.WorkflowExecutionTimeout),
+			WorkflowRunTimeout:       timestamp.DurationPtr(action.WorkflowRunTimeout),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(action.WorkflowTaskTimeout),
+			RetryPolicy:              action.RetryPolicy,
+			Memo:                     action.Memo,
+			SearchAttributes:         action.SearchAttributes,
+			Header:                   action.Header,
+		}
+	}
+	return s
+}
+
+// schedulePatchFromProto converts and validates a schedule patch.
+func schedulePatchFromProto(p *schedulespb.SchedulePatch) (*scheduler.SchedulePatch, error) {
+	patch := &scheduler.SchedulePatch{
+		Pause:   p.GetPause(),
+		Unpause: p.GetUnpause(),
+	}
+	if trigger := p.GetTriggerImmediately(); trigger != nil {
+		overlapPolicy, err := overlapPolicyFromProto(trigger.GetOverlapPolicy())
+		if err != nil {
+			return nil, err
+		}
+		patch.TriggerImmediately = &scheduler.TriggerImmediatelyRequest{OverlapPolicy: overlapPolicy}
+	}
+	for _, backfill := range p.GetBackfillRequest() {
+		if backfill.GetStartTime() == nil || backfill.GetEndTime() == nil {
+			return nil, errBackfillTimeNotSet
+		}
+		if backfill.GetEndTime().Before(*backfill.GetStartTime()) {
+			return nil, errInvalidBackfillRange
+		}
+		overlapPolicy, err := overlapPolicyFromProto(backfill.GetOverlapPolicy())
+		if err != nil {
+			return nil, err
+		}
+		patch.BackfillRequest = append(patch.BackfillRequest, &scheduler.BackfillRequest{
+			StartTime:     *backfill.GetStartTime(),
+			EndTime:       *backfill.GetEndTime(),
+			OverlapPolicy: overlapPolicy,
+		})
+	}
+	return patch, nil
+}
+
+func scheduleInfoToProto(info *scheduler.ScheduleInfo) *schedulespb.ScheduleInfo {
+	recentActions := make([]*schedulespb.ScheduleActionResult, len(info.RecentActions))
+	for i, action := range info.RecentActions {
+		recentActions[i] = &schedulespb.ScheduleActionResult{
+			ScheduleTime:        timestamp.TimePtr(action.ScheduleTime),
+			ActualTime:          timestamp.TimePtr(action.ActualTime),
+			StartWorkflowResult: action.StartWorkflowResult,
+		}
+	}
+	futureActionTimes := make([]*time.Time, len(info.FutureActionTimes))
+	for i, t := range info.FutureActionTimes {
+		futureActionTimes[i] = timestamp.TimePtr(t)
+	}
+	return &schedulespb.ScheduleInfo{
+		ActionCount:          info.ActionCount,
+		MissedCatchupWindow:  info.MissedCatchupWindow,
+		OverlapSkipped:       info.OverlapSkipped,
+		RunningWorkflows:     info.RunningWorkflows,
+		RecentActions:        recentActions,
+		FutureActionTimes:    futureActionTimes,
+		CreateTime:           timestamp.TimePtr(info.CreateTime),
+		UpdateTime:           timestamp.TimePtr(info.UpdateTime),
+		InvalidScheduleError: info.InvalidScheduleError,
+	}
+}
+
+// overlapPolicyFromProto relies on the proto enum numbering the policies like
+// scheduler.OverlapPolicy does.
+func overlapPolicyFromProto(policy enumsspb.ScheduleOverlapPolicy) (scheduler.OverlapPolicy, error) {
+	overlapPolicy := scheduler.OverlapPolicy(policy)
+	if overlapPolicy < scheduler.OverlapPolicyUnspecified || overlapPolicy > scheduler.OverlapPolicyAllowAll {
+		return scheduler.OverlapPolicyUnspecified, errInvalidOverlapPolicy
+	}
+	return overlapPolicy, nil
+}
+
+func validateSchedule(schedule *scheduler.Schedule) error {
+	if schedule == nil {
+		return errScheduleNotSet
+	}
+	action := schedule.Action
+	if action == nil {
+		return errScheduleActionNotSet
+	}
+	if action.WorkflowId == "" {
+		return errWorkflowIDNotSet
+	}
+	if action.WorkflowType == "" {
+		return errWorkflowTypeNotSet
+	}
+	if action.TaskQueue == "" {
+		return errTaskQueueNotSet
+	}
+	spec, err := scheduler.SpecWithCronStrings(schedule.Spec, schedule.CronStrings)
+	if err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	if spec != nil {
+		if err := scheduler.ValidateSpec(spec); err != nil {
+			return serviceerror.NewInvalidArgument(err.Error())
+		}
+	}
+	if schedule.Policies.OverlapPolicy < scheduler.OverlapPolicyUnspecified ||
+		schedule.Policies.OverlapPolicy > scheduler.OverlapPolicyAllowAll {
+		return errInvalidOverlapPolicy
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// PriorityKeyHeaderName is the name of the start request header field which sets the
+	// matching priority of the tasks of the workflow and of its activities. The field holds an
+	// integer payload from 1 (highest priority) to 5 (lowest priority).
+	PriorityKeyHeaderName = "temporal-priority-key"
+	// FairnessKeyHeaderName is the name of the start request header field which sets the
+	// matching fairness key, e.g. the tenant, of the tasks of the workflow and of its
+	// activities. The field holds a string payload.
+	FairnessKeyHeaderName = "temporal-fairness-key"
+)
+
+// taskKeysFromHeader returns the priority and fairness key set on the header of a start
+// request. The start request of the API has no fields for them, so the frontend takes them
+// from the header and passes them on to history as fields of its own start request.
+// Invalid values fall back to the default priority and the empty fairness key.
+func taskKeysFromHeader(header *commonpb.Header) (int32, string) {
+	var priorityKey int32
+	if value, ok := header.GetFields()[PriorityKeyHeaderName]; ok {
+		if err := payload.Decode(value, &priorityKey); err != nil || priorityKey < 0 {
+			priorityKey = 0
+		}
+	}
+	var fairnessKey string
+	if value, ok := header.GetFields()[FairnessKeyHeaderName]; ok {
+		if err := payload.Decode(value, &fairnessKey); err != nil {
+			fairnessKey = ""
+		}
+	}
+	return priorityKey, fairnessKey
+}
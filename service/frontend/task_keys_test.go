@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+func TestTaskKeysFromHeader(t *testing.T) {
+	priorityKey, err := payload.Encode(int32(1))
+	require.NoError(t, err)
+	negativePriorityKey, err := payload.Encode(int32(-1))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		header              *commonpb.Header
+		expectedPriorityKey int32
+		expectedFairnessKey string
+	}{
+		{header: nil},
+		{header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+			PriorityKeyHeaderName: priorityKey,
+			FairnessKeyHeaderName: payload.EncodeString("tenant"),
+		}}, expectedPriorityKey: 1, expectedFairnessKey: "tenant"},
+		{header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+			PriorityKeyHeaderName: negativePriorityKey,
+			FairnessKeyHeaderName: priorityKey,
+		}}},
+	} {
+		priorityKey, fairnessKey := taskKeysFromHeader(tc.header)
+		require.Equal(t, tc.expectedPriorityKey, priorityKey)
+		require.Equal(t, tc.expectedFairnessKey, fairnessKey)
+	}
+}
@@ -402,7 +402,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
-	resp, err := wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC()))
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC())
+	histRequest.PriorityKey, histRequest.FairnessKey = taskKeysFromHeader(request.GetHeader())
+	resp, err := wh.historyClient.StartWorkflowExecution(ctx, histRequest)
 
 	if err != nil {
 		return nil, err
@@ -2106,9 +2108,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
+	priorityKey, fairnessKey := taskKeysFromHeader(request.GetHeader())
 	resp, err := wh.historyClient.SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 		NamespaceId:            namespaceID.String(),
 		SignalWithStartRequest: request,
+		PriorityKey:            priorityKey,
+		FairnessKey:            fairnessKey,
 	})
 
 	if err != nil {
@@ -2626,6 +2631,11 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
+	// Response of workflow service API has no field for groups, so GROUP BY queries
+	// are rejected instead of silently returning only the total count.
+	if persistenceResp.Groups != nil {
+		return nil, errGroupByNotSupported
+	}
 
 	resp := &workflowservice.CountWorkflowExecutionsResponse{
 		Count: persistenceResp.Count,
@@ -2650,7 +2660,7 @@ func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context, _ *workflows
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf(errUnableToGetSearchAttributesMessage, err))
 	}
 	resp := &workflowservice.GetSearchAttributesResponse{
-		Keys: searchAttributes.All(),
+		Keys: searchattribute.APITypes(searchAttributes.All()),
 	}
 	return resp, nil
 }
@@ -2890,6 +2900,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
 // pollers which polled this taskqueue in last few minutes.
+// The backlog stats of the taskqueue are served by the admin DescribeTaskQueueBacklog API.
 func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workflowservice.DescribeTaskQueueRequest) (_ *workflowservice.DescribeTaskQueueResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
 
@@ -41,9 +41,12 @@ import (
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	querypb "go.temporal.io/api/query/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc/health"
 
@@ -55,10 +58,12 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/searchattribute"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
@@ -66,10 +71,12 @@ import (
 	dc "go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
@@ -1699,6 +1706,22 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *workflowHandlerSuite) TestCountWorkflowExecutions_GroupByNotSupported() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{
+		Count:  5,
+		Groups: []manager.CountWorkflowExecutionsGroup{{Value: "Running", Count: 5}},
+	}, nil)
+
+	_, err := wh.CountWorkflowExecutions(context.Background(), &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: s.testNamespace.String(),
+		Query:     "GROUP BY ExecutionStatus",
+	})
+	s.Equal(errGroupByNotSupported, err)
+}
+
 func (s *workflowHandlerSuite) TestVerifyHistoryIsComplete() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
@@ -1768,6 +1791,305 @@ func (s *workflowHandlerSuite) TestGetSystemInfo() {
 	s.True(resp.Capabilities.ActivityFailureIncludeHeartbeat)
 }
 
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_Disabled() {
+	config := s.newConfig()
+	config.EnableSchedules = dc.GetBoolPropertyFnFilteredByNamespace(false)
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.CreateSchedule(context.Background(), &adminservice.CreateScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+	})
+	s.Equal(errSchedulesNotAllowed, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ActionNotSet() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.CreateSchedule(context.Background(), &adminservice.CreateScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Schedule:   &schedulespb.Schedule{},
+		RequestId:  uuid.New(),
+	})
+	s.Equal(errScheduleActionNotSet, err)
+
+	_, err = wh.CreateSchedule(context.Background(), &adminservice.CreateScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		RequestId:  uuid.New(),
+	})
+	s.Equal(errScheduleNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeSchedule() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	createTime := time.Date(2022, 6, 3, 12, 0, 0, 0, time.UTC)
+	queryResult, err := payloads.Encode(&scheduler.DescribeResponse{
+		Schedule: &scheduler.Schedule{
+			CronStrings: []string{"@hourly"},
+			Action: &scheduler.StartWorkflowAction{
+				WorkflowId:         "wf-id",
+				WorkflowType:       "wf-type",
+				TaskQueue:          "task-queue",
+				WorkflowRunTimeout: time.Hour,
+			},
+			Policies: scheduler.SchedulePolicies{OverlapPolicy: scheduler.OverlapPolicyBufferOne},
+			State:    scheduler.ScheduleState{Paused: true, Notes: "maintenance"},
+		},
+		Info: scheduler.ScheduleInfo{
+			ActionCount:       3,
+			FutureActionTimes: []time.Time{createTime.Add(time.Hour)},
+			CreateTime:        createTime,
+		},
+		ConflictToken: 7,
+	})
+	s.NoError(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.QueryWorkflowRequest, _ ...interface{}) (*historyservice.QueryWorkflowResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"sched-id", request.GetRequest().GetExecution().GetWorkflowId())
+			s.Equal(scheduler.QueryNameDescribe, request.GetRequest().GetQuery().GetQueryType())
+			return &historyservice.QueryWorkflowResponse{
+				Response: &workflowservice.QueryWorkflowResponse{QueryResult: queryResult},
+			}, nil
+		})
+
+	resp, err := wh.DescribeSchedule(context.Background(), &adminservice.DescribeScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+	})
+	s.NoError(err)
+	s.Equal([]string{"@hourly"}, resp.Schedule.GetCronStrings())
+	s.Equal("wf-type", resp.Schedule.GetAction().GetWorkflowType())
+	s.Equal(time.Hour, *resp.Schedule.GetAction().GetWorkflowRunTimeout())
+	s.Equal(enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, resp.Schedule.GetPolicies().GetOverlapPolicy())
+	s.True(resp.Schedule.GetState().GetPaused())
+	s.Equal("maintenance", resp.Schedule.GetState().GetNotes())
+	s.Equal(int64(3), resp.Info.GetActionCount())
+	s.Equal(createTime, *resp.Info.GetCreateTime())
+	s.Len(resp.Info.GetFutureActionTimes(), 1)
+	s.Equal(createTime.Add(time.Hour), *resp.Info.GetFutureActionTimes()[0])
+	s.Equal(int64(7), resp.ConflictToken)
+}
+
+func (s *workflowHandlerSuite) TestDescribeSchedule_NotRunning() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&historyservice.QueryWorkflowResponse{
+		Response: &workflowservice.QueryWorkflowResponse{
+			QueryRejected: &querypb.QueryRejected{Status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED},
+		},
+	}, nil)
+
+	_, err := wh.DescribeSchedule(context.Background(), &adminservice.DescribeScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateSchedule() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...interface{}) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"sched-id", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNameUpdate, request.GetSignalRequest().GetSignalName())
+			var update scheduler.FullUpdateRequest
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &update))
+			s.Equal(int64(7), update.ConflictToken)
+			s.Equal("wf-type", update.Schedule.Action.WorkflowType)
+			s.Equal(10*time.Minute, update.Schedule.Policies.CatchupWindow)
+			s.Equal(scheduler.OverlapPolicyAllowAll, update.Schedule.Policies.OverlapPolicy)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	_, err := wh.UpdateSchedule(context.Background(), &adminservice.UpdateScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Schedule: &schedulespb.Schedule{
+			CronStrings: []string{"@hourly"},
+			Action: &schedulespb.StartWorkflowAction{
+				WorkflowId:   "wf-id",
+				WorkflowType: "wf-type",
+				TaskQueue:    "task-queue",
+			},
+			Policies: &schedulespb.SchedulePolicies{
+				OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+				CatchupWindow: timestamp.DurationPtr(10 * time.Minute),
+			},
+		},
+		ConflictToken: 7,
+	})
+	s.NoError(err)
+
+	_, err = wh.UpdateSchedule(context.Background(), &adminservice.UpdateScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Schedule: &schedulespb.Schedule{
+			Action:   &schedulespb.StartWorkflowAction{WorkflowId: "wf-id", WorkflowType: "wf-type", TaskQueue: "task-queue"},
+			Policies: &schedulespb.SchedulePolicies{OverlapPolicy: enumsspb.ScheduleOverlapPolicy(100)},
+		},
+	})
+	s.Equal(errInvalidOverlapPolicy, err)
+}
+
+func (s *workflowHandlerSuite) TestPatchSchedule() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	startTime := time.Date(2022, 6, 3, 12, 0, 0, 0, time.UTC)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...interface{}) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"sched-id", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal(scheduler.SignalNamePatch, request.GetSignalRequest().GetSignalName())
+			s.NotEmpty(request.GetSignalRequest().GetRequestId())
+			var patch scheduler.SchedulePatch
+			s.NoError(payloads.Decode(request.GetSignalRequest().GetInput(), &patch))
+			s.Equal("maintenance", patch.Pause)
+			s.Len(patch.BackfillRequest, 1)
+			s.Equal(startTime, patch.BackfillRequest[0].StartTime)
+			s.Equal(startTime.Add(time.Hour), patch.BackfillRequest[0].EndTime)
+			s.Equal(scheduler.OverlapPolicyBufferAll, patch.BackfillRequest[0].OverlapPolicy)
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	_, err := wh.PatchSchedule(context.Background(), &adminservice.PatchScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Patch: &schedulespb.SchedulePatch{
+			Pause: "maintenance",
+			BackfillRequest: []*schedulespb.BackfillRequest{{
+				StartTime:     timestamp.TimePtr(startTime),
+				EndTime:       timestamp.TimePtr(startTime.Add(time.Hour)),
+				OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+			}},
+		},
+	})
+	s.NoError(err)
+
+	_, err = wh.PatchSchedule(context.Background(), &adminservice.PatchScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Patch: &schedulespb.SchedulePatch{
+			BackfillRequest: []*schedulespb.BackfillRequest{{
+				StartTime: timestamp.TimePtr(startTime),
+				EndTime:   timestamp.TimePtr(startTime.Add(-time.Hour)),
+			}},
+		},
+	})
+	s.Equal(errInvalidBackfillRange, err)
+
+	_, err = wh.PatchSchedule(context.Background(), &adminservice.PatchScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+	})
+	s.Equal(errSchedulePatchNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteSchedule() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.TerminateWorkflowExecutionRequest, _ ...interface{}) (*historyservice.TerminateWorkflowExecutionResponse, error) {
+			s.Equal(s.testNamespaceID.String(), request.GetNamespaceId())
+			s.Equal(scheduler.WorkflowIDPrefix+"sched-id", request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
+			s.Equal("operator", request.GetTerminateRequest().GetIdentity())
+			return &historyservice.TerminateWorkflowExecutionResponse{}, nil
+		})
+
+	_, err := wh.DeleteSchedule(context.Background(), &adminservice.DeleteScheduleRequest{
+		Namespace:  s.testNamespace.String(),
+		ScheduleId: "sched-id",
+		Identity:   "operator",
+	})
+	s.NoError(err)
+
+	_, err = wh.DeleteSchedule(context.Background(), &adminservice.DeleteScheduleRequest{
+		Namespace: s.testNamespace.String(),
+	})
+	s.Equal(errScheduleIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestListSchedules() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"owner": payload.EncodeString("team")}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockVisibilityMgr.EXPECT().ListOpenWorkflowExecutionsByType(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.ListWorkflowExecutionsByTypeRequest) (*manager.ListWorkflowExecutionsResponse, error) {
+			s.Equal(s.testNamespaceID, request.NamespaceID)
+			s.Equal(scheduler.WorkflowType, request.WorkflowTypeName)
+			s.Equal(5, request.PageSize)
+			s.Equal([]byte("token"), request.NextPageToken)
+			return &manager.ListWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{
+					{
+						Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + "sched-id"},
+						Memo:      memo,
+					},
+					// not started by CreateSchedule
+					{Execution: &commonpb.WorkflowExecution{WorkflowId: "other-id"}},
+				},
+				NextPageToken: []byte("next-token"),
+			}, nil
+		})
+
+	resp, err := wh.ListSchedules(context.Background(), &adminservice.ListSchedulesRequest{
+		Namespace:       s.testNamespace.String(),
+		MaximumPageSize: 5,
+		NextPageToken:   []byte("token"),
+	})
+	s.NoError(err)
+	s.Len(resp.Schedules, 1)
+	s.Equal("sched-id", resp.Schedules[0].GetScheduleId())
+	s.Equal(memo, resp.Schedules[0].GetMemo())
+	s.Equal([]byte("next-token"), resp.NextPageToken)
+}
+
+func (s *workflowHandlerSuite) TestPreviewScheduleSpec() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	resp, err := wh.PreviewScheduleSpec(context.Background(), &adminservice.PreviewScheduleSpecRequest{
+		Namespace: s.testNamespace.String(),
+		Spec: &schedpb.ScheduleSpec{
+			Calendar: []*schedpb.CalendarSpec{{Hour: "9", DayOfWeek: "mon-fri"}},
+		},
+		StartTime: timestamp.TimePtr(time.Date(2022, 6, 3, 12, 0, 0, 0, time.UTC)), // friday
+		Count:     2,
+	})
+	s.NoError(err)
+	s.Len(resp.Times, 2)
+	s.Equal(time.Date(2022, 6, 6, 9, 0, 0, 0, time.UTC), *resp.Times[0].NominalTime)
+	s.Equal(time.Date(2022, 6, 7, 9, 0, 0, 0, time.UTC), *resp.Times[1].NominalTime)
+
+	_, err = wh.PreviewScheduleSpec(context.Background(), &adminservice.PreviewScheduleSpecRequest{
+		Namespace: s.testNamespace.String(),
+		Spec: &schedpb.ScheduleSpec{
+			Calendar: []*schedpb.CalendarSpec{{Hour: "25"}},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "calendar[0].hour")
+}
+
 func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNoopClient(), s.mockResource.GetLogger()), numHistoryShards, "", false)
 }
@@ -236,6 +236,7 @@ type Config struct {
 	VisibilityTaskBatchSize                                dynamicconfig.IntPropertyFn
 	VisibilityTaskWorkerCount                              dynamicconfig.IntPropertyFn
 	VisibilityTaskMaxRetryCount                            dynamicconfig.IntPropertyFn
+	VisibilityTaskDLQMaxAttempts                           dynamicconfig.IntPropertyFn
 	VisibilityProcessorEnablePriorityTaskScheduler         dynamicconfig.BoolPropertyFn
 	VisibilityProcessorSchedulerWorkerCount                dynamicconfig.IntPropertyFn
 	VisibilityProcessorSchedulerQueueSize                  dynamicconfig.IntPropertyFn
@@ -436,6 +437,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		VisibilityProcessorMaxPollRPS:                          dc.GetIntProperty(dynamicconfig.VisibilityProcessorMaxPollRPS, 20),
 		VisibilityTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.VisibilityTaskWorkerCount, 10),
 		VisibilityTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.VisibilityTaskMaxRetryCount, 100),
+		VisibilityTaskDLQMaxAttempts:                           dc.GetIntProperty(dynamicconfig.VisibilityTaskDLQMaxAttempts, 0),
 		VisibilityProcessorEnablePriorityTaskScheduler:         dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnablePriorityTaskScheduler, false),
 		VisibilityProcessorSchedulerWorkerCount:                dc.GetIntProperty(dynamicconfig.VisibilityProcessorSchedulerWorkerCount, 200),
 		VisibilityProcessorSchedulerQueueSize:                  dc.GetIntProperty(dynamicconfig.VisibilityProcessorSchedulerQueueSize, 10000),
@@ -251,6 +251,8 @@ func VisibilityManagerProvider(
 		serviceConfig.AdvancedVisibilityWritingMode,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false), // history visibility never read
 		serviceConfig.EnableWriteToSecondaryAdvancedVisibility,
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false), // history visibility never read
+		dynamicconfig.GetDurationPropertyFn(0),
 		metricsClient,
 		logger,
 	)
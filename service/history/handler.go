@@ -988,6 +988,39 @@ func (h *Handler) RemoveSignalMutableState(ctx context.Context, request *history
 	return &historyservice.RemoveSignalMutableStateResponse{}, nil
 }
 
+// UpsertWorkflowExecutionMetadata merges search attributes and memo into those of a running workflow without
+// recording an event. This is currently used by batch operations.
+func (h *Handler) UpsertWorkflowExecutionMetadata(ctx context.Context, request *historyservice.UpsertWorkflowExecutionMetadataRequest) (_ *historyservice.UpsertWorkflowExecutionMetadataResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(ctx, namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngineWithContext(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	err2 := engine.UpsertWorkflowExecutionMetadata(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return &historyservice.UpsertWorkflowExecutionMetadataResponse{}, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx context.Context, request *historyservice.TerminateWorkflowExecutionRequest) (_ *historyservice.TerminateWorkflowExecutionResponse, retError error) {
@@ -109,6 +109,7 @@ type (
 		matchingClient             matchingservice.MatchingServiceClient
 		rawMatchingClient          matchingservice.MatchingServiceClient
 		replicationDLQHandler      replication.DLQHandler
+		visibilityTaskDLQHandler   *visibilityTaskDLQHandler
 		searchAttributesValidator  *searchattribute.Validator
 		workflowDeleteManager      workflow.DeleteManager
 		eventSerializer            serialization.Serializer
@@ -128,6 +129,7 @@ func NewEngineWithShardContext(
 	archivalClient archiver.Client,
 	eventSerializer serialization.Serializer,
 	queueProcessorFactories []queues.ProcessorFactory,
+	visibilityTaskDLQ persistence.VisibilityTaskDLQ,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -214,6 +216,7 @@ func NewEngineWithShardContext(
 
 	historyEngImpl.workflowTaskHandler = newWorkflowTaskHandlerCallback(historyEngImpl)
 	historyEngImpl.replicationDLQHandler = replication.NewLazyDLQHandler(shard, workflowDeleteManager, historyCache)
+	historyEngImpl.visibilityTaskDLQHandler = newVisibilityTaskDLQHandler(shard, visibilityTaskDLQ)
 
 	return historyEngImpl
 }
@@ -1775,6 +1778,9 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	request := signalRequest.SignalRequest
 	parentExecution := signalRequest.ExternalWorkflowExecution
 	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
+	if request.GetSignalName() == workflow.UpsertSearchAttributesAndMemoSignalName {
+		return consts.ErrReservedSignalName
+	}
 
 	return e.updateWorkflow(
 		ctx,
@@ -1855,6 +1861,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		return nil, err
 	}
 	namespaceID := namespaceEntry.ID()
+	if signalWithStartRequest.GetSignalWithStartRequest().GetSignalName() == workflow.UpsertSearchAttributesAndMemoSignalName {
+		return nil, consts.ErrReservedSignalName
+	}
 
 	var currentWorkflowContext api.WorkflowContext
 
@@ -1887,6 +1896,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	// Start workflow and signal
 	startRequest := e.getStartRequest(namespaceID, signalWithStartRequest.SignalWithStartRequest)
+	startRequest.PriorityKey = signalWithStartRequest.GetPriorityKey()
+	startRequest.FairnessKey = signalWithStartRequest.GetFairnessKey()
 	request := startRequest.StartRequest
 	e.overrideStartWorkflowExecutionRequest(request, metrics.HistorySignalWithStartWorkflowExecutionScope)
 	err = e.validateStartWorkflowExecutionRequest(ctx, request, namespaceEntry, "SignalWithStartWorkflowExecution")
@@ -1943,6 +1954,86 @@ func (e *historyEngineImpl) RemoveSignalMutableState(
 		})
 }
 
+// UpsertWorkflowExecutionMetadata merges search attributes and memo into those of a running workflow
+// outside of a workflow task, it is used by batch operations which update many workflows.
+// See MutableState.UpsertWorkflowSearchAttributesAndMemo for how the change is recorded.
+func (e *historyEngineImpl) UpsertWorkflowExecutionMetadata(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowExecutionMetadataRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return err
+	}
+	namespaceName := namespaceEntry.Name().String()
+
+	searchAttributes := request.GetSearchAttributes()
+	memo := request.GetMemo()
+	if len(searchAttributes.GetIndexedFields()) == 0 && len(memo.GetFields()) == 0 {
+		return serviceerror.NewInvalidArgument("Neither search attributes nor memo are set on request.")
+	}
+	if len(searchAttributes.GetIndexedFields()) > 0 {
+		// Empty values unset search attributes and are not validated.
+		searchAttributesToValidate := &commonpb.SearchAttributes{
+			IndexedFields: make(map[string]*commonpb.Payload, len(searchAttributes.GetIndexedFields())),
+		}
+		for saName, saPayload := range searchAttributes.GetIndexedFields() {
+			if !searchattribute.IsEmptyValue(saPayload) {
+				searchAttributesToValidate.IndexedFields[saName] = saPayload
+			}
+		}
+		if err := e.searchAttributesValidator.Validate(searchAttributesToValidate, namespaceName, e.config.DefaultVisibilityIndexName); err != nil {
+			return err
+		}
+		if err := e.searchAttributesValidator.ValidateSize(searchAttributesToValidate, namespaceName); err != nil {
+			return err
+		}
+		if err := searchattribute.SubstituteAliases(e.shard.GetSearchAttributesMapper(), searchAttributes, namespaceName); err != nil {
+			return err
+		}
+	}
+	if len(memo.GetFields()) > 0 {
+		if err := common.CheckEventBlobSizeLimit(
+			memo.Size(),
+			e.config.MemoSizeLimitWarn(namespaceName),
+			e.config.MemoSizeLimitError(namespaceName),
+			namespaceName,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			e.metricsClient.Scope(metrics.HistoryUpsertWorkflowExecutionMetadataScope),
+			e.throttledLogger,
+			tag.BlobSizeViolationOperation("UpsertWorkflowExecutionMetadata"),
+		); err != nil {
+			return err
+		}
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			request.NamespaceId,
+			request.Execution.WorkflowId,
+			request.Execution.RunId,
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			if err := mutableState.UpsertWorkflowSearchAttributesAndMemo(searchAttributes, memo); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		})
+}
+
 func (e *historyEngineImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	terminateRequest *historyservice.TerminateWorkflowExecutionRequest,
@@ -2969,6 +3060,21 @@ func (e *historyEngineImpl) MergeDLQMessages(
 	request *historyservice.MergeDLQMessagesRequest,
 ) (*historyservice.MergeDLQMessagesResponse, error) {
 
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_VISIBILITY {
+		token, err := e.visibilityTaskDLQHandler.MergeMessages(
+			ctx,
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+		}, nil
+	}
+
 	_, ok := e.clusterMetadata.GetAllClusterInfo()[request.GetSourceCluster()]
 	if !ok {
 		return nil, consts.ErrUnknownCluster
@@ -27,6 +27,7 @@ package history
 import (
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
@@ -51,6 +52,7 @@ type (
 		ArchivalClient          archiver.Client
 		EventSerializer         serialization.Serializer
 		QueueProcessorFactories []queues.ProcessorFactory `group:"queueProcessorFactory"`
+		VisibilityTaskDLQ       persistence.VisibilityTaskDLQ
 	}
 
 	historyEngineFactory struct {
@@ -72,5 +74,6 @@ func (f *historyEngineFactory) CreateEngine(
 		f.ArchivalClient,
 		f.EventSerializer,
 		f.QueueProcessorFactories,
+		f.VisibilityTaskDLQ,
 	)
 }
@@ -69,6 +69,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -4817,6 +4818,23 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_ReservedSignalName() {
+	signalRequest := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace: tests.NamespaceID.String(),
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: tests.WorkflowID,
+				RunId:      tests.RunID,
+			},
+			SignalName: workflow.UpsertSearchAttributesAndMemoSignalName,
+		},
+	}
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(consts.ErrReservedSignalName, err)
+}
+
 // Test signal workflow task by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &historyservice.SignalWorkflowExecutionRequest{}
@@ -5039,6 +5057,100 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestUpsertWorkflowExecutionMetadata() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	upsertRequest := &historyservice.UpsertWorkflowExecutionMetadataRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution:   &execution,
+	}
+	err := s.mockHistoryEngine.UpsertWorkflowExecutionMetadata(context.Background(), upsertRequest)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	upsertRequest.Memo = &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")},
+	}
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), tests.RunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "testIdentity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := workflow.TestCloneToProto(msBuilder)
+	ms.ExecutionInfo.NamespaceId = tests.NamespaceID.String()
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(upsertRequest.Memo.Fields, request.UpdateWorkflowMutation.ExecutionInfo.Memo)
+			s.Len(request.UpdateWorkflowMutation.Tasks[tasks.CategoryVisibility], 1)
+			s.Equal(int64(0), request.UpdateWorkflowMutation.ExecutionInfo.SignalCount)
+			// the change is recorded in history so that it is replicated and survives rebuild and reset
+			s.Len(request.UpdateWorkflowEvents[0].Events, 1)
+			attributes := request.UpdateWorkflowEvents[0].Events[0].GetWorkflowExecutionSignaledEventAttributes()
+			s.Equal(workflow.UpsertSearchAttributesAndMemoSignalName, attributes.GetSignalName())
+			var searchAttributes *commonpb.SearchAttributes
+			var memo *commonpb.Memo
+			s.NoError(payloads.Decode(attributes.GetInput(), &searchAttributes, &memo))
+			s.Nil(searchAttributes)
+			s.Equal(upsertRequest.Memo, memo)
+			return tests.UpdateWorkflowExecutionResponse, nil
+		})
+
+	err = s.mockHistoryEngine.UpsertWorkflowExecutionMetadata(context.Background(), upsertRequest)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestUpsertWorkflowExecutionMetadata_UnsetSearchAttribute() {
+	s.mockHistoryEngine.searchAttributesValidator = searchattribute.NewValidator(
+		searchattribute.NewTestProvider(),
+		s.mockShard.Resource.SearchAttributesMapper,
+		s.config.SearchAttributesNumberOfKeysLimit,
+		s.config.SearchAttributesSizeOfValueLimit,
+		s.config.SearchAttributesTotalSizeLimit,
+	)
+	s.mockShard.Resource.SearchAttributesMapper.EXPECT().GetFieldName(gomock.Any(), tests.Namespace.String()).DoAndReturn(
+		func(alias string, _ string) (string, error) { return alias, nil },
+	).AnyTimes()
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	customTextField := payload.EncodeString("text")
+	upsertRequest := &historyservice.UpsertWorkflowExecutionMetadataRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution:   &execution,
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeywordField": {},
+				"CustomTextField":    customTextField,
+			},
+		},
+	}
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), tests.RunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "testIdentity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := workflow.TestCloneToProto(msBuilder)
+	ms.ExecutionInfo.NamespaceId = tests.NamespaceID.String()
+	ms.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("value"),
+	}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(gwmsResponse, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(map[string]*commonpb.Payload{"CustomTextField": customTextField}, request.UpdateWorkflowMutation.ExecutionInfo.SearchAttributes)
+			return tests.UpdateWorkflowExecutionResponse, nil
+		})
+
+	err := s.mockHistoryEngine.UpsertWorkflowExecutionMetadata(context.Background(), upsertRequest)
+	s.NoError(err)
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := commonpb.WorkflowExecution{
 		WorkflowId: "test-reapply",
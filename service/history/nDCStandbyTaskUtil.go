@@ -106,6 +106,8 @@ type (
 
 		taskQueue                          string
 		activityTaskScheduleToStartTimeout time.Duration
+		priorityKey                        int32
+		fairnessKey                        string
 	}
 
 	workflowTaskPostActionInfo struct {
@@ -113,6 +115,9 @@ type (
 
 		workflowTaskScheduleToStartTimeout int64
 		taskqueue                          taskqueuepb.TaskQueue
+		buildID                            string
+		priorityKey                        int32
+		fairnessKey                        string
 	}
 
 	startChildExecutionPostActionInfo struct {
@@ -141,6 +146,8 @@ func newHistoryResendInfo(
 func newActivityTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	activityScheduleToStartTimeout time.Duration,
+	priorityKey int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -150,6 +157,8 @@ func newActivityTaskPostActionInfo(
 	return &activityTaskPostActionInfo{
 		historyResendInfo:                  resendInfo,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priorityKey:                        priorityKey,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -157,6 +166,8 @@ func newActivityRetryTimePostActionInfo(
 	mutableState workflow.MutableState,
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
+	priorityKey int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -167,6 +178,8 @@ func newActivityRetryTimePostActionInfo(
 		historyResendInfo:                  resendInfo,
 		taskQueue:                          taskQueue,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priorityKey:                        priorityKey,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -184,6 +197,9 @@ func newWorkflowTaskPostActionInfo(
 		historyResendInfo:                  resendInfo,
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
+		buildID:                            mutableState.GetExecutionInfo().GetWorkerBuildId(),
+		priorityKey:                        mutableState.GetExecutionInfo().GetPriorityKey(),
+		fairnessKey:                        mutableState.GetExecutionInfo().GetFairnessKey(),
 	}, nil
 }
 
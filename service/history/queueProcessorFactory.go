@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
@@ -101,7 +102,8 @@ type (
 
 		SchedulerParams
 
-		VisibilityMgr manager.VisibilityManager
+		VisibilityMgr     manager.VisibilityManager
+		VisibilityTaskDLQ persistence.VisibilityTaskDLQ
 	}
 
 	replicationQueueProcessorFactoryParams struct {
@@ -280,6 +282,7 @@ func (f *visibilityQueueProcessorFactory) CreateProcessor(
 		workflowCache,
 		f.scheduler,
 		f.VisibilityMgr,
+		f.VisibilityTaskDLQ,
 	)
 }
 
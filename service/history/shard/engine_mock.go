@@ -651,6 +651,20 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// UpsertWorkflowExecutionMetadata mocks base method.
+func (m *MockEngine) UpsertWorkflowExecutionMetadata(ctx context.Context, request *historyservice.UpsertWorkflowExecutionMetadataRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowExecutionMetadata", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowExecutionMetadata indicates an expected call of UpsertWorkflowExecutionMetadata.
+func (mr *MockEngineMockRecorder) UpsertWorkflowExecutionMetadata(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowExecutionMetadata", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowExecutionMetadata), ctx, request)
+}
+
 // VerifyChildExecutionCompletionRecorded mocks base method.
 func (m *MockEngine) VerifyChildExecutionCompletionRecorded(ctx context.Context, request *historyservice.VerifyChildExecutionCompletionRecordedRequest) error {
 	m.ctrl.T.Helper()
@@ -421,6 +421,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
 	}
 	scheduleToStartTimeout := timestamp.DurationValue(activityInfo.ScheduleToStartTimeout)
+	priorityKey := activityInfo.PriorityKey
+	fairnessKey := activityInfo.FairnessKey
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -436,6 +438,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleId:             task.EventID,
 		ScheduleToStartTimeout: timestamp.DurationPtr(scheduleToStartTimeout),
 		Clock:                  vclock.NewShardClock(t.shard.GetShardID(), task.TaskID),
+		PriorityKey:            priorityKey,
+		FairnessKey:            fairnessKey,
 	})
 
 	return retError
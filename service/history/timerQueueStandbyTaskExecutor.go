@@ -290,7 +290,7 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 			return nil, nil
 		}
 
-		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, *activityInfo.ScheduleToStartTimeout)
+		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, *activityInfo.ScheduleToStartTimeout, activityInfo.PriorityKey, activityInfo.FairnessKey)
 	}
 
 	return t.processTimer(
@@ -586,6 +586,8 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		ScheduleId:             activityTask.EventID,
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
 		Clock:                  vclock.NewShardClock(t.shard.GetShardID(), activityTask.TaskID),
+		PriorityKey:            pushActivityInfo.priorityKey,
+		FairnessKey:            pushActivityInfo.fairnessKey,
 	})
 	return err
 }
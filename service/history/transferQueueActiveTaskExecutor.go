@@ -168,12 +168,14 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
+	priorityKey := ai.PriorityKey
+	fairnessKey := ai.FairnessKey
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, &timeout)
+	return t.pushActivity(ctx, task, &timeout, priorityKey, fairnessKey)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -233,12 +235,15 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	}
 
 	originalTaskQueue := mutableState.GetExecutionInfo().TaskQueue
+	buildID := executionInfo.WorkerBuildId
+	priorityKey := executionInfo.PriorityKey
+	fairnessKey := executionInfo.FairnessKey
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	err = t.pushWorkflowTask(ctx, task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds))
+	err = t.pushWorkflowTask(ctx, task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds), buildID, priorityKey, fairnessKey)
 
 	if _, ok := err.(*serviceerrors.StickyWorkerUnavailable); ok {
 		// sticky worker is unavailable, switch to original task queue
@@ -253,7 +258,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushWorkflowTask(ctx, task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds))
+		err = t.pushWorkflowTask(ctx, task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds), buildID, priorityKey, fairnessKey)
 	}
 	return err
 }
@@ -772,6 +777,8 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 		targetNamespaceName,
 		childInfo.CreateRequestId,
 		attributes,
+		mutableState.GetExecutionInfo().GetPriorityKey(),
+		mutableState.GetExecutionInfo().GetFairnessKey(),
 	)
 	if err != nil {
 		t.logger.Debug("Failed to start child workflow execution", tag.Error(err))
@@ -1283,6 +1290,8 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 	targetNamespace namespace.Name,
 	childRequestID string,
 	attributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
+	priorityKey int32,
+	fairnessKey string,
 ) (string, *clockspb.ShardClock, error) {
 	request := common.CreateHistoryStartWorkflowRequest(
 		task.TargetNamespaceID,
@@ -1318,6 +1327,9 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 		},
 		t.shard.GetTimeSource().Now(),
 	)
+	// child workflows inherit the priority and fairness key of their parent
+	request.PriorityKey = priorityKey
+	request.FairnessKey = fairnessKey
 
 	var response *historyservice.StartWorkflowExecutionResponse
 	var err error
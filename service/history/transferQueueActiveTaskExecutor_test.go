@@ -2371,6 +2371,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddWorkflowTaskRequest(
 		ScheduleId:             task.ScheduleID,
 		ScheduleToStartTimeout: &timeout,
 		Clock:                  vclock.NewShardClock(s.mockShard.GetShardID(), task.TaskID),
+		BuildId:                executionInfo.GetWorkerBuildId(),
 	}
 }
 
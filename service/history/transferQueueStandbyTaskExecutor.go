@@ -133,7 +133,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, *activityInfo.ScheduleToStartTimeout)
+			return newActivityTaskPostActionInfo(mutableState, *activityInfo.ScheduleToStartTimeout, activityInfo.PriorityKey, activityInfo.FairnessKey)
 		}
 
 		return nil, nil
@@ -524,6 +524,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*tasks.ActivityTask),
 		&timeout,
+		pushActivityInfo.priorityKey,
+		pushActivityInfo.fairnessKey,
 	)
 }
 
@@ -545,6 +547,9 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		task.(*tasks.WorkflowTask),
 		&pushwtInfo.taskqueue,
 		timestamp.DurationFromSeconds(timeout),
+		pushwtInfo.buildID,
+		pushwtInfo.priorityKey,
+		pushwtInfo.fairnessKey,
 	)
 }
 
@@ -99,6 +99,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	ctx context.Context,
 	task *tasks.ActivityTask,
 	activityScheduleToStartTimeout *time.Duration,
+	priorityKey int32,
+	fairnessKey string,
 ) error {
 	_, err := t.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
 		NamespaceId:       task.NamespaceID,
@@ -114,6 +116,8 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		ScheduleId:             task.ScheduleID,
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
 		Clock:                  vclock.NewShardClock(t.shard.GetShardID(), task.TaskID),
+		PriorityKey:            priorityKey,
+		FairnessKey:            fairnessKey,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -129,6 +133,9 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	task *tasks.WorkflowTask,
 	taskqueue *taskqueuepb.TaskQueue,
 	workflowTaskScheduleToStartTimeout *time.Duration,
+	buildID string,
+	priorityKey int32,
+	fairnessKey string,
 ) error {
 	_, err := t.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
 		NamespaceId: task.NamespaceID,
@@ -140,6 +147,9 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		ScheduleId:             task.ScheduleID,
 		ScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		Clock:                  vclock.NewShardClock(t.shard.GetShardID(), task.TaskID),
+		BuildId:                buildID,
+		PriorityKey:            priorityKey,
+		FairnessKey:            fairnessKey,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
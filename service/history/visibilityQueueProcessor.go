@@ -76,6 +76,7 @@ func newVisibilityQueueProcessor(
 	workflowCache workflow.Cache,
 	scheduler queues.Scheduler,
 	visibilityMgr manager.VisibilityManager,
+	visibilityTaskDLQ persistence.VisibilityTaskDLQ,
 ) queues.Processor {
 
 	config := shard.GetConfig()
@@ -136,6 +137,7 @@ func newVisibilityQueueProcessor(
 		shard,
 		workflowCache,
 		visibilityMgr,
+		visibilityTaskDLQ,
 		logger,
 	)
 
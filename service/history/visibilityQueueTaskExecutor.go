@@ -34,7 +34,10 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/queues"
@@ -49,6 +52,7 @@ type (
 		cache         workflow.Cache
 		logger        log.Logger
 		visibilityMgr manager.VisibilityManager
+		dlqHandler    *visibilityTaskDLQHandler
 	}
 )
 
@@ -60,6 +64,7 @@ func newVisibilityQueueTaskExecutor(
 	shard shard.Context,
 	workflowCache workflow.Cache,
 	visibilityMgr manager.VisibilityManager,
+	visibilityTaskDLQ persistence.VisibilityTaskDLQ,
 	logger log.Logger,
 ) *visibilityQueueTaskExecutor {
 	return &visibilityQueueTaskExecutor{
@@ -67,6 +72,7 @@ func newVisibilityQueueTaskExecutor(
 		cache:         workflowCache,
 		logger:        logger,
 		visibilityMgr: visibilityMgr,
+		dlqHandler:    newVisibilityTaskDLQHandler(shard, visibilityTaskDLQ),
 	}
 }
 
@@ -74,7 +80,18 @@ func (t *visibilityQueueTaskExecutor) Execute(
 	ctx context.Context,
 	executable queues.Executable,
 ) error {
-	switch task := executable.GetTask().(type) {
+	err := t.execute(ctx, executable.GetTask())
+	if err != nil && t.shouldMoveToDLQ(executable, err) {
+		return t.moveToDLQ(ctx, executable.GetTask(), err)
+	}
+	return err
+}
+
+func (t *visibilityQueueTaskExecutor) execute(
+	ctx context.Context,
+	task tasks.Task,
+) error {
+	switch task := task.(type) {
 	case *tasks.StartExecutionVisibilityTask:
 		return t.processStartExecution(ctx, task)
 	case *tasks.UpsertExecutionVisibilityTask:
@@ -88,6 +105,45 @@ func (t *visibilityQueueTaskExecutor) Execute(
 	}
 }
 
+// shouldMoveToDLQ returns true if the task has failed for the max number of attempts
+// configured for visibility task DLQ, instead of retrying forever and blocking the ack level
+// of the visibility queue.
+func (t *visibilityQueueTaskExecutor) shouldMoveToDLQ(
+	executable queues.Executable,
+	err error,
+) bool {
+	maxAttempts := t.shard.GetConfig().VisibilityTaskDLQMaxAttempts()
+	if maxAttempts <= 0 || executable.Attempt() < maxAttempts {
+		return false
+	}
+
+	switch err.(type) {
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// these errors are ignored by the executable and the task won't be retried.
+		return false
+	}
+	return true
+}
+
+func (t *visibilityQueueTaskExecutor) moveToDLQ(
+	ctx context.Context,
+	task tasks.Task,
+	taskErr error,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	messageID, err := t.dlqHandler.EnqueueTask(ctx, task)
+	if err != nil {
+		t.logger.Error("Failed to move visibility task to DLQ.", append(tasks.Tags(task), tag.Error(err))...)
+		return taskErr
+	}
+
+	t.shard.GetMetricsClient().IncCounter(tasks.GetVisibilityTaskMetricsScope(task), metrics.TaskMovedToDLQ)
+	t.logger.Warn("Visibility task moved to DLQ after max attempts.", append(tasks.Tags(task), tag.NewInt64("dlq-message-id", messageID), tag.Error(taskErr))...)
+	return nil
+}
+
 func (t *visibilityQueueTaskExecutor) processStartExecution(
 	ctx context.Context,
 	task *tasks.StartExecutionVisibilityTask,
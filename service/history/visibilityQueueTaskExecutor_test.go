@@ -36,9 +36,11 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
@@ -46,6 +48,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -70,6 +73,7 @@ type (
 		mockShard  *shard.ContextTest
 
 		mockVisibilityMgr *manager.MockVisibilityManager
+		mockVisibilityDLQ *persistence.MockVisibilityTaskDLQ
 		mockExecutionMgr  *persistence.MockExecutionManager
 
 		logger                      log.Logger
@@ -131,6 +135,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 
 	s.mockExecutionMgr = s.mockShard.Resource.ExecutionMgr
 	s.mockVisibilityMgr = manager.NewMockVisibilityManager(s.controller)
+	s.mockVisibilityDLQ = persistence.NewMockVisibilityTaskDLQ(s.controller)
 
 	mockNamespaceCache := s.mockShard.Resource.NamespaceCache
 	mockNamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
@@ -168,6 +173,7 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		s.mockShard,
 		h.historyCache,
 		s.mockVisibilityMgr,
+		s.mockVisibilityDLQ,
 		s.logger,
 	)
 }
@@ -350,6 +356,73 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttrib
 	s.NoError(err)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttributes_MoveToDLQ() {
+	s.mockShard.GetConfig().VisibilityTaskDLQMaxAttempts = dynamicconfig.GetIntPropertyFn(2)
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+
+	taskID := int64(59)
+	di := addWorkflowTaskScheduledEvent(mutableState)
+
+	visibilityTask := &tasks.UpsertExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version: s.version,
+		TaskID:  taskID,
+	}
+
+	upsertErr := serviceerror.NewInternal("some random visibility error")
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, di.ScheduleID, di.Version)
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).Return(upsertErr).Times(2)
+
+	executable := queues.NewMockExecutable(s.controller)
+	executable.EXPECT().GetTask().Return(visibilityTask).AnyTimes()
+	executable.EXPECT().Attempt().Return(1)
+	err = s.visibilityQueueTaskExecutor.Execute(context.Background(), executable)
+	s.Equal(upsertErr, err)
+
+	executable.EXPECT().Attempt().Return(2)
+
+	s.mockVisibilityDLQ.EXPECT().EnqueueTask(gomock.Any(), s.mockShard.GetShardID(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int32, taskInfo *persistencespb.VisibilityTaskInfo) (int64, error) {
+			s.Equal(enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION, taskInfo.GetTaskType())
+			s.Equal(s.namespaceID.String(), taskInfo.GetNamespaceId())
+			s.Equal(execution.GetWorkflowId(), taskInfo.GetWorkflowId())
+			s.Equal(execution.GetRunId(), taskInfo.GetRunId())
+			s.Equal(taskID, taskInfo.GetTaskId())
+			return 1, nil
+		},
+	)
+	err = s.visibilityQueueTaskExecutor.Execute(context.Background(), executable)
+	s.NoError(err)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) createRecordWorkflowExecutionStartedRequest(
 	namespaceName namespace.Name,
 	startEvent *historypb.HistoryEvent,
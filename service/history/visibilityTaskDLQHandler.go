@@ -0,0 +1,141 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// visibilityTaskDLQHandler moves visibility tasks of a shard into the shard's
+	// visibility task DLQ, and merges them back into the visibility queue
+	visibilityTaskDLQHandler struct {
+		shard          shard.Context
+		dlq            persistence.VisibilityTaskDLQ
+		taskSerializer *serialization.TaskSerializer
+		logger         log.Logger
+	}
+)
+
+func newVisibilityTaskDLQHandler(
+	shard shard.Context,
+	dlq persistence.VisibilityTaskDLQ,
+) *visibilityTaskDLQHandler {
+	return &visibilityTaskDLQHandler{
+		shard:          shard,
+		dlq:            dlq,
+		taskSerializer: serialization.NewTaskSerializer(),
+		logger:         shard.GetLogger(),
+	}
+}
+
+func (h *visibilityTaskDLQHandler) EnqueueTask(
+	ctx context.Context,
+	task tasks.Task,
+) (int64, error) {
+
+	blob, err := h.taskSerializer.SerializeTask(task)
+	if err != nil {
+		return persistence.EmptyQueueMessageID, err
+	}
+	taskInfo, err := serialization.VisibilityTaskInfoFromBlob(blob.Data, blob.EncodingType.String())
+	if err != nil {
+		return persistence.EmptyQueueMessageID, err
+	}
+	return h.dlq.EnqueueTask(ctx, h.shard.GetShardID(), taskInfo)
+}
+
+func (h *visibilityTaskDLQHandler) MergeMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	taskInfos, token, err := h.dlq.ReadTasks(
+		ctx,
+		h.shard.GetShardID(),
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, taskInfo := range taskInfos {
+		// task ID of a DLQ task is overwritten to its DLQ message ID,
+		// the re-enqueued task will be assigned a new task ID by shard
+		messageID := taskInfo.GetTaskId()
+		if err := h.reEnqueueTask(ctx, taskInfo); err != nil {
+			return nil, err
+		}
+		if err := h.dlq.DeleteTask(ctx, h.shard.GetShardID(), messageID); err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
+
+func (h *visibilityTaskDLQHandler) reEnqueueTask(
+	ctx context.Context,
+	taskInfo *persistencespb.VisibilityTaskInfo,
+) error {
+
+	blob, err := serialization.VisibilityTaskInfoToBlob(taskInfo)
+	if err != nil {
+		return err
+	}
+	task, err := h.taskSerializer.DeserializeTask(tasks.CategoryVisibility, blob)
+	if err != nil {
+		return err
+	}
+
+	err = h.shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: h.shard.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID: taskInfo.GetNamespaceId(),
+		WorkflowID:  taskInfo.GetWorkflowId(),
+		RunID:       taskInfo.GetRunId(),
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryVisibility: {task},
+		},
+	})
+	if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+		// namespace is deleted, it is safe to drop the task.
+		h.logger.Info("Dropping visibility DLQ task of deleted namespace.", tasks.Tags(task)...)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	visibilityTaskDLQHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		mockShard  *shard.MockContext
+		mockDLQ    *persistence.MockVisibilityTaskDLQ
+
+		shardID    int32
+		dlqHandler *visibilityTaskDLQHandler
+	}
+)
+
+func TestVisibilityTaskDLQHandlerSuite(t *testing.T) {
+	s := new(visibilityTaskDLQHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityTaskDLQHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = shard.NewMockContext(s.controller)
+	s.mockDLQ = persistence.NewMockVisibilityTaskDLQ(s.controller)
+
+	s.shardID = 10
+	s.mockShard.EXPECT().GetShardID().Return(s.shardID).AnyTimes()
+	s.mockShard.EXPECT().GetLogger().Return(log.NewNoopLogger()).AnyTimes()
+
+	s.dlqHandler = newVisibilityTaskDLQHandler(s.mockShard, s.mockDLQ)
+}
+
+func (s *visibilityTaskDLQHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityTaskDLQHandlerSuite) TestEnqueueTask() {
+	task := &tasks.CloseExecutionVisibilityTask{
+		WorkflowKey:         definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              123,
+		Version:             2,
+	}
+
+	s.mockDLQ.EXPECT().EnqueueTask(gomock.Any(), s.shardID, &persistencespb.VisibilityTaskInfo{
+		NamespaceId:    tests.NamespaceID.String(),
+		WorkflowId:     tests.WorkflowID,
+		RunId:          tests.RunID,
+		TaskType:       enumsspb.TASK_TYPE_VISIBILITY_CLOSE_EXECUTION,
+		Version:        2,
+		TaskId:         123,
+		VisibilityTime: timestamp.TimePtr(task.VisibilityTimestamp),
+	}).Return(int64(5), nil)
+
+	messageID, err := s.dlqHandler.EnqueueTask(context.Background(), task)
+	s.NoError(err)
+	s.Equal(int64(5), messageID)
+}
+
+func (s *visibilityTaskDLQHandlerSuite) TestMergeMessages() {
+	runID := uuid.New()
+	taskInfos := []*persistencespb.VisibilityTaskInfo{
+		{
+			NamespaceId:    tests.NamespaceID.String(),
+			WorkflowId:     tests.WorkflowID,
+			RunId:          tests.RunID,
+			TaskType:       enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION,
+			TaskId:         1,
+			VisibilityTime: timestamp.TimePtr(time.Now().UTC()),
+		},
+		{
+			NamespaceId:    tests.NamespaceID.String(),
+			WorkflowId:     tests.WorkflowID,
+			RunId:          runID,
+			TaskType:       enumsspb.TASK_TYPE_VISIBILITY_CLOSE_EXECUTION,
+			TaskId:         3,
+			VisibilityTime: timestamp.TimePtr(time.Now().UTC()),
+		},
+	}
+	s.mockDLQ.EXPECT().ReadTasks(gomock.Any(), s.shardID, int64(10), 2, []byte("token")).
+		Return(taskInfos, []byte("next token"), nil)
+
+	gomock.InOrder(
+		s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+				s.Equal(tests.RunID, request.RunID)
+				s.Len(request.Tasks[tasks.CategoryVisibility], 1)
+				s.IsType(&tasks.UpsertExecutionVisibilityTask{}, request.Tasks[tasks.CategoryVisibility][0])
+				return nil
+			},
+		),
+		s.mockDLQ.EXPECT().DeleteTask(gomock.Any(), s.shardID, int64(1)).Return(nil),
+		// namespace of the second task is deleted, the task is dropped from DLQ
+		s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(serviceerror.NewNamespaceNotFound(tests.NamespaceID.String())),
+		s.mockDLQ.EXPECT().DeleteTask(gomock.Any(), s.shardID, int64(3)).Return(nil),
+	)
+
+	token, err := s.dlqHandler.MergeMessages(context.Background(), 10, 2, []byte("token"))
+	s.NoError(err)
+	s.Equal([]byte("next token"), token)
+}
+
+func (s *visibilityTaskDLQHandlerSuite) TestMergeMessages_AddTasksFailed() {
+	taskInfos := []*persistencespb.VisibilityTaskInfo{
+		{
+			NamespaceId:    tests.NamespaceID.String(),
+			WorkflowId:     tests.WorkflowID,
+			RunId:          tests.RunID,
+			TaskType:       enumsspb.TASK_TYPE_VISIBILITY_UPSERT_EXECUTION,
+			TaskId:         1,
+			VisibilityTime: timestamp.TimePtr(time.Now().UTC()),
+		},
+	}
+	s.mockDLQ.EXPECT().ReadTasks(gomock.Any(), s.shardID, int64(10), 2, nil).Return(taskInfos, nil, nil)
+	s.mockShard.EXPECT().AddTasks(gomock.Any(), gomock.Any()).Return(&persistence.ShardOwnershipLostError{})
+
+	_, err := s.dlqHandler.MergeMessages(context.Background(), 10, 2, nil)
+	s.Error(err)
+}
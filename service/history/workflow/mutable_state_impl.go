@@ -59,6 +59,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -1402,6 +1403,8 @@ func (e *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 		ContinuedFailure:         command.GetFailure(),
 		ContinueAsNewInitiator:   command.Initiator,
 		FirstWorkflowTaskBackoff: command.BackoffStartInterval,
+		PriorityKey:              previousExecutionInfo.PriorityKey,
+		FairnessKey:              previousExecutionInfo.FairnessKey,
 	}
 	if command.GetInitiator() == enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY {
 		req.Attempt = previousExecutionState.GetExecutionInfo().Attempt + 1
@@ -1485,6 +1488,8 @@ func (e *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 	); err != nil {
 		return nil, err
 	}
+	e.executionInfo.PriorityKey = startRequest.GetPriorityKey()
+	e.executionInfo.FairnessKey = startRequest.GetFairnessKey()
 
 	// TODO merge active & passive task generation
 	if err := e.taskGenerator.GenerateWorkflowStartTasks(
@@ -1914,6 +1919,8 @@ func (e *MutableStateImpl) ReplicateActivityTaskScheduledEvent(
 		TaskQueue:               attributes.TaskQueue.GetName(),
 		HasRetryPolicy:          attributes.RetryPolicy != nil,
 		Attempt:                 1,
+		PriorityKey:             e.executionInfo.PriorityKey,
+		FairnessKey:             e.executionInfo.FairnessKey,
 	}
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
@@ -2748,6 +2755,59 @@ func mergeMapOfPayload(
 	return current
 }
 
+// UpsertWorkflowSearchAttributesAndMemo merges search attributes and memo into those of the workflow.
+// The change is recorded as a WorkflowExecutionSignaled event with UpsertSearchAttributesAndMemoSignalName,
+// so it is applied by ReplicateWorkflowExecutionSignaled on standby clusters and on rebuild, and is
+// reapplied with the other signals when the workflow is reset.
+// Search attributes with empty value are removed from the workflow.
+func (e *MutableStateImpl) UpsertWorkflowSearchAttributesAndMemo(
+	searchAttributes *commonpb.SearchAttributes,
+	memo *commonpb.Memo,
+) error {
+
+	opTag := tag.WorkflowActionUpsertWorkflowSearchAttributes
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	input, err := payloads.Encode(searchAttributes, memo)
+	if err != nil {
+		return err
+	}
+	event, err := e.AddWorkflowExecutionSignaled(UpsertSearchAttributesAndMemoSignalName, input, consts.IdentityHistoryService, nil)
+	if err != nil {
+		return err
+	}
+	// TODO merge active & passive task generation
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks(
+		timestamp.TimeValue(event.GetEventTime()),
+	)
+}
+
+func (e *MutableStateImpl) replicateUpsertSearchAttributesAndMemo(
+	input *commonpb.Payloads,
+) error {
+
+	var searchAttributes *commonpb.SearchAttributes
+	var memo *commonpb.Memo
+	if err := payloads.Decode(input, &searchAttributes, &memo); err != nil {
+		return err
+	}
+
+	if len(searchAttributes.GetIndexedFields()) > 0 {
+		e.executionInfo.SearchAttributes = mergeMapOfPayload(e.executionInfo.SearchAttributes, searchAttributes.GetIndexedFields())
+		for saName, saPayload := range searchAttributes.GetIndexedFields() {
+			if searchattribute.IsEmptyValue(saPayload) {
+				delete(e.executionInfo.SearchAttributes, saName)
+			}
+		}
+	}
+	if len(memo.GetFields()) > 0 {
+		e.executionInfo.Memo = mergeMapOfPayload(e.executionInfo.Memo, memo.GetFields())
+	}
+	return nil
+}
+
 func (e *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	namespace namespace.Name,
@@ -3063,9 +3123,15 @@ func (e *MutableStateImpl) AddWorkflowExecutionSignaled(
 }
 
 func (e *MutableStateImpl) ReplicateWorkflowExecutionSignaled(
-	_ *historypb.HistoryEvent,
+	event *historypb.HistoryEvent,
 ) error {
 
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	if attributes.GetSignalName() == UpsertSearchAttributesAndMemoSignalName {
+		// recorded by UpsertWorkflowSearchAttributesAndMemo, doesn't count towards the signal limit
+		return e.replicateUpsertSearchAttributesAndMemo(attributes.GetInput())
+	}
+
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
 	return nil
@@ -38,6 +38,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -153,6 +154,44 @@ func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchReplica
 	s.Equal(0, s.mutableState.hBuilder.BufferEventSize())
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowTaskCompletedEvent_WorkerBuildId() {
+	version := int64(12)
+	runID := uuid.New()
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		version,
+		runID,
+	)
+	// Worker was rolled back to the build which is already in the reset points.
+	s.mutableState.GetExecutionInfo().AutoResetPoints = &workflowpb.ResetPoints{Points: []*workflowpb.ResetPointInfo{
+		{BinaryChecksum: "build-1"},
+		{BinaryChecksum: "build-2"},
+	}}
+
+	newWorkflowTaskScheduleEvent, newWorkflowTaskStartedEvent := s.prepareTransientWorkflowTaskCompletionFirstBatchReplicated(version, runID)
+
+	newWorkflowTaskCompletedEvent := &historypb.HistoryEvent{
+		Version:   version,
+		EventId:   newWorkflowTaskStartedEvent.GetEventId() + 1,
+		EventTime: timestamp.TimePtr(time.Now().UTC()),
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+		Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+			ScheduledEventId: newWorkflowTaskScheduleEvent.GetEventId(),
+			StartedEventId:   newWorkflowTaskStartedEvent.GetEventId(),
+			Identity:         "some random identity",
+			BinaryChecksum:   "build-1",
+		}},
+	}
+	s.mutableState.SetHistoryBuilder(NewImmutableHistoryBuilder([]*historypb.HistoryEvent{
+		newWorkflowTaskCompletedEvent,
+	}))
+	err := s.mutableState.ReplicateWorkflowTaskCompletedEvent(newWorkflowTaskCompletedEvent)
+	s.NoError(err)
+	s.Equal("build-1", s.mutableState.GetExecutionInfo().WorkerBuildId)
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskCompletionFirstBatchReplicated_FailoverWorkflowTaskTimeout() {
 	version := int64(12)
 	runID := uuid.New()
@@ -338,6 +377,51 @@ func (s *mutableStateSuite) TestMergeMapOfPayload() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestReplicateWorkflowExecutionSignaled_UpsertSearchAttributesAndMemo() {
+	s.mutableState.executionInfo.SearchAttributes = map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("keyword"),
+	}
+	input, err := payloads.Encode(
+		&commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"CustomKeywordField": {},
+			"CustomTextField":    payload.EncodeString("text"),
+		}},
+		&commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")}},
+	)
+	s.NoError(err)
+
+	err = s.mutableState.ReplicateWorkflowExecutionSignaled(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: UpsertSearchAttributesAndMemoSignalName,
+			Input:      input,
+		}},
+	})
+	s.NoError(err)
+	s.Equal(map[string]*commonpb.Payload{"CustomTextField": payload.EncodeString("text")}, s.mutableState.executionInfo.SearchAttributes)
+	s.Equal(map[string]*commonpb.Payload{"key": payload.EncodeString("value")}, s.mutableState.executionInfo.Memo)
+	s.Equal(int64(0), s.mutableState.executionInfo.SignalCount)
+}
+
+func (s *mutableStateSuite) TestReplicateActivityTaskScheduledEvent_PriorityAndFairnessKeys() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any())
+	s.mutableState.executionInfo.PriorityKey = 1
+	s.mutableState.executionInfo.FairnessKey = "tenant"
+
+	ai, err := s.mutableState.ReplicateActivityTaskScheduledEvent(5, &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityId: uuid.New(),
+			TaskQueue:  &taskqueuepb.TaskQueue{Name: "some random taskqueue"},
+		}},
+	})
+	s.NoError(err)
+	// activities inherit the keys of their workflow
+	s.Equal(int32(1), ai.PriorityKey)
+	s.Equal("tenant", ai.FairnessKey)
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -2417,3 +2417,17 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateStatus(state, status
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateStatus), state, status)
 }
+
+// UpsertWorkflowSearchAttributesAndMemo mocks base method.
+func (m *MockMutableState) UpsertWorkflowSearchAttributesAndMemo(arg0 *v10.SearchAttributes, arg1 *v10.Memo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributesAndMemo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributesAndMemo indicates an expected call of UpsertWorkflowSearchAttributesAndMemo.
+func (mr *MockMutableStateMockRecorder) UpsertWorkflowSearchAttributesAndMemo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributesAndMemo", reflect.TypeOf((*MockMutableState)(nil).UpsertWorkflowSearchAttributesAndMemo), arg0, arg1)
+}
@@ -501,6 +501,13 @@ func (b *MutableStateRebuilderImpl) ApplyEvents(
 			); err != nil {
 				return nil, err
 			}
+			if event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName() == UpsertSearchAttributesAndMemoSignalName {
+				if err := taskGenerator.GenerateWorkflowSearchAttrTasks(
+					timestamp.TimeValue(event.GetEventTime()),
+				); err != nil {
+					return nil, err
+				}
+			}
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
 			if err := b.mutableState.ReplicateWorkflowExecutionCancelRequestedEvent(
@@ -261,6 +261,8 @@ func SetupNewWorkflowForRetryOrCron(
 		ContinueAsNewInitiator:   initiator,
 		FirstWorkflowTaskBackoff: timestamp.DurationPtr(backoffInterval),
 		Attempt:                  attempt,
+		PriorityKey:              previousExecutionInfo.PriorityKey,
+		FairnessKey:              previousExecutionInfo.FairnessKey,
 	}
 	workflowTimeoutTime := timestamp.TimeValue(previousExecutionInfo.WorkflowExecutionExpirationTime)
 	if !workflowTimeoutTime.IsZero() {
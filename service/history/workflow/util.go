@@ -38,6 +38,12 @@ import (
 	"go.temporal.io/server/service/history/consts"
 )
 
+// UpsertSearchAttributesAndMemoSignalName is the reserved name of the WorkflowExecutionSignaled event
+// which records search attributes and memo upserted outside of a workflow task, e.g. by a batch job.
+// The API has no other event for such a change that SDKs don't match against commands. Its input
+// holds the upserted search attributes and memo. Signals with this name are not accepted from callers.
+const UpsertSearchAttributesAndMemoSignalName = "temporal-sys-upsert-search-attributes-and-memo"
+
 func failWorkflowTask(
 	mutableState MutableState,
 	workflowTask *WorkflowTaskInfo,
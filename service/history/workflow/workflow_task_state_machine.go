@@ -760,7 +760,11 @@ func (m *workflowTaskStateMachine) afterAddWorkflowTaskCompletedEvent(
 	event *historypb.HistoryEvent,
 	maxResetPoints int,
 ) error {
-	m.ms.executionInfo.LastWorkflowTaskStartId = event.GetWorkflowTaskCompletedEventAttributes().GetStartedEventId()
+	attrs := event.GetWorkflowTaskCompletedEventAttributes()
+	m.ms.executionInfo.LastWorkflowTaskStartId = attrs.GetStartedEventId()
+	// Binary checksum is the build id of the worker, matching dispatches next workflow tasks
+	// to pollers compatible with it.
+	m.ms.executionInfo.WorkerBuildId = attrs.GetBinaryChecksum()
 	return m.ms.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
@@ -216,6 +216,13 @@ func (r *workflowResetterImpl) resetWorkflow(
 	}
 	defer resetWorkflow.getReleaseFn()(retError)
 
+	if err := r.inheritTaskKeys(
+		resetWorkflow.getMutableState(),
+		currentMutableState,
+	); err != nil {
+		return err
+	}
+
 	if err := r.reapplyEventsToResetWorkflow(
 		ctx,
 		resetWorkflow.getMutableState(),
@@ -513,6 +520,30 @@ func (r *workflowResetterImpl) failWorkflowTask(
 	return err
 }
 
+// inheritTaskKeys sets the matching priority and fairness key of the current run on the
+// reset run and its pending activities. They are not recorded in history, so replaying
+// the history of the base run leaves them unset.
+func (r *workflowResetterImpl) inheritTaskKeys(
+	resetMutableState workflow.MutableState,
+	currentMutableState workflow.MutableState,
+) error {
+
+	priorityKey := currentMutableState.GetExecutionInfo().GetPriorityKey()
+	fairnessKey := currentMutableState.GetExecutionInfo().GetFairnessKey()
+
+	executionInfo := resetMutableState.GetExecutionInfo()
+	executionInfo.PriorityKey = priorityKey
+	executionInfo.FairnessKey = fairnessKey
+	for _, ai := range resetMutableState.GetPendingActivityInfos() {
+		ai.PriorityKey = priorityKey
+		ai.FairnessKey = fairnessKey
+		if err := resetMutableState.UpdateActivity(ai); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *workflowResetterImpl) failInflightActivity(
 	now time.Time,
 	mutableState workflow.MutableState,
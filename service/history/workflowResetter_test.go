@@ -491,6 +491,36 @@ func (s *workflowResetterSuite) TestFailInflightActivity() {
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestInheritTaskKeys() {
+	currentMutableState := workflow.NewMockMutableState(s.controller)
+	currentMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		PriorityKey: 1,
+		FairnessKey: "tenant",
+	}).AnyTimes()
+
+	resetExecutionInfo := &persistencespb.WorkflowExecutionInfo{}
+	activity := &persistencespb.ActivityInfo{
+		ScheduleId: 123,
+		StartedId:  common.EmptyEventID,
+	}
+	resetMutableState := workflow.NewMockMutableState(s.controller)
+	resetMutableState.EXPECT().GetExecutionInfo().Return(resetExecutionInfo).AnyTimes()
+	resetMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{
+		activity.ScheduleId: activity,
+	}).AnyTimes()
+	resetMutableState.EXPECT().UpdateActivity(&persistencespb.ActivityInfo{
+		ScheduleId:  activity.ScheduleId,
+		StartedId:   activity.StartedId,
+		PriorityKey: 1,
+		FairnessKey: "tenant",
+	}).Return(nil)
+
+	err := s.workflowResetter.inheritTaskKeys(resetMutableState, currentMutableState)
+	s.NoError(err)
+	s.Equal(int32(1), resetExecutionInfo.PriorityKey)
+	s.Equal("tenant", resetExecutionInfo.FairnessKey)
+}
+
 func (s *workflowResetterSuite) TestGenerateBranchToken() {
 	baseBranchToken := []byte("some random base branch token")
 	baseNodeID := int64(1234)
@@ -25,8 +25,10 @@
 package matching
 
 import (
+	"math"
 	"sort"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -41,11 +43,32 @@ type ackManager struct {
 	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
 	ackLevel         int64          // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
-	logger           log.Logger
+	// ackCeiling is the maximum TaskID the ack level may move to, it stays below the
+	// tasks the task reader skipped and has not added yet
+	ackCeiling int64
+	// createTimes holds the creation time of the in-flight tasks, used to report the backlog age
+	createTimes map[int64]time.Time
+	// fairnessKeys holds the fairness key of the in-flight tasks which have one
+	fairnessKeys map[int64]string
+	// approximateBacklogCount is the number of persisted tasks which are not completed yet
+	approximateBacklogCount int64
+	// fairnessKeyBacklogCounts splits approximateBacklogCount by fairness key, keys
+	// without tasks are removed
+	fairnessKeyBacklogCounts map[string]int64
+	logger                   log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:                   logger,
+		outstandingTasks:         make(map[int64]bool),
+		createTimes:              make(map[int64]time.Time),
+		fairnessKeys:             make(map[int64]string),
+		fairnessKeyBacklogCounts: make(map[string]int64),
+		readLevel:                -1,
+		ackLevel:                 -1,
+		ackCeiling:               math.MaxInt64,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
@@ -65,6 +88,38 @@ func (m *ackManager) addTask(taskID int64) {
 	m.backlogCounter.Inc()
 }
 
+// Registers a task below the read level as in-flight, i.e. a task the read level moved
+// past without adding it. The ack ceiling must have kept the ack level below it.
+func (m *ackManager) addTaskBelowReadLevel(taskID int64) {
+	m.Lock()
+	defer m.Unlock()
+	if taskID <= m.ackLevel {
+		m.logger.Fatal("Next task ID is less than current ack level.",
+			tag.TaskID(taskID),
+			tag.AckLevel(m.ackLevel))
+	}
+	if _, ok := m.outstandingTasks[taskID]; ok {
+		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
+	}
+	m.outstandingTasks[taskID] = false // true is for acked
+	m.backlogCounter.Inc()
+}
+
+// Records the creation time and the fairness key of an in-flight task.
+func (m *ackManager) setTaskInfo(taskID int64, createTime time.Time, fairnessKey string) {
+	m.Lock()
+	defer m.Unlock()
+	if completed, ok := m.outstandingTasks[taskID]; !ok || completed {
+		return
+	}
+	if !createTime.IsZero() {
+		m.createTimes[taskID] = createTime
+	}
+	if fairnessKey != "" {
+		m.fairnessKeys[taskID] = fairnessKey
+	}
+}
+
 func (m *ackManager) getReadLevel() int64 {
 	m.RLock()
 	defer m.RUnlock()
@@ -111,14 +166,29 @@ func (m *ackManager) setAckLevel(ackLevel int64) {
 	}
 }
 
+// Sets the maximum TaskID the ack level may move to, math.MaxInt64 for no limit.
+// Returns the ack level, which moves up to the new ceiling when it is raised.
+func (m *ackManager) setAckCeiling(ackCeiling int64) (ackLevel int64) {
+	m.Lock()
+	defer m.Unlock()
+	m.ackCeiling = ackCeiling
+	return m.moveAckLevelLocked()
+}
+
 func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	m.Lock()
 	defer m.Unlock()
 	if completed, ok := m.outstandingTasks[taskID]; ok && !completed {
 		m.outstandingTasks[taskID] = true
 		m.backlogCounter.Dec()
+		delete(m.createTimes, taskID)
+		m.addApproximateBacklogCountLocked(m.fairnessKeys[taskID], -1)
+		delete(m.fairnessKeys, taskID)
 	}
+	return m.moveAckLevelLocked()
+}
 
+func (m *ackManager) moveAckLevelLocked() int64 {
 	// TODO the ack level management shuld be done by a dedicated coroutine
 	//  this is only a temporarily solution
 
@@ -130,6 +200,9 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 
 	// Update ackLevel
 	for _, taskID := range taskIDs {
+		if taskID > m.ackCeiling {
+			return m.ackLevel
+		}
 		if acked := m.outstandingTasks[taskID]; acked {
 			m.ackLevel = taskID
 			delete(m.outstandingTasks, taskID)
@@ -143,3 +216,66 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
+
+// Returns the creation time of the oldest in-flight task, false when there is none.
+func (m *ackManager) getOldestTaskCreateTime() (time.Time, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	var oldest time.Time
+	for _, createTime := range m.createTimes {
+		if oldest.IsZero() || createTime.Before(oldest) {
+			oldest = createTime
+		}
+	}
+	return oldest, !oldest.IsZero()
+}
+
+func (m *ackManager) getApproximateBacklogCount() int64 {
+	m.RLock()
+	defer m.RUnlock()
+	return m.approximateBacklogCount
+}
+
+// Returns a copy of the approximate backlog count of each fairness key.
+func (m *ackManager) getFairnessKeyBacklogCounts() map[string]int64 {
+	m.RLock()
+	defer m.RUnlock()
+	counts := make(map[string]int64, len(m.fairnessKeyBacklogCounts))
+	for key, count := range m.fairnessKeyBacklogCounts {
+		counts[key] = count
+	}
+	return counts
+}
+
+// Sets the approximate backlog count and its split by fairness key.
+func (m *ackManager) setApproximateBacklogCount(count int64, fairnessKeyCounts map[string]int64) {
+	m.Lock()
+	defer m.Unlock()
+	m.approximateBacklogCount = count
+	m.fairnessKeyBacklogCounts = make(map[string]int64, len(fairnessKeyCounts))
+	for key, keyCount := range fairnessKeyCounts {
+		if keyCount > 0 {
+			m.fairnessKeyBacklogCounts[key] = keyCount
+		}
+	}
+}
+
+// Adjusts the approximate backlog count of the given fairness key and the total by delta,
+// they never go below zero.
+func (m *ackManager) addApproximateBacklogCount(fairnessKey string, delta int64) {
+	m.Lock()
+	defer m.Unlock()
+	m.addApproximateBacklogCountLocked(fairnessKey, delta)
+}
+
+func (m *ackManager) addApproximateBacklogCountLocked(fairnessKey string, delta int64) {
+	m.approximateBacklogCount += delta
+	if m.approximateBacklogCount < 0 {
+		m.approximateBacklogCount = 0
+	}
+	if count := m.fairnessKeyBacklogCounts[fairnessKey] + delta; count > 0 {
+		m.fairnessKeyBacklogCounts[fairnessKey] = count
+	} else {
+		delete(m.fairnessKeyBacklogCounts, fairnessKey)
+	}
+}
@@ -59,6 +59,13 @@ type (
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		EnablePriorityFairness     dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		FairnessKeyWeights         dynamicconfig.MapPropertyFnWithNamespaceFilter
+
+		// adaptive partition controller configuration
+		EnableAdaptivePartitions         dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		AdaptivePartitionsTargetRate     dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		AdaptivePartitionsUpdateInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -89,6 +96,14 @@ type (
 		MaxTaskqueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
+		// Dispatch tasks of all priority levels in proportion to their weight instead of strictly by priority
+		EnablePriorityFairness func() bool
+		// Dispatch weight of task fairness keys, keys without a weight get a weight of 1
+		FairnessKeyWeights func() map[string]interface{}
+		// adaptive partition controller configuration, NumWritePartitions and NumReadPartitions are the max counts
+		EnableAdaptivePartitions         func() bool
+		AdaptivePartitionsTargetRate     func() float64
+		AdaptivePartitionsUpdateInterval func() time.Duration
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
@@ -117,6 +132,8 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		EnablePriorityFairness:          dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePriorityFairness, false),
+		FairnessKeyWeights:              dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingFairnessKeyWeights, nil),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
@@ -128,6 +145,10 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
 
+		EnableAdaptivePartitions:         dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnableAdaptivePartitions, false),
+		AdaptivePartitionsTargetRate:     dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingAdaptivePartitionsTargetRate, 500),
+		AdaptivePartitionsUpdateInterval: dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingAdaptivePartitionsUpdateInterval, time.Minute),
+
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
 	}
@@ -170,6 +191,21 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(namespace.String(), taskQueueName, taskType)
 		},
+		EnablePriorityFairness: func() bool {
+			return config.EnablePriorityFairness(namespace.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]interface{} {
+			return config.FairnessKeyWeights(namespace.String())
+		},
+		EnableAdaptivePartitions: func() bool {
+			return config.EnableAdaptivePartitions(namespace.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsTargetRate: func() float64 {
+			return config.AdaptivePartitionsTargetRate(namespace.String(), taskQueueName, taskType)
+		},
+		AdaptivePartitionsUpdateInterval: func() time.Duration {
+			return config.AdaptivePartitionsUpdateInterval(namespace.String(), taskQueueName, taskType)
+		},
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(namespace.String(), taskQueueName, taskType)
 		},
@@ -55,12 +55,24 @@ type (
 		taskType      enumspb.TaskQueueType
 		rangeID       int64
 		ackLevel      int64
-		store         persistence.TaskManager
-		logger        log.Logger
+		// versioningData holds the worker build id version sets of the task queue,
+		// it is preserved on every write of the task queue metadata
+		versioningData *persistencespb.VersioningData
+		// partitionConfig holds the partition counts set by the adaptive partition controller,
+		// it is preserved on every write of the task queue metadata
+		partitionConfig *persistencespb.TaskQueuePartitionConfig
+		// approximateBacklogCount is the last persisted number of tasks which are not completed yet
+		approximateBacklogCount int64
+		// fairnessKeyBacklogCounts is the last persisted approximateBacklogCount per fairness key
+		fairnessKeyBacklogCounts map[string]int64
+		store                    persistence.TaskManager
+		logger                   log.Logger
 	}
 	taskQueueState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID                  int64
+		ackLevel                 int64
+		approximateBacklogCount  int64
+		fairnessKeyBacklogCounts map[string]int64
 	}
 )
 
@@ -70,10 +82,10 @@ type (
 //
 // This class will serialize writes to persistence that do condition updates. There are
 // two reasons for doing this:
-// - To work around known Cassandra issue where concurrent LWT to the same partition cause timeout errors
-// - To provide the guarantee that there is only writer who updates taskQueue in persistence at any given point in time
-//   This guarantee makes some of the other code simpler and there is no impact to perf because updates to taskqueue are
-//   spread out and happen in background routines
+//   - To work around known Cassandra issue where concurrent LWT to the same partition cause timeout errors
+//   - To provide the guarantee that there is only writer who updates taskQueue in persistence at any given point in time
+//     This guarantee makes some of the other code simpler and there is no impact to perf because updates to taskqueue are
+//     spread out and happen in background routines
 func newTaskQueueDB(store persistence.TaskManager, namespaceID namespace.ID, name string, taskType enumspb.TaskQueueType, kind enumspb.TaskQueueKind, logger log.Logger) *taskQueueDB {
 	return &taskQueueDB{
 		namespaceID:   namespaceID,
@@ -109,7 +121,12 @@ func (db *taskQueueDB) RenewLease(
 			return taskQueueState{}, err
 		}
 	}
-	return taskQueueState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	return taskQueueState{
+		rangeID:                  db.rangeID,
+		ackLevel:                 db.ackLevel,
+		approximateBacklogCount:  db.approximateBacklogCount,
+		fairnessKeyBacklogCounts: db.fairnessKeyBacklogCounts,
+	}, nil
 }
 
 func (db *taskQueueDB) takeOverTaskQueueLocked(
@@ -135,20 +152,16 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 		}
 		db.ackLevel = response.TaskQueueInfo.AckLevel
 		db.rangeID = response.RangeID + 1
+		db.versioningData = response.TaskQueueInfo.VersioningData
+		db.partitionConfig = response.TaskQueueInfo.PartitionConfig
+		db.approximateBacklogCount = response.TaskQueueInfo.ApproximateBacklogCount
+		db.fairnessKeyBacklogCounts = response.TaskQueueInfo.FairnessKeyBacklogCounts
 		return nil
 
 	case *serviceerror.NotFound:
 		if _, err := db.store.CreateTaskQueue(ctx, &persistence.CreateTaskQueueRequest{
-			RangeID: initialRangeID,
-			TaskQueueInfo: &persistencespb.TaskQueueInfo{
-				NamespaceId:    db.namespaceID.String(),
-				Name:           db.taskQueueName,
-				TaskType:       db.taskType,
-				Kind:           db.taskQueueKind,
-				AckLevel:       db.ackLevel,
-				ExpiryTime:     db.expiryTime(),
-				LastUpdateTime: timestamp.TimeNowPtrUtc(),
-			},
+			RangeID:       initialRangeID,
+			TaskQueueInfo: db.taskQueueInfo(db.ackLevel, db.approximateBacklogCount, db.fairnessKeyBacklogCounts, db.versioningData, db.partitionConfig),
 		}); err != nil {
 			return err
 		}
@@ -165,17 +178,9 @@ func (db *taskQueueDB) renewTaskQueueLocked(
 	rangeID int64,
 ) error {
 	if _, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
-		RangeID: rangeID,
-		TaskQueueInfo: &persistencespb.TaskQueueInfo{
-			NamespaceId:    db.namespaceID.String(),
-			Name:           db.taskQueueName,
-			TaskType:       db.taskType,
-			Kind:           db.taskQueueKind,
-			AckLevel:       db.ackLevel,
-			ExpiryTime:     db.expiryTime(),
-			LastUpdateTime: timestamp.TimeNowPtrUtc(),
-		},
-		PrevRangeID: db.rangeID,
+		RangeID:       rangeID,
+		TaskQueueInfo: db.taskQueueInfo(db.ackLevel, db.approximateBacklogCount, db.fairnessKeyBacklogCounts, db.versioningData, db.partitionConfig),
+		PrevRangeID:   db.rangeID,
 	}); err != nil {
 		return err
 	}
@@ -188,28 +193,102 @@ func (db *taskQueueDB) renewTaskQueueLocked(
 func (db *taskQueueDB) UpdateState(
 	ctx context.Context,
 	ackLevel int64,
+	approximateBacklogCount int64,
+	fairnessKeyBacklogCounts map[string]int64,
 ) error {
 	db.Lock()
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
-		RangeID: db.rangeID,
-		TaskQueueInfo: &persistencespb.TaskQueueInfo{
-			NamespaceId:    db.namespaceID.String(),
-			Name:           db.taskQueueName,
-			TaskType:       db.taskType,
-			Kind:           db.taskQueueKind,
-			AckLevel:       ackLevel,
-			ExpiryTime:     db.expiryTime(),
-			LastUpdateTime: timestamp.TimeNowPtrUtc(),
-		},
-		PrevRangeID: db.rangeID,
+		RangeID:       db.rangeID,
+		TaskQueueInfo: db.taskQueueInfo(ackLevel, approximateBacklogCount, fairnessKeyBacklogCounts, db.versioningData, db.partitionConfig),
+		PrevRangeID:   db.rangeID,
 	})
 	if err == nil {
 		db.ackLevel = ackLevel
+		db.approximateBacklogCount = approximateBacklogCount
+		db.fairnessKeyBacklogCounts = fairnessKeyBacklogCounts
 	}
 	return err
 }
 
+// VersioningData returns the worker build id version sets of the task queue
+func (db *taskQueueDB) VersioningData() *persistencespb.VersioningData {
+	db.Lock()
+	defer db.Unlock()
+	return db.versioningData
+}
+
+// UpdateVersioningData persists the given worker build id version sets of the task queue
+func (db *taskQueueDB) UpdateVersioningData(
+	ctx context.Context,
+	versioningData *persistencespb.VersioningData,
+) error {
+	db.Lock()
+	defer db.Unlock()
+	if db.rangeID == 0 {
+		return serviceerror.NewUnavailable("task queue lease is not acquired yet")
+	}
+	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       db.rangeID,
+		TaskQueueInfo: db.taskQueueInfo(db.ackLevel, db.approximateBacklogCount, db.fairnessKeyBacklogCounts, versioningData, db.partitionConfig),
+		PrevRangeID:   db.rangeID,
+	})
+	if err == nil {
+		db.versioningData = versioningData
+	}
+	return err
+}
+
+// PartitionConfig returns the partition counts set by the adaptive partition controller
+func (db *taskQueueDB) PartitionConfig() *persistencespb.TaskQueuePartitionConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.partitionConfig
+}
+
+// UpdatePartitionConfig persists the given partition counts of the task queue
+func (db *taskQueueDB) UpdatePartitionConfig(
+	ctx context.Context,
+	partitionConfig *persistencespb.TaskQueuePartitionConfig,
+) error {
+	db.Lock()
+	defer db.Unlock()
+	if db.rangeID == 0 {
+		return serviceerror.NewUnavailable("task queue lease is not acquired yet")
+	}
+	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       db.rangeID,
+		TaskQueueInfo: db.taskQueueInfo(db.ackLevel, db.approximateBacklogCount, db.fairnessKeyBacklogCounts, db.versioningData, partitionConfig),
+		PrevRangeID:   db.rangeID,
+	})
+	if err == nil {
+		db.partitionConfig = partitionConfig
+	}
+	return err
+}
+
+// GetTaskQueueInfoOf reads the metadata persisted on the given task queue of the same
+// namespace and type, usually the root partition of this one. Returns nil if the task
+// queue does not exist.
+func (db *taskQueueDB) GetTaskQueueInfoOf(
+	ctx context.Context,
+	taskQueueName string,
+) (*persistencespb.TaskQueueInfo, error) {
+	response, err := db.store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: db.namespaceID.String(),
+		TaskQueue:   taskQueueName,
+		TaskType:    db.taskType,
+	})
+	switch err.(type) {
+	case nil:
+		return response.TaskQueueInfo, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
 // CreateTasks creates a batch of given tasks for this task queue
 func (db *taskQueueDB) CreateTasks(
 	ctx context.Context,
@@ -221,13 +300,7 @@ func (db *taskQueueDB) CreateTasks(
 		ctx,
 		&persistence.CreateTasksRequest{
 			TaskQueueInfo: &persistence.PersistedTaskQueueInfo{
-				Data: &persistencespb.TaskQueueInfo{
-					NamespaceId: db.namespaceID.String(),
-					Name:        db.taskQueueName,
-					TaskType:    db.taskType,
-					AckLevel:    db.ackLevel,
-					Kind:        db.taskQueueKind,
-				},
+				Data:    db.taskQueueInfo(db.ackLevel, db.approximateBacklogCount, db.fairnessKeyBacklogCounts, db.versioningData, db.partitionConfig),
 				RangeID: db.rangeID,
 			},
 			Tasks: tasks,
@@ -301,6 +374,30 @@ func (db *taskQueueDB) CompleteTasksLessThan(
 	return n, err
 }
 
+// taskQueueInfo builds the metadata persisted for the task queue, the given values override
+// the ones of the last persisted metadata
+func (db *taskQueueDB) taskQueueInfo(
+	ackLevel int64,
+	approximateBacklogCount int64,
+	fairnessKeyBacklogCounts map[string]int64,
+	versioningData *persistencespb.VersioningData,
+	partitionConfig *persistencespb.TaskQueuePartitionConfig,
+) *persistencespb.TaskQueueInfo {
+	return &persistencespb.TaskQueueInfo{
+		NamespaceId:              db.namespaceID.String(),
+		Name:                     db.taskQueueName,
+		TaskType:                 db.taskType,
+		Kind:                     db.taskQueueKind,
+		AckLevel:                 ackLevel,
+		VersioningData:           versioningData,
+		PartitionConfig:          partitionConfig,
+		ApproximateBacklogCount:  approximateBacklogCount,
+		FairnessKeyBacklogCounts: fairnessKeyBacklogCounts,
+		ExpiryTime:               db.expiryTime(),
+		LastUpdateTime:           timestamp.TimeNowPtrUtc(),
+	}
+}
+
 func (db *taskQueueDB) expiryTime() *time.Time {
 	switch db.taskQueueKind {
 	case enumspb.TASK_QUEUE_KIND_NORMAL:
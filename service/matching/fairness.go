@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// fairnessQueue is a FIFO queue of tasks per fairness key. Tasks are popped by interleaved
+	// weighted round robin across the fairness keys, so that a single key with many tasks can
+	// not delay the tasks of the other keys until all of its own tasks are dispatched. Each
+	// round goes over the keys in order, a key with weight w is served once in each of the
+	// first w rounds of a cycle, a cycle being as many rounds as the largest weight.
+	fairnessQueue struct {
+		// keys are the fairness keys which have tasks, in round robin order
+		keys     []string
+		next     int
+		round    int
+		tasks    map[string][]*persistencespb.AllocatedTaskInfo
+		numTasks int
+		// weights returns the dispatch weight of fairness keys, keys it has no valid
+		// weight for get a weight of 1. It is read once per round.
+		weights      func() map[string]interface{}
+		roundWeights map[string]interface{}
+	}
+)
+
+func newFairnessQueue(weights func() map[string]interface{}) *fairnessQueue {
+	q := &fairnessQueue{
+		round:   1,
+		tasks:   make(map[string][]*persistencespb.AllocatedTaskInfo),
+		weights: weights,
+	}
+	q.refreshWeights()
+	return q
+}
+
+func (q *fairnessQueue) push(task *persistencespb.AllocatedTaskInfo) {
+	key := task.Data.GetFairnessKey()
+	tasks, ok := q.tasks[key]
+	if !ok {
+		// new keys join the round right before the next key to be served
+		q.keys = append(q.keys, "")
+		copy(q.keys[q.next+1:], q.keys[q.next:])
+		q.keys[q.next] = key
+		q.next++
+	}
+	q.tasks[key] = append(tasks, task)
+	q.numTasks++
+}
+
+// pop removes the next task in interleaved weighted round robin order, the queue must
+// not be empty
+func (q *fairnessQueue) pop() *persistencespb.AllocatedTaskInfo {
+	for {
+		if q.next >= len(q.keys) {
+			q.next = 0
+			q.round++
+			q.refreshWeights()
+			if q.round > q.maxWeight() {
+				q.round = 1
+			}
+		}
+		if q.weight(q.keys[q.next]) >= q.round {
+			break
+		}
+		q.next++
+	}
+
+	key := q.keys[q.next]
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	q.numTasks--
+
+	if len(tasks) == 1 {
+		delete(q.tasks, key)
+		q.keys = append(q.keys[:q.next], q.keys[q.next+1:]...)
+	} else {
+		q.tasks[key] = tasks[1:]
+		q.next++
+	}
+	return task
+}
+
+func (q *fairnessQueue) len() int {
+	return q.numTasks
+}
+
+// keyLen returns the number of queued tasks of the given fairness key
+func (q *fairnessQueue) keyLen(key string) int {
+	return len(q.tasks[key])
+}
+
+func (q *fairnessQueue) refreshWeights() {
+	if q.weights != nil {
+		q.roundWeights = q.weights()
+	}
+}
+
+func (q *fairnessQueue) weight(key string) int {
+	var weight int
+	switch value := q.roundWeights[key].(type) {
+	case int:
+		weight = value
+	case float64:
+		weight = int(value)
+	}
+	if weight < 1 {
+		return 1
+	}
+	return weight
+}
+
+func (q *fairnessQueue) maxWeight() int {
+	maxWeight := 1
+	for _, key := range q.keys {
+		if weight := q.weight(key); weight > maxWeight {
+			maxWeight = weight
+		}
+	}
+	return maxWeight
+}
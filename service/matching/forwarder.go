@@ -129,6 +129,30 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 		return errForwarderSlowDown
 	}
 
+	return fwdr.handleErr(fwdr.addTask(ctx, task, name, fwdr.taskQueueID.name))
+}
+
+// RedirectTask adds an activity or workflow task to the root partition as if it was added
+// there in the first place, i.e. the root partition persists the task when no poller
+// picks it up. It is used by partitions which no longer take new tasks.
+func (fwdr *Forwarder) RedirectTask(ctx context.Context, task *internalTask) error {
+	if fwdr.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return errTaskQueueKind
+	}
+	if fwdr.taskQueueID.IsRoot() {
+		return errNoParent
+	}
+	return fwdr.addTask(ctx, task, fwdr.taskQueueID.GetRoot(), "")
+}
+
+// addTask adds the task to the given partition, forwardedSource is empty unless the
+// partition is to only sync match the task
+func (fwdr *Forwarder) addTask(
+	ctx context.Context,
+	task *internalTask,
+	name string,
+	forwardedSource string,
+) error {
 	var err error
 
 	var expirationDuration time.Duration
@@ -155,7 +179,10 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Clock:                  task.event.Data.GetClock(),
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
-			ForwardedSource:        fwdr.taskQueueID.name,
+			ForwardedSource:        forwardedSource,
+			BuildId:                task.event.Data.GetBuildId(),
+			PriorityKey:            task.event.Data.GetPriorityKey(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -170,13 +197,14 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Clock:                  task.event.Data.GetClock(),
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
-			ForwardedSource:        fwdr.taskQueueID.name,
+			ForwardedSource:        forwardedSource,
+			PriorityKey:            task.event.Data.GetPriorityKey(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
 		})
 	default:
 		return errInvalidTaskQueueType
 	}
-
-	return fwdr.handleErr(err)
+	return err
 }
 
 // ForwardQueryTask forwards a query task to parent task queue partition, if it exist
@@ -220,6 +248,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	buildID, _ := ctx.Value(workerBuildIDKey).(string)
 
 	switch fwdr.taskQueueID.taskType {
 	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
@@ -231,7 +260,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Name: name,
 					Kind: fwdr.taskQueueKind,
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: buildID,
 			},
 			ForwardedSource: fwdr.taskQueueID.name,
 		})
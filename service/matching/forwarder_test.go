@@ -137,6 +137,26 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(t.taskQueue.name, request.GetForwardedSource())
 }
 
+func (t *ForwarderTestSuite) TestRedirectTask() {
+	task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", false)
+	t.Equal(errNoParent, t.fwdr.RedirectTask(context.Background(), task))
+
+	t.usingTaskqueuePartition(enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	var request *matchingservice.AddWorkflowTaskRequest
+	t.client.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *matchingservice.AddWorkflowTaskRequest, arg2 ...interface{}) {
+			request = arg1
+		},
+	).Return(&matchingservice.AddWorkflowTaskResponse{}, nil)
+
+	t.NoError(t.fwdr.RedirectTask(context.Background(), task))
+	t.NotNil(request)
+	t.Equal(t.taskQueue.GetRoot(), request.TaskQueue.GetName())
+	t.Equal(task.event.Data.GetWorkflowId(), request.GetExecution().GetWorkflowId())
+	// the root partition persists the task when it cannot sync match it
+	t.Empty(request.GetForwardedSource())
+}
+
 func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
 	t.usingTaskqueuePartition(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 
@@ -29,6 +29,7 @@ import (
 	"sync"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -174,7 +175,15 @@ func (h *Handler) AddActivityTask(
 		hCtx.scope.RecordTimer(metrics.SyncMatchLatencyPerTaskQueue, time.Since(startT))
 	}
 
-	return &matchingservice.AddActivityTaskResponse{}, err
+	partitionConfig, partitionsDisabled := h.engine.PartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		request.GetTaskQueue(),
+		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	)
+	return &matchingservice.AddActivityTaskResponse{
+		PartitionConfig:            partitionConfig,
+		AdaptivePartitionsDisabled: partitionsDisabled,
+	}, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -199,7 +208,15 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		hCtx.scope.RecordTimer(metrics.SyncMatchLatencyPerTaskQueue, time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{}, err
+	partitionConfig, partitionsDisabled := h.engine.PartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		request.GetTaskQueue(),
+		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	)
+	return &matchingservice.AddWorkflowTaskResponse{
+		PartitionConfig:            partitionConfig,
+		AdaptivePartitionsDisabled: partitionsDisabled,
+	}, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -228,7 +245,23 @@ func (h *Handler) PollActivityTaskQueue(
 	}
 
 	response, err := h.engine.PollActivityTaskQueue(hCtx, request)
-	return response, err
+	if err != nil {
+		return nil, err
+	}
+	partitionConfig, partitionsDisabled := h.engine.PartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		request.GetPollRequest().GetTaskQueue(),
+		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	)
+	if partitionConfig != nil || partitionsDisabled {
+		if response == emptyPollActivityTaskQueueResponse {
+			// the empty response is shared, attach the partition counts to a new one
+			response = &matchingservice.PollActivityTaskQueueResponse{}
+		}
+		response.PartitionConfig = partitionConfig
+		response.AdaptivePartitionsDisabled = partitionsDisabled
+	}
+	return response, nil
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -257,7 +290,23 @@ func (h *Handler) PollWorkflowTaskQueue(
 	}
 
 	response, err := h.engine.PollWorkflowTaskQueue(hCtx, request)
-	return response, err
+	if err != nil {
+		return nil, err
+	}
+	partitionConfig, partitionsDisabled := h.engine.PartitionConfig(
+		namespace.ID(request.GetNamespaceId()),
+		request.GetPollRequest().GetTaskQueue(),
+		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	)
+	if partitionConfig != nil || partitionsDisabled {
+		if response == emptyPollWorkflowTaskQueueResponse {
+			// the empty response is shared, attach the partition counts to a new one
+			response = &matchingservice.PollWorkflowTaskQueueResponse{}
+		}
+		response.PartitionConfig = partitionConfig
+		response.AdaptivePartitionsDisabled = partitionsDisabled
+	}
+	return response, nil
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -351,6 +400,46 @@ func (h *Handler) ListTaskQueuePartitions(
 	return response, err
 }
 
+// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets of a task queue
+func (h *Handler) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdOrderingRequest,
+) (_ *matchingservice.UpdateWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		namespace.ID(request.GetNamespaceId()),
+		&taskqueuepb.TaskQueue{
+			Name: request.GetTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+		metrics.MatchingUpdateWorkerBuildIdOrderingScope,
+	)
+
+	response, err := h.engine.UpdateWorkerBuildIdOrdering(hCtx, request)
+	return response, err
+}
+
+// GetWorkerBuildIdOrdering returns the worker build id version sets of a task queue
+func (h *Handler) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdOrderingRequest,
+) (_ *matchingservice.GetWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		namespace.ID(request.GetNamespaceId()),
+		&taskqueuepb.TaskQueue{
+			Name: request.GetTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+		metrics.MatchingGetWorkerBuildIdOrderingScope,
+	)
+
+	response, err := h.engine.GetWorkerBuildIdOrdering(hCtx, request)
+	return response, err
+}
+
 func (h *Handler) namespaceName(id namespace.ID) namespace.Name {
 	entry, err := h.namespaceRegistry.GetNamespaceByID(id)
 	if err != nil {
@@ -26,7 +26,9 @@ package matching
 
 import (
 	"context"
+	"errors"
 	"math"
+	"sync"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -41,8 +43,17 @@ import (
 type TaskMatcher struct {
 	config *taskQueueConfig
 
-	// synchronous task channel to match producer/consumer
-	taskC chan *internalTask
+	// synchronous task channels to match producer/consumer, keyed by the
+	// compatible version set tasks and pollers belong to, with one channel
+	// per task priority level
+	taskCLock sync.Mutex
+	taskCs    map[string][]chan *internalTask
+	// versionKeyer derives the task channel key of tasks and pollers from
+	// their build ids, nil when the task queue is not versioned
+	versionKeyer *versionKeyer
+	// onPoll is called with the channel key of every poll, it releases the
+	// backlog tasks parked for lack of pollers of that key
+	onPoll func(key string)
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when namespace is
@@ -62,12 +73,20 @@ type TaskMatcher struct {
 const (
 	defaultTaskDispatchRPS    = 100000.0
 	defaultTaskDispatchRPSTTL = time.Minute
+	// versionedTaskOfferTimeout bounds how long MustOffer waits for a poller of the
+	// version set of a versioned task before giving up with errNoCompatiblePoller
+	versionedTaskOfferTimeout = time.Second
 )
 
+// errNoCompatiblePoller is returned by MustOffer when no poller of the version set of
+// a versioned task picked it up in time. The caller is expected to park the task until
+// a compatible poller polls rather than block the dispatch of tasks of other version sets.
+var errNoCompatiblePoller = errors.New("no poller compatible with the task build id")
+
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
-func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scope metrics.Scope) *TaskMatcher {
+func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scope metrics.Scope, versionKeyer *versionKeyer) *TaskMatcher {
 	dynamicRateBurst := quotas.NewMutableRateBurst(
 		defaultTaskDispatchRPS,
 		int(defaultTaskDispatchRPS),
@@ -90,7 +109,8 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scope metrics.Scop
 		rateLimiter:      limiter,
 		scope:            scope,
 		fwdr:             fwdr,
-		taskC:            make(chan *internalTask),
+		taskCs:           make(map[string][]chan *internalTask),
+		versionKeyer:     versionKeyer,
 		queryTaskC:       make(chan *internalTask),
 		numPartitions:    config.NumReadPartitions,
 	}
@@ -133,8 +153,9 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 		}
 	}
 
+	taskC := tm.taskCForTask(task)
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -159,7 +180,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 				task.isForwarded() { // task came from a child partition
 				// a forwarded backlog task from a child partition, block trying
 				// to match with a poller until ctx timeout
-				return tm.offerOrTimeout(ctx, task)
+				return tm.offerOrTimeout(ctx, taskC, task)
 			}
 		}
 
@@ -167,9 +188,9 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 }
 
-func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
+func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, taskC chan<- *internalTask, task *internalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case taskC <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -222,7 +243,9 @@ func (tm *TaskMatcher) OfferQuery(ctx context.Context, task *internalTask) (*mat
 }
 
 // MustOffer blocks until a consumer is found to handle this task
-// Returns error only when context is canceled or the ratelimit is set to zero (allow nothing)
+// Returns error only when context is canceled or the ratelimit is set to zero (allow nothing),
+// or with errNoCompatiblePoller when no poller of the version set of a versioned task shows up
+// within versionedTaskOfferTimeout
 // The passed in context MUST NOT have a deadline associated with it
 func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error {
 	if err := tm.rateLimiter.Wait(ctx); err != nil {
@@ -231,19 +254,33 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
+	taskC := tm.taskCForTask(task)
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
+	// tasks of a version set only match pollers of that set, don't let a task
+	// nobody can poll hold up the backlog behind it
+	var versionedTimeoutC <-chan time.Time
+	if tm.versionKeyer.taskKey(task.event.Data.GetBuildId()) != "" {
+		timer := time.NewTimer(versionedTaskOfferTimeout)
+		defer timer.Stop()
+		versionedTimeoutC = timer.C
+	}
+
 forLoop:
 	for {
+		// the version sets may have changed since the last attempt
+		taskC = tm.taskCForTask(task)
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
 			return nil
+		case <-versionedTimeoutC:
+			return errNoCompatiblePoller
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
 			err := tm.fwdr.ForwardTask(childCtx, task)
@@ -255,9 +292,12 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					cancel()
 					return nil
+				case <-versionedTimeoutC:
+					cancel()
+					return errNoCompatiblePoller
 				case <-childCtx.Done():
 				case <-ctx.Done():
 					cancel()
@@ -280,6 +320,7 @@ forLoop:
 
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
+// compatible with the build id of the poller found on the context
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*internalTask, error) {
 	return tm.poll(ctx, false)
@@ -320,16 +361,24 @@ func (tm *TaskMatcher) Rate() float64 {
 }
 
 func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
-	taskC, queryTaskC := tm.taskC, tm.queryTaskC
-	if queryOnly {
-		taskC = nil
+	// one task channel per priority level, left nil for query only polls
+	var taskCs []chan *internalTask
+	var taskC1, taskC2, taskC3, taskC4, taskC5 <-chan *internalTask
+	if !queryOnly {
+		buildID, _ := ctx.Value(workerBuildIDKey).(string)
+		key := tm.versionKeyer.pollerKey(buildID)
+		if tm.onPoll != nil {
+			tm.onPoll(key)
+		}
+		taskCs = tm.getTaskCs(key)
+		taskC1, taskC2, taskC3, taskC4, taskC5 = taskCs[0], taskCs[1], taskCs[2], taskCs[3], taskCs[4]
 	}
 
 	// We want to effectively do a prioritized select, but Go select is random
 	// if multiple cases are ready, so split into multiple selects.
 	// The priority order is:
 	// 1. ctx.Done
-	// 2. taskC and queryTaskC
+	// 2. taskCs in task priority order and queryTaskC
 	// 3. forwarding
 	// 4. block looking locally for remainder of context lifetime
 	// To correctly handle priorities and allow any case to succeed, all select
@@ -344,18 +393,19 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	default:
 	}
 
-	// 2. taskC and queryTaskC
-	select {
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
+	// 2. taskCs and queryTaskC
+	if taskCs != nil {
+		for _, level := range priorityOrder(tm.config.EnablePriorityFairness()) {
+			select {
+			case task := <-taskCs[level]:
+				return tm.polled(task), nil
+			default:
+			}
 		}
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
-	case task := <-queryTaskC:
-		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
+	}
+	select {
+	case task := <-tm.queryTaskC:
+		return tm.polled(task), nil
 	default:
 	}
 
@@ -364,16 +414,18 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	case <-ctx.Done():
 		tm.scope.IncCounter(metrics.PollTimeoutPerTaskQueueCounter)
 		return nil, ErrNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		}
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
-	case task := <-queryTaskC:
-		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
+	case task := <-tm.queryTaskC:
+		return tm.polled(task), nil
+	case task := <-taskC1:
+		return tm.polled(task), nil
+	case task := <-taskC2:
+		return tm.polled(task), nil
+	case task := <-taskC3:
+		return tm.polled(task), nil
+	case task := <-taskC4:
+		return tm.polled(task), nil
+	case task := <-taskC5:
+		return tm.polled(task), nil
 	case token := <-tm.fwdrPollReqTokenC():
 		if task, err := tm.fwdr.ForwardPoll(ctx); err == nil {
 			token.release()
@@ -387,16 +439,60 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	case <-ctx.Done():
 		tm.scope.IncCounter(metrics.PollTimeoutPerTaskQueueCounter)
 		return nil, ErrNoTasks
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		}
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
-	case task := <-queryTaskC:
+	case task := <-tm.queryTaskC:
+		return tm.polled(task), nil
+	case task := <-taskC1:
+		return tm.polled(task), nil
+	case task := <-taskC2:
+		return tm.polled(task), nil
+	case task := <-taskC3:
+		return tm.polled(task), nil
+	case task := <-taskC4:
+		return tm.polled(task), nil
+	case task := <-taskC5:
+		return tm.polled(task), nil
+	}
+}
+
+func (tm *TaskMatcher) polled(task *internalTask) *internalTask {
+	if task.responseC != nil {
 		tm.scope.IncCounter(metrics.PollSuccessWithSyncPerTaskQueueCounter)
-		tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
-		return task, nil
+	}
+	tm.scope.IncCounter(metrics.PollSuccessPerTaskQueueCounter)
+	return task
+}
+
+func (tm *TaskMatcher) taskCForTask(task *internalTask) chan *internalTask {
+	taskCs := tm.getTaskCs(tm.versionKeyer.taskKey(task.event.Data.GetBuildId()))
+	return taskCs[priorityLevel(task.event.Data.GetPriorityKey())]
+}
+
+// getTaskCs returns the task channels of the given version key, one per priority level
+func (tm *TaskMatcher) getTaskCs(key string) []chan *internalTask {
+	tm.taskCLock.Lock()
+	defer tm.taskCLock.Unlock()
+	taskCs, ok := tm.taskCs[key]
+	if !ok {
+		taskCs = make([]chan *internalTask, numPriorityLevels)
+		for i := range taskCs {
+			taskCs[i] = make(chan *internalTask)
+		}
+		tm.taskCs[key] = taskCs
+	}
+	return taskCs
+}
+
+// pruneTaskCs drops the task channels of keys no task or poller is routed to anymore
+// after the version sets changed, e.g. the empty key once the task queue gets its first
+// version set. Pollers still blocked on a dropped channel time out and poll again on the
+// channels of their new key.
+func (tm *TaskMatcher) pruneTaskCs() {
+	tm.taskCLock.Lock()
+	defer tm.taskCLock.Unlock()
+	for key := range tm.taskCs {
+		if !tm.versionKeyer.isKeyInUse(key) {
+			delete(tm.taskCs, key)
+		}
 	}
 }
 
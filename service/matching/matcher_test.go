@@ -81,12 +81,12 @@ func (t *MatcherTestSuite) SetupTest() {
 	}
 	t.cfg = tlCfg
 	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, t.client)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope)
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope, nil)
 
 	rootTaskQueue := newTestTaskQueueID(t.taskQueue.namespaceID, t.taskQueue.Parent(20), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	rootTaskqueueCfg, err := newTaskQueueConfig(rootTaskQueue, cfg, "test-namespace")
 	t.NoError(err)
-	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, metrics.NoopScope)
+	t.rootMatcher = newTaskMatcher(rootTaskqueueCfg, nil, metrics.NoopScope, nil)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -335,6 +335,121 @@ func (t *MatcherTestSuite) TestQueryRemoteSyncMatchError() {
 	t.True(matched)
 }
 
+func (t *MatcherTestSuite) TestVersionedSyncMatch() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	matcher := newTaskMatcher(t.cfg, nil, metrics.NoopScope, &versionKeyer{
+		versioningData: func() *persistencespb.VersioningData { return versioningData },
+	})
+
+	pollStarted := make(chan struct{})
+	pollDone := make(chan struct{})
+	go func() {
+		defer close(pollDone)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		ctx = context.WithValue(ctx, workerBuildIDKey, "1.1")
+		close(pollStarted)
+		task, err := matcher.Poll(ctx)
+		cancel()
+		if err == nil {
+			task.finish(nil)
+		}
+	}()
+
+	<-pollStarted
+	time.Sleep(10 * time.Millisecond)
+
+	offer := func(buildID string) bool {
+		taskInfo := randomTaskInfo()
+		taskInfo.Data.BuildId = buildID
+		task := newInternalTask(taskInfo, nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		syncMatch, err := matcher.Offer(ctx, task)
+		t.NoError(err)
+		return syncMatch
+	}
+
+	// tasks of the default version set and of unknown build ids are not handed to the poller
+	t.False(offer("2.0"))
+	t.False(offer("0.9"))
+	// a task of a compatible build id is
+	t.True(offer("1.0"))
+	<-pollDone
+}
+
+func (t *MatcherTestSuite) TestVersionedMustOffer_NoCompatiblePoller() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	matcher := newTaskMatcher(t.cfg, nil, metrics.NoopScope, &versionKeyer{
+		versioningData: func() *persistencespb.VersioningData { return versioningData },
+	})
+
+	taskInfo := randomTaskInfo()
+	taskInfo.Data.BuildId = "1.0"
+	task := newInternalTask(taskInfo, nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+	// no poller of version set 1.0 is around, the task is handed back
+	t.Equal(errNoCompatiblePoller, matcher.MustOffer(context.Background(), task))
+}
+
+func (t *MatcherTestSuite) TestPruneTaskCs() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	matcher := newTaskMatcher(t.cfg, nil, metrics.NoopScope, &versionKeyer{
+		versioningData: func() *persistencespb.VersioningData { return versioningData },
+	})
+	matcher.getTaskCs("1.0")
+	matcher.getTaskCs("2.0")
+
+	// 1.0 is retired
+	versioningData = &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	matcher.pruneTaskCs()
+	t.Len(matcher.taskCs, 1)
+	t.Contains(matcher.taskCs, "2.0")
+}
+
+func (t *MatcherTestSuite) TestPriorityMatch() {
+	matcher := newTaskMatcher(t.cfg, nil, metrics.NoopScope, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	offer := func(priorityKey int32) {
+		taskInfo := randomTaskInfo()
+		taskInfo.Data.PriorityKey = priorityKey
+		task := newInternalTask(taskInfo, nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		go func() { _ = matcher.MustOffer(ctx, task) }()
+	}
+	offer(5)
+	offer(0)
+	offer(1)
+	// let the offers block waiting for a poller
+	time.Sleep(10 * time.Millisecond)
+
+	var priorityKeys []int32
+	for i := 0; i < 3; i++ {
+		task, err := matcher.Poll(ctx)
+		t.NoError(err)
+		priorityKeys = append(priorityKeys, task.event.Data.GetPriorityKey())
+	}
+	t.Equal([]int32{1, 0, 5}, priorityKeys)
+}
+
 // todo: note from shawn, when does this case happen in production?
 func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	// force disable remote forwarding
@@ -65,8 +65,9 @@ const stickyPollerUnavailableWindow = 10 * time.Second
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey      string
+	identityCtxKey      string
+	workerBuildIDCtxKey string
 
 	// lockableQueryTaskMap maps query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel
 	// that QueryWorkflow() will block on. The channel is unblocked either by worker sending response through
@@ -114,8 +115,9 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task queue pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey      pollerIDCtxKey      = "pollerID"
+	identityKey      identityCtxKey      = "identity"
+	workerBuildIDKey workerBuildIDCtxKey = "workerBuildID"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -279,6 +281,9 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		Clock:       addRequest.GetClock(),
 		ExpiryTime:  expirationTime,
 		CreateTime:  now,
+		BuildId:     addRequest.GetBuildId(),
+		PriorityKey: addRequest.GetPriorityKey(),
+		FairnessKey: addRequest.GetFairnessKey(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -331,6 +336,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		Clock:       addRequest.GetClock(),
 		CreateTime:  now,
 		ExpiryTime:  expirationTime,
+		PriorityKey: addRequest.GetPriorityKey(),
+		FairnessKey: addRequest.GetFairnessKey(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -361,6 +368,9 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		// Workers report their build id as binary checksum, it is used to only hand
+		// out workflow tasks compatible with the worker
+		pollerCtx = context.WithValue(pollerCtx, workerBuildIDKey, request.GetBinaryChecksum())
 		taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 		if err != nil {
 			return nil, err
@@ -647,7 +657,148 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
+	response := tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus())
+	if request.GetAggregatePartitions() && taskQueue.IsRoot() && taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		backlogStats, err := e.describePartitionsBacklog(hCtx, request, tlMgr)
+		if err != nil {
+			return nil, err
+		}
+		response.BacklogStats = backlogStats
+	}
+	return response, nil
+}
+
+// describePartitionsBacklog fans out to all the partitions of a task queue and aggregates
+// their backlog stats, the root partition is described locally
+func (e *matchingEngineImpl) describePartitionsBacklog(
+	hCtx *handlerContext,
+	request *matchingservice.DescribeTaskQueueRequest,
+	rootMgr taskQueueManager,
+) (*matchingservice.TaskQueueBacklogStats, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := e.getAllPartitions(nsName, *request.DescRequest.TaskQueue, request.DescRequest.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+
+	stats := []*matchingservice.TaskQueueBacklogStats{rootMgr.DescribeTaskQueue(true).GetBacklogStats()}
+	for _, partition := range partitions {
+		if partition == request.DescRequest.TaskQueue.GetName() {
+			continue
+		}
+		resp, err := e.matchingClient.DescribeTaskQueue(hCtx.Context, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: request.GetNamespaceId(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace: request.DescRequest.GetNamespace(),
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: partition,
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          request.DescRequest.GetTaskQueueType(),
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, resp.GetBacklogStats())
+	}
+	return aggregateBacklogStats(stats), nil
+}
+
+// aggregateBacklogStats sums up the backlog counts and task rates of partitions, the
+// backlog age is the one of the oldest partition backlog
+func aggregateBacklogStats(stats []*matchingservice.TaskQueueBacklogStats) *matchingservice.TaskQueueBacklogStats {
+	result := &matchingservice.TaskQueueBacklogStats{}
+	for _, s := range stats {
+		result.ApproximateBacklogCount += s.GetApproximateBacklogCount()
+		result.TasksAddRate += s.GetTasksAddRate()
+		result.TasksDispatchRate += s.GetTasksDispatchRate()
+		if age := s.GetApproximateBacklogAge(); age != nil &&
+			(result.ApproximateBacklogAge == nil || *age > *result.ApproximateBacklogAge) {
+			result.ApproximateBacklogAge = timestamp.DurationPtr(*age)
+		}
+	}
+	return result
+}
+
+// UpdateWorkerBuildIdOrdering adds or promotes a worker build id in the version sets
+// of a task queue. Version sets are kept on the root partition of the workflow task queue.
+func (e *matchingEngineImpl) UpdateWorkerBuildIdOrdering(
+	hCtx *handlerContext,
+	request *matchingservice.UpdateWorkerBuildIdOrderingRequest,
+) (*matchingservice.UpdateWorkerBuildIdOrderingResponse, error) {
+	tlMgr, err := e.getVersionedTaskQueueManager(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	err = tlMgr.UpdateVersionSets(hCtx.Context, request.GetBuildId(), request.GetPreviousCompatible(), request.GetBecomeDefault())
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateWorkerBuildIdOrderingResponse{}, nil
+}
+
+// GetWorkerBuildIdOrdering returns the worker build id version sets of a task queue
+func (e *matchingEngineImpl) GetWorkerBuildIdOrdering(
+	hCtx *handlerContext,
+	request *matchingservice.GetWorkerBuildIdOrderingRequest,
+) (*matchingservice.GetWorkerBuildIdOrderingResponse, error) {
+	tlMgr, err := e.getVersionedTaskQueueManager(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	versioningData, err := tlMgr.GetVersionSets(hCtx.Context)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.GetWorkerBuildIdOrderingResponse{VersioningData: versioningData}, nil
+}
+
+func (e *matchingEngineImpl) getVersionedTaskQueueManager(
+	namespaceID namespace.ID,
+	taskQueueName string,
+) (taskQueueManager, error) {
+	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("Version sets can only be managed on the root task queue partition.")
+	}
+	return e.getTaskQueueManager(taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL)
+}
+
+// PartitionConfig returns the partition counts known to a loaded task queue partition and
+// whether the adaptive partition controller is known to be disabled for it. It does not
+// load the partition so that it can be called after the partition is unloaded, partitions
+// which are not loaded report neither.
+func (e *matchingEngineImpl) PartitionConfig(
+	namespaceID namespace.ID,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskQueueType enumspb.TaskQueueType,
+) (*persistencespb.TaskQueuePartitionConfig, bool) {
+	if taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil, false
+	}
+	taskQueueID, err := newTaskQueueID(namespaceID, taskQueue.GetName(), taskQueueType)
+	if err != nil {
+		return nil, false
+	}
+	e.taskQueuesLock.RLock()
+	tqMgr, ok := e.taskQueues[*taskQueueID]
+	e.taskQueuesLock.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if partitionConfig := tqMgr.PartitionConfig(); partitionConfig != nil {
+		return partitionConfig, false
+	}
+	return nil, tqMgr.AdaptivePartitionsDisabled()
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
@@ -719,8 +870,15 @@ func (e *matchingEngineImpl) getAllPartitions(
 
 	partitionKeys = append(partitionKeys, rootPartition)
 
-	nWritePartitions := e.config.NumTaskqueueWritePartitions
-	n := nWritePartitions(namespace.String(), rootPartition, taskQueueType)
+	// partitions past the write partition count are still polled until their backlog is drained
+	n := common.MaxInt(
+		e.config.NumTaskqueueWritePartitions(namespace.String(), rootPartition, taskQueueType),
+		e.config.NumTaskqueueReadPartitions(namespace.String(), rootPartition, taskQueueType),
+	)
+	rootTaskQueue := &taskqueuepb.TaskQueue{Name: rootPartition, Kind: taskQueue.GetKind()}
+	if partitionConfig, _ := e.PartitionConfig(namespaceID, rootTaskQueue, taskQueueType); partitionConfig != nil {
+		n = int(partitionConfig.GetReadPartitionCount())
+	}
 	if n <= 0 {
 		return partitionKeys, nil
 	}
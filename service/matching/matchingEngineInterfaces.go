@@ -25,7 +25,12 @@
 package matching
 
 import (
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
 )
 
 type (
@@ -41,5 +46,11 @@ type (
 		CancelOutstandingPoll(hCtx *handlerContext, request *matchingservice.CancelOutstandingPollRequest) error
 		DescribeTaskQueue(hCtx *handlerContext, request *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error)
 		ListTaskQueuePartitions(hCtx *handlerContext, request *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error)
+		UpdateWorkerBuildIdOrdering(hCtx *handlerContext, request *matchingservice.UpdateWorkerBuildIdOrderingRequest) (*matchingservice.UpdateWorkerBuildIdOrderingResponse, error)
+		GetWorkerBuildIdOrdering(hCtx *handlerContext, request *matchingservice.GetWorkerBuildIdOrderingRequest) (*matchingservice.GetWorkerBuildIdOrderingResponse, error)
+		// PartitionConfig returns the partition counts known to a loaded task queue partition
+		// when they are managed by the adaptive partition controller, nil otherwise. The
+		// returned flag is set when the partition knows the controller is disabled.
+		PartitionConfig(namespaceID namespace.ID, taskQueue *taskqueuepb.TaskQueue, taskQueueType enumspb.TaskQueueType) (*persistencespb.TaskQueuePartitionConfig, bool)
 	}
 )
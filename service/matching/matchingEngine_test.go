@@ -241,6 +241,61 @@ func (s *matchingEngineSuite) TestAckManager_Sort() {
 	s.EqualValues(t5, m.getAckLevel())
 }
 
+func (s *matchingEngineSuite) TestAckManager_BacklogStats() {
+	m := newAckManager(s.logger)
+	m.setAckLevel(100)
+	m.setApproximateBacklogCount(5, map[string]int64{"a": 2, "b": 1})
+	_, ok := m.getOldestTaskCreateTime()
+	s.False(ok)
+
+	now := time.Now().UTC()
+	m.addTask(200)
+	m.setTaskInfo(200, now.Add(-time.Minute), "a")
+	m.addTask(220)
+	m.setTaskInfo(220, now, "b")
+	oldest, ok := m.getOldestTaskCreateTime()
+	s.True(ok)
+	s.Equal(now.Add(-time.Minute), oldest)
+
+	m.completeTask(200)
+	s.EqualValues(4, m.getApproximateBacklogCount())
+	s.Equal(map[string]int64{"a": 1, "b": 1}, m.getFairnessKeyBacklogCounts())
+	oldest, ok = m.getOldestTaskCreateTime()
+	s.True(ok)
+	s.Equal(now, oldest)
+
+	// completing a task twice does not change the count
+	m.completeTask(200)
+	s.EqualValues(4, m.getApproximateBacklogCount())
+	s.Equal(map[string]int64{"a": 1, "b": 1}, m.getFairnessKeyBacklogCounts())
+
+	// keys without tasks are removed
+	m.completeTask(220)
+	s.EqualValues(3, m.getApproximateBacklogCount())
+	s.Equal(map[string]int64{"a": 1}, m.getFairnessKeyBacklogCounts())
+	_, ok = m.getOldestTaskCreateTime()
+	s.False(ok)
+
+	m.addApproximateBacklogCount("a", -10)
+	s.EqualValues(0, m.getApproximateBacklogCount())
+	s.Empty(m.getFairnessKeyBacklogCounts())
+}
+
+func (s *matchingEngineSuite) TestAggregateBacklogStats() {
+	stats := aggregateBacklogStats([]*matchingservice.TaskQueueBacklogStats{
+		{ApproximateBacklogCount: 3, TasksAddRate: 1.5, TasksDispatchRate: 2},
+		{ApproximateBacklogCount: 4, ApproximateBacklogAge: timestamp.DurationPtr(time.Minute), TasksAddRate: 0.5},
+		nil,
+		{ApproximateBacklogAge: timestamp.DurationPtr(time.Second), TasksDispatchRate: 1},
+	})
+	s.Equal(&matchingservice.TaskQueueBacklogStats{
+		ApproximateBacklogCount: 7,
+		ApproximateBacklogAge:   timestamp.DurationPtr(time.Minute),
+		TasksAddRate:            2,
+		TasksDispatchRate:       3,
+	}, stats)
+}
+
 func (s *matchingEngineSuite) TestPollActivityTaskQueuesEmptyResult() {
 	s.PollForTasksEmptyResultTest(context.Background(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
 }
@@ -396,6 +451,51 @@ func (s *matchingEngineSuite) TestPollWorkflowTaskQueues() {
 	s.Equal(expectedResp, resp)
 }
 
+func (s *matchingEngineSuite) TestUpdateWorkerBuildIdOrdering() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+
+	update := func(taskQueue string, buildID string, previousCompatible string, becomeDefault bool) error {
+		_, err := s.matchingEngine.UpdateWorkerBuildIdOrdering(s.handlerContext, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+			NamespaceId:        namespaceID.String(),
+			TaskQueue:          taskQueue,
+			BuildId:            buildID,
+			PreviousCompatible: previousCompatible,
+			BecomeDefault:      becomeDefault,
+		})
+		return err
+	}
+
+	// the update is rejected until the task queue lease is acquired
+	s.Eventually(func() bool {
+		return update(tl, "1.0", "", true) == nil
+	}, time.Second, 10*time.Millisecond)
+	s.NoError(update(tl, "1.1", "1.0", false))
+	s.NoError(update(tl, "2.0", "", true))
+	s.IsType(&serviceerror.InvalidArgument{}, update(tl, "1.1", "", false))
+	s.IsType(&serviceerror.InvalidArgument{}, update(tl, "3.0", "unknown", false))
+	s.IsType(&serviceerror.InvalidArgument{}, update("/_sys/makeToast/1", "3.0", "", true))
+
+	expected := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	resp, err := s.matchingEngine.GetWorkerBuildIdOrdering(s.handlerContext, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tl,
+	})
+	s.NoError(err)
+	s.Equal(expected, resp.GetVersioningData())
+
+	tlm := s.taskManager.getTaskQueueManager(tlID)
+	tlm.Lock()
+	defer tlm.Unlock()
+	s.Equal(expected, tlm.versioningData)
+}
+
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType enumspb.TaskQueueType) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
@@ -1629,8 +1729,8 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := common.MinInt(tlMgr.taskReader.taskBuffer.capacity(), taskCount)
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.size() == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -1796,7 +1896,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task queue manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.size() >= (taskCount/2 - 1) }, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 
@@ -1930,9 +2030,14 @@ type testTaskQueueManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
-	createTaskCount int
-	getTasksCount   int
-	tasks           *treemap.Map
+	versioningData  *persistencespb.VersioningData
+	partitionConfig *persistencespb.TaskQueuePartitionConfig
+	backlogCount    int64
+	// fairnessKeyBacklogCounts is the backlog count per fairness key
+	fairnessKeyBacklogCounts map[string]int64
+	createTaskCount          int
+	getTasksCount            int
+	tasks                    *treemap.Map
 }
 
 func (m *testTaskQueueManager) RangeID() int64 {
@@ -1983,6 +2088,10 @@ func (m *testTaskManager) CreateTaskQueue(
 
 	tlm.rangeID = request.RangeID
 	tlm.ackLevel = tli.AckLevel
+	tlm.versioningData = tli.VersioningData
+	tlm.partitionConfig = tli.PartitionConfig
+	tlm.backlogCount = tli.ApproximateBacklogCount
+	tlm.fairnessKeyBacklogCounts = tli.FairnessKeyBacklogCounts
 	return &persistence.CreateTaskQueueResponse{}, nil
 }
 
@@ -2002,6 +2111,10 @@ func (m *testTaskManager) UpdateTaskQueue(
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.versioningData = tli.VersioningData
+	tlm.partitionConfig = tli.PartitionConfig
+	tlm.backlogCount = tli.ApproximateBacklogCount
+	tlm.fairnessKeyBacklogCounts = tli.FairnessKeyBacklogCounts
 	tlm.rangeID = request.RangeID
 	return &persistence.UpdateTaskQueueResponse{}, nil
 }
@@ -2019,13 +2132,17 @@ func (m *testTaskManager) GetTaskQueue(
 	}
 	return &persistence.GetTaskQueueResponse{
 		TaskQueueInfo: &persistencespb.TaskQueueInfo{
-			NamespaceId:    request.NamespaceID,
-			Name:           request.TaskQueue,
-			TaskType:       request.TaskType,
-			Kind:           enumspb.TASK_QUEUE_KIND_NORMAL,
-			AckLevel:       tlm.ackLevel,
-			ExpiryTime:     nil,
-			LastUpdateTime: timestamp.TimeNowPtrUtc(),
+			NamespaceId:              request.NamespaceID,
+			Name:                     request.TaskQueue,
+			TaskType:                 request.TaskType,
+			Kind:                     enumspb.TASK_QUEUE_KIND_NORMAL,
+			AckLevel:                 tlm.ackLevel,
+			VersioningData:           tlm.versioningData,
+			PartitionConfig:          tlm.partitionConfig,
+			ApproximateBacklogCount:  tlm.backlogCount,
+			FairnessKeyBacklogCounts: tlm.fairnessKeyBacklogCounts,
+			ExpiryTime:               nil,
+			LastUpdateTime:           timestamp.TimeNowPtrUtc(),
 		},
 		RangeID: tlm.rangeID,
 	}, nil
@@ -0,0 +1,344 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/internal/goro"
+)
+
+const (
+	// partitionRetireGracePeriod is how long retiring partitions keep being polled after
+	// the write partition count was lowered, it gives the other partitions and the clients
+	// time to learn the new count and stop adding tasks to the retiring partitions
+	partitionRetireGracePeriod = 2 * rootMetadataRefreshInterval
+
+	// partitionScaleDownUtilization is the utilization of the target rate the remaining
+	// write partitions must stay under for the write partition count to be lowered, it
+	// keeps the partition count from flapping around the target rate
+	partitionScaleDownUtilization = 0.5
+)
+
+type (
+	// partitionController runs on the root partition of a normal task queue and adjusts
+	// the partition counts of the task queue to its load when adaptive partitions are
+	// enabled. Partitions are added right away. Partitions are removed by first lowering
+	// the write partition count, the retiring partitions are still polled and forward
+	// their backlog to their parent until it is drained, only then the read partition
+	// count is lowered. The removed partitions are still observed for a grace period and
+	// polled again if tasks show up in them.
+	partitionController struct {
+		status         int32
+		tqMgr          *taskQueueManagerImpl
+		matchingClient matchingservice.MatchingServiceClient
+		loop           *goro.Handle
+		// counters reported by each partition in the last round, used to compute the rates
+		lastStats     map[int]*matchingservice.TaskQueuePartitionStats
+		lastStatsTime time.Time
+		// time at which the write partition count was last lowered
+		writeScaleDownTime time.Time
+		// removedReadCount is the read partition count before it was last lowered, the
+		// removed partitions are observed until the grace period after readScaleDownTime is over
+		removedReadCount  int32
+		readScaleDownTime time.Time
+	}
+
+	// partitionLoad is the load observed on the partitions of a task queue
+	partitionLoad struct {
+		// rate of the tasks added or dispatched, whichever is higher, across all partitions
+		taskRate float64
+		// drained tells for each observed partition whether all of its backlog is dispatched,
+		// the observed partitions are the read partitions and the recently removed ones
+		drained []bool
+	}
+)
+
+func newPartitionController(
+	tqMgr *taskQueueManagerImpl,
+	matchingClient matchingservice.MatchingServiceClient,
+) *partitionController {
+	return &partitionController{
+		status:         common.DaemonStatusInitialized,
+		tqMgr:          tqMgr,
+		matchingClient: matchingClient,
+	}
+}
+
+func (pc *partitionController) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&pc.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+	pc.loop = goro.Go(context.Background(), pc.run)
+}
+
+func (pc *partitionController) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&pc.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+	pc.loop.Cancel()
+}
+
+func (pc *partitionController) run(ctx context.Context) error {
+	timer := time.NewTimer(pc.tqMgr.config.AdaptivePartitionsUpdateInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if err := pc.update(ctx); err != nil && ctx.Err() == nil {
+				pc.tqMgr.logger.Warn("Failed to update task queue partition counts", tag.Error(err))
+			}
+			timer.Reset(pc.tqMgr.config.AdaptivePartitionsUpdateInterval())
+		}
+	}
+}
+
+// update observes the load of the partitions and persists new partition counts when
+// they need to change
+func (pc *partitionController) update(ctx context.Context) error {
+	tqMgr := pc.tqMgr
+	config := tqMgr.config
+	if tqMgr.db.RangeID() == 0 {
+		// lease is not acquired yet
+		return nil
+	}
+
+	current := tqMgr.db.PartitionConfig()
+	if !config.EnableAdaptivePartitions() {
+		pc.lastStats = nil
+		if current == nil {
+			return nil
+		}
+		// partitions and clients fall back to the configured partition counts
+		return tqMgr.updatePartitionConfig(ctx, nil)
+	}
+	maxPartitions := config.NumWritePartitions()
+	if current == nil {
+		// start from the configured partition counts so that no partition stops being polled
+		return tqMgr.updatePartitionConfig(ctx, &persistencespb.TaskQueuePartitionConfig{
+			WritePartitionCount: int32(maxPartitions),
+			ReadPartitionCount:  int32(common.MaxInt(maxPartitions, config.NumReadPartitions())),
+		})
+	}
+
+	now := time.Now().UTC()
+	numObserved := int(current.ReadPartitionCount)
+	if pc.removedReadCount > current.ReadPartitionCount {
+		if now.Sub(pc.readScaleDownTime) < partitionRetireGracePeriod {
+			// tasks added by clients which had not learnt the new counts yet are found
+			numObserved = int(pc.removedReadCount)
+		} else {
+			pc.removedReadCount = 0
+		}
+	}
+	load, ok, err := pc.observe(ctx, numObserved, now)
+	if err != nil || !ok {
+		return err
+	}
+	if current.ReadPartitionCount > current.WritePartitionCount && pc.writeScaleDownTime.IsZero() {
+		// the write partition count was lowered before this partition was loaded
+		pc.writeScaleDownTime = now
+	}
+
+	next := nextPartitionConfig(
+		current,
+		load,
+		config.AdaptivePartitionsTargetRate(),
+		maxPartitions,
+		now.Sub(pc.writeScaleDownTime) >= partitionRetireGracePeriod,
+	)
+	if next.Equal(current) {
+		return nil
+	}
+	if err := tqMgr.updatePartitionConfig(ctx, next); err != nil {
+		return err
+	}
+	if next.WritePartitionCount < current.WritePartitionCount {
+		pc.writeScaleDownTime = now
+	}
+	if next.ReadPartitionCount < current.ReadPartitionCount {
+		pc.removedReadCount = current.ReadPartitionCount
+		pc.readScaleDownTime = now
+	}
+	tqMgr.logger.Info("Updated task queue partition counts",
+		tag.NewInt32("write-partitions", next.WritePartitionCount),
+		tag.NewInt32("read-partitions", next.ReadPartitionCount),
+		tag.NewAnyTag("task-rate", load.taskRate))
+	return nil
+}
+
+// observe describes the first numPartitions partitions of the task queue and computes
+// their load from the counters reported since the last round. Returns false when the
+// load cannot be computed yet, i.e. on the first round.
+func (pc *partitionController) observe(
+	ctx context.Context,
+	numPartitions int,
+	now time.Time,
+) (partitionLoad, bool, error) {
+	tqMgr := pc.tqMgr
+	stats := make(map[int]*matchingservice.TaskQueuePartitionStats, numPartitions)
+	load := partitionLoad{drained: make([]bool, numPartitions)}
+	for i := 0; i < numPartitions; i++ {
+		resp, err := pc.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: tqMgr.taskQueueID.namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: tqMgr.taskQueueID.mkName(i),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          tqMgr.taskQueueID.taskType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			return partitionLoad{}, false, err
+		}
+		stats[i] = resp.GetPartitionStats()
+		load.drained[i] = resp.GetPartitionStats().GetBacklogDrained()
+	}
+
+	lastStats, lastStatsTime := pc.lastStats, pc.lastStatsTime
+	pc.lastStats, pc.lastStatsTime = stats, now
+	elapsed := now.Sub(lastStatsTime).Seconds()
+	if lastStats == nil || elapsed <= 0 {
+		return partitionLoad{}, false, nil
+	}
+
+	var added, dispatched int64
+	for i, s := range stats {
+		last := lastStats[i]
+		added += counterDelta(last.GetTasksAdded(), s.GetTasksAdded())
+		dispatched += counterDelta(last.GetTasksDispatched(), s.GetTasksDispatched())
+	}
+	load.taskRate = float64(common.MaxInt64(added, dispatched)) / elapsed
+	return load, true, nil
+}
+
+// counterDelta returns how much a partition counter grew, the counters start over from
+// zero when the partition is reloaded
+func counterDelta(last int64, current int64) int64 {
+	if current < last {
+		return current
+	}
+	return current - last
+}
+
+// nextPartitionConfig computes the partition counts of a task queue from its load.
+// The write partition count is raised as soon as the task rate exceeds the target rate
+// of the current partitions, and lowered when the remaining partitions would stay well
+// under their target rate. The read partition count follows the write partition count
+// once the retire grace period is over and the retiring partitions are drained, it is
+// raised again when a removed partition is observed with a backlog.
+func nextPartitionConfig(
+	current *persistencespb.TaskQueuePartitionConfig,
+	load partitionLoad,
+	targetRate float64,
+	maxPartitions int,
+	retireGracePeriodOver bool,
+) *persistencespb.TaskQueuePartitionConfig {
+	write := int(current.WritePartitionCount)
+	read := int(current.ReadPartitionCount)
+
+	for i := len(load.drained) - 1; i >= read; i-- {
+		if !load.drained[i] {
+			// the partition got tasks after it was seen drained, poll it again
+			return &persistencespb.TaskQueuePartitionConfig{
+				WritePartitionCount: int32(write),
+				ReadPartitionCount:  int32(i + 1),
+			}
+		}
+	}
+
+	up := partitionsForRate(load.taskRate, targetRate, maxPartitions)
+	if up > write {
+		return &persistencespb.TaskQueuePartitionConfig{
+			WritePartitionCount: int32(up),
+			ReadPartitionCount:  int32(common.MaxInt(read, up)),
+		}
+	}
+
+	if read > write {
+		// partitions are retiring, wait for them to drain before removing more
+		if !retireGracePeriodOver {
+			return current
+		}
+		for i := write; i < read; i++ {
+			if i >= len(load.drained) || !load.drained[i] {
+				return current
+			}
+		}
+		return &persistencespb.TaskQueuePartitionConfig{
+			WritePartitionCount: int32(write),
+			ReadPartitionCount:  int32(write),
+		}
+	}
+
+	down := partitionsForRate(load.taskRate, targetRate*partitionScaleDownUtilization, maxPartitions)
+	if down < write {
+		return &persistencespb.TaskQueuePartitionConfig{
+			WritePartitionCount: int32(down),
+			ReadPartitionCount:  int32(read),
+		}
+	}
+	return current
+}
+
+// partitionsForRate returns the number of partitions needed to keep the rate of each
+// partition under the target rate
+func partitionsForRate(rate float64, targetRate float64, maxPartitions int) int {
+	if targetRate <= 0 {
+		return maxPartitions
+	}
+	n := int(math.Ceil(rate / targetRate))
+	if n < 1 {
+		return 1
+	}
+	if n > maxPartitions {
+		return maxPartitions
+	}
+	return n
+}
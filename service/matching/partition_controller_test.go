@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func TestNextPartitionConfig(t *testing.T) {
+	cfg := func(write, read int32) *persistencespb.TaskQueuePartitionConfig {
+		return &persistencespb.TaskQueuePartitionConfig{WritePartitionCount: write, ReadPartitionCount: read}
+	}
+	drained := func(d ...bool) []bool { return d }
+
+	testCases := []struct {
+		name          string
+		current       *persistencespb.TaskQueuePartitionConfig
+		load          partitionLoad
+		gracePeriodOk bool
+		expected      *persistencespb.TaskQueuePartitionConfig
+	}{
+		{
+			name:     "scale up",
+			current:  cfg(1, 1),
+			load:     partitionLoad{taskRate: 250, drained: drained(true)},
+			expected: cfg(3, 3),
+		},
+		{
+			name:     "scale up capped at max partitions",
+			current:  cfg(2, 2),
+			load:     partitionLoad{taskRate: 10000, drained: drained(true, true)},
+			expected: cfg(4, 4),
+		},
+		{
+			name:     "scale up while partitions are retiring",
+			current:  cfg(2, 4),
+			load:     partitionLoad{taskRate: 300, drained: drained(true, true, false, false)},
+			expected: cfg(3, 4),
+		},
+		{
+			name:     "steady",
+			current:  cfg(3, 3),
+			load:     partitionLoad{taskRate: 250, drained: drained(true, true, true)},
+			expected: cfg(3, 3),
+		},
+		{
+			name:     "scale down write partitions first",
+			current:  cfg(4, 4),
+			load:     partitionLoad{taskRate: 90, drained: drained(false, false, false, false)},
+			expected: cfg(2, 4),
+		},
+		{
+			name:     "no scale down within utilization headroom",
+			current:  cfg(2, 2),
+			load:     partitionLoad{taskRate: 60, drained: drained(true, true)},
+			expected: cfg(2, 2),
+		},
+		{
+			name:     "never below one partition",
+			current:  cfg(1, 1),
+			load:     partitionLoad{taskRate: 0, drained: drained(true)},
+			expected: cfg(1, 1),
+		},
+		{
+			name:     "retiring partitions within grace period",
+			current:  cfg(1, 3),
+			load:     partitionLoad{taskRate: 0, drained: drained(true, true, true)},
+			expected: cfg(1, 3),
+		},
+		{
+			name:          "retiring partitions not drained",
+			current:       cfg(1, 3),
+			load:          partitionLoad{taskRate: 0, drained: drained(true, true, false)},
+			gracePeriodOk: true,
+			expected:      cfg(1, 3),
+		},
+		{
+			name:          "retiring partitions drained",
+			current:       cfg(1, 3),
+			load:          partitionLoad{taskRate: 0, drained: drained(false, true, true)},
+			gracePeriodOk: true,
+			expected:      cfg(1, 1),
+		},
+		{
+			name:     "removed partitions drained",
+			current:  cfg(1, 1),
+			load:     partitionLoad{taskRate: 0, drained: drained(false, true, true)},
+			expected: cfg(1, 1),
+		},
+		{
+			name:     "removed partition with a backlog",
+			current:  cfg(1, 1),
+			load:     partitionLoad{taskRate: 0, drained: drained(true, false, true)},
+			expected: cfg(1, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := nextPartitionConfig(tc.current, tc.load, 100, 4, tc.gracePeriodOk)
+			require.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func TestPartitionsForRate(t *testing.T) {
+	require.Equal(t, 1, partitionsForRate(0, 100, 4))
+	require.Equal(t, 1, partitionsForRate(100, 100, 4))
+	require.Equal(t, 2, partitionsForRate(101, 100, 4))
+	require.Equal(t, 4, partitionsForRate(1000, 100, 4))
+	require.Equal(t, 4, partitionsForRate(1, 0, 4))
+}
+
+func TestCounterDelta(t *testing.T) {
+	require.Equal(t, int64(5), counterDelta(10, 15))
+	require.Equal(t, int64(0), counterDelta(10, 10))
+	// counters start over when the partition is reloaded
+	require.Equal(t, int64(3), counterDelta(10, 3))
+}
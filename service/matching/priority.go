@@ -0,0 +1,268 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	// numPriorityLevels is the number of task priority levels. Priority keys go from
+	// 1 (highest priority) to numPriorityLevels (lowest priority). TaskMatcher.poll
+	// selects on one channel per level and must be updated along with it.
+	numPriorityLevels = 5
+	// defaultPriorityKey is the priority of tasks which do not specify one
+	defaultPriorityKey = 3
+)
+
+type (
+	// priorityTaskBuffer is a bounded buffer of tasks loaded from persistence. Tasks are
+	// taken out of the buffer by priority, either strictly or, when fairness is enabled,
+	// by smooth weighted round robin across the priority levels which have tasks. Within
+	// a priority level, tasks are taken weighted round robin across their fairness keys.
+	//
+	// The task reader bounds the tasks of each priority level and fairness key in the
+	// buffer, so that a large backlog of one key does not keep the tasks of the other keys
+	// from being loaded, see taskReader.addTasksToBuffer.
+	priorityTaskBuffer struct {
+		sync.Mutex
+		levels   []*fairnessQueue
+		credits  []int
+		numTasks int
+		maxTasks int
+		closed   bool
+		fairness func() bool
+		// notFullC and notEmptyC wake up producers and consumers waiting on the buffer
+		notFullC  chan struct{}
+		notEmptyC chan struct{}
+	}
+)
+
+// priorityLevel returns the index of the priority level of the given priority key,
+// 0 being the highest priority
+func priorityLevel(priorityKey int32) int {
+	if priorityKey <= 0 {
+		priorityKey = defaultPriorityKey
+	}
+	if priorityKey > numPriorityLevels {
+		priorityKey = numPriorityLevels
+	}
+	return int(priorityKey) - 1
+}
+
+// priorityWeight returns the share of dispatches a priority level gets when fairness is
+// enabled, each level gets twice the share of the next lower one
+func priorityWeight(level int) int {
+	return 1 << (numPriorityLevels - 1 - level)
+}
+
+// priorityOrder returns the order in which the priority levels are checked for tasks.
+// Without fairness, levels are checked from the highest to the lowest priority. With
+// fairness, a level chosen at random in proportion to its weight is checked first.
+func priorityOrder(fairness bool) []int {
+	order := make([]int, numPriorityLevels)
+	for i := range order {
+		order[i] = i
+	}
+	if !fairness {
+		return order
+	}
+
+	totalWeight := 0
+	for level := 0; level < numPriorityLevels; level++ {
+		totalWeight += priorityWeight(level)
+	}
+	n := rand.Intn(totalWeight)
+	first := 0
+	for ; first < numPriorityLevels-1; first++ {
+		n -= priorityWeight(first)
+		if n < 0 {
+			break
+		}
+	}
+	copy(order[1:first+1], order[:first])
+	order[0] = first
+	return order
+}
+
+func newPriorityTaskBuffer(
+	capacity int,
+	fairness func() bool,
+	fairnessKeyWeights func() map[string]interface{},
+) *priorityTaskBuffer {
+	levels := make([]*fairnessQueue, numPriorityLevels)
+	for i := range levels {
+		levels[i] = newFairnessQueue(fairnessKeyWeights)
+	}
+	return &priorityTaskBuffer{
+		levels:    levels,
+		credits:   make([]int, numPriorityLevels),
+		maxTasks:  capacity,
+		fairness:  fairness,
+		notFullC:  make(chan struct{}, 1),
+		notEmptyC: make(chan struct{}, 1),
+	}
+}
+
+// add adds a task to the buffer, blocking while the buffer is full
+func (b *priorityTaskBuffer) add(ctx context.Context, task *persistencespb.AllocatedTaskInfo) error {
+	for {
+		b.Lock()
+		if b.closed {
+			b.Unlock()
+			return errPumpClosed
+		}
+		if b.numTasks < b.maxTasks {
+			level := priorityLevel(task.Data.GetPriorityKey())
+			b.levels[level].push(task)
+			b.numTasks++
+			notFull := b.numTasks < b.maxTasks
+			b.Unlock()
+			wakeUp(b.notEmptyC)
+			if notFull {
+				// pass the wake up on to other waiting producers
+				wakeUp(b.notFullC)
+			}
+			return nil
+		}
+		b.Unlock()
+
+		select {
+		case <-b.notFullC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// take removes the next task to dispatch from the buffer, blocking while the buffer
+// is empty. Returns false once the buffer is closed and drained.
+func (b *priorityTaskBuffer) take(ctx context.Context) (*persistencespb.AllocatedTaskInfo, bool, error) {
+	for {
+		b.Lock()
+		if b.numTasks > 0 {
+			level := b.nextLevelLocked()
+			task := b.levels[level].pop()
+			b.numTasks--
+			notEmpty := b.numTasks > 0
+			b.Unlock()
+			wakeUp(b.notFullC)
+			if notEmpty {
+				// pass the wake up on to other waiting consumers
+				wakeUp(b.notEmptyC)
+			}
+			return task, true, nil
+		}
+		closed := b.closed
+		b.Unlock()
+		if closed {
+			return nil, false, nil
+		}
+
+		select {
+		case <-b.notEmptyC:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+}
+
+// requeue puts a task taken from the buffer back behind the other tasks of its priority
+// level. The task still counts against the read level of the task reader, so it is put
+// back even when the buffer is full or closed.
+func (b *priorityTaskBuffer) requeue(task *persistencespb.AllocatedTaskInfo) {
+	b.Lock()
+	level := priorityLevel(task.Data.GetPriorityKey())
+	b.levels[level].push(task)
+	b.numTasks++
+	b.Unlock()
+	wakeUp(b.notEmptyC)
+}
+
+// close stops the buffer from accepting tasks, tasks already in the buffer can still be taken
+func (b *priorityTaskBuffer) close() {
+	b.Lock()
+	b.closed = true
+	b.Unlock()
+	wakeUp(b.notEmptyC)
+	wakeUp(b.notFullC)
+}
+
+func (b *priorityTaskBuffer) size() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.numTasks
+}
+
+// backlogKeyLen returns the number of buffered tasks of the given backlog key
+func (b *priorityTaskBuffer) backlogKeyLen(key backlogKey) int {
+	b.Lock()
+	defer b.Unlock()
+	return b.levels[key.level].keyLen(key.fairnessKey)
+}
+
+func (b *priorityTaskBuffer) capacity() int {
+	return b.maxTasks
+}
+
+func (b *priorityTaskBuffer) nextLevelLocked() int {
+	if !b.fairness() {
+		for level, tasks := range b.levels {
+			if tasks.len() > 0 {
+				return level
+			}
+		}
+	}
+
+	// smooth weighted round robin: every level with tasks earns credits by its weight,
+	// the level with the most credits is picked and pays for the round
+	next := -1
+	totalWeight := 0
+	for level, tasks := range b.levels {
+		if tasks.len() == 0 {
+			b.credits[level] = 0
+			continue
+		}
+		weight := priorityWeight(level)
+		totalWeight += weight
+		b.credits[level] += weight
+		if next < 0 || b.credits[level] > b.credits[next] {
+			next = level
+		}
+	}
+	b.credits[next] -= totalWeight
+	return next
+}
+
+func wakeUp(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	priorityTaskBufferSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestPriorityTaskBufferSuite(t *testing.T) {
+	s := new(priorityTaskBufferSuite)
+	suite.Run(t, s)
+}
+
+func (s *priorityTaskBufferSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *priorityTaskBufferSuite) TestPriorityLevel() {
+	s.Equal(0, priorityLevel(1))
+	s.Equal(defaultPriorityKey-1, priorityLevel(0))
+	s.Equal(numPriorityLevels-1, priorityLevel(numPriorityLevels))
+	s.Equal(numPriorityLevels-1, priorityLevel(numPriorityLevels+10))
+	s.Equal(defaultPriorityKey-1, priorityLevel(-1))
+}
+
+func (s *priorityTaskBufferSuite) TestPriorityOrder() {
+	s.Equal([]int{0, 1, 2, 3, 4}, priorityOrder(false))
+	for i := 0; i < 100; i++ {
+		order := priorityOrder(true)
+		s.Len(order, numPriorityLevels)
+		// all levels but the first one are in priority order
+		rest := make([]int, 0, numPriorityLevels-1)
+		for level := 0; level < numPriorityLevels; level++ {
+			if level != order[0] {
+				rest = append(rest, level)
+			}
+		}
+		s.Equal(rest, order[1:])
+	}
+}
+
+func (s *priorityTaskBufferSuite) TestTake_Strict() {
+	buffer := newPriorityTaskBuffer(10, func() bool { return false }, nil)
+	ctx := context.Background()
+	for _, key := range []int32{5, 0, 1, 3, 1} {
+		s.NoError(buffer.add(ctx, mkPriorityTask(key)))
+	}
+	s.Equal(5, buffer.size())
+
+	var keys []int32
+	for i := 0; i < 5; i++ {
+		task, ok, err := buffer.take(ctx)
+		s.NoError(err)
+		s.True(ok)
+		keys = append(keys, task.Data.GetPriorityKey())
+	}
+	// tasks of the same priority are taken in the order they were added
+	s.Equal([]int32{1, 1, 0, 3, 5}, keys)
+	s.Equal(0, buffer.size())
+}
+
+func (s *priorityTaskBufferSuite) TestTake_Fairness() {
+	buffer := newPriorityTaskBuffer(100, func() bool { return true }, nil)
+	ctx := context.Background()
+	for i := 0; i < 40; i++ {
+		s.NoError(buffer.add(ctx, mkPriorityTask(1)))
+		s.NoError(buffer.add(ctx, mkPriorityTask(2)))
+	}
+
+	counts := make(map[int32]int)
+	for i := 0; i < 30; i++ {
+		task, ok, err := buffer.take(ctx)
+		s.NoError(err)
+		s.True(ok)
+		counts[task.Data.GetPriorityKey()]++
+	}
+	// priority 1 has twice the weight of priority 2
+	s.Equal(20, counts[1])
+	s.Equal(10, counts[2])
+}
+
+func (s *priorityTaskBufferSuite) TestAdd_BlocksWhenFull() {
+	buffer := newPriorityTaskBuffer(1, func() bool { return false }, nil)
+	s.NoError(buffer.add(context.Background(), mkPriorityTask(1)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.Equal(context.DeadlineExceeded, buffer.add(ctx, mkPriorityTask(1)))
+
+	added := make(chan error, 1)
+	go func() {
+		added <- buffer.add(context.Background(), mkPriorityTask(2))
+	}()
+	task, ok, err := buffer.take(context.Background())
+	s.NoError(err)
+	s.True(ok)
+	s.EqualValues(1, task.Data.GetPriorityKey())
+	s.NoError(<-added)
+	s.Equal(1, buffer.size())
+}
+
+func (s *priorityTaskBufferSuite) TestRequeue() {
+	buffer := newPriorityTaskBuffer(2, func() bool { return false }, nil)
+	ctx := context.Background()
+	first := mkPriorityTask(1)
+	first.TaskId = 1
+	s.NoError(buffer.add(ctx, first))
+	s.NoError(buffer.add(ctx, mkPriorityTask(1)))
+
+	task, ok, err := buffer.take(ctx)
+	s.NoError(err)
+	s.True(ok)
+	s.EqualValues(1, task.GetTaskId())
+	s.NoError(buffer.add(ctx, mkPriorityTask(1)))
+	// a requeued task goes behind the other tasks of its level, even when the buffer is full
+	buffer.requeue(task)
+	s.Equal(3, buffer.size())
+	for i := 0; i < 2; i++ {
+		task, _, err = buffer.take(ctx)
+		s.NoError(err)
+		s.EqualValues(0, task.GetTaskId())
+	}
+	task, _, err = buffer.take(ctx)
+	s.NoError(err)
+	s.EqualValues(1, task.GetTaskId())
+}
+
+func (s *priorityTaskBufferSuite) TestClose() {
+	buffer := newPriorityTaskBuffer(10, func() bool { return false }, nil)
+	ctx := context.Background()
+	s.NoError(buffer.add(ctx, mkPriorityTask(1)))
+	buffer.close()
+	s.Equal(errPumpClosed, buffer.add(ctx, mkPriorityTask(1)))
+
+	// tasks already in the buffer are still handed out
+	_, ok, err := buffer.take(ctx)
+	s.NoError(err)
+	s.True(ok)
+	_, ok, err = buffer.take(ctx)
+	s.NoError(err)
+	s.False(ok)
+}
+
+func (s *priorityTaskBufferSuite) TestTake_FairnessKeys() {
+	buffer := newPriorityTaskBuffer(10, func() bool { return false }, nil)
+	ctx := context.Background()
+	for _, key := range []string{"noisy", "noisy", "noisy", "a", "noisy", "b", "a"} {
+		task := mkPriorityTask(0)
+		task.Data.FairnessKey = key
+		s.NoError(buffer.add(ctx, task))
+	}
+	level := priorityLevel(0)
+	s.Equal(4, buffer.backlogKeyLen(backlogKey{level: level, fairnessKey: "noisy"}))
+	s.Equal(2, buffer.backlogKeyLen(backlogKey{level: level, fairnessKey: "a"}))
+	s.Equal(1, buffer.backlogKeyLen(backlogKey{level: level, fairnessKey: "b"}))
+
+	var keys []string
+	for i := 0; i < 7; i++ {
+		task, ok, err := buffer.take(ctx)
+		s.NoError(err)
+		s.True(ok)
+		keys = append(keys, task.Data.GetFairnessKey())
+	}
+	s.Equal([]string{"noisy", "a", "b", "noisy", "a", "noisy", "noisy"}, keys)
+	s.Zero(buffer.size())
+}
+
+func (s *priorityTaskBufferSuite) TestTake_FairnessKeyWeights() {
+	weights := map[string]interface{}{"heavy": 2}
+	buffer := newPriorityTaskBuffer(10, func() bool { return false }, func() map[string]interface{} { return weights })
+	ctx := context.Background()
+	for i := 0; i < 9; i++ {
+		task := mkPriorityTask(0)
+		task.Data.FairnessKey = "heavy"
+		if i >= 6 {
+			task.Data.FairnessKey = "light"
+		}
+		s.NoError(buffer.add(ctx, task))
+	}
+
+	var keys []string
+	for i := 0; i < 9; i++ {
+		task, ok, err := buffer.take(ctx)
+		s.NoError(err)
+		s.True(ok)
+		keys = append(keys, task.Data.GetFairnessKey())
+	}
+	// heavy gets two dispatches for each one of light
+	s.Equal([]string{"heavy", "heavy", "light", "heavy", "heavy", "light", "heavy", "heavy", "light"}, keys)
+}
+
+func (s *priorityTaskBufferSuite) TestTake_FairnessKeysWithinPriority() {
+	buffer := newPriorityTaskBuffer(10, func() bool { return false }, nil)
+	ctx := context.Background()
+	for _, key := range []string{"noisy", "noisy", "a"} {
+		task := mkPriorityTask(5)
+		task.Data.FairnessKey = key
+		s.NoError(buffer.add(ctx, task))
+	}
+	task := mkPriorityTask(1)
+	task.Data.FairnessKey = "noisy"
+	s.NoError(buffer.add(ctx, task))
+
+	var keys []string
+	for i := 0; i < 4; i++ {
+		task, ok, err := buffer.take(ctx)
+		s.NoError(err)
+		s.True(ok)
+		keys = append(keys, fmt.Sprintf("%v/%v", task.Data.GetPriorityKey(), task.Data.GetFairnessKey()))
+	}
+	s.Equal([]string{"1/noisy", "5/noisy", "5/a", "5/noisy"}, keys)
+}
+
+func mkPriorityTask(priorityKey int32) *persistencespb.AllocatedTaskInfo {
+	return &persistencespb.AllocatedTaskInfo{
+		Data: &persistencespb.TaskInfo{PriorityKey: priorityKey},
+	}
+}
@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// rateTracker computes the rate of events over a sliding window made of fixed size buckets
+	rateTracker struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		bucketSize time.Duration
+		buckets    []int64
+		// lastBucket is the number of buckets elapsed since the epoch at the last update
+		lastBucket int64
+		startTime  time.Time
+	}
+)
+
+func newRateTracker(timeSource clock.TimeSource, window time.Duration, numBuckets int) *rateTracker {
+	now := timeSource.Now()
+	bucketSize := window / time.Duration(numBuckets)
+	return &rateTracker{
+		timeSource: timeSource,
+		bucketSize: bucketSize,
+		buckets:    make([]int64, numBuckets),
+		lastBucket: now.UnixNano() / int64(bucketSize),
+		startTime:  now,
+	}
+}
+
+// add records count events at the current time
+func (r *rateTracker) add(count int64) {
+	r.Lock()
+	defer r.Unlock()
+	r.advanceLocked(r.timeSource.Now())
+	r.buckets[r.lastBucket%int64(len(r.buckets))] += count
+}
+
+// rate returns the number of events per second over the window, or since the tracker
+// was created when it is more recent than the window
+func (r *rateTracker) rate() float64 {
+	r.Lock()
+	defer r.Unlock()
+	now := r.timeSource.Now()
+	r.advanceLocked(now)
+
+	var total int64
+	for _, count := range r.buckets {
+		total += count
+	}
+	window := r.bucketSize * time.Duration(len(r.buckets))
+	if elapsed := now.Sub(r.startTime); elapsed < window {
+		window = elapsed
+	}
+	if window < r.bucketSize {
+		window = r.bucketSize
+	}
+	return float64(total) / window.Seconds()
+}
+
+// advanceLocked clears the buckets which fell out of the window since the last update
+func (r *rateTracker) advanceLocked(now time.Time) {
+	bucket := now.UnixNano() / int64(r.bucketSize)
+	if bucket <= r.lastBucket {
+		return
+	}
+	if bucket-r.lastBucket >= int64(len(r.buckets)) {
+		for i := range r.buckets {
+			r.buckets[i] = 0
+		}
+	} else {
+		for b := r.lastBucket + 1; b <= bucket; b++ {
+			r.buckets[b%int64(len(r.buckets))] = 0
+		}
+	}
+	r.lastBucket = bucket
+}
@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+)
+
+func TestRateTracker(t *testing.T) {
+	start := time.Unix(1000, 0)
+	timeSource := clock.NewEventTimeSource().Update(start)
+	tracker := newRateTracker(timeSource, time.Minute, 30)
+	require.Equal(t, float64(0), tracker.rate())
+
+	// the rate is computed over the elapsed time until the window is full
+	tracker.add(20)
+	timeSource.Update(start.Add(10 * time.Second))
+	require.Equal(t, float64(2), tracker.rate())
+
+	tracker.add(100)
+	timeSource.Update(start.Add(50 * time.Second))
+	require.Equal(t, 2.4, tracker.rate())
+
+	// the first events fall out of the window
+	timeSource.Update(start.Add(time.Minute))
+	require.InDelta(t, float64(100)/60, tracker.rate(), 0.001)
+
+	// all events fall out of the window
+	timeSource.Update(start.Add(5 * time.Minute))
+	require.Equal(t, float64(0), tracker.rate())
+}
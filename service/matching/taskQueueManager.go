@@ -51,6 +51,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/internal/goro"
 )
 
 const (
@@ -60,6 +62,16 @@ const (
 
 	// Fake Task ID to wrap a task for syncmatch
 	syncMatchTaskId = -137
+
+	// Interval at which task queue partitions reload the metadata persisted on the
+	// root partition, i.e. the version sets and the partition counts
+	rootMetadataRefreshInterval = time.Minute
+	// Interval at which loading the metadata of the root partition is retried
+	rootMetadataRetryInterval = 5 * time.Second
+
+	// Window and number of buckets of the task rates reported in the backlog stats
+	taskRateWindow  = time.Minute
+	taskRateBuckets = 30
 )
 
 type (
@@ -102,6 +114,17 @@ type (
 		String() string
 		QueueID() *taskQueueID
 		TaskQueueKind() enumspb.TaskQueueKind
+		// UpdateVersionSets adds or promotes a worker build id in the version sets of
+		// the task queue. Only valid on the root partition of a workflow task queue
+		UpdateVersionSets(ctx context.Context, buildID string, previousCompatible string, becomeDefault bool) error
+		// GetVersionSets returns the worker build id version sets of the task queue
+		GetVersionSets(ctx context.Context) (*persistencespb.VersioningData, error)
+		// PartitionConfig returns the partition counts of the task queue when they are
+		// managed by the adaptive partition controller, nil otherwise
+		PartitionConfig() *persistencespb.TaskQueuePartitionConfig
+		// AdaptivePartitionsDisabled returns true once the metadata of the root partition
+		// is known and it has no partition counts set by the adaptive partition controller
+		AdaptivePartitionsDisabled() bool
 	}
 
 	// Single task queue in memory state
@@ -133,6 +156,29 @@ type (
 		outstandingPollsMap  map[string]context.CancelFunc
 		signalFatalProblem   func(taskQueueManager)
 		clusterMeta          cluster.Metadata
+		// versioningData and partitionConfig cache the metadata persisted on the root
+		// partition, versioningData is only used by normal workflow task queues
+		metadataLock    sync.Mutex
+		versioningData  *persistencespb.VersioningData
+		partitionConfig *persistencespb.TaskQueuePartitionConfig
+		// metadataRefreshTime is the time of the last refresh or update of the cached
+		// metadata, a refresh only applies what it read when no update happened meanwhile
+		metadataRefreshTime time.Time
+		// partitionConfigLoaded is set once partitionConfig holds the persisted value
+		partitionConfigLoaded bool
+		// metadataLoop refreshes the cached metadata in the background
+		metadataLoop *goro.Handle
+		// partitionController adjusts the partition counts of the task queue to its
+		// load, only set on the root partition of normal task queues
+		partitionController *partitionController
+		// tasksAdded and tasksDispatched count the tasks handled by this partition,
+		// they are reported to the adaptive partition controller
+		tasksAdded      int64
+		tasksDispatched int64
+		// tasksAddRate and tasksDispatchRate track the same tasks over the last minute,
+		// they are reported in the backlog stats
+		tasksAddRate      *rateTracker
+		tasksDispatchRate *rateTracker
 	}
 )
 
@@ -193,6 +239,8 @@ func newTaskQueueManager(
 		clusterMeta:         clusterMeta,
 		namespace:           nsName,
 		metricScope:         metricsScope,
+		tasksAddRate:        newRateTracker(clock.NewRealTimeSource(), taskRateWindow, taskRateBuckets),
+		tasksDispatchRate:   newRateTracker(clock.NewRealTimeSource(), taskRateWindow, taskRateBuckets),
 	}
 
 	tlMgr.liveness = newLiveness(
@@ -207,7 +255,19 @@ func newTaskQueueManager(
 	if tlMgr.isFowardingAllowed(taskQueue, taskQueueKind) {
 		fwdr = newForwarder(&taskQueueConfig.forwarderConfig, taskQueue, taskQueueKind, e.matchingClient)
 	}
-	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.metricScope)
+	var keyer *versionKeyer
+	if taskQueue.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		keyer = &versionKeyer{
+			sticky:         taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY,
+			versioningData: tlMgr.getVersioningData,
+		}
+	}
+	tlMgr.matcher = newTaskMatcher(taskQueueConfig, fwdr, tlMgr.metricScope, keyer)
+	tlMgr.matcher.onPoll = tlMgr.taskReader.unpark
+	tlMgr.matcher.numPartitions = tlMgr.numReadPartitions
+	if taskQueue.IsRoot() && taskQueueKind == enumspb.TASK_QUEUE_KIND_NORMAL {
+		tlMgr.partitionController = newPartitionController(tlMgr, e.matchingClient)
+	}
 	for _, opt := range opts {
 		opt(tlMgr)
 	}
@@ -244,6 +304,12 @@ func (c *taskQueueManagerImpl) Start() {
 	c.liveness.Start()
 	c.taskWriter.Start()
 	c.taskReader.Start()
+	if c.taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		c.metadataLoop = goro.Go(context.Background(), c.refreshRootMetadataLoop)
+	}
+	if c.partitionController != nil {
+		c.partitionController.Start()
+	}
 	c.logger.Info("", tag.LifeCycleStarted)
 	c.metricScope.IncCounter(metrics.TaskQueueStartedCounter)
 }
@@ -257,7 +323,13 @@ func (c *taskQueueManagerImpl) Stop() {
 	) {
 		return
 	}
-	_ = c.db.UpdateState(context.TODO(), c.taskAckManager.getAckLevel())
+	if c.partitionController != nil {
+		c.partitionController.Stop()
+	}
+	if c.metadataLoop != nil {
+		c.metadataLoop.Cancel()
+	}
+	_ = c.db.UpdateState(context.TODO(), c.taskAckManager.getAckLevel(), c.approximateBacklogCount(), c.fairnessKeyBacklogCounts())
 	c.taskGC.RunNow(context.TODO(), c.taskAckManager.getAckLevel())
 	c.liveness.Stop()
 	c.taskWriter.Stop()
@@ -273,9 +345,21 @@ func (c *taskQueueManagerImpl) AddTask(
 	ctx context.Context,
 	params addTaskParams,
 ) (bool, error) {
+	if params.forwardedFrom == "" && c.writePartitionRetired() {
+		// the client has not learnt the lowered write partition count yet, the task goes to
+		// the root partition as this partition is no longer polled once its backlog is drained
+		task := newInternalTask(&persistencespb.AllocatedTaskInfo{
+			Data:   params.taskInfo,
+			TaskId: syncMatchTaskId,
+		}, nil, params.source, params.forwardedFrom, true)
+		return false, c.matcher.fwdr.RedirectTask(ctx, task)
+	}
+
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive(time.Now())
+		atomic.AddInt64(&c.tasksAdded, 1)
+		c.tasksAddRate.add(1)
 	}
 
 	var syncMatch bool
@@ -372,6 +456,10 @@ func (c *taskQueueManagerImpl) GetTask(
 		return nil, err
 	}
 
+	if !task.isStarted() {
+		atomic.AddInt64(&c.tasksDispatched, 1)
+		c.tasksDispatchRate.add(1)
+	}
 	task.namespace = c.namespace
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
 	return task, nil
@@ -445,10 +533,54 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 			EndId:   taskIDBlock.end,
 		},
 	}
+	response.FairnessKeyBacklogs = c.taskReader.fairnessKeyBacklogs()
+	response.PartitionStats = &matchingservice.TaskQueuePartitionStats{
+		TasksAdded:      atomic.LoadInt64(&c.tasksAdded),
+		TasksDispatched: atomic.LoadInt64(&c.tasksDispatched),
+		BacklogDrained:  c.backlogDrained(),
+	}
+	response.BacklogStats = c.backlogStats()
 
 	return response
 }
 
+// backlogStats returns the backlog stats of this partition
+func (c *taskQueueManagerImpl) backlogStats() *matchingservice.TaskQueueBacklogStats {
+	stats := &matchingservice.TaskQueueBacklogStats{
+		ApproximateBacklogCount: c.approximateBacklogCount(),
+		TasksAddRate:            c.tasksAddRate.rate(),
+		TasksDispatchRate:       c.tasksDispatchRate.rate(),
+	}
+	if createTime, ok := c.taskAckManager.getOldestTaskCreateTime(); ok {
+		stats.ApproximateBacklogAge = timestamp.DurationPtr(time.Since(createTime))
+	}
+	return stats
+}
+
+// approximateBacklogCount returns the number of persisted tasks which are not completed
+// yet. The count is only tracked approximately, e.g. it misses tasks lost on ownership
+// changes, so it is reset once all persisted tasks are completed.
+func (c *taskQueueManagerImpl) approximateBacklogCount() int64 {
+	if c.backlogDrained() {
+		c.taskAckManager.setApproximateBacklogCount(0, nil)
+	}
+	return c.taskAckManager.getApproximateBacklogCount()
+}
+
+// fairnessKeyBacklogCounts returns the approximate backlog count of each fairness key,
+// the counts are reset along with approximateBacklogCount
+func (c *taskQueueManagerImpl) fairnessKeyBacklogCounts() map[string]int64 {
+	if c.backlogDrained() {
+		c.taskAckManager.setApproximateBacklogCount(0, nil)
+	}
+	return c.taskAckManager.getFairnessKeyBacklogCounts()
+}
+
+// backlogDrained returns true when all tasks persisted in this partition are completed
+func (c *taskQueueManagerImpl) backlogDrained() bool {
+	return c.taskAckManager.getAckLevel() >= c.taskWriter.GetMaxReadLevel()
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -573,6 +705,168 @@ func (c *taskQueueManagerImpl) isFowardingAllowed(taskQueue *taskQueueID, kind e
 	return !taskQueue.IsRoot() && kind != enumspb.TASK_QUEUE_KIND_STICKY
 }
 
+// UpdateVersionSets adds or promotes a worker build id in the version sets of the task queue
+func (c *taskQueueManagerImpl) UpdateVersionSets(
+	ctx context.Context,
+	buildID string,
+	previousCompatible string,
+	becomeDefault bool,
+) error {
+	c.metadataLock.Lock()
+	versioningData, err := updateVersionSets(c.db.VersioningData(), buildID, previousCompatible, becomeDefault)
+	if err != nil {
+		c.metadataLock.Unlock()
+		return err
+	}
+	if err := c.db.UpdateVersioningData(ctx, versioningData); err != nil {
+		c.metadataLock.Unlock()
+		c.signalIfFatal(err)
+		return err
+	}
+	c.versioningData = versioningData
+	c.metadataRefreshTime = time.Now().UTC()
+	c.metadataLock.Unlock()
+
+	c.versionSetsChanged()
+	return nil
+}
+
+// versionSetsChanged drops the matcher channels of the keys the version sets no longer
+// route to, and puts the parked tasks back into the task buffer to be routed anew
+func (c *taskQueueManagerImpl) versionSetsChanged() {
+	c.matcher.pruneTaskCs()
+	c.taskReader.unparkAll()
+}
+
+// GetVersionSets returns the worker build id version sets of the task queue
+func (c *taskQueueManagerImpl) GetVersionSets(ctx context.Context) (*persistencespb.VersioningData, error) {
+	if c.db.RangeID() != 0 {
+		return c.db.VersioningData(), nil
+	}
+	// lease is not acquired yet, read the version sets from persistence
+	info, err := c.db.GetTaskQueueInfoOf(ctx, c.taskQueueID.name)
+	if err != nil {
+		return nil, err
+	}
+	return info.GetVersioningData(), nil
+}
+
+// PartitionConfig returns the partition counts of the task queue when they are managed
+// by the adaptive partition controller, nil otherwise
+func (c *taskQueueManagerImpl) PartitionConfig() *persistencespb.TaskQueuePartitionConfig {
+	c.metadataLock.Lock()
+	defer c.metadataLock.Unlock()
+	return c.partitionConfig
+}
+
+// AdaptivePartitionsDisabled returns true once the metadata of the root partition is
+// known and it has no partition counts set by the adaptive partition controller
+func (c *taskQueueManagerImpl) AdaptivePartitionsDisabled() bool {
+	c.metadataLock.Lock()
+	defer c.metadataLock.Unlock()
+	return c.partitionConfigLoaded && c.partitionConfig == nil
+}
+
+// updatePartitionConfig persists the partition counts set by the adaptive partition
+// controller, only valid on the root partition
+func (c *taskQueueManagerImpl) updatePartitionConfig(
+	ctx context.Context,
+	partitionConfig *persistencespb.TaskQueuePartitionConfig,
+) error {
+	c.metadataLock.Lock()
+	defer c.metadataLock.Unlock()
+
+	if err := c.db.UpdatePartitionConfig(ctx, partitionConfig); err != nil {
+		c.signalIfFatal(err)
+		return err
+	}
+	c.partitionConfig = partitionConfig
+	c.partitionConfigLoaded = true
+	c.metadataRefreshTime = time.Now().UTC()
+	return nil
+}
+
+// writePartitionRetired returns true when the adaptive partition controller lowered the
+// write partition count below this partition
+func (c *taskQueueManagerImpl) writePartitionRetired() bool {
+	partitionConfig := c.PartitionConfig()
+	return partitionConfig != nil && c.matcher.fwdr != nil &&
+		c.taskQueueID.partition >= int(partitionConfig.GetWritePartitionCount())
+}
+
+// numReadPartitions returns the number of partitions of the task queue which are polled
+func (c *taskQueueManagerImpl) numReadPartitions() int {
+	if partitionConfig := c.PartitionConfig(); partitionConfig != nil {
+		return common.MaxInt(1, int(partitionConfig.GetReadPartitionCount()))
+	}
+	return c.config.NumReadPartitions()
+}
+
+func (c *taskQueueManagerImpl) getVersioningData() *persistencespb.VersioningData {
+	c.metadataLock.Lock()
+	defer c.metadataLock.Unlock()
+	return c.versioningData
+}
+
+// refreshRootMetadataLoop keeps the version sets and partition counts persisted on the
+// root partition cached, off the AddTask and GetTask paths. The root partition only
+// loads them once, afterwards they only change through UpdateVersionSets and the
+// partition controller.
+func (c *taskQueueManagerImpl) refreshRootMetadataLoop(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if !c.refreshRootMetadata(ctx) {
+				timer.Reset(rootMetadataRetryInterval)
+				continue
+			}
+			if c.taskQueueID.IsRoot() {
+				return nil
+			}
+			timer.Reset(rootMetadataRefreshInterval)
+		}
+	}
+}
+
+// refreshRootMetadata reloads the version sets and partition counts persisted on the
+// root partition, returns false when they could not be loaded or were updated while
+// loading them, in which case the refresh is to be retried
+func (c *taskQueueManagerImpl) refreshRootMetadata(ctx context.Context) bool {
+	now := time.Now().UTC()
+	c.metadataLock.Lock()
+	c.metadataRefreshTime = now
+	c.metadataLock.Unlock()
+
+	info, err := c.db.GetTaskQueueInfoOf(ctx, c.taskQueueID.GetRoot())
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("Failed to load task queue root partition metadata", tag.Error(err))
+		}
+		return false
+	}
+
+	c.metadataLock.Lock()
+	refreshed := c.metadataRefreshTime.Equal(now)
+	versionSetsChanged := false
+	if refreshed {
+		versionSetsChanged = !c.versioningData.Equal(info.GetVersioningData())
+		c.versioningData = info.GetVersioningData()
+		c.partitionConfig = info.GetPartitionConfig()
+		c.partitionConfigLoaded = true
+	}
+	c.metadataLock.Unlock()
+
+	if versionSetsChanged {
+		c.versionSetsChanged()
+	}
+	return refreshed
+}
+
 func (c *taskQueueManagerImpl) QueueID() *taskQueueID {
 	return c.taskQueueID
 }
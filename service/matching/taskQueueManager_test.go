@@ -39,6 +39,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -57,13 +58,14 @@ func TestDeliverBufferTasks(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []func(tlm *taskQueueManagerImpl){
-		func(tlm *taskQueueManagerImpl) { close(tlm.taskReader.taskBuffer) },
+		func(tlm *taskQueueManagerImpl) { tlm.taskReader.taskBuffer.close() },
 		func(tlm *taskQueueManagerImpl) { tlm.taskReader.gorogrp.Cancel() },
 		func(tlm *taskQueueManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
-			err := tlm.matcher.rateLimiter.Wait(context.Background()) // consume the token
+			err := tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{})
+			assert.NoError(t, err)
+			err = tlm.matcher.rateLimiter.Wait(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.gorogrp.Cancel()
 		},
@@ -82,13 +84,36 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := mustCreateTestTaskQueueManager(t, controller)
-	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
+	err := tlm.taskReader.taskBuffer.add(context.Background(), &persistencespb.AllocatedTaskInfo{})
+	assert.NoError(t, err)
 	tlm.taskReader.gorogrp.Go(tlm.taskReader.dispatchBufferedTasks)
 	time.Sleep(100 * time.Millisecond) // let go routine run first and block on tasksForPoll
 	tlm.taskReader.gorogrp.Cancel()
 	tlm.taskReader.gorogrp.Wait()
 }
 
+func TestParkTasksWithoutCompatiblePoller(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	tlm.taskReader.gorogrp.Cancel()
+	tlm.taskReader.gorogrp.Wait()
+
+	capacity := tlm.taskReader.taskBuffer.capacity() * parkedTasksPerBufferedTask
+	for i := 0; i < capacity; i++ {
+		require.True(t, tlm.taskReader.park(randomTaskInfo()))
+	}
+	// parking is capped, the task is left to the caller to retry
+	require.False(t, tlm.taskReader.park(randomTaskInfo()))
+	require.Equal(t, 0, tlm.taskReader.taskBuffer.size())
+
+	// a poll of the key releases the parked tasks into the buffer
+	tlm.taskReader.unpark("")
+	require.Equal(t, capacity, tlm.taskReader.taskBuffer.size())
+	require.True(t, tlm.taskReader.park(randomTaskInfo()))
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -144,6 +169,135 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	require.Equal(t, int64(14), tlm.taskAckManager.getReadLevel())
 }
 
+func TestSkipTasksOfFullBacklogKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(11)
+	tlm := mustCreateTestTaskQueueManagerWithConfig(t, controller, cfg)
+	tlm.taskAckManager.setAckLevel(0)
+	tlm.taskAckManager.setReadLevel(0)
+	require.Equal(t, 10, tlm.taskReader.taskBuffer.capacity())
+	require.Equal(t, 2, tlm.taskReader.maxTasksPerBacklogKey())
+
+	// a backlog of low priority tasks larger than the buffer, followed by a high priority task
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for id := int64(1); id <= 13; id++ {
+		priorityKey := int32(5)
+		if id == 13 {
+			priorityKey = 1
+		}
+		tasks = append(tasks, &persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{
+				ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(60),
+				CreateTime:  timestamp.TimeNowPtrUtc(),
+				PriorityKey: priorityKey,
+			},
+			TaskId: id,
+		})
+	}
+	_, err := tlm.db.CreateTasks(context.Background(), tasks)
+	require.NoError(t, err)
+
+	take := func() int64 {
+		task, ok, err := tlm.taskReader.taskBuffer.take(context.Background())
+		require.NoError(t, err)
+		require.True(t, ok)
+		return task.GetTaskId()
+	}
+
+	// the low priority tasks after the first ten are skipped once the buffer is full
+	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.Background(), tasks[:12]))
+	require.Equal(t, 10, tlm.taskReader.taskBuffer.size())
+	require.Equal(t, map[backlogKey]int64{{level: 4}: 11}, tlm.taskReader.backlogCursors())
+
+	// the high priority task is read past them and dispatched first
+	require.Equal(t, int64(1), take())
+	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.Background(), tasks[12:]))
+	require.Equal(t, int64(13), tlm.taskAckManager.getReadLevel())
+	require.Equal(t, int64(13), take())
+
+	// the ack level stays below the skipped tasks
+	tlm.taskAckManager.completeTask(1)
+	tlm.taskAckManager.completeTask(13)
+	for i := 0; i < 9; i++ {
+		tlm.taskAckManager.completeTask(take())
+	}
+	require.Equal(t, int64(10), tlm.taskAckManager.getAckLevel())
+
+	// the skipped tasks are read back once their backlog key has room
+	require.NoError(t, tlm.taskReader.readSkippedTasks(context.Background()))
+	require.Empty(t, tlm.taskReader.backlogCursors())
+	require.Equal(t, int64(13), tlm.taskAckManager.getReadLevel())
+	tlm.taskAckManager.completeTask(take())
+	require.Equal(t, int64(11), tlm.taskAckManager.getAckLevel())
+	require.Equal(t, int64(13), tlm.taskAckManager.completeTask(take()))
+}
+
+func TestSkipTasksOfFullFairnessKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(11)
+	tlm := mustCreateTestTaskQueueManagerWithConfig(t, controller, cfg)
+	tlm.taskAckManager.setAckLevel(0)
+	tlm.taskAckManager.setReadLevel(0)
+
+	// a backlog of a noisy fairness key larger than the buffer, followed by a task of
+	// another key of the same priority
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for id := int64(1); id <= 13; id++ {
+		fairnessKey := "noisy"
+		if id == 13 {
+			fairnessKey = "quiet"
+		}
+		tasks = append(tasks, &persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{
+				ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(60),
+				CreateTime:  timestamp.TimeNowPtrUtc(),
+				FairnessKey: fairnessKey,
+			},
+			TaskId: id,
+		})
+	}
+	_, err := tlm.db.CreateTasks(context.Background(), tasks)
+	require.NoError(t, err)
+
+	noisy := backlogKey{level: priorityLevel(0), fairnessKey: "noisy"}
+	quiet := backlogKey{level: priorityLevel(0), fairnessKey: "quiet"}
+	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.Background(), tasks[:12]))
+	require.Equal(t, map[backlogKey]int64{noisy: 11}, tlm.taskReader.backlogCursors())
+
+	// the task of the other key is read past the skipped tasks
+	_, ok, err := tlm.taskReader.taskBuffer.take(context.Background())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, tlm.taskReader.addTasksToBuffer(context.Background(), tasks[12:]))
+	require.Equal(t, int64(13), tlm.taskAckManager.getReadLevel())
+	require.Equal(t, 1, tlm.taskReader.taskBuffer.backlogKeyLen(quiet))
+	require.Equal(t, 9, tlm.taskReader.taskBuffer.backlogKeyLen(noisy))
+}
+
+func TestFairnessKeyBacklogCountsPersisted(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	ctx := context.Background()
+	_, err := tlm.db.RenewLease(ctx)
+	require.NoError(t, err)
+	counts := map[string]int64{"a": 2, "b": 1}
+	require.NoError(t, tlm.db.UpdateState(ctx, 0, 3, counts))
+
+	db := newTaskQueueDB(tlm.db.store, tlm.db.namespaceID, tlm.db.taskQueueName, tlm.db.taskType, tlm.db.taskQueueKind, tlm.logger)
+	state, err := db.RenewLease(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, state.approximateBacklogCount)
+	require.Equal(t, counts, state.fairnessKeyBacklogCounts)
+}
+
 type testIDBlockAlloc struct {
 	rid   int64
 	alloc func() (taskQueueState, error)
@@ -396,6 +550,88 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
 }
 
+func TestDescribeTaskQueue_FairnessKeyBacklogs(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	for _, key := range []string{"b", "a", "b", ""} {
+		tlm.taskAckManager.addApproximateBacklogCount(key, 1)
+	}
+
+	require.Empty(t, tlm.DescribeTaskQueue(false).GetFairnessKeyBacklogs())
+	require.Equal(t, []*matchingservice.FairnessKeyBacklog{
+		{FairnessKey: "", BacklogCount: 1},
+		{FairnessKey: "a", BacklogCount: 1},
+		{FairnessKey: "b", BacklogCount: 2},
+	}, tlm.DescribeTaskQueue(true).GetFairnessKeyBacklogs())
+}
+
+func TestDescribeTaskQueue_PartitionStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	atomic.AddInt64(&tlm.tasksAdded, 3)
+	atomic.AddInt64(&tlm.tasksDispatched, 2)
+
+	require.Nil(t, tlm.DescribeTaskQueue(false).GetPartitionStats())
+	stats := tlm.DescribeTaskQueue(true).GetPartitionStats()
+	require.Equal(t, int64(3), stats.GetTasksAdded())
+	require.Equal(t, int64(2), stats.GetTasksDispatched())
+}
+
+func TestNumReadPartitions_PartitionConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(4)
+	tlm := mustCreateTestTaskQueueManagerWithConfig(t, controller, cfg)
+	require.Equal(t, 4, tlm.numReadPartitions())
+	require.Equal(t, 4, tlm.matcher.numPartitions())
+
+	tlm.partitionConfig = &persistencespb.TaskQueuePartitionConfig{WritePartitionCount: 1, ReadPartitionCount: 2}
+	require.Equal(t, 2, tlm.numReadPartitions())
+	require.Equal(t, 2, tlm.matcher.numPartitions())
+}
+
+func TestAdaptivePartitionsDisabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	// unknown until the root partition metadata is loaded
+	require.False(t, tlm.AdaptivePartitionsDisabled())
+
+	tlm.partitionConfigLoaded = true
+	require.True(t, tlm.AdaptivePartitionsDisabled())
+
+	tlm.partitionConfig = &persistencespb.TaskQueuePartitionConfig{WritePartitionCount: 1, ReadPartitionCount: 2}
+	require.False(t, tlm.AdaptivePartitionsDisabled())
+}
+
+func TestWritePartitionRetired(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	// the root partition is never retired
+	tlm.partitionConfig = &persistencespb.TaskQueuePartitionConfig{WritePartitionCount: 1, ReadPartitionCount: 3}
+	require.False(t, tlm.writePartitionRetired())
+
+	tlm.taskQueueID.partition = 2
+	tlm.matcher.fwdr = newForwarder(&tlm.config.forwarderConfig, tlm.taskQueueID, enumspb.TASK_QUEUE_KIND_NORMAL, nil)
+	require.True(t, tlm.writePartitionRetired())
+
+	tlm.partitionConfig = &persistencespb.TaskQueuePartitionConfig{WritePartitionCount: 3, ReadPartitionCount: 3}
+	require.False(t, tlm.writePartitionRetired())
+
+	// partitions are only retired by the adaptive partition controller
+	tlm.partitionConfig = nil
+	require.False(t, tlm.writePartitionRetired())
+}
+
 func TestCheckIdleTaskQueue(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
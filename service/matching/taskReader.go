@@ -26,42 +26,86 @@ package matching
 
 import (
 	"context"
+	"math"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/internal/goro"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
 const (
 	taskReaderOfferThrottleWait = time.Second
+	// parkedTasksPerBufferedTask bounds the number of parked tasks to a multiple of
+	// the task buffer capacity
+	parkedTasksPerBufferedTask = 10
+	// backlogKeysPerBuffer is the number of backlog keys whose shares fill up the task
+	// buffer. Once the buffer is full, the tasks of keys holding more than their share are
+	// skipped to make room for the tasks of the other keys.
+	backlogKeysPerBuffer = numPriorityLevels
 )
 
 type (
 	taskReader struct {
 		status     int32
-		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
-		notifyC    chan struct{}                          // Used as signal to notify pump of new tasks
+		taskBuffer *priorityTaskBuffer // tasks loaded from persistence
+		notifyC    chan struct{}       // Used as signal to notify pump of new tasks
 		tlMgr      *taskQueueManagerImpl
 		gorogrp    goro.Group
+		// parked holds the backlog tasks no compatible poller picked up, keyed by the
+		// matcher channel key of their build id. They are kept out of the task buffer so
+		// that they neither take up its capacity nor get offered over and over, and are
+		// put back into it once a poller of their key polls or the version sets change.
+		parkedLock sync.Mutex
+		parked     map[string][]*persistencespb.AllocatedTaskInfo
+		numParked  int
+		// cursors holds the id of the first task the reader skipped for each backlog key
+		// which took up more than its share of the full task buffer. The reader skips all
+		// further tasks of such a key and reads them back from the cursor once the key has
+		// room again.
+		cursorsLock sync.Mutex
+		cursors     map[backlogKey]int64
+	}
+
+	// backlogKey identifies the tasks which share a queue of the task buffer
+	backlogKey struct {
+		level       int
+		fairnessKey string
 	}
 )
 
+func backlogKeyOf(task *persistencespb.AllocatedTaskInfo) backlogKey {
+	return backlogKey{
+		level:       priorityLevel(task.Data.GetPriorityKey()),
+		fairnessKey: task.Data.GetFairnessKey(),
+	}
+}
+
 func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
 	return &taskReader{
 		status:  common.DaemonStatusInitialized,
 		tlMgr:   tlMgr,
 		notifyC: make(chan struct{}, 1),
+		parked:  make(map[string][]*persistencespb.AllocatedTaskInfo),
+		cursors: make(map[backlogKey]int64),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		taskBuffer: newPriorityTaskBuffer(
+			tlMgr.config.GetTasksBatchSize()-1,
+			tlMgr.config.EnablePriorityFairness,
+			tlMgr.config.FairnessKeyWeights,
+		),
 	}
 }
 
@@ -102,34 +146,87 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(ctx context.Context) error {
-dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
+		// tasks are taken out of the buffer by priority rather than in the order they were read
+		taskInfo, ok, err := tr.taskBuffer.take(ctx)
+		if err != nil || !ok { // context is cancelled or task queue getTasks pump is shutdown
+			return nil
+		}
+		tr.signalIfBacklogKeyHasRoom(backlogKeyOf(taskInfo))
+		task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		for {
+			err := tr.tlMgr.DispatchTask(ctx, task)
+			if err == nil {
+				break
+			}
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+				return err
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(ctx, task)
-				if err == nil {
+			if err == errNoCompatiblePoller {
+				// no poller of the task's version set showed up, move on to the
+				// tasks of other version sets until one does
+				if tr.park(taskInfo) {
 					break
 				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
-					return err
+				// too many tasks are parked already, retry this one after a while
+				select {
+				case <-time.After(taskReaderOfferThrottleWait):
+				case <-ctx.Done():
+					return nil
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				time.Sleep(taskReaderOfferThrottleWait)
+				continue
 			}
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			time.Sleep(taskReaderOfferThrottleWait)
+		}
+	}
+}
 
-		case <-ctx.Done():
-			return nil
+// park holds a task no compatible poller picked up until a poller of its key polls.
+// Returns false when the limit of parked tasks is reached.
+func (tr *taskReader) park(task *persistencespb.AllocatedTaskInfo) bool {
+	key := tr.tlMgr.matcher.versionKeyer.taskKey(task.Data.GetBuildId())
+
+	tr.parkedLock.Lock()
+	defer tr.parkedLock.Unlock()
+	if tr.numParked >= tr.taskBuffer.capacity()*parkedTasksPerBufferedTask {
+		return false
+	}
+	tr.parked[key] = append(tr.parked[key], task)
+	tr.numParked++
+	return true
+}
+
+// unpark puts the tasks parked for the given key back into the task buffer
+func (tr *taskReader) unpark(key string) {
+	tr.parkedLock.Lock()
+	tasks := tr.parked[key]
+	delete(tr.parked, key)
+	tr.numParked -= len(tasks)
+	tr.parkedLock.Unlock()
+
+	for _, task := range tasks {
+		tr.taskBuffer.requeue(task)
+	}
+}
+
+// unparkAll puts all parked tasks back into the task buffer, their keys may have
+// changed along with the version sets
+func (tr *taskReader) unparkAll() {
+	tr.parkedLock.Lock()
+	parked := tr.parked
+	tr.parked = make(map[string][]*persistencespb.AllocatedTaskInfo)
+	tr.numParked = 0
+	tr.parkedLock.Unlock()
+
+	for _, tasks := range parked {
+		for _, task := range tasks {
+			tr.taskBuffer.requeue(task)
 		}
 	}
-	return nil
 }
 
 func (tr *taskReader) getTasksPump(ctx context.Context) error {
@@ -158,6 +255,16 @@ Loop:
 			return nil
 
 		case <-tr.notifyC:
+			if err := tr.readSkippedTasks(ctx); err != nil {
+				tr.tlMgr.signalIfFatal(err)
+				tr.Signal() // re-enqueue the event
+				continue Loop
+			}
+			if tr.readAheadLimitReached() {
+				// wait for skipped tasks to be read back
+				continue Loop
+			}
+
 			tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch()
 			tr.tlMgr.signalIfFatal(err)
 			if err != nil {
@@ -235,16 +342,26 @@ func (tr *taskReader) getTaskBatch() ([]*persistencespb.AllocatedTaskInfo, int64
 	return tasks, readLevel, readLevel == maxReadLevel, nil // caller will update readLevel when no task grabbed
 }
 
+// addTasksToBuffer adds the tasks read in task id order to the task buffer. Once the buffer
+// is full, tasks of a backlog key which holds more than its share of the buffer are skipped,
+// the read level moves past them while the ack level stays below them until they are read
+// back by readSkippedTasks. This way a large backlog of one key does not hold back the
+// tasks of the other keys behind it.
 func (tr *taskReader) addTasksToBuffer(
 	ctx context.Context,
 	tasks []*persistencespb.AllocatedTaskInfo,
 ) error {
 	for _, t := range tasks {
+		if tr.skipTask(t) {
+			tr.tlMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			continue
+		}
 		if taskqueue.IsTaskExpired(t) {
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskQueueCounter)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.tlMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			tr.tlMgr.taskAckManager.addApproximateBacklogCount(t.Data.GetFairnessKey(), -1)
 			continue
 		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
@@ -259,18 +376,170 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
 	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
-	select {
-	case tr.taskBuffer <- task:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
+	tr.tlMgr.taskAckManager.setTaskInfo(task.GetTaskId(), timestamp.TimeValue(task.GetData().GetCreateTime()), task.GetData().GetFairnessKey())
+	return tr.taskBuffer.add(ctx, task)
+}
+
+// skipTask returns true when the task is to be read back later as its backlog key has no
+// room in the full task buffer, recording the task as the cursor of the key if it is the
+// first task skipped
+func (tr *taskReader) skipTask(task *persistencespb.AllocatedTaskInfo) bool {
+	key := backlogKeyOf(task)
+
+	tr.cursorsLock.Lock()
+	defer tr.cursorsLock.Unlock()
+	if _, ok := tr.cursors[key]; ok {
+		return true
+	}
+	if tr.taskBuffer.size() < tr.taskBuffer.capacity() ||
+		tr.taskBuffer.backlogKeyLen(key) < tr.maxTasksPerBacklogKey() {
+		return false
+	}
+	tr.cursors[key] = task.GetTaskId()
+	tr.tlMgr.taskAckManager.setAckCeiling(tr.ackCeilingLocked())
+	return true
+}
+
+// readSkippedTasks reads back the skipped tasks of the backlog keys which have room in the
+// task buffer again. Tasks are persisted in task id order only, so the tasks of a key are
+// read from its cursor up to the read level, dropping the tasks of the other keys.
+func (tr *taskReader) readSkippedTasks(ctx context.Context) error {
+	for key, cursor := range tr.backlogCursors() {
+		room := tr.maxTasksPerBacklogKey() - tr.taskBuffer.backlogKeyLen(key)
+		if room < tr.maxTasksPerBacklogKey()/2 {
+			continue
+		}
+		next, err := tr.readSkippedTasksOf(ctx, key, cursor, room)
+
+		tr.cursorsLock.Lock()
+		if next > 0 {
+			tr.cursors[key] = next
+		} else {
+			delete(tr.cursors, key)
+		}
+		tr.tlMgr.taskAckManager.setAckCeiling(tr.ackCeilingLocked())
+		tr.cursorsLock.Unlock()
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSkippedTasksOf adds up to room skipped tasks of the given backlog key to the task
+// buffer. Returns the new cursor of the key, or 0 when no task of the key is left behind.
+func (tr *taskReader) readSkippedTasksOf(
+	ctx context.Context,
+	key backlogKey,
+	cursor int64,
+	room int,
+) (int64, error) {
+	readLevel := tr.tlMgr.taskAckManager.getReadLevel()
+	for cursor <= readLevel {
+		tasks, err := tr.getTaskBatchWithRange(cursor-1, readLevel)
+		if err != nil {
+			return cursor, err
+		}
+		if len(tasks) == 0 {
+			break
+		}
+		for _, t := range tasks {
+			if backlogKeyOf(t) != key {
+				continue
+			}
+			if taskqueue.IsTaskExpired(t) {
+				tr.scope().IncCounter(metrics.ExpiredTasksPerTaskQueueCounter)
+				tr.tlMgr.taskAckManager.addApproximateBacklogCount(t.Data.GetFairnessKey(), -1)
+				continue
+			}
+			if room == 0 {
+				return t.GetTaskId(), nil
+			}
+			tr.tlMgr.taskAckManager.addTaskBelowReadLevel(t.GetTaskId())
+			tr.tlMgr.taskAckManager.setTaskInfo(t.GetTaskId(), timestamp.TimeValue(t.GetData().GetCreateTime()), t.GetData().GetFairnessKey())
+			if err := tr.taskBuffer.add(ctx, t); err != nil {
+				return t.GetTaskId(), err
+			}
+			room--
+		}
+		cursor = tasks[len(tasks)-1].GetTaskId() + 1
+	}
+	return 0, nil
+}
+
+// signalIfBacklogKeyHasRoom wakes up the reader to read back the skipped tasks of the
+// given backlog key once half of its share of the task buffer is free
+func (tr *taskReader) signalIfBacklogKeyHasRoom(key backlogKey) {
+	tr.cursorsLock.Lock()
+	_, skipped := tr.cursors[key]
+	tr.cursorsLock.Unlock()
+	if skipped && tr.taskBuffer.backlogKeyLen(key) <= tr.maxTasksPerBacklogKey()/2 {
+		tr.Signal()
 	}
 }
 
+// readAheadLimitReached returns true when the read level is a task buffer's worth of task
+// ids past the first skipped task. Reading further ahead looks for tasks of other backlog
+// keys at the cost of reading the skipped tasks twice.
+func (tr *taskReader) readAheadLimitReached() bool {
+	tr.cursorsLock.Lock()
+	ackCeiling := tr.ackCeilingLocked()
+	tr.cursorsLock.Unlock()
+	return ackCeiling != math.MaxInt64 &&
+		tr.tlMgr.taskAckManager.getReadLevel()-ackCeiling > int64(tr.taskBuffer.capacity())
+}
+
+func (tr *taskReader) backlogCursors() map[backlogKey]int64 {
+	tr.cursorsLock.Lock()
+	defer tr.cursorsLock.Unlock()
+	cursors := make(map[backlogKey]int64, len(tr.cursors))
+	for key, cursor := range tr.cursors {
+		cursors[key] = cursor
+	}
+	return cursors
+}
+
+// ackCeilingLocked returns the task id right below the first skipped task
+func (tr *taskReader) ackCeilingLocked() int64 {
+	ackCeiling := int64(math.MaxInt64)
+	for _, cursor := range tr.cursors {
+		if cursor-1 < ackCeiling {
+			ackCeiling = cursor - 1
+		}
+	}
+	return ackCeiling
+}
+
+func (tr *taskReader) maxTasksPerBacklogKey() int {
+	maxTasks := tr.taskBuffer.capacity() / backlogKeysPerBuffer
+	if maxTasks < 1 {
+		return 1
+	}
+	return maxTasks
+}
+
+// fairnessKeyBacklogs returns the approximate number of persisted tasks which are not
+// completed yet per fairness key, sorted by fairness key
+func (tr *taskReader) fairnessKeyBacklogs() []*matchingservice.FairnessKeyBacklog {
+	counts := tr.tlMgr.fairnessKeyBacklogCounts()
+	backlogs := make([]*matchingservice.FairnessKeyBacklog, 0, len(counts))
+	for key, count := range counts {
+		backlogs = append(backlogs, &matchingservice.FairnessKeyBacklog{
+			FairnessKey:  key,
+			BacklogCount: count,
+		})
+	}
+	sort.Slice(backlogs, func(i, j int) bool {
+		return backlogs[i].FairnessKey < backlogs[j].FairnessKey
+	})
+	return backlogs
+}
+
 func (tr *taskReader) persistAckLevel() error {
 	ackLevel := tr.tlMgr.taskAckManager.getAckLevel()
 	tr.emitTaskLagMetric(ackLevel)
-	return tr.tlMgr.db.UpdateState(context.TODO(), ackLevel)
+	return tr.tlMgr.db.UpdateState(context.TODO(), ackLevel, tr.tlMgr.approximateBacklogCount(), tr.tlMgr.fairnessKeyBacklogCounts())
 }
 
 func (tr *taskReader) isTaskAddedRecently(lastAddTime time.Time) bool {
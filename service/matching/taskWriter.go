@@ -133,6 +133,7 @@ func (w *taskWriter) initReadWriteState(ctx context.Context) error {
 	w.taskIDBlock = rangeIDToTaskIDBlock(state.rangeID, w.config.RangeSize)
 	atomic.StoreInt64(&w.maxReadLevel, w.taskIDBlock.start-1)
 	w.tlMgr.taskAckManager.setAckLevel(state.ackLevel)
+	w.tlMgr.taskAckManager.setApproximateBacklogCount(state.approximateBacklogCount, state.fairnessKeyBacklogCounts)
 	w.tlMgr.taskReader.Signal()
 	return nil
 }
@@ -253,6 +254,11 @@ writerLoop:
 			if maxReadLevel > 0 {
 				atomic.StoreInt64(&w.maxReadLevel, maxReadLevel)
 			}
+			if err == nil {
+				for _, task := range tasks {
+					w.tlMgr.taskAckManager.addApproximateBacklogCount(task.Data.GetFairnessKey(), 1)
+				}
+			}
 
 		case <-ctx.Done():
 			return ctx.Err()
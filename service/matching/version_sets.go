@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// versionKeyer maps the build ids of workflow tasks and pollers to the key of the
+	// matcher channel they meet on. Tasks are only handed to pollers whose build id
+	// belongs to the same compatible version set as the build id of the worker that
+	// last completed a workflow task of the task's workflow.
+	versionKeyer struct {
+		// sticky task queues have no version sets of their own, tasks are matched
+		// with pollers running the exact same build id
+		sticky bool
+		// versioningData returns the version sets of the task queue, or nil when
+		// the task queue has no version sets
+		versioningData func() *persistencespb.VersioningData
+	}
+)
+
+// taskKey returns the key of the channel a task with the given build id is offered on.
+// A task is dispatched to the version set holding its build id, and to the default
+// set when its build id is unknown to the task queue.
+func (k *versionKeyer) taskKey(buildID string) string {
+	if k == nil {
+		return ""
+	}
+	if k.sticky {
+		return buildID
+	}
+	data := k.versioningData()
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		return ""
+	}
+	if idx := findVersionSet(data, buildID); idx >= 0 {
+		return versionSetKey(sets[idx])
+	}
+	return versionSetKey(sets[len(sets)-1])
+}
+
+// pollerKey returns the key of the channel a poller with the given build id listens on.
+// Pollers follow the same routing as tasks: a poller with an empty build id or a build
+// id unknown to the task queue listens on the default set, so that workers which have
+// not been registered yet, or do not report a build id, still make progress.
+func (k *versionKeyer) pollerKey(buildID string) string {
+	return k.taskKey(buildID)
+}
+
+// isKeyInUse returns false when no task or poller is routed to the channel of the given
+// key anymore, i.e. the key is neither the key of a version set nor the empty key of a
+// task queue without version sets. Sticky task queues key channels by build id, which
+// are all in use.
+func (k *versionKeyer) isKeyInUse(key string) bool {
+	if k == nil || k.sticky {
+		return true
+	}
+	sets := k.versioningData().GetVersionSets()
+	if len(sets) == 0 {
+		return key == ""
+	}
+	for _, set := range sets {
+		if versionSetKey(set) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func versionSetKey(set *persistencespb.CompatibleVersionSet) string {
+	return set.GetBuildIds()[0]
+}
+
+// findVersionSet returns the index of the version set holding the given build id, or
+// -1 when no set holds it.
+func findVersionSet(data *persistencespb.VersioningData, buildID string) int {
+	for i, set := range data.GetVersionSets() {
+		for _, id := range set.GetBuildIds() {
+			if id == buildID {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// updateVersionSets returns a copy of the given version sets with the build id added
+// or promoted. When previousCompatible is set, the build id joins the set holding
+// previousCompatible, otherwise it forms a new set of its own. When becomeDefault is
+// set, the set holding the build id becomes the default set, which is the last one.
+// An existing build id can only be made the default.
+func updateVersionSets(
+	data *persistencespb.VersioningData,
+	buildID string,
+	previousCompatible string,
+	becomeDefault bool,
+) (*persistencespb.VersioningData, error) {
+	if buildID == "" {
+		return nil, serviceerror.NewInvalidArgument("BuildId is not set on request.")
+	}
+
+	sets := make([]*persistencespb.CompatibleVersionSet, 0, len(data.GetVersionSets())+1)
+	for _, set := range data.GetVersionSets() {
+		sets = append(sets, &persistencespb.CompatibleVersionSet{
+			BuildIds: append([]string(nil), set.GetBuildIds()...),
+		})
+	}
+	result := &persistencespb.VersioningData{VersionSets: sets}
+
+	idx := findVersionSet(result, buildID)
+	switch {
+	case idx >= 0:
+		if previousCompatible != "" || !becomeDefault {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("BuildId %v already exists.", buildID))
+		}
+	case previousCompatible != "":
+		idx = findVersionSet(result, previousCompatible)
+		if idx < 0 {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Previous compatible BuildId %v does not exist.", previousCompatible))
+		}
+		result.VersionSets[idx].BuildIds = append(result.VersionSets[idx].BuildIds, buildID)
+	default:
+		set := &persistencespb.CompatibleVersionSet{BuildIds: []string{buildID}}
+		idx = len(result.VersionSets)
+		if !becomeDefault && idx > 0 {
+			// keep the current default set last
+			idx--
+		}
+		result.VersionSets = append(result.VersionSets, nil)
+		copy(result.VersionSets[idx+1:], result.VersionSets[idx:])
+		result.VersionSets[idx] = set
+	}
+
+	if becomeDefault {
+		set := result.VersionSets[idx]
+		result.VersionSets = append(result.VersionSets[:idx], result.VersionSets[idx+1:]...)
+		result.VersionSets = append(result.VersionSets, set)
+	}
+	return result, nil
+}
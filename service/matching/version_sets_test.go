@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	versionSetsSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestVersionSetsSuite(t *testing.T) {
+	s := new(versionSetsSuite)
+	suite.Run(t, s)
+}
+
+func (s *versionSetsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *versionSetsSuite) TestUpdateVersionSets() {
+	data, err := updateVersionSets(nil, "1.0", "", false)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}), data)
+
+	data, err = updateVersionSets(data, "2.0", "", true)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}, []string{"2.0"}), data)
+
+	// a new set which is not the default goes before the default set
+	data, err = updateVersionSets(data, "1.5", "", false)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0"}, []string{"1.5"}, []string{"2.0"}), data)
+
+	previous := data
+	data, err = updateVersionSets(data, "1.1", "1.0", false)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.0", "1.1"}, []string{"1.5"}, []string{"2.0"}), data)
+	// the given version sets are not modified
+	s.Equal(mkVersioningData([]string{"1.0"}, []string{"1.5"}, []string{"2.0"}), previous)
+
+	// joining a set and promoting it to default
+	data, err = updateVersionSets(data, "1.2", "1.1", true)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.5"}, []string{"2.0"}, []string{"1.0", "1.1", "1.2"}), data)
+
+	// promoting an existing build id
+	data, err = updateVersionSets(data, "2.0", "", true)
+	s.NoError(err)
+	s.Equal(mkVersioningData([]string{"1.5"}, []string{"1.0", "1.1", "1.2"}, []string{"2.0"}), data)
+}
+
+func (s *versionSetsSuite) TestUpdateVersionSets_Invalid() {
+	data := mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+
+	_, err := updateVersionSets(data, "", "", true)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = updateVersionSets(data, "1.1", "", false)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = updateVersionSets(data, "1.1", "2.0", true)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	_, err = updateVersionSets(data, "3.0", "2.5", false)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *versionSetsSuite) TestVersionKeyer() {
+	var data *persistencespb.VersioningData
+	keyer := &versionKeyer{
+		versioningData: func() *persistencespb.VersioningData { return data },
+	}
+
+	// without version sets every task matches every poller
+	s.Equal("", keyer.taskKey("1.0"))
+	s.Equal("", keyer.pollerKey("2.0"))
+
+	data = mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+	s.Equal(keyer.pollerKey("1.0"), keyer.taskKey("1.1"))
+	s.Equal(keyer.pollerKey("2.0"), keyer.taskKey("2.0"))
+	s.NotEqual(keyer.pollerKey("2.0"), keyer.taskKey("1.0"))
+	// tasks of unknown build ids go to the default set
+	s.Equal(keyer.pollerKey("2.0"), keyer.taskKey(""))
+	s.Equal(keyer.pollerKey("2.0"), keyer.taskKey("0.9"))
+	// pollers of unknown build ids poll the default set
+	s.Equal(keyer.pollerKey("3.0"), keyer.taskKey("2.0"))
+	s.Equal(keyer.pollerKey(""), keyer.taskKey("2.0"))
+	s.NotEqual(keyer.pollerKey("3.0"), keyer.taskKey("1.0"))
+
+	// sticky task queues match exact build ids
+	stickyKeyer := &versionKeyer{sticky: true}
+	s.Equal(stickyKeyer.pollerKey("1.0"), stickyKeyer.taskKey("1.0"))
+	s.NotEqual(stickyKeyer.pollerKey("1.1"), stickyKeyer.taskKey("1.0"))
+
+	// unversioned task queues match everything
+	var nilKeyer *versionKeyer
+	s.Equal(nilKeyer.pollerKey("1.1"), nilKeyer.taskKey("1.0"))
+}
+
+func (s *versionSetsSuite) TestVersionKeyer_IsKeyInUse() {
+	var data *persistencespb.VersioningData
+	keyer := &versionKeyer{
+		versioningData: func() *persistencespb.VersioningData { return data },
+	}
+	s.True(keyer.isKeyInUse(""))
+	s.False(keyer.isKeyInUse("1.0"))
+
+	data = mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+	s.True(keyer.isKeyInUse(keyer.taskKey("1.1")))
+	s.True(keyer.isKeyInUse(keyer.taskKey("2.0")))
+	s.False(keyer.isKeyInUse(""))
+
+	// the build id left the version sets
+	data = mkVersioningData([]string{"2.0"})
+	s.False(keyer.isKeyInUse("1.0"))
+
+	s.True((&versionKeyer{sticky: true}).isKeyInUse("1.0"))
+}
+
+func mkVersioningData(sets ...[]string) *persistencespb.VersioningData {
+	data := &persistencespb.VersioningData{}
+	for _, set := range sets {
+		data.VersionSets = append(data.VersionSets, &persistencespb.CompatibleVersionSet{BuildIds: set})
+	}
+	return data
+}
@@ -31,10 +31,12 @@ import (
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/sdk"
 )
 
@@ -50,10 +52,12 @@ type (
 	// Batcher is the background sub-system that execute workflow for batch operations
 	// It is also the context object that get's passed around within the scanner workflows / activities
 	Batcher struct {
-		cfg              *Config
-		sdkClientFactory sdk.ClientFactory
-		metricsClient    metrics.Client
-		logger           log.Logger
+		cfg               *Config
+		sdkClientFactory  sdk.ClientFactory
+		metricsClient     metrics.Client
+		logger            log.Logger
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
 	}
 )
 
@@ -63,12 +67,16 @@ func New(
 	metricsClient metrics.Client,
 	logger log.Logger,
 	sdkClientFactory sdk.ClientFactory,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
 ) *Batcher {
 	return &Batcher{
-		cfg:              cfg,
-		sdkClientFactory: sdkClientFactory,
-		metricsClient:    metricsClient,
-		logger:           log.With(logger, tag.ComponentBatcher),
+		cfg:               cfg,
+		sdkClientFactory:  sdkClientFactory,
+		metricsClient:     metricsClient,
+		logger:            log.With(logger, tag.ComponentBatcher),
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
 	}
 }
 
@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	sdkclient "go.temporal.io/sdk/client"
+)
+
+const (
+	// ResetTypeFirstWorkflowTask resets to the end of the first workflow task
+	ResetTypeFirstWorkflowTask = "FirstWorkflowTask"
+	// ResetTypeLastWorkflowTask resets to the end of the last workflow task
+	ResetTypeLastWorkflowTask = "LastWorkflowTask"
+	// ResetTypeLastContinuedAsNew resets the run which continued as new into the matched run to the end of its last workflow task
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first workflow task completed by a bad binary
+	ResetTypeBadBinary = "BadBinary"
+)
+
+// AllResetTypes is the reset types we supported
+var AllResetTypes = []string{ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeLastContinuedAsNew, ResetTypeBadBinary}
+
+var errResetPointNotFound = errors.New("reset point not found")
+
+type (
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType is the point to reset to, one of AllResetTypes
+		ResetType string
+		// BadBinaryChecksum is the binary checksum of the bad deployment, only for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// ResetReapplyType is the type of events to reapply after the reset point. Default to signals.
+		ResetReapplyType enumspb.ResetReapplyType
+	}
+)
+
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeLastContinuedAsNew:
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+	default:
+		return fmt.Errorf("not supported reset type: %v, must be one of %v", params.ResetType, AllResetTypes)
+	}
+	switch params.ResetReapplyType {
+	case enumspb.RESET_REAPPLY_TYPE_SIGNAL, enumspb.RESET_REAPPLY_TYPE_NONE:
+		return nil
+	default:
+		return fmt.Errorf("not supported reset reapply type: %v", params.ResetReapplyType)
+	}
+}
+
+// getResetPoint returns the run to reset and the ID of the workflow task finish event to reset it to.
+// The run differs from the given execution for ResetTypeLastContinuedAsNew, and for ResetTypeBadBinary
+// if the bad binary completed a workflow task in a run before the execution was continued as new.
+func getResetPoint(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	params ResetParams,
+	execution commonpb.WorkflowExecution,
+) (runID string, workflowTaskFinishEventID int64, err error) {
+	workflowID := execution.GetWorkflowId()
+	runID = execution.GetRunId()
+	switch params.ResetType {
+	case ResetTypeFirstWorkflowTask:
+		workflowTaskFinishEventID, err = getWorkflowTaskFinishEventID(ctx, sdkClient, workflowID, runID, true)
+	case ResetTypeLastWorkflowTask:
+		workflowTaskFinishEventID, err = getWorkflowTaskFinishEventID(ctx, sdkClient, workflowID, runID, false)
+	case ResetTypeLastContinuedAsNew:
+		runID, err = getContinuedAsNewFromRunID(ctx, sdkClient, workflowID, runID)
+		if err != nil {
+			return "", 0, err
+		}
+		workflowTaskFinishEventID, err = getWorkflowTaskFinishEventID(ctx, sdkClient, workflowID, runID, false)
+	case ResetTypeBadBinary:
+		runID, workflowTaskFinishEventID, err = getBadBinaryResetPoint(ctx, sdkClient, workflowID, runID, params.BadBinaryChecksum)
+	default:
+		err = fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	return runID, workflowTaskFinishEventID, nil
+}
+
+// getWorkflowTaskFinishEventID returns the ID of the first or last workflow task completed event of a run.
+// If the workflow task was never completed, the event after the workflow task scheduled event is used,
+// which makes the reset fail the workflow task.
+func getWorkflowTaskFinishEventID(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	workflowID string,
+	runID string,
+	first bool,
+) (int64, error) {
+	var workflowTaskFinishEventID int64
+	iter := sdkClient.GetWorkflowHistory(ctx, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return 0, err
+		}
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+			workflowTaskFinishEventID = event.GetEventId()
+			if first {
+				return workflowTaskFinishEventID, nil
+			}
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+			if first && workflowTaskFinishEventID != 0 {
+				return workflowTaskFinishEventID, nil
+			}
+			workflowTaskFinishEventID = event.GetEventId() + 1
+		}
+	}
+	if workflowTaskFinishEventID == 0 {
+		return 0, fmt.Errorf("%w: no workflow task in workflow %v run %v", errResetPointNotFound, workflowID, runID)
+	}
+	return workflowTaskFinishEventID, nil
+}
+
+// getContinuedAsNewFromRunID returns the ID of the run which continued as new into the given run.
+func getContinuedAsNewFromRunID(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	workflowID string,
+	runID string,
+) (string, error) {
+	iter := sdkClient.GetWorkflowHistory(ctx, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	if !iter.HasNext() {
+		return "", fmt.Errorf("%w: empty history of workflow %v run %v", errResetPointNotFound, workflowID, runID)
+	}
+	event, err := iter.Next()
+	if err != nil {
+		return "", err
+	}
+	continuedRunID := event.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+	if continuedRunID == "" {
+		return "", fmt.Errorf("%w: workflow %v run %v is not continued as new", errResetPointNotFound, workflowID, runID)
+	}
+	return continuedRunID, nil
+}
+
+// getBadBinaryResetPoint returns the run and the ID of the first workflow task completed event
+// recorded by a worker with the given binary checksum, using the auto reset points of the execution.
+func getBadBinaryResetPoint(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	workflowID string,
+	runID string,
+	badBinaryChecksum string,
+) (string, int64, error) {
+	resp, err := sdkClient.DescribeWorkflowExecution(ctx, workflowID, runID)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, point := range resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints() {
+		if point.GetBinaryChecksum() == badBinaryChecksum && point.GetResettable() {
+			if point.GetRunId() != "" {
+				runID = point.GetRunId()
+			}
+			return runID, point.GetFirstWorkflowTaskCompletedId(), nil
+		}
+	}
+	return "", 0, fmt.Errorf("%w: no resettable point for binary checksum %v in workflow %v run %v", errResetPointNotFound, badBinaryChecksum, workflowID, runID)
+}
@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+	sdkmocks "go.temporal.io/sdk/mocks"
+)
+
+type (
+	resetSuite struct {
+		suite.Suite
+		sdkClient *sdkmocks.Client
+	}
+
+	historyIterator struct {
+		events []*historypb.HistoryEvent
+	}
+)
+
+func TestResetSuite(t *testing.T) {
+	suite.Run(t, new(resetSuite))
+}
+
+func (s *resetSuite) SetupTest() {
+	s.sdkClient = &sdkmocks.Client{}
+}
+
+func (s *resetSuite) TearDownTest() {
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *resetSuite) TestValidateParams() {
+	params := setDefaultParams(BatchParams{
+		Namespace:   "namespace",
+		Query:       "WorkflowType = 'type'",
+		Reason:      "bad deploy",
+		BatchType:   BatchTypeReset,
+		ResetParams: ResetParams{ResetType: ResetTypeLastWorkflowTask},
+	})
+	s.NoError(validateParams(params))
+	s.Equal(enumspb.RESET_REAPPLY_TYPE_SIGNAL, params.ResetParams.ResetReapplyType)
+
+	params.ResetParams.ResetType = "unknown"
+	s.Error(validateParams(params))
+
+	params.ResetParams.ResetType = ResetTypeBadBinary
+	s.Error(validateParams(params))
+	params.ResetParams.BadBinaryChecksum = "checksum"
+	s.NoError(validateParams(params))
+}
+
+func (s *resetSuite) TestGetResetPoint_FirstAndLastWorkflowTask() {
+	events := []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{EventId: 4, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+		{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+		{EventId: 6, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 7, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{EventId: 8, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+		{EventId: 9, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED},
+		{EventId: 10, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+	}
+	s.expectHistory("wid", "rid", events).Twice()
+	execution := commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+
+	runID, eventID, err := getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeFirstWorkflowTask}, execution)
+	s.NoError(err)
+	s.Equal("rid", runID)
+	s.Equal(int64(4), eventID)
+
+	// the last workflow task was scheduled but not completed yet
+	runID, eventID, err = getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeLastWorkflowTask}, execution)
+	s.NoError(err)
+	s.Equal("rid", runID)
+	s.Equal(int64(11), eventID)
+}
+
+func (s *resetSuite) TestGetResetPoint_NoWorkflowTask() {
+	s.expectHistory("wid", "rid", []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+	}).Once()
+
+	_, _, err := getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeFirstWorkflowTask}, commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"})
+	s.True(errors.Is(err, errResetPointNotFound))
+}
+
+func (s *resetSuite) TestGetResetPoint_LastContinuedAsNew() {
+	s.expectHistory("wid", "rid", []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					ContinuedExecutionRunId: "previous-rid",
+				},
+			},
+		},
+	}).Once()
+	s.expectHistory("wid", "previous-rid", []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED},
+		{EventId: 4, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+		{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW},
+	}).Once()
+
+	runID, eventID, err := getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeLastContinuedAsNew}, commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"})
+	s.NoError(err)
+	s.Equal("previous-rid", runID)
+	s.Equal(int64(4), eventID)
+}
+
+func (s *resetSuite) TestGetResetPoint_BadBinary() {
+	s.sdkClient.On("DescribeWorkflowExecution", mock.Anything, "wid", "rid").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{
+					{BinaryChecksum: "good", RunId: "rid", FirstWorkflowTaskCompletedId: 4, Resettable: true},
+					{BinaryChecksum: "bad", RunId: "rid", FirstWorkflowTaskCompletedId: 8, Resettable: true},
+				},
+			},
+		},
+	}, nil).Twice()
+	execution := commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"}
+
+	runID, eventID, err := getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "bad"}, execution)
+	s.NoError(err)
+	s.Equal("rid", runID)
+	s.Equal(int64(8), eventID)
+
+	_, _, err = getResetPoint(context.Background(), s.sdkClient, ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "unknown"}, execution)
+	s.True(errors.Is(err, errResetPointNotFound))
+}
+
+func (s *resetSuite) expectHistory(workflowID string, runID string, events []*historypb.HistoryEvent) *mock.Call {
+	return s.sdkClient.On("GetWorkflowHistory", mock.Anything, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT).
+		Return(func(context.Context, string, string, bool, enumspb.HistoryEventFilterType) sdkclient.HistoryEventIterator {
+			return &historyIterator{events: events}
+		})
+}
+
+func (it *historyIterator) HasNext() bool {
+	return len(it.events) > 0
+}
+
+func (it *historyIterator) Next() (*historypb.HistoryEvent, error) {
+	event := it.events[0]
+	it.events = it.events[1:]
+	return event, nil
+}
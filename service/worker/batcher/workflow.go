@@ -26,10 +26,13 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
@@ -38,10 +41,12 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 )
 
 const (
@@ -51,6 +56,10 @@ const (
 	// BatchWFTypeName is the workflow type
 	BatchWFTypeName   = "temporal-sys-batch-workflow"
 	batchActivityName = "temporal-sys-batch-activity"
+	// BatchProgressQueryType is the query type for the progress of a running batch operation,
+	// the result is the HeartBeatDetails of the last processed page
+	BatchProgressQueryType  = "batch-progress"
+	batchProgressSignalName = "temporal-sys-batch-progress"
 	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
 	InfiniteDuration = 20 * 365 * 24 * time.Hour
 	pageSize         = 1000
@@ -63,6 +72,8 @@ const (
 	DefaultAttemptsOnRetryableError = 50
 	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
 	DefaultActivityHeartBeatTimeout = time.Second * 10
+	// DefaultProgressReportInterval is the default value for ProgressReportInterval
+	DefaultProgressReportInterval = time.Minute
 )
 
 const (
@@ -72,10 +83,22 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
+	// BatchTypeUpsertSearchAttributes is batch type for upserting search attributes of running workflows
+	BatchTypeUpsertSearchAttributes = "upsert-search-attributes"
+	// BatchTypeUpsertMemo is batch type for upserting memo of running workflows
+	BatchTypeUpsertMemo = "upsert-memo"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReset, BatchTypeDelete, BatchTypeUpsertSearchAttributes, BatchTypeUpsertMemo}
+
+// errTaskSkipped is the result of a task whose workflow was not found, e.g. because
+// it was already deleted, or it was closed when terminating or canceling it
+var errTaskSkipped = errors.New("workflow not found")
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -100,6 +123,16 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// UpsertSearchAttributesParams is the parameters for upserting search attributes of workflows
+	UpsertSearchAttributesParams struct {
+		SearchAttributes *commonpb.SearchAttributes
+	}
+
+	// UpsertMemoParams is the parameters for upserting memo of workflows
+	UpsertMemoParams struct {
+		Memo *commonpb.Memo
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -108,7 +141,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,reset,delete,upsert-search-attributes,upsert-memo
 		BatchType string
 
 		// Below are all optional
@@ -118,6 +151,12 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// UpsertSearchAttributesParams is params only for BatchTypeUpsertSearchAttributes
+		UpsertSearchAttributesParams UpsertSearchAttributesParams
+		// UpsertMemoParams is params only for BatchTypeUpsertMemo
+		UpsertMemoParams UpsertMemoParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
 		RPS int
@@ -127,6 +166,9 @@ type (
 		AttemptsOnRetryableError int
 		// timeout for activity heartbeat
 		ActivityHeartBeatTimeout time.Duration
+		// minimum interval between progress reports to the batch workflow, which are recorded in its history.
+		// Default to DefaultProgressReportInterval
+		ProgressReportInterval time.Duration
 		// errors that will not retry which consumes AttemptsOnRetryableError. Default to empty
 		NonRetryableErrors []string
 		// internal conversion for NonRetryableErrors
@@ -143,6 +185,8 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Number of workflows that were not found.
+		SkippedCount int
 	}
 
 	taskDetail struct {
@@ -174,10 +218,32 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	if err != nil {
 		return HeartBeatDetails{}, err
 	}
+	// the activity signals its progress at most once per ProgressReportInterval
+	var progress HeartBeatDetails
+	if err := workflow.SetQueryHandler(ctx, BatchProgressQueryType, func() (HeartBeatDetails, error) {
+		return progress, nil
+	}); err != nil {
+		return HeartBeatDetails{}, err
+	}
+
 	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
+	future := workflow.ExecuteActivity(opt, batchActivityName, batchParams)
+
+	activityDone := false
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(workflow.GetSignalChannel(ctx, batchProgressSignalName), func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, &progress)
+	})
+	selector.AddFuture(future, func(workflow.Future) {
+		activityDone = true
+	})
+	for !activityDone {
+		selector.Select(ctx)
+	}
+
 	var result HeartBeatDetails
-	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
+	err = future.Get(ctx, &result)
 	return result, err
 }
 
@@ -196,6 +262,20 @@ func validateParams(params BatchParams) error {
 		return nil
 	case BatchTypeCancel, BatchTypeTerminate:
 		return nil
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeDelete:
+		return nil
+	case BatchTypeUpsertSearchAttributes:
+		if len(params.UpsertSearchAttributesParams.SearchAttributes.GetIndexedFields()) == 0 {
+			return fmt.Errorf("must provide search attributes")
+		}
+		return nil
+	case BatchTypeUpsertMemo:
+		if len(params.UpsertMemoParams.Memo.GetFields()) == 0 {
+			return fmt.Errorf("must provide memo")
+		}
+		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
@@ -214,6 +294,9 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.ActivityHeartBeatTimeout <= 0 {
 		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
 	}
+	if params.ProgressReportInterval <= 0 {
+		params.ProgressReportInterval = DefaultProgressReportInterval
+	}
 	if len(params.NonRetryableErrors) > 0 {
 		params._nonRetryableErrors = make(map[string]struct{}, len(params.NonRetryableErrors))
 		for _, estr := range params.NonRetryableErrors {
@@ -223,6 +306,9 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.TerminateParams.TerminateChildren == nil {
 		params.TerminateParams.TerminateChildren = convert.BoolPtr(true)
 	}
+	if params.ResetParams.ResetReapplyType == enumspb.RESET_REAPPLY_TYPE_UNSPECIFIED {
+		params.ResetParams.ResetReapplyType = enumspb.RESET_REAPPLY_TYPE_SIGNAL
+	}
 	return params
 }
 
@@ -237,6 +323,18 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		logger.Error("Unable to create SDK client for namespace.", tag.Error(err), tag.WorkflowNamespace(batchParams.Namespace))
 		return hbd, err
 	}
+	namespaceID, err := batcher.namespaceRegistry.GetNamespaceID(namespace.Name(batchParams.Namespace))
+	if err != nil {
+		logger.Error("Unable to get namespace ID.", tag.Error(err), tag.WorkflowNamespace(batchParams.Namespace))
+		return hbd, err
+	}
+	// the batch workflow may run in a different namespace than the workflows it processes
+	batchInfo := activity.GetInfo(ctx)
+	batchSdkClient, err := batcher.sdkClientFactory.NewClient(batchInfo.WorkflowNamespace, logger)
+	if err != nil {
+		logger.Error("Unable to create SDK client for namespace.", tag.Error(err), tag.WorkflowNamespace(batchInfo.WorkflowNamespace))
+		return hbd, err
+	}
 
 	startOver := true
 	if activity.HasHeartbeatDetails(ctx) {
@@ -262,9 +360,10 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, logger)
+		go startTaskProcessor(ctx, batchParams, namespaceID, taskCh, respCh, rateLimiter, sdkClient, batcher.historyClient, logger)
 	}
 
+	var lastProgressReport time.Time
 	for {
 		resp, err := sdkClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
 			PageSize:      int32(pageSize),
@@ -290,17 +389,21 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 
 		succCount := 0
 		errCount := 0
+		skippedCount := 0
 		// wait for counters indicate this batch is done
 	Loop:
 		for {
 			select {
 			case err := <-respCh:
-				if err == nil {
+				switch err {
+				case nil:
 					succCount++
-				} else {
+				case errTaskSkipped:
+					skippedCount++
+				default:
 					errCount++
 				}
-				if succCount+errCount == batchCount {
+				if succCount+errCount+skippedCount == batchCount {
 					break Loop
 				}
 			case <-ctx.Done():
@@ -312,7 +415,16 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		hbd.PageToken = resp.NextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		hbd.SkippedCount += skippedCount
 		activity.RecordHeartbeat(ctx, hbd)
+		// progress reporting is best effort, the heartbeat is what the activity resumes from.
+		// Every report adds events to the batch workflow's history, so reports are throttled.
+		if now := time.Now(); now.Sub(lastProgressReport) >= batchParams.ProgressReportInterval {
+			lastProgressReport = now
+			if err := batchSdkClient.SignalWorkflow(ctx, batchInfo.WorkflowExecution.ID, batchInfo.WorkflowExecution.RunID, batchProgressSignalName, hbd); err != nil {
+				logger.Warn("Failed to report batch operation progress", tag.Error(err))
+			}
+		}
 
 		if len(hbd.PageToken) == 0 {
 			break
@@ -325,10 +437,12 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
+	historyClient historyservice.HistoryServiceClient,
 	logger log.Logger,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
@@ -360,13 +474,42 @@ func startTaskProcessor(
 					func(workflowID, runID string) error {
 						return sdkClient.SignalWorkflow(ctx, workflowID, runID, batchParams.SignalParams.SignalName, batchParams.SignalParams.Input)
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, sdkClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, sdkClient, batchParams, workflowID, runID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, sdkClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+							NamespaceId: namespaceID.String(),
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+						})
+						return err
+					})
+			case BatchTypeUpsertSearchAttributes:
+				err = processTask(ctx, limiter, task, sdkClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return upsertWorkflowMetadata(ctx, historyClient, namespaceID, workflowID, runID, batchParams.UpsertSearchAttributesParams.SearchAttributes, nil)
+					})
+			case BatchTypeUpsertMemo:
+				err = processTask(ctx, limiter, task, sdkClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return upsertWorkflowMetadata(ctx, historyClient, namespaceID, workflowID, runID, nil, batchParams.UpsertMemoParams.Memo)
+					})
 			}
-			if err != nil {
+			if err == errTaskSkipped {
+				respCh <- err
+			} else if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				logger.Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
+				if ok || errors.Is(err, errResetPointNotFound) || task.attempts > batchParams.AttemptsOnRetryableError {
 					respCh <- err
 				} else {
 					// put back to the channel if less than attemptsOnError
@@ -391,7 +534,8 @@ func processTask(
 	procFn func(string, string) error,
 ) error {
 	wfs := []commonpb.WorkflowExecution{task.execution}
-	for len(wfs) > 0 {
+	skipped := false
+	for i := 0; len(wfs) > 0; i++ {
 		wf := wfs[0]
 
 		err := limiter.Wait(ctx)
@@ -406,6 +550,10 @@ func processTask(
 			if _, isNotFound := err.(*serviceerror.NotFound); !isNotFound {
 				return err
 			}
+			// only the task's own workflow counts, not its children
+			if i == 0 {
+				skipped = true
+			}
 		}
 		wfs = wfs[1:]
 		resp, err := sdkClient.DescribeWorkflowExecution(ctx, wf.GetWorkflowId(), wf.GetRunId())
@@ -430,9 +578,64 @@ func processTask(
 		}
 	}
 
+	if skipped {
+		return errTaskSkipped
+	}
 	return nil
 }
 
+func resetWorkflow(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	resetRunID, workflowTaskFinishEventID, err := getResetPoint(ctx, sdkClient, batchParams.ResetParams, commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	})
+	if err != nil {
+		return err
+	}
+	// history resets the workflow with its workflowResetter, which also terminates the current run if it is open
+	_, err = sdkClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      resetRunID,
+		},
+		Reason:                    batchParams.Reason,
+		WorkflowTaskFinishEventId: workflowTaskFinishEventID,
+		RequestId:                 uuid.New(),
+		ResetReapplyType:          batchParams.ResetParams.ResetReapplyType,
+	})
+	return err
+}
+
+// upsertWorkflowMetadata upserts search attributes and memo of a running workflow, closed workflows
+// are skipped as history returns NotFound for them
+func upsertWorkflowMetadata(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+	searchAttributes *commonpb.SearchAttributes,
+	memo *commonpb.Memo,
+) error {
+	_, err := historyClient.UpsertWorkflowExecutionMetadata(ctx, &historyservice.UpsertWorkflowExecutionMetadataRequest{
+		NamespaceId: namespaceID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		SearchAttributes: searchAttributes,
+		Memo:             memo,
+	})
+	return err
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
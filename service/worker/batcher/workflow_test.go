@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+type batcherSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestBatcherSuite(t *testing.T) {
+	suite.Run(t, new(batcherSuite))
+}
+
+func (s *batcherSuite) TestBatchWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+
+	env.ExecuteWorkflow(BatchWFTypeName, BatchParams{
+		Namespace: "namespace",
+		Query:     "WorkflowType = 'type'",
+		Reason:    "test",
+		BatchType: "unknown",
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *batcherSuite) TestBatchWorkflow_ProgressQuery() {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+	env.RegisterActivityWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
+	env.OnActivity(batchActivityName, mock.Anything, mock.Anything).
+		After(time.Hour).
+		Return(HeartBeatDetails{CurrentPage: 2, SuccessCount: 3, SkippedCount: 1}, nil)
+
+	queryProgress := func() HeartBeatDetails {
+		value, err := env.QueryWorkflow(BatchProgressQueryType)
+		s.NoError(err)
+		var progress HeartBeatDetails
+		s.NoError(value.Get(&progress))
+		return progress
+	}
+	env.RegisterDelayedCallback(func() {
+		s.Equal(HeartBeatDetails{}, queryProgress())
+		env.SignalWorkflow(batchProgressSignalName, HeartBeatDetails{
+			PageToken:    []byte("token"),
+			CurrentPage:  1,
+			SuccessCount: 2,
+			SkippedCount: 1,
+		})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		progress := queryProgress()
+		s.Equal([]byte("token"), progress.PageToken)
+		s.Equal(1, progress.CurrentPage)
+		s.Equal(2, progress.SuccessCount)
+		s.Equal(1, progress.SkippedCount)
+	}, 2*time.Minute)
+
+	env.ExecuteWorkflow(BatchWFTypeName, BatchParams{
+		Namespace: "namespace",
+		Query:     "WorkflowType = 'type'",
+		Reason:    "test",
+		BatchType: BatchTypeDelete,
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(3, result.SuccessCount)
+	s.Equal(1, result.SkippedCount)
+}
+
+func (s *batcherSuite) TestValidateParams_Upsert() {
+	params := setDefaultParams(BatchParams{
+		Namespace: "namespace",
+		Query:     "WorkflowType = 'type'",
+		Reason:    "test",
+		BatchType: BatchTypeUpsertSearchAttributes,
+	})
+	s.Equal(DefaultProgressReportInterval, params.ProgressReportInterval)
+	s.Error(validateParams(params))
+	params.UpsertSearchAttributesParams.SearchAttributes = &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("value")},
+	}
+	s.NoError(validateParams(params))
+
+	params.BatchType = BatchTypeUpsertMemo
+	s.Error(validateParams(params))
+	params.UpsertMemoParams.Memo = &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")},
+	}
+	s.NoError(validateParams(params))
+}
+
+func (s *batcherSuite) TestUpsertWorkflowMetadata() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")},
+	}
+	historyClient.EXPECT().UpsertWorkflowExecutionMetadata(gomock.Any(), &historyservice.UpsertWorkflowExecutionMetadataRequest{
+		NamespaceId: "namespace-id",
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "wid",
+			RunId:      "rid",
+		},
+		Memo: memo,
+	}).Return(&historyservice.UpsertWorkflowExecutionMetadataResponse{}, nil)
+
+	err := upsertWorkflowMetadata(context.Background(), historyClient, namespace.ID("namespace-id"), "wid", "rid", nil, memo)
+	s.NoError(err)
+}
@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	activities struct {
+		activityDeps
+	}
+
+	// StartWorkflowRequest is the input to the StartWorkflow activity.
+	StartWorkflowRequest struct {
+		Namespace  string
+		RequestID  string
+		WorkflowID string
+		Identity   string
+		Action     *StartWorkflowAction
+	}
+
+	// StartWorkflowResponse is the result of the StartWorkflow activity.
+	StartWorkflowResponse struct {
+		RunID         string
+		RealStartTime time.Time
+	}
+
+	// WatchWorkflowRequest is the input to the WatchWorkflow activity.
+	WatchWorkflowRequest struct {
+		Namespace string
+		Execution *commonpb.WorkflowExecution
+	}
+
+	// WatchWorkflowResponse is the result of the WatchWorkflow activity.
+	WatchWorkflowResponse struct {
+		// Status of the last run in the chain, or unspecified if the workflow no longer exists.
+		Status enumspb.WorkflowExecutionStatus
+	}
+
+	// StopWorkflowRequest is the input to the CancelWorkflow and TerminateWorkflow activities.
+	StopWorkflowRequest struct {
+		Namespace string
+		Execution *commonpb.WorkflowExecution
+		Identity  string
+		Reason    string
+	}
+)
+
+// StartWorkflow starts one run of the scheduled action. Starting is idempotent on RequestID.
+func (a *activities) StartWorkflow(ctx context.Context, req *StartWorkflowRequest) (*StartWorkflowResponse, error) {
+	action := req.Action
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                req.Namespace,
+		WorkflowId:               req.WorkflowID,
+		WorkflowType:             &commonpb.WorkflowType{Name: action.WorkflowType},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: action.TaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		Input:                    action.Input,
+		WorkflowExecutionTimeout: timestamp.DurationPtr(action.WorkflowExecutionTimeout),
+		WorkflowRunTimeout:       timestamp.DurationPtr(action.WorkflowRunTimeout),
+		WorkflowTaskTimeout:      timestamp.DurationPtr(action.WorkflowTaskTimeout),
+		Identity:                 req.Identity,
+		RequestId:                req.RequestID,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		RetryPolicy:              action.RetryPolicy,
+		Memo:                     action.Memo,
+		SearchAttributes:         action.SearchAttributes,
+		Header:                   action.Header,
+	}
+	resp, err := a.FrontendClient.StartWorkflowExecution(ctx, request)
+	if err != nil {
+		if alreadyStarted, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok && alreadyStarted.StartRequestId == req.RequestID {
+			// A previous attempt of this activity already started the workflow.
+			return &StartWorkflowResponse{RunID: alreadyStarted.RunId, RealStartTime: time.Now().UTC()}, nil
+		}
+		return nil, err
+	}
+	return &StartWorkflowResponse{RunID: resp.GetRunId(), RealStartTime: time.Now().UTC()}, nil
+}
+
+// WatchWorkflow blocks until the given workflow (following continue-as-new) is closed.
+func (a *activities) WatchWorkflow(ctx context.Context, req *WatchWorkflowRequest) (*WatchWorkflowResponse, error) {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: req.Execution.GetWorkflowId(),
+		RunId:      req.Execution.GetRunId(),
+	}
+	var nextPageToken []byte
+	for {
+		activity.RecordHeartbeat(ctx)
+		resp, err := a.FrontendClient.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              req.Namespace,
+			Execution:              execution,
+			MaximumPageSize:        1,
+			NextPageToken:          nextPageToken,
+			WaitNewEvent:           true,
+			HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+			SkipArchival:           true,
+		})
+		if err != nil {
+			if _, ok := err.(*serviceerror.NotFound); ok {
+				return &WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED}, nil
+			}
+			return nil, err
+		}
+
+		events := resp.GetHistory().GetEvents()
+		if len(events) == 0 {
+			// long poll timed out, keep waiting
+			nextPageToken = resp.GetNextPageToken()
+			continue
+		}
+
+		closeEvent := events[len(events)-1]
+		if attrs := closeEvent.GetWorkflowExecutionContinuedAsNewEventAttributes(); attrs != nil {
+			execution = &commonpb.WorkflowExecution{
+				WorkflowId: execution.WorkflowId,
+				RunId:      attrs.GetNewExecutionRunId(),
+			}
+			nextPageToken = nil
+			continue
+		}
+		return &WatchWorkflowResponse{Status: closeEventStatus(closeEvent)}, nil
+	}
+}
+
+// CancelWorkflow requests cancellation of a running workflow.
+func (a *activities) CancelWorkflow(ctx context.Context, req *StopWorkflowRequest) error {
+	_, err := a.FrontendClient.RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+		Namespace:         req.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: req.Execution.GetWorkflowId()},
+		Identity:          req.Identity,
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil
+	}
+	return err
+}
+
+// TerminateWorkflow terminates a running workflow.
+func (a *activities) TerminateWorkflow(ctx context.Context, req *StopWorkflowRequest) error {
+	_, err := a.FrontendClient.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         req.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: req.Execution.GetWorkflowId()},
+		Reason:            req.Reason,
+		Identity:          req.Identity,
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil
+	}
+	return err
+}
+
+func closeEventStatus(event *historypb.HistoryEvent) enumspb.WorkflowExecutionStatus {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+	default:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
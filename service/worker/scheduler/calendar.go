@@ -26,6 +26,7 @@ package scheduler
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -37,6 +38,15 @@ import (
 type (
 	parseMode int
 
+	// SpecFieldError describes a field of a ScheduleSpec that could not be compiled.
+	SpecFieldError struct {
+		// Path to the field, e.g. "calendar[1].day_of_week".
+		Field string
+		// The offending value as given in the spec.
+		Value string
+		Err   error
+	}
+
 	compiledCalendar struct {
 		// Time zone that this calendar spec is interpreted in
 		tz *time.Location
@@ -98,21 +108,25 @@ var (
 
 func newCompiledCalendar(cal *schedpb.CalendarSpec, tz *time.Location) (*compiledCalendar, error) {
 	cc := &compiledCalendar{tz: tz}
-	var err error
-	if cc.year, err = makeMatcher(cal.Year, "*", minCalendarYear, maxCalendarYear, parseModeInt); err != nil {
-		return nil, err
-	} else if cc.month, err = makeMatcher(cal.Month, "*", 1, 12, parseModeMonth); err != nil {
-		return nil, err
-	} else if cc.dayOfMonth, err = makeMatcher(cal.DayOfMonth, "*", 1, 31, parseModeInt); err != nil {
-		return nil, err
-	} else if cc.dayOfWeek, err = makeMatcher(cal.DayOfWeek, "*", 0, 7, parseModeDow); err != nil {
-		return nil, err
-	} else if cc.hour, err = makeMatcher(cal.Hour, "0", 0, 23, parseModeInt); err != nil {
-		return nil, err
-	} else if cc.minute, err = makeMatcher(cal.Minute, "0", 0, 59, parseModeInt); err != nil {
-		return nil, err
-	} else if cc.second, err = makeMatcher(cal.Second, "0", 0, 59, parseModeInt); err != nil {
-		return nil, err
+	for _, f := range []struct {
+		name, value, def string
+		min, max         int
+		parseMode        parseMode
+		matcher          *func(int) bool
+	}{
+		{"year", cal.Year, "*", minCalendarYear, maxCalendarYear, parseModeInt, &cc.year},
+		{"month", cal.Month, "*", 1, 12, parseModeMonth, &cc.month},
+		{"day_of_month", cal.DayOfMonth, "*", 1, 31, parseModeInt, &cc.dayOfMonth},
+		{"day_of_week", cal.DayOfWeek, "*", 0, 7, parseModeDow, &cc.dayOfWeek},
+		{"hour", cal.Hour, "0", 0, 23, parseModeInt, &cc.hour},
+		{"minute", cal.Minute, "0", 0, 59, parseModeInt, &cc.minute},
+		{"second", cal.Second, "0", 0, 59, parseModeInt, &cc.second},
+	} {
+		matcher, err := makeMatcher(f.value, f.def, f.min, f.max, f.parseMode)
+		if err != nil {
+			return nil, &SpecFieldError{Field: f.name, Value: f.value, Err: err}
+		}
+		*f.matcher = matcher
 	}
 	return cc, nil
 }
@@ -313,15 +327,12 @@ func parseStringSpec(s string, min, max int, parseMode parseMode, f func(int)) e
 		if strings.Contains(part, "/") {
 			skipParts := strings.Split(part, "/")
 			if len(skipParts) != 2 {
-				return errMalformed
+				return fmt.Errorf("%w: %q has more than one '/'", errMalformed, part)
 			}
 			part = skipParts[0]
 			skipBy, err = strconv.Atoi(skipParts[1])
-			if err != nil {
-				return err
-			}
-			if skipBy < 1 {
-				return errMalformed
+			if err != nil || skipBy < 1 {
+				return fmt.Errorf("%w: step %q must be a positive integer", errMalformed, skipParts[1])
 			}
 			hasSkipBy = true
 		}
@@ -331,7 +342,7 @@ func parseStringSpec(s string, min, max int, parseMode parseMode, f func(int)) e
 			if strings.Contains(part, "-") {
 				rangeParts := strings.Split(part, "-")
 				if len(rangeParts) != 2 {
-					return errMalformed
+					return fmt.Errorf("%w: %q has more than one '-'", errMalformed, part)
 				}
 				if start, err = parseValue(rangeParts[0], min, max, parseMode); err != nil {
 					return err
@@ -361,27 +372,31 @@ func parseStringSpec(s string, min, max int, parseMode parseMode, f func(int)) e
 
 // Parses a single value (integer or day-of-week or month name).
 func parseValue(s string, min, max int, parseMode parseMode) (int, error) {
+	i, err := parseValueUnchecked(s, parseMode)
+	if err != nil {
+		return i, err
+	}
+	if i < min || i > max {
+		return i, fmt.Errorf("%w: %q must be between %d and %d", errOutOfRange, s, min, max)
+	}
+	return i, nil
+}
+
+func parseValueUnchecked(s string, parseMode parseMode) (int, error) {
 	if parseMode == parseModeMonth {
 		if len(s) >= 3 {
-			s = strings.ToLower(s)
+			ls := strings.ToLower(s)
 			for i, month := range monthStrings {
-				if strings.HasPrefix(month, s) {
-					i++
-					if i < min || i > max {
-						return i, errOutOfRange
-					}
-					return i, nil
+				if strings.HasPrefix(month, ls) {
+					return i + 1, nil
 				}
 			}
 		}
 	} else if parseMode == parseModeDow {
 		if len(s) >= 2 {
-			s = strings.ToLower(s)
+			ls := strings.ToLower(s)
 			for i, dow := range dowStrings {
-				if strings.HasPrefix(dow, s) {
-					if i < min || i > max {
-						return i, errOutOfRange
-					}
+				if strings.HasPrefix(dow, ls) {
 					return i, nil
 				}
 			}
@@ -389,14 +404,30 @@ func parseValue(s string, min, max int, parseMode parseMode) (int, error) {
 	}
 	i, err := strconv.Atoi(s)
 	if err != nil {
-		return i, err
-	}
-	if i < min || i > max {
-		return i, errOutOfRange
+		return i, fmt.Errorf("%w: %q is not a %s", errMalformed, s, parseMode)
 	}
 	return i, nil
 }
 
+func (m parseMode) String() string {
+	switch m {
+	case parseModeMonth:
+		return "number or month name"
+	case parseModeDow:
+		return "number or day-of-week name"
+	default:
+		return "number"
+	}
+}
+
+func (e *SpecFieldError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %v", e.Field, e.Value, e.Err)
+}
+
+func (e *SpecFieldError) Unwrap() error {
+	return e.Err
+}
+
 // same as Go's version
 func isLeapYear(y int) bool {
 	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	schedpb "go.temporal.io/api/schedule/v1"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+var (
+	errCronFieldCount      = errors.New("expected 5 or 6 fields")
+	errCronDescriptor      = errors.New("unknown descriptor")
+	errCronEveryInterval   = errors.New("@every interval must be a whole number of seconds, at least 1s")
+	errCronTimezoneMissing = errors.New("missing time zone name")
+	errCronTimezoneClash   = errors.New("conflicts with the time zone of the schedule spec")
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// SpecWithCronStrings returns a copy of spec with the given cron strings added to it as
+// calendar and interval specs. spec is returned as-is if there are no cron strings.
+//
+// Cron strings are interpreted like robfig/cron's standard parser (as used for
+// CronSchedule workflows), with an optional leading seconds field:
+//   [CRON_TZ=<zone>] [second] minute hour day-of-month month day-of-week
+//   [CRON_TZ=<zone>] @yearly|@annually|@monthly|@weekly|@daily|@midnight|@hourly
+//   @every <duration>
+// As in cron, if both day-of-month and day-of-week are restricted, a time matches when
+// either of them matches. Because cron strings become calendar specs, they follow the
+// same DST rules as calendar specs. @every intervals are aligned to the unix epoch rather
+// than to the time the schedule was created.
+//
+// A CRON_TZ (or TZ) prefix sets the time zone of the whole spec, so all cron strings
+// must agree with each other and with spec.TimezoneName, if set.
+func SpecWithCronStrings(spec *schedpb.ScheduleSpec, cronStrings []string) (*schedpb.ScheduleSpec, error) {
+	if len(cronStrings) == 0 {
+		return spec, nil
+	}
+
+	merged := &schedpb.ScheduleSpec{}
+	if spec != nil {
+		*merged = *spec
+	}
+	merged.Calendar = append([]*schedpb.CalendarSpec(nil), merged.Calendar...)
+	merged.Interval = append([]*schedpb.IntervalSpec(nil), merged.Interval...)
+
+	for i, c := range cronStrings {
+		field := fmt.Sprintf("cron_strings[%d]", i)
+		tz, calendars, interval, err := parseCronString(c)
+		if err != nil {
+			return nil, prefixFieldError(field+".", err)
+		}
+		if tz != "" {
+			if merged.TimezoneName != "" && merged.TimezoneName != tz {
+				return nil, &SpecFieldError{Field: field, Value: c, Err: errCronTimezoneClash}
+			}
+			merged.TimezoneName = tz
+		}
+		merged.Calendar = append(merged.Calendar, calendars...)
+		if interval != nil {
+			merged.Interval = append(merged.Interval, interval)
+		}
+	}
+	return merged, nil
+}
+
+// Parses a single cron string into either calendar specs or an interval spec, plus the
+// time zone name from a CRON_TZ prefix, if any. Errors are *SpecFieldErrors relative to
+// the cron string.
+func parseCronString(c string) (tz string, calendars []*schedpb.CalendarSpec, interval *schedpb.IntervalSpec, err error) {
+	c = strings.TrimSpace(c)
+
+	if strings.HasPrefix(c, "CRON_TZ=") || strings.HasPrefix(c, "TZ=") {
+		prefix := strings.Fields(c)[0]
+		tz = prefix[strings.Index(prefix, "=")+1:]
+		if tz == "" {
+			return "", nil, nil, &SpecFieldError{Field: "timezone", Value: prefix, Err: errCronTimezoneMissing}
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			return "", nil, nil, &SpecFieldError{Field: "timezone", Value: tz, Err: err}
+		}
+		c = strings.TrimSpace(strings.TrimPrefix(c, prefix))
+	}
+
+	if strings.HasPrefix(c, "@every") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(c, "@every")))
+		if err != nil {
+			return "", nil, nil, &SpecFieldError{Field: "interval", Value: c, Err: err}
+		}
+		if d < time.Second || d%time.Second != 0 {
+			return "", nil, nil, &SpecFieldError{Field: "interval", Value: c, Err: errCronEveryInterval}
+		}
+		return tz, nil, &schedpb.IntervalSpec{Interval: timestamp.DurationPtr(d)}, nil
+	}
+
+	if strings.HasPrefix(c, "@") {
+		expanded, ok := cronDescriptors[c]
+		if !ok {
+			return "", nil, nil, &SpecFieldError{Field: "descriptor", Value: c, Err: errCronDescriptor}
+		}
+		c = expanded
+	}
+
+	fields := strings.Fields(c)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return "", nil, nil, &SpecFieldError{Field: "fields", Value: c, Err: errCronFieldCount}
+	}
+	for i, f := range fields {
+		// "?" is a synonym for "*" in robfig/cron
+		if f == "?" {
+			fields[i] = "*"
+		}
+	}
+
+	cal := &schedpb.CalendarSpec{
+		Second:     fields[0],
+		Minute:     fields[1],
+		Hour:       fields[2],
+		DayOfMonth: fields[3],
+		Month:      fields[4],
+		DayOfWeek:  fields[5],
+	}
+	// Validate fields here so errors point at the cron string instead of the
+	// calendar specs it turns into.
+	if _, err := newCompiledCalendar(cal, time.UTC); err != nil {
+		return "", nil, nil, err
+	}
+
+	// Cron matches either day field when both are restricted, while calendar specs
+	// require all fields to match, so split into one calendar per day field.
+	if !isCronStar(cal.DayOfMonth) && !isCronStar(cal.DayOfWeek) {
+		byDayOfMonth := *cal
+		byDayOfMonth.DayOfWeek = "*"
+		byDayOfWeek := *cal
+		byDayOfWeek.DayOfMonth = "*"
+		return tz, []*schedpb.CalendarSpec{&byDayOfMonth, &byDayOfWeek}, nil, nil
+	}
+	return tz, []*schedpb.CalendarSpec{cal}, nil, nil
+}
+
+// Returns true if robfig/cron considers the field unrestricted. Note that "*/2" is not.
+func isCronStar(f string) bool {
+	return f == "*" || f == "*/1"
+}
@@ -0,0 +1,141 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/suite"
+
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type cronSuite struct {
+	suite.Suite
+}
+
+func TestCron(t *testing.T) {
+	suite.Run(t, new(cronSuite))
+}
+
+func (s *cronSuite) TestParseFields() {
+	_, cals, iv, err := parseCronString("30 9 * * mon-fri")
+	s.NoError(err)
+	s.Nil(iv)
+	s.Equal([]*schedpb.CalendarSpec{
+		{Second: "0", Minute: "30", Hour: "9", DayOfMonth: "*", Month: "*", DayOfWeek: "mon-fri"},
+	}, cals)
+
+	_, cals, _, err = parseCronString("15 30 9 ? * *")
+	s.NoError(err)
+	s.Equal([]*schedpb.CalendarSpec{
+		{Second: "15", Minute: "30", Hour: "9", DayOfMonth: "*", Month: "*", DayOfWeek: "*"},
+	}, cals)
+
+	// both day fields restricted: either one matches
+	_, cals, _, err = parseCronString("0 0 1,15 * 1")
+	s.NoError(err)
+	s.Equal([]*schedpb.CalendarSpec{
+		{Second: "0", Minute: "0", Hour: "0", DayOfMonth: "1,15", Month: "*", DayOfWeek: "*"},
+		{Second: "0", Minute: "0", Hour: "0", DayOfMonth: "*", Month: "*", DayOfWeek: "1"},
+	}, cals)
+}
+
+func (s *cronSuite) TestParseDescriptors() {
+	tz, cals, _, err := parseCronString("CRON_TZ=Asia/Tokyo @hourly")
+	s.NoError(err)
+	s.Equal("Asia/Tokyo", tz)
+	s.Equal([]*schedpb.CalendarSpec{
+		{Second: "0", Minute: "0", Hour: "*", DayOfMonth: "*", Month: "*", DayOfWeek: "*"},
+	}, cals)
+
+	_, cals, iv, err := parseCronString("@every 90m")
+	s.NoError(err)
+	s.Nil(cals)
+	s.Equal(&schedpb.IntervalSpec{Interval: timestamp.DurationPtr(90 * time.Minute)}, iv)
+}
+
+func (s *cronSuite) TestParseErrors() {
+	check := func(c, field string) {
+		s.T().Helper()
+		_, err := SpecWithCronStrings(nil, []string{"@daily", c})
+		var fieldErr *SpecFieldError
+		s.Require().ErrorAs(err, &fieldErr)
+		s.Equal(field, fieldErr.Field)
+	}
+	check("* * *", "cron_strings[1].fields")
+	check("0 25 * * *", "cron_strings[1].hour")
+	check("0 0 * jam *", "cron_strings[1].month")
+	check("@fortnightly", "cron_strings[1].descriptor")
+	check("@every 1500ms", "cron_strings[1].interval")
+	check("CRON_TZ=Mars/Olympus_Mons 0 0 * * *", "cron_strings[1].timezone")
+
+	_, err := SpecWithCronStrings(&schedpb.ScheduleSpec{TimezoneName: "UTC"}, []string{"TZ=Asia/Tokyo @daily"})
+	s.Error(err)
+}
+
+func (s *cronSuite) TestSpecWithCronStringsKeepsSpec() {
+	spec := &schedpb.ScheduleSpec{
+		Calendar: []*schedpb.CalendarSpec{{Hour: "12"}},
+	}
+	merged, err := SpecWithCronStrings(spec, []string{"@every 1h"})
+	s.NoError(err)
+	s.Len(merged.Calendar, 1)
+	s.Len(merged.Interval, 1)
+	s.Empty(spec.Interval)
+
+	same, err := SpecWithCronStrings(spec, nil)
+	s.NoError(err)
+	s.Same(spec, same)
+}
+
+func (s *cronSuite) TestMatchesRobfigCron() {
+	for _, c := range []string{
+		"30 9 * * mon-fri",
+		"0 */4 * * *",
+		"15 10 1,15 * 1",
+		"CRON_TZ=America/New_York 45 17 * * sun",
+		"CRON_TZ=Europe/London 0 8 1-7 jan,jul *",
+		"@weekly",
+	} {
+		expected, err := cron.ParseStandard(c)
+		s.Require().NoError(err, c)
+		spec, err := SpecWithCronStrings(nil, []string{c})
+		s.Require().NoError(err, c)
+		cs, err := newCompiledSpec(spec)
+		s.Require().NoError(err, c)
+
+		t := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 50; i++ {
+			exp := expected.Next(t)
+			next := cs.rawNextTime(t)
+			s.True(exp.Equal(next), "%s: expected %v, got %v", c, exp, next)
+			t = next
+		}
+	}
+}
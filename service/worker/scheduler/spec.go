@@ -25,6 +25,8 @@
 package scheduler
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"time"
 
@@ -47,10 +49,32 @@ type (
 	}
 )
 
+var (
+	errNonPositiveInterval = errors.New("must be positive")
+	errInvalidPhase        = errors.New("must be non-negative and less than the interval")
+	errNegativeJitter      = errors.New("must not be negative")
+	errEndBeforeStart      = errors.New("must not be before start_time")
+)
+
+type (
+	// SpecPreviewTime is one upcoming action time of a schedule spec.
+	SpecPreviewTime struct {
+		// NominalTime is the time that matches the spec.
+		NominalTime time.Time
+		// ActualTime is the nominal time with jitter applied, i.e. when the action
+		// would really be taken.
+		ActualTime time.Time
+	}
+)
+
 func newCompiledSpec(spec *schedpb.ScheduleSpec) (*compiledSpec, error) {
+	if err := validateSpec(spec); err != nil {
+		return nil, err
+	}
+
 	tz, err := loadTimezone(spec)
 	if err != nil {
-		return nil, err
+		return nil, &SpecFieldError{Field: "timezone_name", Value: spec.TimezoneName, Err: err}
 	}
 
 	cspec := &compiledSpec{
@@ -62,18 +86,72 @@ func newCompiledSpec(spec *schedpb.ScheduleSpec) (*compiledSpec, error) {
 
 	for i, cal := range spec.Calendar {
 		if cspec.calendar[i], err = newCompiledCalendar(cal, tz); err != nil {
-			return nil, err
+			return nil, prefixFieldError(fmt.Sprintf("calendar[%d].", i), err)
 		}
 	}
 	for i, excal := range spec.ExcludeCalendar {
 		if cspec.excludes[i], err = newCompiledCalendar(excal, tz); err != nil {
-			return nil, err
+			return nil, prefixFieldError(fmt.Sprintf("exclude_calendar[%d].", i), err)
 		}
 	}
 
 	return cspec, nil
 }
 
+// Checks the parts of the spec that are used as-is, i.e. everything but calendars and
+// time zone.
+func validateSpec(spec *schedpb.ScheduleSpec) error {
+	for i, iv := range spec.Interval {
+		interval := timestamp.DurationValue(iv.Interval)
+		if interval <= 0 {
+			return &SpecFieldError{Field: fmt.Sprintf("interval[%d].interval", i), Value: interval.String(), Err: errNonPositiveInterval}
+		}
+		if phase := timestamp.DurationValue(iv.Phase); phase < 0 || phase >= interval {
+			return &SpecFieldError{Field: fmt.Sprintf("interval[%d].phase", i), Value: phase.String(), Err: errInvalidPhase}
+		}
+	}
+	if jitter := timestamp.DurationValue(spec.Jitter); jitter < 0 {
+		return &SpecFieldError{Field: "jitter", Value: jitter.String(), Err: errNegativeJitter}
+	}
+	if spec.StartTime != nil && spec.EndTime != nil && spec.EndTime.Before(*spec.StartTime) {
+		return &SpecFieldError{Field: "end_time", Value: spec.EndTime.Format(time.RFC3339), Err: errEndBeforeStart}
+	}
+	return nil
+}
+
+func prefixFieldError(prefix string, err error) error {
+	var fieldErr *SpecFieldError
+	if errors.As(err, &fieldErr) {
+		return &SpecFieldError{Field: prefix + fieldErr.Field, Value: fieldErr.Value, Err: fieldErr.Err}
+	}
+	return err
+}
+
+// ValidateSpec returns a *SpecFieldError if the given spec can't be compiled.
+func ValidateSpec(spec *schedpb.ScheduleSpec) error {
+	_, err := newCompiledSpec(spec)
+	return err
+}
+
+// PreviewSpec compiles the given spec and returns up to count action times after the
+// given time. An invalid spec returns a *SpecFieldError naming the offending field.
+func PreviewSpec(spec *schedpb.ScheduleSpec, after time.Time, count int) ([]SpecPreviewTime, error) {
+	cspec, err := newCompiledSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	var times []SpecPreviewTime
+	for len(times) < count {
+		nominal, next, has := cspec.getNextTime(after)
+		if !has {
+			break
+		}
+		times = append(times, SpecPreviewTime{NominalTime: nominal, ActualTime: next})
+		after = nominal
+	}
+	return times, nil
+}
+
 func loadTimezone(spec *schedpb.ScheduleSpec) (*time.Location, error) {
 	if spec.TimezoneData != nil {
 		return time.LoadLocationFromTZData(spec.TimezoneName, spec.TimezoneData)
@@ -251,3 +251,58 @@ func (s *specSuite) TestSpecBoundedJitter() {
 		time.Date(2022, 3, 23, 15, 8, 3, 724000000, time.UTC),
 	)
 }
+
+func (s *specSuite) TestSpecFieldErrors() {
+	check := func(spec *schedpb.ScheduleSpec, field string) {
+		s.T().Helper()
+		_, err := newCompiledSpec(spec)
+		var fieldErr *SpecFieldError
+		s.Require().ErrorAs(err, &fieldErr)
+		s.Equal(field, fieldErr.Field)
+	}
+	check(&schedpb.ScheduleSpec{
+		Calendar: []*schedpb.CalendarSpec{
+			{Hour: "9"},
+			{Hour: "9", DayOfWeek: "mon-fry"},
+		},
+	}, "calendar[1].day_of_week")
+	check(&schedpb.ScheduleSpec{
+		ExcludeCalendar: []*schedpb.CalendarSpec{
+			{Month: "dec", DayOfMonth: "25-32"},
+		},
+	}, "exclude_calendar[0].day_of_month")
+	check(&schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(time.Hour), Phase: timestamp.DurationPtr(2 * time.Hour)},
+		},
+	}, "interval[0].phase")
+	check(&schedpb.ScheduleSpec{TimezoneName: "Mars/Olympus_Mons"}, "timezone_name")
+	check(&schedpb.ScheduleSpec{
+		StartTime: timestamp.TimePtr(time.Date(2022, 3, 23, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamp.TimePtr(time.Date(2022, 3, 22, 0, 0, 0, 0, time.UTC)),
+	}, "end_time")
+}
+
+func (s *specSuite) TestPreviewSpec() {
+	times, err := PreviewSpec(
+		&schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{
+				{Interval: timestamp.DurationPtr(90 * time.Minute)},
+			},
+			EndTime: timestamp.TimePtr(time.Date(2022, 3, 23, 14, 0, 0, 0, time.UTC)),
+		},
+		time.Date(2022, 3, 23, 11, 00, 0, 0, time.UTC),
+		10,
+	)
+	s.NoError(err)
+	s.Equal([]SpecPreviewTime{
+		{
+			NominalTime: time.Date(2022, 3, 23, 12, 00, 0, 0, time.UTC),
+			ActualTime:  time.Date(2022, 3, 23, 12, 00, 0, 162000000, time.UTC),
+		},
+		{
+			NominalTime: time.Date(2022, 3, 23, 13, 30, 0, 0, time.UTC),
+			ActualTime:  time.Date(2022, 3, 23, 13, 30, 0, 587000000, time.UTC),
+		},
+	}, times)
+}
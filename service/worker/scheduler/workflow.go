@@ -0,0 +1,765 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	sdklog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// WorkflowType is the workflow type of scheduler workflows.
+	WorkflowType = "temporal-sys-scheduler-workflow"
+	// WorkflowIDPrefix is prepended to the schedule id to get the scheduler workflow id.
+	WorkflowIDPrefix = "temporal-sys-scheduler:"
+
+	// SignalNameUpdate is the signal that replaces the whole schedule.
+	SignalNameUpdate = "update"
+	// SignalNamePatch is the signal that applies a SchedulePatch.
+	SignalNamePatch = "patch"
+	// QueryNameDescribe is the query that returns a DescribeResponse.
+	QueryNameDescribe = "describe"
+
+	// Maximum number of recent actions kept in ScheduleInfo.
+	recentActionCount = 10
+	// Number of future action times returned by the describe query.
+	futureActionCount = 10
+	// Number of loop iterations before the workflow continues as new, to bound history size.
+	iterationsBeforeContinueAsNew = 500
+
+	defaultCatchupWindow = 60 * time.Second
+	minCatchupWindow     = 10 * time.Second
+
+	noSleep time.Duration = -1
+)
+
+const (
+	// OverlapPolicyUnspecified means use the schedule's overlap policy (for manual
+	// actions), or OverlapPolicySkip (for the schedule itself).
+	OverlapPolicyUnspecified OverlapPolicy = iota
+	// OverlapPolicySkip doesn't start a new workflow if one is already running.
+	OverlapPolicySkip
+	// OverlapPolicyBufferOne starts the workflow again as soon as the running one
+	// completes, but buffers at most one start.
+	OverlapPolicyBufferOne
+	// OverlapPolicyBufferAll buffers every start and runs them sequentially.
+	OverlapPolicyBufferAll
+	// OverlapPolicyCancelOther cancels the running workflow and starts the new one
+	// once the running one has closed.
+	OverlapPolicyCancelOther
+	// OverlapPolicyTerminateOther terminates the running workflow and starts the new one.
+	OverlapPolicyTerminateOther
+	// OverlapPolicyAllowAll starts every workflow immediately, regardless of overlap.
+	OverlapPolicyAllowAll
+)
+
+type (
+	// OverlapPolicy controls what happens when an action would start while a workflow
+	// started by a previous action is still running.
+	OverlapPolicy int32
+
+	// Schedule is the user-controlled part of a schedule.
+	Schedule struct {
+		Spec *schedpb.ScheduleSpec
+		// CronStrings are added to Spec when the schedule is compiled. See
+		// SpecWithCronStrings for the accepted syntax.
+		CronStrings []string
+		Action      *StartWorkflowAction
+		Policies    SchedulePolicies
+		State       ScheduleState
+	}
+
+	// StartWorkflowAction describes the workflow started by each scheduled action. The
+	// actual workflow id is WorkflowId with the nominal time appended.
+	StartWorkflowAction struct {
+		WorkflowId               string
+		WorkflowType             string
+		TaskQueue                string
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		WorkflowTaskTimeout      time.Duration
+		RetryPolicy              *commonpb.RetryPolicy
+		Memo                     *commonpb.Memo
+		SearchAttributes         *commonpb.SearchAttributes
+		Header                   *commonpb.Header
+	}
+
+	SchedulePolicies struct {
+		// Policy for overlapping scheduled actions. Default is OverlapPolicySkip.
+		OverlapPolicy OverlapPolicy
+		// If the scheduler was down or paused and an action is more than this late, it
+		// is skipped. Default is one minute.
+		CatchupWindow time.Duration
+	}
+
+	ScheduleState struct {
+		// Informative notes, set with pause and unpause.
+		Notes  string
+		Paused bool
+		// If LimitedActions is true, only RemainingActions more scheduled actions will
+		// be taken. Manual actions don't count towards the limit.
+		LimitedActions   bool
+		RemainingActions int64
+	}
+
+	// SchedulePatch is a set of one-off changes to a schedule, sent with SignalNamePatch.
+	SchedulePatch struct {
+		TriggerImmediately *TriggerImmediatelyRequest
+		BackfillRequest    []*BackfillRequest
+		// If set, pause the schedule with this string as notes.
+		Pause string
+		// If set, unpause the schedule with this string as notes.
+		Unpause string
+	}
+
+	TriggerImmediatelyRequest struct {
+		OverlapPolicy OverlapPolicy
+	}
+
+	// BackfillRequest takes all actions that the schedule would have taken in the
+	// range [StartTime, EndTime], ignoring the catchup window and pause.
+	BackfillRequest struct {
+		StartTime     time.Time
+		EndTime       time.Time
+		OverlapPolicy OverlapPolicy
+	}
+
+	ScheduleActionResult struct {
+		// Scheduled time, including jitter.
+		ScheduleTime time.Time
+		// Time that the action was actually taken.
+		ActualTime          time.Time
+		StartWorkflowResult *commonpb.WorkflowExecution
+	}
+
+	// ScheduleInfo holds statistics and recent history, maintained by the scheduler.
+	ScheduleInfo struct {
+		ActionCount         int64
+		MissedCatchupWindow int64
+		OverlapSkipped      int64
+		RunningWorkflows    []*commonpb.WorkflowExecution
+		RecentActions       []*ScheduleActionResult
+		// Only filled in by the describe query.
+		FutureActionTimes    []time.Time
+		CreateTime           time.Time
+		UpdateTime           time.Time
+		InvalidScheduleError string
+	}
+
+	BufferedStart struct {
+		// Nominal (pre-jitter) time of the action.
+		NominalTime time.Time
+		// Time the action should be taken, including jitter.
+		ActualTime    time.Time
+		OverlapPolicy OverlapPolicy
+		// Manual actions are triggers and backfills.
+		Manual bool
+	}
+
+	// InternalState is state that is not visible to users.
+	InternalState struct {
+		Namespace  string
+		ScheduleID string
+		// All scheduled times up to and including this one have been processed.
+		LastProcessedTime time.Time
+		BufferedStarts    []*BufferedStart
+		// Incremented on every change, used to detect conflicting updates.
+		ConflictToken int64
+	}
+
+	// StartScheduleArgs is the input to SchedulerWorkflow, and is also carried across
+	// continue-as-new.
+	StartScheduleArgs struct {
+		Schedule     *Schedule
+		Info         ScheduleInfo
+		State        InternalState
+		InitialPatch *SchedulePatch
+	}
+
+	// FullUpdateRequest replaces the schedule, sent with SignalNameUpdate. If
+	// ConflictToken is non-zero it must match the current one or the update is ignored.
+	FullUpdateRequest struct {
+		Schedule      *Schedule
+		ConflictToken int64
+	}
+
+	DescribeResponse struct {
+		Schedule      *Schedule
+		Info          ScheduleInfo
+		ConflictToken int64
+	}
+
+	processBufferResult struct {
+		// Starts that should happen now regardless of what else is running.
+		overlappingStarts []*BufferedStart
+		// A start that should happen now because nothing is running.
+		nonOverlappingStart *BufferedStart
+		// The new buffer, to be processed when the running workflow closes.
+		newBuffer []*BufferedStart
+		// Whether running workflows should be canceled or terminated.
+		needCancel    bool
+		needTerminate bool
+		// Number of starts dropped because of the overlap policy.
+		overlapSkipped int64
+	}
+
+	scheduler struct {
+		StartScheduleArgs
+
+		ctx    workflow.Context
+		a      *activities
+		logger sdklog.Logger
+
+		cspec *compiledSpec
+		// Pending WatchWorkflow activities, by workflow id. Not carried across
+		// continue-as-new: watchers are restarted for RunningWorkflows.
+		watchers map[string]workflow.Future
+	}
+)
+
+var (
+	defaultActivityOptions = workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			MaximumInterval:    1 * time.Minute,
+			MaximumAttempts:    10,
+			BackoffCoefficient: 2.0,
+		},
+	}
+
+	watchActivityOptions = workflow.ActivityOptions{
+		// the workflow we're watching may run for a long time: rely on heartbeats instead
+		StartToCloseTimeout: 365 * 24 * time.Hour,
+		HeartbeatTimeout:    1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			MaximumInterval:    1 * time.Minute,
+			BackoffCoefficient: 2.0,
+		},
+	}
+)
+
+// SchedulerWorkflow takes actions according to a schedule until it is terminated.
+func SchedulerWorkflow(ctx workflow.Context, args *StartScheduleArgs) error {
+	scheduler := &scheduler{
+		StartScheduleArgs: *args,
+		ctx:               ctx,
+		logger:            workflow.GetLogger(ctx),
+		watchers:          make(map[string]workflow.Future),
+	}
+	return scheduler.run()
+}
+
+func (s *scheduler) run() error {
+	s.ensureFields()
+	s.compileSpec()
+
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.handleDescribeQuery); err != nil {
+		return err
+	}
+
+	if s.State.LastProcessedTime.IsZero() {
+		// Newly created schedule: don't take actions for times before now.
+		s.State.LastProcessedTime = s.now()
+		s.Info.CreateTime = s.State.LastProcessedTime
+		s.logger.Info("Starting schedule", "schedule-id", s.State.ScheduleID)
+	}
+
+	for _, ex := range s.Info.RunningWorkflows {
+		s.startWatcher(ex)
+	}
+
+	if s.InitialPatch != nil {
+		s.processPatch(s.InitialPatch)
+		s.InitialPatch = nil
+	}
+
+	for iters := iterationsBeforeContinueAsNew; iters > 0; iters-- {
+		t1 := s.State.LastProcessedTime
+		t2 := s.now()
+		if t2.Before(t1) {
+			// Time went backwards. Just wait until we get past the last processed time.
+			s.logger.Warn("Time went backwards", "from", t1, "to", t2)
+			t2 = t1
+		}
+		nextSleep, processedUpTo := s.processTimeRange(t1, t2, OverlapPolicyUnspecified, false)
+		s.State.LastProcessedTime = processedUpTo
+		s.processBuffer()
+		s.sleep(nextSleep)
+	}
+
+	// Handle any signals that arrived during the last iteration so they aren't lost.
+	s.drainSignals()
+
+	s.logger.Info("Schedule doing continue-as-new", "schedule-id", s.State.ScheduleID)
+	return workflow.NewContinueAsNewError(s.ctx, WorkflowType, &s.StartScheduleArgs)
+}
+
+func (s *scheduler) ensureFields() {
+	if s.Schedule == nil {
+		s.Schedule = &Schedule{}
+	}
+	if s.Schedule.Spec == nil {
+		s.Schedule.Spec = &schedpb.ScheduleSpec{}
+	}
+}
+
+func (s *scheduler) compileSpec() {
+	spec, err := SpecWithCronStrings(s.Schedule.Spec, s.Schedule.CronStrings)
+	var cspec *compiledSpec
+	if err == nil {
+		cspec, err = newCompiledSpec(spec)
+	}
+	if err != nil {
+		s.logger.Error("Invalid schedule", "error", err)
+		s.Info.InvalidScheduleError = err.Error()
+		s.cspec = nil
+		return
+	}
+	s.Info.InvalidScheduleError = ""
+	s.cspec = cspec
+}
+
+func (s *scheduler) now() time.Time {
+	return workflow.Now(s.ctx)
+}
+
+// processTimeRange buffers starts for all scheduled times in (t1, t2]. It returns how
+// long to sleep until the next scheduled time (or noSleep if there isn't one), and the
+// time up to which the range has been processed.
+func (s *scheduler) processTimeRange(
+	t1, t2 time.Time,
+	overlapPolicy OverlapPolicy,
+	manual bool,
+) (time.Duration, time.Time) {
+	if s.cspec == nil {
+		return noSleep, t2
+	}
+
+	catchupWindow := s.getCatchupWindow()
+
+	for {
+		nominal, next, has := s.cspec.getNextTime(t1)
+		if !has {
+			return noSleep, t2
+		}
+		if next.After(t2) {
+			// t1 is returned rather than t2 so that we come back to this nominal time
+			// even if jitter pushed it past t2.
+			return next.Sub(t2), t1
+		}
+		t1 = nominal
+
+		if !manual {
+			if t2.Sub(next) > catchupWindow {
+				s.logger.Warn("Schedule missed catchup window", "now", t2, "time", next)
+				s.Info.MissedCatchupWindow++
+				continue
+			}
+			if !s.canTakeScheduledAction(false, false) {
+				continue
+			}
+		}
+		s.addStart(nominal, next, overlapPolicy, manual)
+	}
+}
+
+func (s *scheduler) getCatchupWindow() time.Duration {
+	cw := s.Schedule.Policies.CatchupWindow
+	if cw == 0 {
+		return defaultCatchupWindow
+	} else if cw < minCatchupWindow {
+		return minCatchupWindow
+	}
+	return cw
+}
+
+func (s *scheduler) resolveOverlapPolicy(overlapPolicy OverlapPolicy) OverlapPolicy {
+	if overlapPolicy == OverlapPolicyUnspecified {
+		overlapPolicy = s.Schedule.Policies.OverlapPolicy
+	}
+	if overlapPolicy == OverlapPolicyUnspecified {
+		overlapPolicy = OverlapPolicySkip
+	}
+	return overlapPolicy
+}
+
+// canTakeScheduledAction returns whether the schedule may take an action now, and if
+// decrement is true, also consumes one of the remaining actions.
+func (s *scheduler) canTakeScheduledAction(manual, decrement bool) bool {
+	// Manual actions are always allowed.
+	if manual {
+		return true
+	}
+	if s.Schedule.State.Paused {
+		return false
+	}
+	if !s.Schedule.State.LimitedActions {
+		return true
+	}
+	if s.Schedule.State.RemainingActions > 0 {
+		if decrement {
+			s.Schedule.State.RemainingActions--
+			s.incConflictToken()
+		}
+		return true
+	}
+	return false
+}
+
+func (s *scheduler) sleep(nextSleep time.Duration) {
+	sel := workflow.NewSelector(s.ctx)
+
+	upCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	sel.AddReceive(upCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var req FullUpdateRequest
+		ch.Receive(s.ctx, &req)
+		s.processUpdate(&req)
+	})
+
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	sel.AddReceive(patchCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var patch SchedulePatch
+		ch.Receive(s.ctx, &patch)
+		s.processPatch(&patch)
+	})
+
+	var cancelTimer workflow.CancelFunc
+	if nextSleep != noSleep {
+		var timerCtx workflow.Context
+		timerCtx, cancelTimer = workflow.WithCancel(s.ctx)
+		sel.AddFuture(workflow.NewTimer(timerCtx, nextSleep), func(workflow.Future) {})
+	}
+
+	// iterate over the slice, not the map, so that the order is deterministic
+	for _, ex := range s.Info.RunningWorkflows {
+		if f, ok := s.watchers[ex.WorkflowId]; ok {
+			sel.AddFuture(f, s.watcherReturned(ex.WorkflowId))
+		}
+	}
+
+	sel.Select(s.ctx)
+	for sel.HasPending() {
+		sel.Select(s.ctx)
+	}
+
+	if cancelTimer != nil {
+		cancelTimer()
+	}
+}
+
+func (s *scheduler) drainSignals() {
+	upCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	for {
+		var req FullUpdateRequest
+		if !upCh.ReceiveAsync(&req) {
+			break
+		}
+		s.processUpdate(&req)
+	}
+
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	for {
+		var patch SchedulePatch
+		if !patchCh.ReceiveAsync(&patch) {
+			break
+		}
+		s.processPatch(&patch)
+	}
+}
+
+func (s *scheduler) processUpdate(req *FullUpdateRequest) {
+	if req.ConflictToken != 0 && req.ConflictToken != s.State.ConflictToken {
+		s.logger.Warn("Update conflicted with concurrent change", "token", req.ConflictToken, "current", s.State.ConflictToken)
+		return
+	}
+
+	s.logger.Info("Schedule update", "schedule-id", s.State.ScheduleID)
+
+	s.Schedule = req.Schedule
+	s.ensureFields()
+	s.compileSpec()
+
+	s.Info.UpdateTime = s.now()
+	// Times before the update were processed with the old spec.
+	s.State.LastProcessedTime = s.Info.UpdateTime
+	s.incConflictToken()
+}
+
+func (s *scheduler) processPatch(patch *SchedulePatch) {
+	s.logger.Info("Schedule patch", "schedule-id", s.State.ScheduleID)
+
+	if trigger := patch.TriggerImmediately; trigger != nil {
+		now := s.now()
+		s.addStart(now, now, trigger.OverlapPolicy, true)
+	}
+
+	for _, bfr := range patch.BackfillRequest {
+		// Subtract a millisecond so that the start time is inclusive.
+		s.processTimeRange(bfr.StartTime.Add(-time.Millisecond), bfr.EndTime, bfr.OverlapPolicy, true)
+	}
+
+	if patch.Pause != "" {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = patch.Pause
+	}
+	if patch.Unpause != "" {
+		s.Schedule.State.Paused = false
+		s.Schedule.State.Notes = patch.Unpause
+	}
+
+	s.incConflictToken()
+}
+
+func (s *scheduler) handleDescribeQuery() (*DescribeResponse, error) {
+	info := s.Info
+	info.FutureActionTimes = s.getFutureActionTimes(futureActionCount)
+	return &DescribeResponse{
+		Schedule:      s.Schedule,
+		Info:          info,
+		ConflictToken: s.State.ConflictToken,
+	}, nil
+}
+
+func (s *scheduler) getFutureActionTimes(n int) []time.Time {
+	if s.cspec == nil {
+		return nil
+	}
+	out := make([]time.Time, 0, n)
+	t := s.State.LastProcessedTime
+	for len(out) < n {
+		var next time.Time
+		var has bool
+		t, next, has = s.cspec.getNextTime(t)
+		if !has {
+			break
+		}
+		out = append(out, next)
+	}
+	return out
+}
+
+func (s *scheduler) incConflictToken() {
+	s.State.ConflictToken++
+}
+
+func (s *scheduler) addStart(nominalTime, actualTime time.Time, overlapPolicy OverlapPolicy, manual bool) {
+	s.State.BufferedStarts = append(s.State.BufferedStarts, &BufferedStart{
+		NominalTime:   nominalTime,
+		ActualTime:    actualTime,
+		OverlapPolicy: overlapPolicy,
+		Manual:        manual,
+	})
+}
+
+// processBuffer takes actions for buffered starts, according to overlap policies and
+// whether a workflow started by this schedule is still running.
+func (s *scheduler) processBuffer() {
+	// Nothing can be started without an action, drop anything buffered.
+	if s.Schedule.Action == nil {
+		s.State.BufferedStarts = nil
+		return
+	}
+
+	isRunning := len(s.Info.RunningWorkflows) > 0
+	action := processBuffer(s.State.BufferedStarts, isRunning, s.resolveOverlapPolicy)
+
+	s.State.BufferedStarts = action.newBuffer
+	s.Info.OverlapSkipped += action.overlapSkipped
+
+	for _, start := range action.overlappingStarts {
+		s.startWorkflow(start)
+	}
+	if action.nonOverlappingStart != nil {
+		s.startWorkflow(action.nonOverlappingStart)
+	}
+	if action.needCancel {
+		s.stopRunningWorkflows(s.a.CancelWorkflow)
+	} else if action.needTerminate {
+		s.stopRunningWorkflows(s.a.TerminateWorkflow)
+	}
+}
+
+// processBuffer decides what to do with the buffered starts, given whether a workflow
+// is running. It doesn't modify any state.
+func processBuffer(
+	buffer []*BufferedStart,
+	isRunning bool,
+	resolve func(OverlapPolicy) OverlapPolicy,
+) processBufferResult {
+	var result processBufferResult
+
+	for _, start := range buffer {
+		overlapPolicy := resolve(start.OverlapPolicy)
+
+		if !isRunning {
+			// Nothing is running, this one can start. Everything after it overlaps.
+			result.nonOverlappingStart = start
+			isRunning = true
+			continue
+		}
+
+		switch overlapPolicy {
+		case OverlapPolicySkip:
+			result.overlapSkipped++
+		case OverlapPolicyBufferOne:
+			if len(result.newBuffer) > 0 {
+				result.overlapSkipped++
+			} else {
+				result.newBuffer = append(result.newBuffer, start)
+			}
+		case OverlapPolicyBufferAll:
+			result.newBuffer = append(result.newBuffer, start)
+		case OverlapPolicyCancelOther:
+			// Only the latest start is kept.
+			result.needCancel = true
+			result.newBuffer = []*BufferedStart{start}
+		case OverlapPolicyTerminateOther:
+			// Only the latest start is kept.
+			result.needTerminate = true
+			result.newBuffer = []*BufferedStart{start}
+		case OverlapPolicyAllowAll:
+			result.overlappingStarts = append(result.overlappingStarts, start)
+		default:
+			result.overlapSkipped++
+		}
+	}
+
+	return result
+}
+
+func (s *scheduler) startWorkflow(start *BufferedStart) {
+	if !s.canTakeScheduledAction(start.Manual, true) {
+		return
+	}
+
+	action := s.Schedule.Action
+	// Include the nominal time in the workflow id so that repeated attempts to start
+	// the same action are deduplicated.
+	workflowID := action.WorkflowId + "-" + start.NominalTime.UTC().Format(time.RFC3339)
+	req := &StartWorkflowRequest{
+		Namespace:  s.State.Namespace,
+		RequestID:  s.newUUIDString(),
+		WorkflowID: workflowID,
+		Identity:   s.identity(),
+		Action:     action,
+	}
+
+	ctx := workflow.WithActivityOptions(s.ctx, defaultActivityOptions)
+	var res StartWorkflowResponse
+	if err := workflow.ExecuteActivity(ctx, s.a.StartWorkflow, req).Get(s.ctx, &res); err != nil {
+		s.logger.Error("Failed to start workflow", "workflow-id", workflowID, "error", err)
+		return
+	}
+
+	ex := &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      res.RunID,
+	}
+	s.Info.ActionCount++
+	s.recordAction(&ScheduleActionResult{
+		ScheduleTime:        start.ActualTime,
+		ActualTime:          res.RealStartTime,
+		StartWorkflowResult: ex,
+	})
+	s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, ex)
+	s.startWatcher(ex)
+}
+
+func (s *scheduler) recordAction(result *ScheduleActionResult) {
+	s.Info.RecentActions = append(s.Info.RecentActions, result)
+	if extra := len(s.Info.RecentActions) - recentActionCount; extra > 0 {
+		s.Info.RecentActions = s.Info.RecentActions[extra:]
+	}
+}
+
+func (s *scheduler) startWatcher(ex *commonpb.WorkflowExecution) {
+	ctx := workflow.WithActivityOptions(s.ctx, watchActivityOptions)
+	req := &WatchWorkflowRequest{
+		Namespace: s.State.Namespace,
+		Execution: ex,
+	}
+	s.watchers[ex.WorkflowId] = workflow.ExecuteActivity(ctx, s.a.WatchWorkflow, req)
+}
+
+func (s *scheduler) watcherReturned(workflowID string) func(workflow.Future) {
+	return func(f workflow.Future) {
+		delete(s.watchers, workflowID)
+
+		var res WatchWorkflowResponse
+		if err := f.Get(s.ctx, &res); err != nil {
+			// The watcher retries forever, so this shouldn't happen. If it does, treat
+			// the workflow as closed so that the schedule isn't blocked by it.
+			s.logger.Error("Error watching workflow", "workflow-id", workflowID, "error", err)
+		} else {
+			s.logger.Info("Started workflow closed", "workflow-id", workflowID, "status", res.Status.String())
+		}
+
+		for i, ex := range s.Info.RunningWorkflows {
+			if ex.WorkflowId == workflowID {
+				s.Info.RunningWorkflows = append(s.Info.RunningWorkflows[:i], s.Info.RunningWorkflows[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// stopRunningWorkflows cancels or terminates (depending on activityFn) all workflows
+// started by this schedule that are still running. The buffered start will be taken
+// when their watchers return.
+func (s *scheduler) stopRunningWorkflows(activityFn interface{}) {
+	ctx := workflow.WithActivityOptions(s.ctx, defaultActivityOptions)
+	for _, ex := range s.Info.RunningWorkflows {
+		req := &StopWorkflowRequest{
+			Namespace: s.State.Namespace,
+			Execution: ex,
+			Identity:  s.identity(),
+			Reason:    "overlap policy",
+		}
+		if err := workflow.ExecuteActivity(ctx, activityFn, req).Get(s.ctx, nil); err != nil {
+			s.logger.Error("Failed to stop workflow", "workflow-id", ex.WorkflowId, "error", err)
+		}
+	}
+}
+
+func (s *scheduler) identity() string {
+	return "temporal-scheduler-" + s.State.ScheduleID
+}
+
+func (s *scheduler) newUUIDString() string {
+	var str string
+	_ = workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		return uuid.NewString()
+	}).Get(&str)
+	return str
+}
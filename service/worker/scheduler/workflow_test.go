@@ -0,0 +1,353 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type workflowSuite struct {
+	suite.Suite
+}
+
+func TestWorkflow(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) newScheduler(spec *schedpb.ScheduleSpec, policies SchedulePolicies) *scheduler {
+	sched := &scheduler{
+		StartScheduleArgs: StartScheduleArgs{
+			Schedule: &Schedule{
+				Spec:     spec,
+				Action:   &StartWorkflowAction{WorkflowId: "wf"},
+				Policies: policies,
+			},
+		},
+		logger: log.NewSdkLogger(log.NewNoopLogger()),
+	}
+	sched.compileSpec()
+	s.Require().NotNil(sched.cspec)
+	return sched
+}
+
+func (s *workflowSuite) resolveSkip(p OverlapPolicy) OverlapPolicy {
+	if p == OverlapPolicyUnspecified {
+		return OverlapPolicySkip
+	}
+	return p
+}
+
+func (s *workflowSuite) bufferOf(policies ...OverlapPolicy) []*BufferedStart {
+	base := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	buffer := make([]*BufferedStart, len(policies))
+	for i, p := range policies {
+		t := base.Add(time.Duration(i) * time.Minute)
+		buffer[i] = &BufferedStart{NominalTime: t, ActualTime: t, OverlapPolicy: p}
+	}
+	return buffer
+}
+
+func (s *workflowSuite) TestProcessBufferNothingRunning() {
+	buffer := s.bufferOf(OverlapPolicySkip, OverlapPolicySkip)
+	res := processBuffer(buffer, false, s.resolveSkip)
+	s.Equal(buffer[0], res.nonOverlappingStart)
+	s.Empty(res.newBuffer)
+	s.Equal(int64(1), res.overlapSkipped)
+}
+
+func (s *workflowSuite) TestProcessBufferSkip() {
+	buffer := s.bufferOf(OverlapPolicyUnspecified, OverlapPolicySkip)
+	res := processBuffer(buffer, true, s.resolveSkip)
+	s.Nil(res.nonOverlappingStart)
+	s.Empty(res.newBuffer)
+	s.Equal(int64(2), res.overlapSkipped)
+}
+
+func (s *workflowSuite) TestProcessBufferBufferOne() {
+	buffer := s.bufferOf(OverlapPolicyBufferOne, OverlapPolicyBufferOne, OverlapPolicyBufferOne)
+	res := processBuffer(buffer, true, s.resolveSkip)
+	s.Nil(res.nonOverlappingStart)
+	s.Equal(buffer[:1], res.newBuffer)
+	s.Equal(int64(2), res.overlapSkipped)
+}
+
+func (s *workflowSuite) TestProcessBufferBufferAll() {
+	buffer := s.bufferOf(OverlapPolicyBufferAll, OverlapPolicyBufferAll, OverlapPolicyBufferAll)
+	res := processBuffer(buffer, true, s.resolveSkip)
+	s.Nil(res.nonOverlappingStart)
+	s.Equal(buffer, res.newBuffer)
+	s.Zero(res.overlapSkipped)
+
+	// once the running workflow is done, the first one starts and the rest stay buffered
+	res = processBuffer(res.newBuffer, false, s.resolveSkip)
+	s.Equal(buffer[0], res.nonOverlappingStart)
+	s.Equal(buffer[1:], res.newBuffer)
+}
+
+func (s *workflowSuite) TestProcessBufferCancelOther() {
+	buffer := s.bufferOf(OverlapPolicyCancelOther, OverlapPolicyCancelOther)
+	res := processBuffer(buffer, true, s.resolveSkip)
+	s.Nil(res.nonOverlappingStart)
+	s.True(res.needCancel)
+	s.False(res.needTerminate)
+	s.Equal(buffer[1:], res.newBuffer)
+}
+
+func (s *workflowSuite) TestProcessBufferTerminateOther() {
+	buffer := s.bufferOf(OverlapPolicyTerminateOther)
+	res := processBuffer(buffer, true, s.resolveSkip)
+	s.Nil(res.nonOverlappingStart)
+	s.True(res.needTerminate)
+	s.Equal(buffer, res.newBuffer)
+}
+
+func (s *workflowSuite) TestProcessBufferAllowAll() {
+	buffer := s.bufferOf(OverlapPolicyAllowAll, OverlapPolicyAllowAll, OverlapPolicyAllowAll)
+	res := processBuffer(buffer, false, s.resolveSkip)
+	s.Equal(buffer[0], res.nonOverlappingStart)
+	s.Equal(buffer[1:], res.overlappingStarts)
+	s.Empty(res.newBuffer)
+	s.Zero(res.overlapSkipped)
+}
+
+func (s *workflowSuite) TestProcessTimeRange() {
+	sched := s.newScheduler(&schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(5 * time.Minute)},
+		},
+		Jitter: timestamp.DurationPtr(time.Millisecond),
+	}, SchedulePolicies{})
+
+	t1 := time.Date(2022, 6, 1, 0, 1, 0, 0, time.UTC)
+	t2 := time.Date(2022, 6, 1, 0, 10, 30, 0, time.UTC)
+	sleep, processed := sched.processTimeRange(t1, t2, OverlapPolicyUnspecified, false)
+
+	// 00:05 is more than a minute old
+	s.Equal(int64(1), sched.Info.MissedCatchupWindow)
+	s.Require().Len(sched.State.BufferedStarts, 1)
+	s.Equal(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC), sched.State.BufferedStarts[0].NominalTime)
+	s.Equal(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC), processed)
+	s.Equal(4*time.Minute+30*time.Second, sleep)
+}
+
+func (s *workflowSuite) TestProcessTimeRangePaused() {
+	sched := s.newScheduler(&schedpb.ScheduleSpec{
+		Interval: []*schedpb.IntervalSpec{
+			{Interval: timestamp.DurationPtr(5 * time.Minute)},
+		},
+		Jitter: timestamp.DurationPtr(time.Millisecond),
+	}, SchedulePolicies{CatchupWindow: time.Hour})
+	sched.Schedule.State.Paused = true
+
+	t1 := time.Date(2022, 6, 1, 0, 1, 0, 0, time.UTC)
+	t2 := time.Date(2022, 6, 1, 0, 10, 30, 0, time.UTC)
+	_, processed := sched.processTimeRange(t1, t2, OverlapPolicyUnspecified, false)
+	s.Empty(sched.State.BufferedStarts)
+	s.Equal(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC), processed)
+
+	// backfills ignore pause and the catchup window
+	_, _ = sched.processTimeRange(t1.Add(-time.Hour), t2, OverlapPolicyBufferAll, true)
+	s.Len(sched.State.BufferedStarts, 14)
+	s.Zero(sched.Info.MissedCatchupWindow)
+}
+
+func (s *workflowSuite) TestCanTakeScheduledActionLimited() {
+	sched := s.newScheduler(&schedpb.ScheduleSpec{}, SchedulePolicies{})
+	sched.Schedule.State.LimitedActions = true
+	sched.Schedule.State.RemainingActions = 1
+
+	s.True(sched.canTakeScheduledAction(false, false))
+	s.True(sched.canTakeScheduledAction(false, true))
+	s.False(sched.canTakeScheduledAction(false, true))
+	s.True(sched.canTakeScheduledAction(true, true))
+	s.Equal(int64(0), sched.Schedule.State.RemainingActions)
+}
+
+type workflowEnvSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+var envStartTime = time.Date(2022, 6, 1, 0, 30, 0, 0, time.UTC)
+
+func TestWorkflowEnv(t *testing.T) {
+	suite.Run(t, new(workflowEnvSuite))
+}
+
+func (s *workflowEnvSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowType})
+	s.env.RegisterActivity(new(activities))
+	s.env.SetStartTime(envStartTime)
+
+	s.env.OnActivity(new(activities).StartWorkflow, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *StartWorkflowRequest) (*StartWorkflowResponse, error) {
+			return &StartWorkflowResponse{RunID: req.WorkflowID + "-run"}, nil
+		})
+	// started workflows run for ten minutes
+	s.env.OnActivity(new(activities).WatchWorkflow, mock.Anything, mock.Anything).
+		After(10*time.Minute).
+		Return(&WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+}
+
+func (s *workflowEnvSuite) hourly(workflowID string) *Schedule {
+	return &Schedule{
+		Spec: &schedpb.ScheduleSpec{
+			Interval: []*schedpb.IntervalSpec{
+				{Interval: timestamp.DurationPtr(time.Hour)},
+			},
+		},
+		Action: &StartWorkflowAction{WorkflowId: workflowID, WorkflowType: "wt", TaskQueue: "tq"},
+	}
+}
+
+func (s *workflowEnvSuite) describe() *DescribeResponse {
+	value, err := s.env.QueryWorkflow(QueryNameDescribe)
+	s.Require().NoError(err)
+	var desc DescribeResponse
+	s.Require().NoError(value.Get(&desc))
+	return &desc
+}
+
+func (s *workflowEnvSuite) requireContinuedAsNew() *StartScheduleArgs {
+	s.True(s.env.IsWorkflowCompleted())
+	var canErr *workflow.ContinueAsNewError
+	s.Require().True(errors.As(s.env.GetWorkflowError(), &canErr))
+	s.Equal(WorkflowType, canErr.WorkflowType.Name)
+	var args StartScheduleArgs
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(canErr.Input, &args))
+	return &args
+}
+
+func (s *workflowEnvSuite) actionWorkflowIDs(desc *DescribeResponse) []string {
+	var ids []string
+	for _, action := range desc.Info.RecentActions {
+		ids = append(ids, action.StartWorkflowResult.WorkflowId)
+	}
+	return ids
+}
+
+func (s *workflowEnvSuite) TestScheduledActions() {
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(3), desc.Info.ActionCount)
+		s.Equal([]string{
+			"wf-2022-06-01T01:00:00Z",
+			"wf-2022-06-01T02:00:00Z",
+			"wf-2022-06-01T03:00:00Z",
+		}, s.actionWorkflowIDs(desc))
+		s.Equal("wf-2022-06-01T03:00:00Z-run", desc.Info.RecentActions[2].StartWorkflowResult.RunId)
+		// the last started workflow closed at 03:10
+		s.Empty(desc.Info.RunningWorkflows)
+		s.Equal(time.Date(2022, 6, 1, 4, 0, 0, 0, time.UTC), desc.Info.FutureActionTimes[0])
+	}, 3*time.Hour)
+
+	s.env.ExecuteWorkflow(WorkflowType, &StartScheduleArgs{
+		Schedule: s.hourly("wf"),
+		State:    InternalState{Namespace: "ns", ScheduleID: "sched"},
+	})
+
+	args := s.requireContinuedAsNew()
+	s.Equal("sched", args.State.ScheduleID)
+	s.Equal(envStartTime, args.Info.CreateTime)
+	s.Len(args.Info.RecentActions, recentActionCount)
+}
+
+func (s *workflowEnvSuite) TestSignals() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, &SchedulePatch{Pause: "paused"})
+	}, 10*time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Equal("paused", desc.Schedule.State.Notes)
+		s.Zero(desc.Info.ActionCount)
+		// manual actions are taken while paused
+		s.env.SignalWorkflow(SignalNamePatch, &SchedulePatch{TriggerImmediately: &TriggerImmediatelyRequest{}})
+	}, 2*time.Hour)
+
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.Equal(int64(1), desc.Info.ActionCount)
+		s.Equal([]string{"wf-2022-06-01T02:30:00Z"}, s.actionWorkflowIDs(desc))
+
+		// an update with a stale conflict token is ignored
+		stale := s.hourly("stale")
+		s.env.SignalWorkflow(SignalNameUpdate, &FullUpdateRequest{Schedule: stale, ConflictToken: desc.ConflictToken + 1})
+		s.env.SignalWorkflow(SignalNameUpdate, &FullUpdateRequest{Schedule: s.hourly("wf2"), ConflictToken: desc.ConflictToken})
+	}, 2*time.Hour+time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Equal("wf2", desc.Schedule.Action.WorkflowId)
+		s.Equal(time.Date(2022, 6, 1, 2, 31, 0, 0, time.UTC), desc.Info.UpdateTime)
+		s.Equal(int64(2), desc.Info.ActionCount)
+		s.Equal([]string{"wf-2022-06-01T02:30:00Z", "wf2-2022-06-01T03:00:00Z"}, s.actionWorkflowIDs(desc))
+	}, 3*time.Hour+time.Minute)
+
+	s.env.ExecuteWorkflow(WorkflowType, &StartScheduleArgs{
+		Schedule: s.hourly("wf"),
+		State:    InternalState{Namespace: "ns", ScheduleID: "sched"},
+	})
+	s.requireContinuedAsNew()
+}
+
+func (s *workflowEnvSuite) TestContinueAsNew() {
+	s.env.ExecuteWorkflow(WorkflowType, &StartScheduleArgs{
+		Schedule:     s.hourly("wf"),
+		State:        InternalState{Namespace: "ns", ScheduleID: "sched"},
+		InitialPatch: &SchedulePatch{Pause: "initially paused"},
+	})
+
+	// a paused schedule wakes up once per scheduled time without taking actions
+	args := s.requireContinuedAsNew()
+	s.Nil(args.InitialPatch)
+	s.True(args.Schedule.State.Paused)
+	s.Equal("initially paused", args.Schedule.State.Notes)
+	s.Zero(args.Info.ActionCount)
+	s.Equal("ns", args.State.Namespace)
+	s.Equal("sched", args.State.ScheduleID)
+	s.Equal(envStartTime, args.Info.CreateTime)
+	s.Equal(int64(1), args.State.ConflictToken)
+	s.Equal(time.Date(2022, 6, 21, 19, 0, 0, 0, time.UTC), args.State.LastProcessedTime)
+}
@@ -435,7 +435,9 @@ func (s *Service) startBatcher() {
 		s.config.BatcherCfg,
 		s.metricsClient,
 		s.logger,
-		s.sdkClientFactory).Start(); err != nil {
+		s.sdkClientFactory,
+		s.historyClient,
+		s.namespaceRegistry).Start(); err != nil {
 		s.logger.Fatal(
 			"error starting batcher",
 			tag.Error(err),
@@ -0,0 +1,409 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitybackfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// Target visibility is looked up within this window around start (or close) time of execution,
+	// because visibility stores may keep timestamps with lower precision than primary persistence.
+	lookupTimeWindow = time.Second
+	lookupPageSize   = 100
+	maxLookupPages   = 10
+)
+
+type (
+	activities struct {
+		historyShardCount int32
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		targetProvider    func(target string) (manager.VisibilityManager, error)
+		logger            log.Logger
+	}
+
+	// backfillShardHeartbeatDetails is used to resume shard backfill from last processed page.
+	backfillShardHeartbeatDetails struct {
+		PageToken []byte
+		Report    BackfillReport
+	}
+
+	// executionRecord is visibility record rebuilt from mutable state.
+	executionRecord struct {
+		base          *manager.VisibilityRequestBase
+		closed        bool
+		closeTime     time.Time
+		historyLength int64
+	}
+)
+
+var (
+	errInvalidTarget = errors.New("invalid target visibility")
+)
+
+// GetMetadata returns history shard count.
+func (a *activities) GetMetadata(ctx context.Context) (*metadataResponse, error) {
+	return &metadataResponse{
+		ShardCount: a.historyShardCount,
+	}, nil
+}
+
+// BackfillShard rebuilds visibility records of all executions of the shard from mutable state
+// and writes them to target visibility, or reports differences with target visibility in dry run mode.
+func (a *activities) BackfillShard(ctx context.Context, request *backfillShardRequest) (*BackfillReport, error) {
+	target, err := a.targetProvider(request.TargetVisibility)
+	if err != nil {
+		if errors.Is(err, errInvalidTarget) {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), nonRetryableInvalidTargetError, err)
+		}
+		return nil, err
+	}
+
+	var hbd backfillShardHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			a.logger.Error("Failed to recover from last heartbeat, start over from beginning of shard.", tag.ShardID(request.ShardID), tag.Error(err))
+			hbd = backfillShardHeartbeatDetails{}
+		}
+	}
+
+	namespaceIDs := make(map[string]struct{}, len(request.NamespaceIDs))
+	for _, namespaceID := range request.NamespaceIDs {
+		namespaceIDs[namespaceID] = struct{}{}
+	}
+
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	for {
+		resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   request.ShardID,
+			PageSize:  request.PageSize,
+			PageToken: hbd.PageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mutableState := range resp.States {
+			hbd.Report.ExecutionsScanned++
+
+			if len(namespaceIDs) > 0 {
+				if _, ok := namespaceIDs[mutableState.GetExecutionInfo().GetNamespaceId()]; !ok {
+					hbd.Report.ExecutionsSkipped++
+					continue
+				}
+			}
+
+			record, err := a.buildExecutionRecord(ctx, request.ShardID, mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if record == nil {
+				hbd.Report.ExecutionsSkipped++
+				continue
+			}
+
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+
+			if request.DryRun {
+				if err := a.diffExecution(ctx, target, record, request, &hbd.Report); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if err := a.writeExecution(ctx, target, record); err != nil {
+				return nil, err
+			}
+			hbd.Report.ExecutionsWritten++
+		}
+
+		hbd.PageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, hbd)
+
+		if len(hbd.PageToken) == 0 {
+			break
+		}
+	}
+
+	hbd.Report.ShardCount = 1
+	a.logger.Info("Visibility backfill of shard is completed.",
+		tag.ShardID(request.ShardID),
+		tag.NewStringTag("target-visibility", request.TargetVisibility),
+		tag.NewBoolTag("dry-run", request.DryRun),
+		tag.NewInt64("executions-scanned", hbd.Report.ExecutionsScanned),
+		tag.NewInt64("executions-written", hbd.Report.ExecutionsWritten),
+		tag.NewInt64("executions-missing", hbd.Report.ExecutionsMissing),
+		tag.NewInt64("executions-mismatched", hbd.Report.ExecutionsMismatched),
+	)
+	return &hbd.Report, nil
+}
+
+// buildExecutionRecord rebuilds visibility record of execution the same way visibility queue task executor does.
+// Returns nil if execution doesn't have visibility record.
+func (a *activities) buildExecutionRecord(
+	ctx context.Context,
+	shardID int32,
+	mutableState *persistencespb.WorkflowMutableState,
+) (*executionRecord, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+
+	switch executionState.GetState() {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+	default:
+		// Zombie, void and corrupted executions are not visible to users.
+		return nil, nil
+	}
+
+	namespaceEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	if err != nil {
+		if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	record := &executionRecord{
+		base: &manager.VisibilityRequestBase{
+			NamespaceID: namespaceEntry.ID(),
+			Namespace:   namespaceEntry.Name(),
+			Execution: commonpb.WorkflowExecution{
+				WorkflowId: executionInfo.GetWorkflowId(),
+				RunId:      executionState.GetRunId(),
+			},
+			WorkflowTypeName:     executionInfo.GetWorkflowTypeName(),
+			StartTime:            timestamp.TimeValue(executionInfo.GetStartTime()),
+			ExecutionTime:        timestamp.TimeValue(executionInfo.GetExecutionTime()),
+			StateTransitionCount: executionInfo.GetStateTransitionCount(),
+			// Elasticsearch uses task ID as document version. Reuse task ID of last transaction
+			// to never overwrite a record which was written by newer visibility task.
+			TaskID:    executionInfo.GetLastEventTaskId(),
+			Status:    executionState.GetStatus(),
+			ShardID:   shardID,
+			TaskQueue: executionInfo.GetTaskQueue(),
+		},
+	}
+	if len(executionInfo.GetMemo()) != 0 {
+		record.base.Memo = &commonpb.Memo{Fields: executionInfo.GetMemo()}
+	}
+	if len(executionInfo.GetSearchAttributes()) != 0 {
+		record.base.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+	}
+
+	if executionState.GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return record, nil
+	}
+
+	record.closed = true
+	record.historyLength = mutableState.GetNextEventId() - 1
+	if executionInfo.GetCloseVisibilityTaskId() != 0 {
+		record.base.TaskID = executionInfo.GetCloseVisibilityTaskId()
+	}
+	record.closeTime, err = a.getCloseTime(ctx, shardID, mutableState)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// getCloseTime reads completion event of closed execution and returns its time.
+func (a *activities) getCloseTime(
+	ctx context.Context,
+	shardID int32,
+	mutableState *persistencespb.WorkflowMutableState,
+) (time.Time, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	historyEvents, _, _, err := persistence.ReadFullPageEvents(ctx, a.executionManager, &persistence.ReadHistoryBranchRequest{
+		BranchToken: currentVersionHistory.GetBranchToken(),
+		MinEventID:  executionInfo.GetCompletionEventBatchId(),
+		MaxEventID:  mutableState.GetNextEventId(),
+		PageSize:    1,
+		ShardID:     shardID,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(historyEvents) == 0 {
+		return time.Time{}, serviceerror.NewInternal(fmt.Sprintf("unable to find completion event of workflow %s", executionInfo.GetWorkflowId()))
+	}
+	// Completion event is always the last event of closed execution.
+	return timestamp.TimeValue(historyEvents[len(historyEvents)-1].GetEventTime()), nil
+}
+
+func (a *activities) writeExecution(
+	ctx context.Context,
+	target manager.VisibilityManager,
+	record *executionRecord,
+) error {
+	if record.closed {
+		return target.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+			VisibilityRequestBase: record.base,
+			CloseTime:             record.closeTime,
+			HistoryLength:         record.historyLength,
+		})
+	}
+
+	// Some stores ignore start record if execution already exists,
+	// upsert is required to bring search attributes and memo up to date.
+	if err := target.RecordWorkflowExecutionStarted(ctx, &manager.RecordWorkflowExecutionStartedRequest{
+		VisibilityRequestBase: record.base,
+	}); err != nil {
+		return err
+	}
+	return target.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+		VisibilityRequestBase: record.base,
+	})
+}
+
+// diffExecution looks up execution in target visibility and adds differences to the report.
+func (a *activities) diffExecution(
+	ctx context.Context,
+	target manager.VisibilityManager,
+	record *executionRecord,
+	request *backfillShardRequest,
+	report *BackfillReport,
+) error {
+	var existing *workflowpb.WorkflowExecutionInfo
+	var err error
+	if record.closed {
+		existing, err = a.findExecution(ctx, target.ListClosedWorkflowExecutionsByWorkflowID, record, record.closeTime)
+		if err != nil {
+			return err
+		}
+	}
+	if existing == nil {
+		existing, err = a.findExecution(ctx, target.ListOpenWorkflowExecutionsByWorkflowID, record, record.base.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	var reason string
+	switch {
+	case existing == nil:
+		report.ExecutionsMissing++
+		reason = "record is missing"
+	default:
+		reason = compareExecution(record, existing)
+		if reason == "" {
+			return nil
+		}
+		report.ExecutionsMismatched++
+	}
+
+	report.addMismatch(Mismatch{
+		ShardID:     request.ShardID,
+		NamespaceID: record.base.NamespaceID.String(),
+		WorkflowID:  record.base.Execution.GetWorkflowId(),
+		RunID:       record.base.Execution.GetRunId(),
+		Reason:      reason,
+	}, request.MaxReportedMismatches)
+	return nil
+}
+
+func (a *activities) findExecution(
+	ctx context.Context,
+	listFn func(context.Context, *manager.ListWorkflowExecutionsByWorkflowIDRequest) (*manager.ListWorkflowExecutionsResponse, error),
+	record *executionRecord,
+	around time.Time,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	request := &manager.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: &manager.ListWorkflowExecutionsRequest{
+			NamespaceID:       record.base.NamespaceID,
+			Namespace:         record.base.Namespace,
+			EarliestStartTime: around.Add(-lookupTimeWindow),
+			LatestStartTime:   around.Add(lookupTimeWindow),
+			PageSize:          lookupPageSize,
+		},
+		WorkflowID: record.base.Execution.GetWorkflowId(),
+	}
+
+	for page := 0; page < maxLookupPages; page++ {
+		resp, err := listFn(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			if execution.GetExecution().GetRunId() == record.base.Execution.GetRunId() {
+				return execution, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	return nil, nil
+}
+
+// compareExecution returns description of the first difference between rebuilt and existing records
+// or empty string if they are equal.
+func compareExecution(record *executionRecord, existing *workflowpb.WorkflowExecutionInfo) string {
+	if existing.GetStatus() != record.base.Status {
+		return fmt.Sprintf("status is %v, expected %v", existing.GetStatus(), record.base.Status)
+	}
+	if existing.GetType().GetName() != record.base.WorkflowTypeName {
+		return fmt.Sprintf("workflow type is %q, expected %q", existing.GetType().GetName(), record.base.WorkflowTypeName)
+	}
+	if existing.GetTaskQueue() != record.base.TaskQueue {
+		return fmt.Sprintf("task queue is %q, expected %q", existing.GetTaskQueue(), record.base.TaskQueue)
+	}
+	if record.closed && existing.GetHistoryLength() != record.historyLength {
+		return fmt.Sprintf("history length is %d, expected %d", existing.GetHistoryLength(), record.historyLength)
+	}
+	return ""
+}
@@ -0,0 +1,224 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitybackfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+func newMutableState(namespaceID string, workflowID string, runID string, state enumsspb.WorkflowExecutionState) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:      namespaceID,
+			WorkflowId:       workflowID,
+			WorkflowTypeName: "workflow-type",
+			TaskQueue:        "task-queue",
+			LastEventTaskId:  100,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  runID,
+			State:  state,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+		NextEventId: 5,
+	}
+}
+
+func newTestActivities(t *testing.T) (*activities, *persistence.MockExecutionManager, *manager.MockVisibilityManager) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+		namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Id: "namespace-id", Name: "namespace"}, nil, "active"), nil,
+	).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("deleted-namespace-id")).Return(
+		nil, serviceerror.NewNamespaceNotFound("deleted-namespace-id"),
+	).AnyTimes()
+
+	a := &activities{
+		historyShardCount: 4,
+		executionManager:  executionManager,
+		namespaceRegistry: namespaceRegistry,
+		targetProvider: func(target string) (manager.VisibilityManager, error) {
+			if target != TargetAdvanced {
+				return nil, errInvalidTarget
+			}
+			return visibilityManager, nil
+		},
+		logger: log.NewNoopLogger(),
+	}
+	return a, executionManager, visibilityManager
+}
+
+func Test_BackfillShard(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a, executionManager, visibilityManager := newTestActivities(t)
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: 2,
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("namespace-id", "workflow-id-1", "run-id-1", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+			newMutableState("namespace-id", "workflow-id-2", "run-id-2", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE),
+		},
+		PageToken: []byte("page-token"),
+	}, nil)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+		ShardID:   1,
+		PageSize:  2,
+		PageToken: []byte("page-token"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("deleted-namespace-id", "workflow-id-3", "run-id-3", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+		},
+	}, nil)
+
+	visibilityManager.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionStartedRequest) error {
+			require.Equal(t, namespace.Name("namespace"), request.Namespace)
+			require.Equal(t, "workflow-id-1", request.Execution.GetWorkflowId())
+			require.Equal(t, "run-id-1", request.Execution.GetRunId())
+			require.Equal(t, "workflow-type", request.WorkflowTypeName)
+			require.Equal(t, int64(100), request.TaskID)
+			require.Equal(t, int32(1), request.ShardID)
+			return nil
+		})
+	visibilityManager.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+
+	env.RegisterActivity(a.BackfillShard)
+	val, err := env.ExecuteActivity(a.BackfillShard, &backfillShardRequest{
+		ShardID:          1,
+		TargetVisibility: TargetAdvanced,
+		RPS:              100,
+		PageSize:         2,
+	})
+	require.NoError(t, err)
+
+	var report BackfillReport
+	require.NoError(t, val.Get(&report))
+	require.Equal(t, 1, report.ShardCount)
+	require.Equal(t, int64(3), report.ExecutionsScanned)
+	require.Equal(t, int64(2), report.ExecutionsSkipped)
+	require.Equal(t, int64(1), report.ExecutionsWritten)
+}
+
+func Test_BackfillShard_DryRun(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a, executionManager, visibilityManager := newTestActivities(t)
+
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			newMutableState("namespace-id", "workflow-id-1", "run-id-1", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+			newMutableState("namespace-id", "workflow-id-2", "run-id-2", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+			newMutableState("namespace-id", "workflow-id-3", "run-id-3", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING),
+		},
+	}, nil)
+
+	visibilityManager.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.ListWorkflowExecutionsByWorkflowIDRequest) (*manager.ListWorkflowExecutionsResponse, error) {
+			switch request.WorkflowID {
+			case "workflow-id-1":
+				return &manager.ListWorkflowExecutionsResponse{
+					Executions: []*workflowpb.WorkflowExecutionInfo{{
+						Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id-1", RunId: "run-id-1"},
+						Type:      &commonpb.WorkflowType{Name: "workflow-type"},
+						Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+						TaskQueue: "task-queue",
+					}},
+				}, nil
+			case "workflow-id-2":
+				return &manager.ListWorkflowExecutionsResponse{
+					Executions: []*workflowpb.WorkflowExecutionInfo{{
+						Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id-2", RunId: "run-id-2"},
+						Type:      &commonpb.WorkflowType{Name: "other-workflow-type"},
+						Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+						TaskQueue: "task-queue",
+					}},
+				}, nil
+			default:
+				return &manager.ListWorkflowExecutionsResponse{}, nil
+			}
+		}).Times(3)
+
+	env.RegisterActivity(a.BackfillShard)
+	val, err := env.ExecuteActivity(a.BackfillShard, &backfillShardRequest{
+		ShardID:               1,
+		TargetVisibility:      TargetAdvanced,
+		RPS:                   100,
+		PageSize:              10,
+		MaxReportedMismatches: 10,
+		DryRun:                true,
+	})
+	require.NoError(t, err)
+
+	var report BackfillReport
+	require.NoError(t, val.Get(&report))
+	require.Equal(t, int64(3), report.ExecutionsScanned)
+	require.Equal(t, int64(0), report.ExecutionsWritten)
+	require.Equal(t, int64(1), report.ExecutionsMissing)
+	require.Equal(t, int64(1), report.ExecutionsMismatched)
+	require.Len(t, report.Mismatches, 2)
+	require.Equal(t, "workflow-id-2", report.Mismatches[0].WorkflowID)
+	require.Equal(t, "workflow-id-3", report.Mismatches[1].WorkflowID)
+	require.Equal(t, "record is missing", report.Mismatches[1].Reason)
+}
+
+func Test_BackfillShard_InvalidTarget(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	a, _, _ := newTestActivities(t)
+
+	env.RegisterActivity(a.BackfillShard)
+	_, err := env.ExecuteActivity(a.BackfillShard, &backfillShardRequest{
+		ShardID:          1,
+		TargetVisibility: TargetSecondary,
+	})
+	require.Error(t, err)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, nonRetryableInvalidTargetError, appErr.Type())
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitybackfill
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/fx"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+)
+
+type (
+	initParams struct {
+		fx.In
+		PersistenceConfig          *config.Persistence
+		PersistenceServiceResolver resolver.ServiceResolver
+		ExecutionManager           persistence.ExecutionManager
+		NamespaceRegistry          namespace.Registry
+		EsConfig                   *esclient.Config
+		EsClient                   esclient.Client
+		SearchAttributesProvider   searchattribute.Provider
+		SearchAttributesMapper     searchattribute.Mapper
+		DynamicCollection          *dynamicconfig.Collection
+		Logger                     log.Logger
+		MetricsClient              metrics.Client
+	}
+
+	fxResult struct {
+		fx.Out
+		Component workercommon.WorkerComponent `group:"workerComponent"`
+	}
+
+	visibilityBackfillComponent struct {
+		initParams
+
+		targetsLock sync.Mutex
+		// Target visibility managers are created on first use and reused by all activities.
+		// Unlike the worker visibility manager, they are able to write.
+		targets map[string]manager.VisibilityManager
+	}
+)
+
+var Module = fx.Options(
+	fx.Provide(NewResult),
+)
+
+func NewResult(params initParams) fxResult {
+	component := &visibilityBackfillComponent{
+		initParams: params,
+		targets:    make(map[string]manager.VisibilityManager),
+	}
+	return fxResult{
+		Component: component,
+	}
+}
+
+func (wc *visibilityBackfillComponent) Register(worker sdkworker.Worker) {
+	worker.RegisterWorkflowWithOptions(VisibilityBackfillWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	worker.RegisterActivity(wc.activities())
+}
+
+func (wc *visibilityBackfillComponent) DedicatedWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *visibilityBackfillComponent) activities() *activities {
+	return &activities{
+		historyShardCount: wc.PersistenceConfig.NumHistoryShards,
+		executionManager:  wc.ExecutionManager,
+		namespaceRegistry: wc.NamespaceRegistry,
+		targetProvider:    wc.getTarget,
+		logger:            wc.Logger,
+	}
+}
+
+func (wc *visibilityBackfillComponent) getTarget(target string) (manager.VisibilityManager, error) {
+	wc.targetsLock.Lock()
+	defer wc.targetsLock.Unlock()
+
+	if visibilityManager, ok := wc.targets[target]; ok {
+		return visibilityManager, nil
+	}
+
+	visibilityManager, err := wc.newTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	if visibilityManager == nil {
+		return nil, fmt.Errorf("%w: %s visibility is not configured", errInvalidTarget, target)
+	}
+	wc.targets[target] = visibilityManager
+	return visibilityManager, nil
+}
+
+func (wc *visibilityBackfillComponent) newTarget(target string) (manager.VisibilityManager, error) {
+	dc := wc.DynamicCollection
+	readQPS := dc.GetIntProperty(dynamicconfig.StandardVisibilityPersistenceMaxReadQPS, 9000)
+	writeQPS := dc.GetIntProperty(dynamicconfig.StandardVisibilityPersistenceMaxWriteQPS, 9000)
+	advancedReadQPS := dc.GetIntProperty(dynamicconfig.AdvancedVisibilityPersistenceMaxReadQPS, 9000)
+	advancedWriteQPS := dc.GetIntProperty(dynamicconfig.AdvancedVisibilityPersistenceMaxWriteQPS, 9000)
+
+	switch target {
+	case TargetStandard:
+		if !wc.PersistenceConfig.StandardVisibilityConfigExist() {
+			return nil, nil
+		}
+		return visibility.NewStandardManager(
+			*wc.PersistenceConfig,
+			wc.PersistenceServiceResolver,
+			wc.EsConfig.GetVisibilityIndex(),
+			wc.SearchAttributesProvider,
+			wc.SearchAttributesMapper,
+			readQPS,
+			writeQPS,
+			wc.MetricsClient,
+			wc.Logger,
+		)
+	case TargetAdvanced, TargetSecondary:
+		indexName := wc.EsConfig.GetVisibilityIndex()
+		if target == TargetSecondary {
+			indexName = wc.EsConfig.GetSecondaryVisibilityIndex()
+		}
+		return visibility.NewAdvancedManager(
+			indexName,
+			wc.EsClient,
+			wc.esProcessorConfig(),
+			wc.SearchAttributesProvider,
+			wc.SearchAttributesMapper,
+			advancedReadQPS,
+			advancedWriteQPS,
+			wc.MetricsClient,
+			wc.Logger,
+		)
+	default:
+		return nil, fmt.Errorf("%w: %q", errInvalidTarget, target)
+	}
+}
+
+func (wc *visibilityBackfillComponent) esProcessorConfig() *elasticsearch.ProcessorConfig {
+	dc := wc.DynamicCollection
+	return &elasticsearch.ProcessorConfig{
+		IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 100),
+		ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
+		ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 500),
+		ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 16*1024*1024),
+		ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
+		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.WorkerESProcessorAckTimeout, 1*time.Minute),
+	}
+}
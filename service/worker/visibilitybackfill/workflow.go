@@ -0,0 +1,281 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitybackfill
+
+import (
+	"errors"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	WorkflowName = "temporal-sys-visibility-backfill-workflow"
+
+	// TargetStandard is the standard visibility store (Cassandra or SQL).
+	TargetStandard = "standard"
+	// TargetAdvanced is the primary advanced visibility index (Elasticsearch).
+	TargetAdvanced = "advanced"
+	// TargetSecondary is the secondary advanced visibility index (Elasticsearch).
+	TargetSecondary = "secondary"
+
+	visibilityBackfillStatusQueryType = "visibility-backfill-status"
+
+	defaultPageSize                = 500
+	defaultRPS                     = 100
+	defaultShardCountPerExecution  = 64
+	maxShardCountPerExecution      = 1000
+	defaultMaxReportedMismatches   = 100
+	nonRetryableInvalidTargetError = "InvalidTarget"
+)
+
+type (
+	VisibilityBackfillParams struct {
+		TargetVisibility        string   // one of standard, advanced or secondary
+		ShardIDs                []int32  // shards to backfill, all shards are backfilled if empty
+		NamespaceIDs            []string // backfill only executions of these namespaces, all namespaces if empty
+		OverallRps              float64  // RPS of visibility writes (or reads in dry run mode) across all shards
+		ConcurrentActivityCount int      // number of shards processed concurrently
+		PageSize                int      // PageSize of ListConcreteExecutions
+		ShardCountPerExecution  int      // number of shards to be processed before continue as new, max is 1000.
+		MaxReportedMismatches   int      // max number of mismatches kept in report in dry run mode
+		DryRun                  bool     // compare target visibility with primary persistence instead of writing to it
+
+		// Used by continue as new
+		NextShardIndex      int
+		Report              BackfillReport
+		ContinuedAsNewCount int
+	}
+
+	VisibilityBackfillStatus struct {
+		TotalShardCount     int
+		NextShardIndex      int
+		ContinuedAsNewCount int
+		Report              BackfillReport
+	}
+
+	// BackfillReport aggregates results of processed shards.
+	BackfillReport struct {
+		ShardCount        int
+		ExecutionsScanned int64
+		ExecutionsSkipped int64 // executions which don't need visibility records (zombie, deleted namespace, etc.)
+		ExecutionsWritten int64
+
+		// Dry run only.
+		ExecutionsMissing    int64 // executions which have no record in target visibility
+		ExecutionsMismatched int64 // executions which have stale record in target visibility
+		Mismatches           []Mismatch
+	}
+
+	// Mismatch describes a single difference between primary persistence and target visibility.
+	Mismatch struct {
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+	}
+
+	metadataResponse struct {
+		ShardCount int32
+	}
+
+	backfillShardRequest struct {
+		ShardID               int32
+		TargetVisibility      string
+		NamespaceIDs          []string
+		RPS                   float64
+		PageSize              int
+		MaxReportedMismatches int
+		DryRun                bool
+	}
+)
+
+var (
+	visibilityBackfillActivityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:        time.Second,
+		MaximumInterval:        time.Second * 10,
+		NonRetryableErrorTypes: []string{nonRetryableInvalidTargetError},
+	}
+)
+
+func VisibilityBackfillWorkflow(ctx workflow.Context, params VisibilityBackfillParams) (BackfillReport, error) {
+	workflow.SetQueryHandler(ctx, visibilityBackfillStatusQueryType, func() (VisibilityBackfillStatus, error) {
+		return VisibilityBackfillStatus{
+			TotalShardCount:     len(params.ShardIDs),
+			NextShardIndex:      params.NextShardIndex,
+			ContinuedAsNewCount: params.ContinuedAsNewCount,
+			Report:              params.Report,
+		}, nil
+	})
+
+	if err := validateAndSetVisibilityBackfillParams(&params); err != nil {
+		return params.Report, err
+	}
+
+	if len(params.ShardIDs) == 0 {
+		metadataResp, err := getMetadata(ctx)
+		if err != nil {
+			return params.Report, err
+		}
+		for shardID := int32(1); shardID <= metadataResp.ShardCount; shardID++ {
+			params.ShardIDs = append(params.ShardIDs, shardID)
+		}
+	}
+
+	lastShardIndex := params.NextShardIndex + params.ShardCountPerExecution
+	if lastShardIndex > len(params.ShardIDs) {
+		lastShardIndex = len(params.ShardIDs)
+	}
+
+	if err := backfillShards(ctx, &params, params.ShardIDs[params.NextShardIndex:lastShardIndex]); err != nil {
+		return params.Report, err
+	}
+	params.NextShardIndex = lastShardIndex
+
+	if params.NextShardIndex >= len(params.ShardIDs) {
+		return params.Report, nil
+	}
+
+	params.ContinuedAsNewCount++
+
+	// There are still more shards to backfill. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return params.Report, workflow.NewContinueAsNewError(ctx, VisibilityBackfillWorkflow, params)
+}
+
+func validateAndSetVisibilityBackfillParams(params *VisibilityBackfillParams) error {
+	switch params.TargetVisibility {
+	case TargetStandard, TargetAdvanced, TargetSecondary:
+	default:
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: TargetVisibility must be one of standard, advanced or secondary", nonRetryableInvalidTargetError, nil)
+	}
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = 1
+	}
+	if params.OverallRps <= 0 {
+		params.OverallRps = defaultRPS
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.ShardCountPerExecution <= 0 {
+		params.ShardCountPerExecution = defaultShardCountPerExecution
+	}
+	if params.ShardCountPerExecution > maxShardCountPerExecution {
+		params.ShardCountPerExecution = maxShardCountPerExecution
+	}
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = defaultMaxReportedMismatches
+	}
+	if params.NextShardIndex < 0 || params.NextShardIndex > len(params.ShardIDs) {
+		return errors.New("InvalidArgument: NextShardIndex is out of range")
+	}
+
+	return nil
+}
+
+func getMetadata(ctx workflow.Context) (metadataResponse, error) {
+	var a *activities
+
+	lao := workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy:         visibilityBackfillActivityRetryPolicy,
+	}
+
+	actx := workflow.WithLocalActivityOptions(ctx, lao)
+	var metadataResp metadataResponse
+	err := workflow.ExecuteLocalActivity(actx, a.GetMetadata).Get(ctx, &metadataResp)
+	return metadataResp, err
+}
+
+func backfillShards(ctx workflow.Context, params *VisibilityBackfillParams, shardIDs []int32) error {
+	selector := workflow.NewSelector(ctx)
+	pendingActivities := 0
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         visibilityBackfillActivityRetryPolicy,
+	}
+
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+	var backfillErr error
+
+	for _, shardID := range shardIDs {
+		backfillFuture := workflow.ExecuteActivity(actx, a.BackfillShard, &backfillShardRequest{
+			ShardID:               shardID,
+			TargetVisibility:      params.TargetVisibility,
+			NamespaceIDs:          params.NamespaceIDs,
+			RPS:                   params.OverallRps / float64(params.ConcurrentActivityCount),
+			PageSize:              params.PageSize,
+			MaxReportedMismatches: params.MaxReportedMismatches,
+			DryRun:                params.DryRun,
+		})
+
+		pendingActivities++
+		selector.AddFuture(backfillFuture, func(f workflow.Future) {
+			pendingActivities--
+			var shardReport BackfillReport
+			if err := f.Get(ctx, &shardReport); err != nil {
+				if backfillErr == nil {
+					backfillErr = err
+				}
+				return
+			}
+			params.Report.merge(shardReport, params.MaxReportedMismatches)
+		})
+
+		if pendingActivities == params.ConcurrentActivityCount {
+			selector.Select(ctx) // this will block until one of the in-flight activities completes
+		}
+	}
+
+	for pendingActivities > 0 {
+		selector.Select(ctx)
+	}
+
+	return backfillErr
+}
+
+func (r *BackfillReport) merge(other BackfillReport, maxReportedMismatches int) {
+	r.ShardCount += other.ShardCount
+	r.ExecutionsScanned += other.ExecutionsScanned
+	r.ExecutionsSkipped += other.ExecutionsSkipped
+	r.ExecutionsWritten += other.ExecutionsWritten
+	r.ExecutionsMissing += other.ExecutionsMissing
+	r.ExecutionsMismatched += other.ExecutionsMismatched
+	for _, mismatch := range other.Mismatches {
+		r.addMismatch(mismatch, maxReportedMismatches)
+	}
+}
+
+func (r *BackfillReport) addMismatch(mismatch Mismatch, maxReportedMismatches int) {
+	if len(r.Mismatches) < maxReportedMismatches {
+		r.Mismatches = append(r.Mismatches, mismatch)
+	}
+}
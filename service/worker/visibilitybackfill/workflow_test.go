@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitybackfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestVisibilityBackfillWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything).Return(&metadataResponse{ShardCount: 4}, nil)
+
+	var processedShards []int32
+	env.OnActivity(a.BackfillShard, mock.Anything, mock.Anything).Return(func(_ context.Context, request *backfillShardRequest) (*BackfillReport, error) {
+		assert.Equal(t, TargetAdvanced, request.TargetVisibility)
+		assert.Equal(t, float64(5), request.RPS)
+		assert.True(t, request.DryRun)
+		processedShards = append(processedShards, request.ShardID)
+		return &BackfillReport{
+			ShardCount:        1,
+			ExecutionsScanned: 10,
+			ExecutionsMissing: 1,
+			Mismatches: []Mismatch{
+				{ShardID: request.ShardID, Reason: "record is missing"},
+			},
+		}, nil
+	}).Times(4)
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		TargetVisibility:        TargetAdvanced,
+		OverallRps:              10,
+		ConcurrentActivityCount: 2,
+		MaxReportedMismatches:   3,
+		DryRun:                  true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	assert.ElementsMatch(t, []int32{1, 2, 3, 4}, processedShards)
+
+	var report BackfillReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	assert.Equal(t, 4, report.ShardCount)
+	assert.Equal(t, int64(40), report.ExecutionsScanned)
+	assert.Equal(t, int64(4), report.ExecutionsMissing)
+	assert.Len(t, report.Mismatches, 3)
+
+	envValue, err := env.QueryWorkflow(visibilityBackfillStatusQueryType)
+	require.NoError(t, err)
+
+	var status VisibilityBackfillStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 4, status.TotalShardCount)
+	assert.Equal(t, 4, status.NextShardIndex)
+	assert.Equal(t, 0, status.ContinuedAsNewCount)
+	assert.Equal(t, 4, status.Report.ShardCount)
+}
+
+func TestVisibilityBackfillWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.BackfillShard, mock.Anything, mock.Anything).Return(&BackfillReport{
+		ShardCount:        1,
+		ExecutionsScanned: 10,
+		ExecutionsWritten: 10,
+	}, nil).Times(2)
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		TargetVisibility:       TargetStandard,
+		ShardIDs:               []int32{3, 5, 7},
+		ShardCountPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "continue as new")
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(visibilityBackfillStatusQueryType)
+	require.NoError(t, err)
+
+	var status VisibilityBackfillStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 3, status.TotalShardCount)
+	assert.Equal(t, 2, status.NextShardIndex)
+	assert.Equal(t, 1, status.ContinuedAsNewCount)
+	assert.Equal(t, int64(20), status.Report.ExecutionsWritten)
+}
+
+func TestVisibilityBackfillWorkflow_InvalidTarget(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(VisibilityBackfillWorkflow, VisibilityBackfillParams{
+		TargetVisibility: "unknown",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+}
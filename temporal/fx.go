@@ -40,6 +40,7 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -47,6 +48,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -106,6 +108,7 @@ type (
 
 		SearchAttributesMapper searchattribute.Mapper
 		CustomInterceptors     []grpc.UnaryServerInterceptor
+		CustomArchivers        *provider.CustomArchivers
 		Authorizer             authorization.Authorizer
 		ClaimMapper            authorization.ClaimMapper
 		AudienceGetter         authorization.JWTAudienceMapper
@@ -266,6 +269,7 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 
 		SearchAttributesMapper: so.searchAttributesMapper,
 		CustomInterceptors:     so.customInterceptors,
+		CustomArchivers:        so.customArchivers,
 		Authorizer:             so.authorizer,
 		ClaimMapper:            so.claimMapper,
 		AudienceGetter:         so.audienceGetter,
@@ -327,6 +331,7 @@ type (
 		PersistenceFactoryProvider persistenceClient.FactoryProviderFn
 		SearchAttributesMapper     searchattribute.Mapper
 		CustomInterceptors         []grpc.UnaryServerInterceptor
+		CustomArchivers            *provider.CustomArchivers
 		Authorizer                 authorization.Authorizer
 		ClaimMapper                authorization.ClaimMapper
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
@@ -363,8 +368,11 @@ func HistoryServiceProvider(
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
-		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func(namespaceRegistry namespace.Registry) searchattribute.Mapper {
+			return namespace.NewSearchAttributesMapper(namespaceRegistry, params.SearchAttributesMapper)
+		}),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
+		fx.Provide(func() *provider.CustomArchivers { return params.CustomArchivers }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
@@ -420,8 +428,11 @@ func MatchingServiceProvider(
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
-		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func(namespaceRegistry namespace.Registry) searchattribute.Mapper {
+			return namespace.NewSearchAttributesMapper(namespaceRegistry, params.SearchAttributesMapper)
+		}),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
+		fx.Provide(func() *provider.CustomArchivers { return params.CustomArchivers }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
@@ -475,8 +486,11 @@ func FrontendServiceProvider(
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
-		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func(namespaceRegistry namespace.Registry) searchattribute.Mapper {
+			return namespace.NewSearchAttributesMapper(namespaceRegistry, params.SearchAttributesMapper)
+		}),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
+		fx.Provide(func() *provider.CustomArchivers { return params.CustomArchivers }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
@@ -531,8 +545,11 @@ func WorkerServiceProvider(
 		fx.Provide(func() client.FactoryProvider { return params.ClientFactoryProvider }),
 		fx.Provide(func() authorization.JWTAudienceMapper { return params.AudienceGetter }),
 		fx.Provide(func() resolver.ServiceResolver { return params.PersistenceServiceResolver }),
-		fx.Provide(func() searchattribute.Mapper { return params.SearchAttributesMapper }),
+		fx.Provide(func(namespaceRegistry namespace.Registry) searchattribute.Mapper {
+			return namespace.NewSearchAttributesMapper(namespaceRegistry, params.SearchAttributesMapper)
+		}),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
+		fx.Provide(func() *provider.CustomArchivers { return params.CustomArchivers }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
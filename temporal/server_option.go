@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -173,6 +174,7 @@ func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) Ser
 }
 
 // WithSearchAttributesMapper sets a custom search attributes mapper which converts search attributes aliases to field names and vice versa.
+// If not set, per-namespace aliases persisted in namespace config are used.
 func WithSearchAttributesMapper(m searchattribute.Mapper) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
 		s.searchAttributesMapper = m
@@ -190,3 +192,27 @@ func WithChainedFrontendGrpcInterceptors(
 		s.customInterceptors = interceptors
 	})
 }
+
+// WithCustomHistoryArchiver registers a factory for history archivers with the given URI scheme, so that
+// namespaces can archive to storage not supported by the server. The factory is passed the YAML block
+// configured under archival.history.provider.<scheme>. Built-in schemes can't be overridden.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithCustomHistoryArchiver(scheme string, factory provider.HistoryArchiverFactory) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		if err := s.customArchivers.RegisterHistoryArchiver(scheme, factory); err != nil && s.customArchiversErr == nil {
+			s.customArchiversErr = err
+		}
+	})
+}
+
+// WithCustomVisibilityArchiver registers a factory for visibility archivers with the given URI scheme.
+// The factory is passed the YAML block configured under archival.visibility.provider.<scheme>.
+// Built-in schemes can't be overridden.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithCustomVisibilityArchiver(scheme string, factory provider.VisibilityArchiverFactory) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		if err := s.customArchivers.RegisterVisibilityArchiver(scheme, factory); err != nil && s.customArchiversErr == nil {
+			s.customArchiversErr = err
+		}
+	})
+}
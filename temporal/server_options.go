@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -68,6 +69,8 @@ type (
 		clientFactoryProvider      client.FactoryProvider
 		searchAttributesMapper     searchattribute.Mapper
 		customInterceptors         []grpc.UnaryServerInterceptor
+		customArchivers            *provider.CustomArchivers
+		customArchiversErr         error
 	}
 )
 
@@ -75,6 +78,7 @@ func newServerOptions(opts []ServerOption) *serverOptions {
 	so := &serverOptions{
 		// Set defaults here.
 		persistenceServiceResolver: resolver.NewNoopResolver(),
+		customArchivers:            provider.NewCustomArchivers(),
 	}
 	for _, opt := range opts {
 		opt.apply(so)
@@ -84,6 +88,10 @@ func newServerOptions(opts []ServerOption) *serverOptions {
 }
 
 func (so *serverOptions) loadAndValidate() error {
+	if so.customArchiversErr != nil {
+		return fmt.Errorf("invalid custom archiver: %w", so.customArchiversErr)
+	}
+
 	for serviceName := range so.serviceNames {
 		if !isValidService(serviceName) {
 			return fmt.Errorf("invalid service %q in service list %v", serviceName, so.serviceNames)